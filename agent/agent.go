@@ -725,7 +725,7 @@ func (a *Agent) runTask(ctx context.Context, tcInput *taskContext, nt *apimodels
 		defer shutdown(ctx)
 	}
 
-	go tc.resourceMonitor.start(tskCtx)
+	go tc.resourceMonitor.start(tskCtx, tc.diskDevices)
 
 	tc.setHeartbeatTimeout(heartbeatTimeoutOptions{})
 	preAndMainCtx, preAndMainCancel := context.WithCancel(tskCtx)
@@ -1159,6 +1159,7 @@ func (a *Agent) handleTimeoutAndOOM(ctx context.Context, tc *taskContext, detail
 			rcInfo.CPUConstrained, rcInfo.PeakCPUPercent, rcInfo.MemoryConstrained, rcInfo.PeakMemoryPercent)
 		detail.ResourceConstraints = rcInfo
 	}
+	detail.ResourceUsage = tc.resourceMonitor.usage()
 }
 
 // finishTask finishes up a running task. It runs any post-task command blocks
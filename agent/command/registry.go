@@ -27,6 +27,9 @@ func init() {
 		"archive.zip_extract":                   zipExtractFactory,
 		evergreen.AttachResultsCommandName:      attachResultsFactory,
 		evergreen.AttachXUnitResultsCommandName: xunitResultsFactory,
+		evergreen.AttachTAPResultsCommandName:   tapResultsFactory,
+		evergreen.AttachTRXResultsCommandName:   trxResultsFactory,
+		evergreen.AttachCucumberJSONCommandName: cucumberJSONResultsFactory,
 		evergreen.AttachArtifactsCommandName:    attachArtifactsFactory,
 		evergreen.HostCreateCommandName:         createHostFactory,
 		"ec2.assume_role":                       ec2AssumeRoleFactory,
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+// cucumberFeature is a single feature in a Cucumber JSON report.
+type cucumberFeature struct {
+	Name     string            `json:"name"`
+	URI      string            `json:"uri"`
+	Elements []cucumberElement `json:"elements"`
+}
+
+// cucumberElement is either a scenario or a background within a feature.
+type cucumberElement struct {
+	Name           string         `json:"name"`
+	Type           string         `json:"type"`
+	Keyword        string         `json:"keyword"`
+	StartTimestamp string         `json:"start_timestamp"`
+	Steps          []cucumberStep `json:"steps"`
+}
+
+type cucumberStep struct {
+	Name    string `json:"name"`
+	Keyword string `json:"keyword"`
+	Result  struct {
+		Status string `json:"status"`
+		// Duration is in nanoseconds.
+		Duration     int64  `json:"duration"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"result"`
+}
+
+const cucumberBackgroundType = "background"
+
+// parseCucumberJSONResults parses a Cucumber JSON report. Each scenario is
+// reported as a single test case named after its feature and scenario. Steps
+// from a background are included in the scenario that follows it.
+func parseCucumberJSONResults(ctx context.Context, reader io.Reader) ([]reportTestCase, error) {
+	var features []cucumberFeature
+	if err := json.NewDecoder(reader).Decode(&features); err != nil {
+		return nil, errors.Wrap(err, "decoding Cucumber JSON report")
+	}
+
+	var testCases []reportTestCase
+	for _, feature := range features {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "context cancelled during Cucumber JSON parsing")
+		}
+
+		featureName := feature.Name
+		if featureName == "" {
+			featureName = feature.URI
+		}
+
+		var background []cucumberStep
+		for _, element := range feature.Elements {
+			if element.Type == cucumberBackgroundType {
+				background = append(background, element.Steps...)
+				continue
+			}
+
+			steps := append([]cucumberStep{}, background...)
+			steps = append(steps, element.Steps...)
+			background = nil
+			testCases = append(testCases, element.toReportTestCase(featureName, steps))
+		}
+	}
+
+	return testCases, nil
+}
+
+func (e cucumberElement) toReportTestCase(featureName string, steps []cucumberStep) reportTestCase {
+	tc := reportTestCase{
+		Name:   fmt.Sprintf("%s.%s", featureName, e.Name),
+		Status: evergreen.TestSucceededStatus,
+	}
+	if start, err := time.Parse(time.RFC3339Nano, e.StartTimestamp); err == nil {
+		tc.StartTime = start
+	}
+
+	var failed, skipped bool
+	for _, step := range steps {
+		tc.Duration += time.Duration(step.Result.Duration)
+
+		switch step.Result.Status {
+		case "failed", "ambiguous":
+			failed = true
+			tc.Output = append(tc.Output, fmt.Sprintf("%s: %s%s", strings.ToUpper(step.Result.Status), step.Keyword, step.Name))
+			tc.Output = append(tc.Output, splitOutputLines(step.Result.ErrorMessage)...)
+		case "skipped", "pending", "undefined":
+			skipped = true
+		}
+	}
+
+	switch {
+	case failed:
+		tc.Status = evergreen.TestFailedStatus
+	case skipped:
+		tc.Status = evergreen.TestSkippedStatus
+	}
+
+	return tc
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCucumberJSONResults(t *testing.T) {
+	cwd := testutil.GetDirectoryOfFile()
+
+	t.Run("ParsesScenarios", func(t *testing.T) {
+		file, err := os.Open(filepath.Join(cwd, "testdata", "cucumber", "results.json"))
+		require.NoError(t, err)
+		defer file.Close()
+
+		testCases, err := parseCucumberJSONResults(t.Context(), file)
+		require.NoError(t, err)
+		require.Len(t, testCases, 3)
+
+		assert.Equal(t, "Login.valid credentials", testCases[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[0].Status)
+		assert.Equal(t, 6*time.Millisecond, testCases[0].Duration, "background steps should count toward the scenario")
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), testCases[0].StartTime)
+		assert.Empty(t, testCases[0].Output)
+
+		assert.Equal(t, "Login.invalid credentials", testCases[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, testCases[1].Status)
+		assert.Equal(t, 5*time.Millisecond, testCases[1].Duration)
+		assert.Equal(t, []string{
+			"FAILED: Then I see an error",
+			"expected error banner",
+			"but found none",
+		}, testCases[1].Output)
+
+		assert.Equal(t, "Login.password reset", testCases[2].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, testCases[2].Status)
+	})
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := parseCucumberJSONResults(t.Context(), strings.NewReader("{"))
+		assert.Error(t, err)
+	})
+}
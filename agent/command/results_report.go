@@ -0,0 +1,216 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// reportParser parses a single test report file into its test cases.
+type reportParser func(ctx context.Context, reader io.Reader) ([]reportTestCase, error)
+
+// reportTestCase is a format-agnostic representation of a single test case
+// read from a test report. Each of the report formats supported by
+// reportResults is converted into this before being sent to Evergreen.
+type reportTestCase struct {
+	// Name is the fully-qualified test name.
+	Name string
+	// Status is one of the Evergreen test statuses.
+	Status string
+	// StartTime is the time the test started, if the report includes it.
+	StartTime time.Time
+	// Duration is the how long the test took to run.
+	Duration time.Duration
+	// Output contains the failure message and any other output associated
+	// with the test. It is uploaded as the test's log.
+	Output []string
+}
+
+// reportResults parses test report files of a particular format and attaches
+// them to the task as test results. The report format is determined by the
+// command's parser.
+type reportResults struct {
+	// File describes the relative path of the file to be sent. Supports
+	// globbing.
+	File  string   `mapstructure:"file" plugin:"expand"`
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// OptionalOutput, when set to true, causes this command to be skipped
+	// over without an error when no files are found to be parsed.
+	OptionalOutput   string `mapstructure:"optional_output" plugin:"expand"`
+	outputIsOptional bool
+
+	name   string
+	parser reportParser
+	base
+}
+
+func tapResultsFactory() Command {
+	return &reportResults{name: evergreen.AttachTAPResultsCommandName, parser: parseTAPResults}
+}
+
+func trxResultsFactory() Command {
+	return &reportResults{name: evergreen.AttachTRXResultsCommandName, parser: parseTRXResults}
+}
+
+func cucumberJSONResultsFactory() Command {
+	return &reportResults{name: evergreen.AttachCucumberJSONCommandName, parser: parseCucumberJSONResults}
+}
+
+func (c *reportResults) Name() string { return c.name }
+
+// ParseParams reads and validates the command parameters.
+func (c *reportResults) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.OptionalOutput != "" {
+		var err error
+		c.outputIsOptional, err = strconv.ParseBool(c.OptionalOutput)
+		if err != nil {
+			return errors.Wrap(err, "parsing optional output parameter as a boolean")
+		}
+	}
+
+	if c.File == "" && len(c.Files) == 0 {
+		return errors.New("must specify at least one file")
+	}
+
+	return nil
+}
+
+// Execute parses the report files and sends the test logs and test results
+// found in them to Evergreen.
+func (c *reportResults) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.File != "" {
+		c.Files = append(c.Files, c.File)
+	}
+
+	// All file patterns should be relative to the task's working directory.
+	patterns := make([]string, 0, len(c.Files))
+	for _, file := range c.Files {
+		patterns = append(patterns, GetWorkingDirectory(conf, file))
+	}
+
+	reportFiles, err := globFiles(patterns...)
+	if err != nil {
+		return errors.Wrap(err, "obtaining names of report files")
+	}
+	if len(reportFiles) == 0 {
+		if c.outputIsOptional {
+			return nil
+		}
+		return errors.New("no files found to be parsed")
+	}
+
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+	catcher := grip.NewBasicCatcher()
+	for _, reportFile := range reportFiles {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while processing report files")
+		}
+
+		testCases, err := c.parseFile(ctx, reportFile)
+		if err != nil {
+			catcher.Wrapf(err, "parsing report file '%s'", reportFile)
+			continue
+		}
+		logger.Task().Infof(ctx, "Parsed %d test(s) from report file '%s'.", len(testCases), reportFile)
+
+		for _, tc := range testCases {
+			result, log := tc.toModelTestResultAndLog(conf)
+			if log != nil {
+				logs = append(logs, *log)
+			}
+			results = append(results, result)
+		}
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if len(results) == 0 {
+		if conf.Task.MustHaveResults {
+			return errors.New("no test results found in report files")
+		}
+		return nil
+	}
+
+	return errors.Wrap(sendTestLogsAndResults(ctx, comm, logger, conf, logs, results), "sending test logs and test results")
+}
+
+func (c *reportResults) parseFile(ctx context.Context, filePath string) ([]reportTestCase, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening file")
+	}
+	defer file.Close()
+
+	return c.parser(ctx, newContextReader(ctx, file, contextCheckInterval))
+}
+
+// toModelTestResultAndLog converts the report test case into a test result
+// and, if the test case has any output, a test log.
+func (tc reportTestCase) toModelTestResultAndLog(conf *internal.TaskConfig) (testresult.TestResult, *testlog.TestLog) {
+	res := testresult.TestResult{
+		TestName:      util.CleanForPath(tc.Name),
+		Status:        tc.Status,
+		TestStartTime: tc.StartTime,
+	}
+	if res.TestName == "" {
+		res.TestName = fmt.Sprintf("Unnamed Test-%s", utility.RandomString())
+	}
+	if res.TestStartTime.IsZero() {
+		res.TestStartTime = time.Now()
+	}
+	res.TestEndTime = res.TestStartTime.Add(tc.Duration)
+
+	if len(tc.Output) == 0 {
+		return res, nil
+	}
+
+	// Use a unique log name since there may be duplicate test names.
+	log := &testlog.TestLog{
+		Name:          utility.RandomString(),
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+		Lines:         tc.Output,
+	}
+	res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+
+	return res, log
+}
+
+// splitOutputLines splits a block of test output into individual log lines,
+// ignoring leading and trailing whitespace.
+func splitOutputLines(output string) []string {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportResultsParseParams(t *testing.T) {
+	for _, factory := range []CommandFactory{tapResultsFactory, trxResultsFactory, cucumberJSONResultsFactory} {
+		cmd := factory()
+		t.Run(cmd.Name(), func(t *testing.T) {
+			t.Run("SucceedsWithFile", func(t *testing.T) {
+				cmd := factory()
+				assert.NoError(t, cmd.ParseParams(map[string]any{"file": "report"}))
+			})
+			t.Run("SucceedsWithFiles", func(t *testing.T) {
+				cmd := factory()
+				assert.NoError(t, cmd.ParseParams(map[string]any{"files": []string{"a", "b"}, "optional_output": "true"}))
+				assert.True(t, cmd.(*reportResults).outputIsOptional)
+			})
+			t.Run("FailsWithoutFiles", func(t *testing.T) {
+				cmd := factory()
+				assert.Error(t, cmd.ParseParams(map[string]any{}))
+			})
+			t.Run("FailsWithInvalidOptionalOutput", func(t *testing.T) {
+				cmd := factory()
+				assert.Error(t, cmd.ParseParams(map[string]any{"file": "report", "optional_output": "maybe"}))
+			})
+		})
+	}
+}
+
+func TestReportTestCaseToModelTestResultAndLog(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task", Execution: 2}}
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("PassingTestHasNoLog", func(t *testing.T) {
+		tc := reportTestCase{
+			Name:      "suite.some test",
+			Status:    evergreen.TestSucceededStatus,
+			StartTime: start,
+			Duration:  time.Second,
+		}
+		res, log := tc.toModelTestResultAndLog(conf)
+		assert.Nil(t, log)
+		assert.Nil(t, res.LogInfo)
+		assert.Equal(t, "suite.some_test", res.TestName)
+		assert.Equal(t, evergreen.TestSucceededStatus, res.Status)
+		assert.Equal(t, start, res.TestStartTime)
+		assert.Equal(t, start.Add(time.Second), res.TestEndTime)
+	})
+	t.Run("FailingTestHasLog", func(t *testing.T) {
+		tc := reportTestCase{
+			Name:     "failing",
+			Status:   evergreen.TestFailedStatus,
+			Duration: time.Minute,
+			Output:   []string{"FAILED: oops"},
+		}
+		res, log := tc.toModelTestResultAndLog(conf)
+		require.NotNil(t, log)
+		require.NotNil(t, res.LogInfo)
+		assert.Equal(t, log.Name, res.LogInfo.LogName)
+		assert.Equal(t, "task", log.Task)
+		assert.Equal(t, 2, log.TaskExecution)
+		assert.Equal(t, tc.Output, log.Lines)
+		assert.False(t, res.TestStartTime.IsZero())
+		assert.Equal(t, time.Minute, res.TestEndTime.Sub(res.TestStartTime))
+	})
+}
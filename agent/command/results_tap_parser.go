@@ -0,0 +1,237 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// tapTestLineRegex matches a TAP test point, e.g.
+	// "not ok 2 - some test # SKIP reason".
+	tapTestLineRegex = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(.*))?$`)
+	// tapBailOutRegex matches a TAP bail out line.
+	tapBailOutRegex = regexp.MustCompile(`^Bail out!\s*(.*)$`)
+	// tapSubtestRegex matches the comment that introduces a TAP subtest,
+	// e.g. "# Subtest: some group".
+	tapSubtestRegex = regexp.MustCompile(`^#\s*Subtest:\s*(.*)$`)
+)
+
+const (
+	tapYAMLStart = "---"
+	tapYAMLEnd   = "..."
+
+	// tapSubtestIndent is the number of spaces each level of subtest is
+	// indented by.
+	tapSubtestIndent = 4
+)
+
+// parseTAPResults parses a Test Anything Protocol (TAP) report. Test points
+// are converted into test cases; YAML diagnostic blocks and comment lines
+// following a test point are attached to it as its output. A
+// "duration_ms" key in the YAML diagnostic block is used as the test
+// duration.
+//
+// Subtests are indented by four spaces per level and are followed by the
+// parent test point that summarizes them. Test points in a subtest are
+// prefixed with the name of their parent test point, e.g. "parent/child".
+// See https://testanything.org/tap-version-14-specification.html.
+func parseTAPResults(ctx context.Context, reader io.Reader) ([]reportTestCase, error) {
+	var (
+		// levels holds the parsed test cases at each subtest depth that
+		// have not yet been attached to their parent test point.
+		levels = [][]reportTestCase{nil}
+		// subtestNames holds the names from "# Subtest:" comments by
+		// subtest depth.
+		subtestNames = map[int]string{}
+		current      *reportTestCase
+		currentDepth int
+		inYAML       bool
+		yamlLines    []string
+	)
+
+	flush := func() {
+		if current != nil {
+			levels[currentDepth] = append(levels[currentDepth], *current)
+			current = nil
+		}
+	}
+	// closeSubtests attaches the test cases of every subtest deeper than
+	// the given depth to their parent, prefixing their names with the
+	// parent's name.
+	closeSubtests := func(depth int, parentName string) {
+		for d := len(levels) - 1; d > depth; d-- {
+			prefix := subtestNames[d]
+			if d == depth+1 && parentName != "" {
+				prefix = parentName
+			}
+			for _, tc := range levels[d] {
+				if prefix != "" {
+					tc.Name = prefix + "/" + tc.Name
+				}
+				levels[d-1] = append(levels[d-1], tc)
+			}
+			delete(subtestNames, d)
+		}
+		levels = levels[:depth+1]
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "context cancelled during TAP parsing")
+		}
+
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		depth := tapDepth(line)
+
+		if inYAML {
+			if trimmed == tapYAMLEnd {
+				inYAML = false
+				if current != nil {
+					current.applyTAPDiagnostics(yamlLines)
+				}
+				yamlLines = nil
+				continue
+			}
+			yamlLines = append(yamlLines, line)
+			continue
+		}
+
+		switch {
+		case trimmed == tapYAMLStart && current != nil:
+			inYAML = true
+		case tapSubtestRegex.MatchString(trimmed):
+			flush()
+			for len(levels) <= depth {
+				levels = append(levels, nil)
+			}
+			subtestNames[depth] = strings.TrimSpace(tapSubtestRegex.FindStringSubmatch(trimmed)[1])
+		case tapTestLineRegex.MatchString(trimmed):
+			flush()
+			for len(levels) <= depth {
+				levels = append(levels, nil)
+			}
+			tc := parseTAPTestLine(trimmed, len(levels[depth])+1, subtestNames[depth+1])
+			closeSubtests(depth, tc.Name)
+			current = &tc
+			currentDepth = depth
+		case tapBailOutRegex.MatchString(trimmed):
+			flush()
+			closeSubtests(0, "")
+			reason := tapBailOutRegex.FindStringSubmatch(trimmed)[1]
+			levels[0] = append(levels[0], reportTestCase{
+				Name:   "Bail out!",
+				Status: evergreen.TestFailedStatus,
+				Output: []string{fmt.Sprintf("Bail out! %s", reason)},
+			})
+			return levels[0], nil
+		case strings.HasPrefix(trimmed, "#") && current != nil && current.Status == evergreen.TestFailedStatus:
+			current.Output = append(current.Output, strings.TrimSpace(strings.TrimPrefix(trimmed, "#")))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading TAP report")
+	}
+	if inYAML && current != nil {
+		current.applyTAPDiagnostics(yamlLines)
+	}
+	flush()
+	closeSubtests(0, "")
+
+	return levels[0], nil
+}
+
+// tapDepth returns the subtest depth of a TAP line from its indentation.
+func tapDepth(line string) int {
+	spaces := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			spaces++
+		case '\t':
+			spaces += tapSubtestIndent
+		default:
+			return spaces / tapSubtestIndent
+		}
+	}
+	return spaces / tapSubtestIndent
+}
+
+// parseTAPTestLine converts a single TAP test point line into a test case.
+// Unnamed tests are named after the subtest they summarize, if any, and
+// otherwise after their test number.
+func parseTAPTestLine(line string, testNum int, subtestName string) reportTestCase {
+	matches := tapTestLineRegex.FindStringSubmatch(line)
+	result, num, description, directive := matches[1], matches[2], matches[3], strings.TrimSpace(matches[4])
+
+	tc := reportTestCase{Name: description}
+	if tc.Name == "" {
+		tc.Name = subtestName
+	}
+	if tc.Name == "" {
+		if num == "" {
+			num = strconv.Itoa(testNum)
+		}
+		tc.Name = fmt.Sprintf("test %s", num)
+	}
+
+	switch {
+	case hasTAPDirective(directive, "SKIP"):
+		tc.Status = evergreen.TestSkippedStatus
+	case hasTAPDirective(directive, "TODO"):
+		// TODO tests are expected to fail, so they should not fail the
+		// task.
+		tc.Status = evergreen.TestSilentlyFailedStatus
+		if result == "ok" {
+			tc.Status = evergreen.TestSucceededStatus
+		}
+	case result == "ok":
+		tc.Status = evergreen.TestSucceededStatus
+	default:
+		tc.Status = evergreen.TestFailedStatus
+	}
+	if tc.Status != evergreen.TestSucceededStatus && directive != "" {
+		tc.Output = append(tc.Output, fmt.Sprintf("%s # %s", line[:len(result)], directive))
+	}
+
+	return tc
+}
+
+func hasTAPDirective(directive, name string) bool {
+	return len(directive) >= len(name) && strings.EqualFold(directive[:len(name)], name)
+}
+
+// applyTAPDiagnostics attaches a TAP YAML diagnostic block to the test case.
+func (tc *reportTestCase) applyTAPDiagnostics(lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+
+	diagnostics := map[string]any{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &diagnostics); err == nil {
+		if ms, ok := diagnostics["duration_ms"]; ok {
+			if f, err := strconv.ParseFloat(fmt.Sprint(ms), 64); err == nil {
+				tc.Duration = time.Duration(f * float64(time.Millisecond))
+			}
+		}
+	}
+
+	if tc.Status == evergreen.TestSucceededStatus {
+		return
+	}
+	for _, line := range lines {
+		tc.Output = append(tc.Output, strings.TrimRight(line, " \t"))
+	}
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTAPResults(t *testing.T) {
+	cwd := testutil.GetDirectoryOfFile()
+
+	t.Run("ParsesTestPointsAndDiagnostics", func(t *testing.T) {
+		file, err := os.Open(filepath.Join(cwd, "testdata", "tap", "results.tap"))
+		require.NoError(t, err)
+		defer file.Close()
+
+		testCases, err := parseTAPResults(t.Context(), file)
+		require.NoError(t, err)
+		require.Len(t, testCases, 6)
+
+		assert.Equal(t, "addition works", testCases[0].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[0].Status)
+		assert.Empty(t, testCases[0].Output)
+
+		assert.Equal(t, "subtraction works", testCases[1].Name)
+		assert.Equal(t, evergreen.TestFailedStatus, testCases[1].Status)
+		assert.Equal(t, 12500*time.Microsecond, testCases[1].Duration)
+		output := strings.Join(testCases[1].Output, "\n")
+		assert.Contains(t, output, "message: expected 1 to equal 2")
+		assert.Contains(t, output, "subtraction failed on the second assertion")
+
+		assert.Equal(t, "network test", testCases[2].Name)
+		assert.Equal(t, evergreen.TestSkippedStatus, testCases[2].Status)
+
+		assert.Equal(t, "unfinished feature", testCases[3].Name)
+		assert.Equal(t, evergreen.TestSilentlyFailedStatus, testCases[3].Status)
+
+		assert.Equal(t, "test 5", testCases[4].Name)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[4].Status)
+		assert.Equal(t, 1500*time.Millisecond, testCases[4].Duration)
+		assert.Empty(t, testCases[4].Output)
+
+		assert.Equal(t, "last test", testCases[5].Name)
+	})
+	t.Run("NamesSubtestsAfterTheirParent", func(t *testing.T) {
+		file, err := os.Open(filepath.Join(cwd, "testdata", "tap", "subtests.tap"))
+		require.NoError(t, err)
+		defer file.Close()
+
+		testCases, err := parseTAPResults(t.Context(), file)
+		require.NoError(t, err)
+
+		var names []string
+		for _, tc := range testCases {
+			names = append(names, tc.Name)
+		}
+		assert.Equal(t, []string{
+			"math/addition",
+			"math/division/by zero",
+			"math/division/by one",
+			"math/division",
+			"math",
+			"strings/concat",
+			"strings",
+			"standalone",
+		}, names)
+
+		assert.Equal(t, evergreen.TestFailedStatus, testCases[1].Status)
+		assert.Contains(t, strings.Join(testCases[1].Output, "\n"), "message: divided by zero")
+		assert.Equal(t, evergreen.TestFailedStatus, testCases[3].Status)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[5].Status)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[6].Status)
+	})
+	t.Run("StopsAtBailOut", func(t *testing.T) {
+		file, err := os.Open(filepath.Join(cwd, "testdata", "tap", "bail_out.tap"))
+		require.NoError(t, err)
+		defer file.Close()
+
+		testCases, err := parseTAPResults(t.Context(), file)
+		require.NoError(t, err)
+		require.Len(t, testCases, 2)
+		assert.Equal(t, evergreen.TestSucceededStatus, testCases[0].Status)
+		assert.Equal(t, evergreen.TestFailedStatus, testCases[1].Status)
+		assert.Equal(t, []string{"Bail out! database unavailable"}, testCases[1].Output)
+	})
+	t.Run("EmptyReport", func(t *testing.T) {
+		testCases, err := parseTAPResults(t.Context(), strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Empty(t, testCases)
+	})
+}
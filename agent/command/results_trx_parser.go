@@ -0,0 +1,148 @@
+package command
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+// trxTestRun is the root element of a Visual Studio test results (TRX) file.
+// See https://github.com/microsoft/vstest/blob/main/src/Microsoft.TestPlatform.Extensions.TrxLogger/Resources/TrxSchema.xsd.
+type trxTestRun struct {
+	Definitions []trxUnitTest       `xml:"TestDefinitions>UnitTest"`
+	Results     []trxUnitTestResult `xml:"Results>UnitTestResult"`
+}
+
+type trxUnitTest struct {
+	ID         string `xml:"id,attr"`
+	Name       string `xml:"name,attr"`
+	TestMethod struct {
+		ClassName string `xml:"className,attr"`
+		Name      string `xml:"name,attr"`
+	} `xml:"TestMethod"`
+}
+
+type trxUnitTestResult struct {
+	TestID       string              `xml:"testId,attr"`
+	TestName     string              `xml:"testName,attr"`
+	Outcome      string              `xml:"outcome,attr"`
+	Duration     string              `xml:"duration,attr"`
+	StartTime    string              `xml:"startTime,attr"`
+	EndTime      string              `xml:"endTime,attr"`
+	Output       trxOutput           `xml:"Output"`
+	InnerResults []trxUnitTestResult `xml:"InnerResults>UnitTestResult"`
+}
+
+type trxOutput struct {
+	StdOut    string `xml:"StdOut"`
+	StdErr    string `xml:"StdErr"`
+	ErrorInfo *struct {
+		Message    string `xml:"Message"`
+		StackTrace string `xml:"StackTrace"`
+	} `xml:"ErrorInfo"`
+}
+
+// parseTRXResults parses a .NET TRX report. Data-driven tests with inner
+// results are reported as one test case per inner result.
+func parseTRXResults(ctx context.Context, reader io.Reader) ([]reportTestCase, error) {
+	var run trxTestRun
+	if err := xml.NewDecoder(reader).Decode(&run); err != nil {
+		return nil, errors.Wrap(err, "decoding TRX report")
+	}
+
+	classNames := map[string]string{}
+	for _, def := range run.Definitions {
+		classNames[def.ID] = def.TestMethod.ClassName
+	}
+
+	var testCases []reportTestCase
+	var addResults func(results []trxUnitTestResult)
+	addResults = func(results []trxUnitTestResult) {
+		for _, res := range results {
+			if len(res.InnerResults) > 0 {
+				addResults(res.InnerResults)
+				continue
+			}
+			testCases = append(testCases, res.toReportTestCase(classNames[res.TestID]))
+		}
+	}
+	addResults(run.Results)
+
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "context cancelled during TRX parsing")
+	}
+
+	return testCases, nil
+}
+
+func (res trxUnitTestResult) toReportTestCase(className string) reportTestCase {
+	tc := reportTestCase{Name: res.TestName}
+	// TRX test names are usually fully-qualified already, but some adapters
+	// only report the method name.
+	if className != "" && !strings.HasPrefix(tc.Name, className+".") {
+		tc.Name = fmt.Sprintf("%s.%s", className, tc.Name)
+	}
+
+	switch strings.ToLower(res.Outcome) {
+	case "passed", "passedbutrunaborted", "completed", "warning":
+		tc.Status = evergreen.TestSucceededStatus
+	case "notexecuted", "inconclusive", "pending", "disconnected", "notrunnable":
+		tc.Status = evergreen.TestSkippedStatus
+	case "timeout":
+		tc.Status = evergreen.TestTimedOutStatus
+	default:
+		tc.Status = evergreen.TestFailedStatus
+	}
+
+	if start, err := time.Parse(time.RFC3339Nano, res.StartTime); err == nil {
+		tc.StartTime = start
+	}
+	if dur, err := parseTRXDuration(res.Duration); err == nil {
+		tc.Duration = dur
+	} else if end, err := time.Parse(time.RFC3339Nano, res.EndTime); err == nil && !tc.StartTime.IsZero() {
+		tc.Duration = end.Sub(tc.StartTime)
+	}
+
+	if res.Output.ErrorInfo != nil {
+		if res.Output.ErrorInfo.Message != "" {
+			tc.Output = append(tc.Output, fmt.Sprintf("%s: %s", strings.ToUpper(res.Outcome), strings.TrimSpace(res.Output.ErrorInfo.Message)))
+		}
+		tc.Output = append(tc.Output, splitOutputLines(res.Output.ErrorInfo.StackTrace)...)
+	}
+	tc.Output = append(tc.Output, constructSystemLogs(strings.TrimSpace(res.Output.StdOut), strings.TrimSpace(res.Output.StdErr))...)
+
+	return tc
+}
+
+// parseTRXDuration parses a TRX duration, which is formatted as a .NET
+// TimeSpan (e.g. "00:01:02.5000000" or "1.00:00:00").
+func parseTRXDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, errors.New("empty duration")
+	}
+
+	var days int
+	if dayIdx := strings.Index(s, "."); dayIdx >= 0 && dayIdx < strings.Index(s, ":") {
+		if _, err := fmt.Sscanf(s[:dayIdx], "%d", &days); err != nil {
+			return 0, errors.Wrapf(err, "parsing days in duration '%s'", s)
+		}
+		s = s[dayIdx+1:]
+	}
+
+	var hours, minutes int
+	var seconds float64
+	if _, err := fmt.Sscanf(s, "%d:%d:%f", &hours, &minutes, &seconds); err != nil {
+		return 0, errors.Wrapf(err, "parsing duration '%s'", s)
+	}
+
+	return time.Duration(days)*24*time.Hour +
+		time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTRXResults(t *testing.T) {
+	cwd := testutil.GetDirectoryOfFile()
+
+	file, err := os.Open(filepath.Join(cwd, "testdata", "trx", "results.trx"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	testCases, err := parseTRXResults(t.Context(), file)
+	require.NoError(t, err)
+	require.Len(t, testCases, 5)
+
+	assert.Equal(t, "Calculator.Tests.Add_ReturnsSum", testCases[0].Name)
+	assert.Equal(t, evergreen.TestSucceededStatus, testCases[0].Status)
+	assert.Equal(t, 12300*time.Microsecond, testCases[0].Duration)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), testCases[0].StartTime.UTC())
+	assert.Empty(t, testCases[0].Output)
+
+	assert.Equal(t, "Calculator.Tests.Divide_ByZero", testCases[1].Name)
+	assert.Equal(t, evergreen.TestFailedStatus, testCases[1].Status)
+	assert.Equal(t, 1500*time.Millisecond, testCases[1].Duration)
+	assert.Equal(t, []string{
+		"FAILED: Expected DivideByZeroException.",
+		"at Calculator.Tests.Divide_ByZero() in Tests.cs:line 42",
+		"at System.RuntimeMethodHandle.InvokeMethod()",
+		systemOut,
+		"dividing by zero",
+	}, testCases[1].Output)
+
+	assert.Equal(t, "Calculator.Tests.Multiply_Large", testCases[2].Name)
+	assert.Equal(t, evergreen.TestSkippedStatus, testCases[2].Status)
+	assert.Equal(t, 24*time.Hour+2*time.Second, testCases[2].Duration)
+
+	assert.Equal(t, `Calculator.Tests.Parse ("1")`, testCases[3].Name)
+	assert.Equal(t, evergreen.TestSucceededStatus, testCases[3].Status)
+	assert.Equal(t, `Calculator.Tests.Parse ("x")`, testCases[4].Name)
+	assert.Equal(t, evergreen.TestFailedStatus, testCases[4].Status)
+}
+
+func TestParseTRXDuration(t *testing.T) {
+	for input, expected := range map[string]time.Duration{
+		"00:00:00":          0,
+		"00:00:00.5000000":  500 * time.Millisecond,
+		"01:02:03":          time.Hour + 2*time.Minute + 3*time.Second,
+		"2.00:00:01.250000": 48*time.Hour + 1250*time.Millisecond,
+	} {
+		dur, err := parseTRXDuration(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, dur, input)
+	}
+
+	_, err := parseTRXDuration("")
+	assert.Error(t, err)
+	_, err = parseTRXDuration("not a duration")
+	assert.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
@@ -29,6 +30,7 @@ func sendTestResults(ctx context.Context, comm client.Communicator, logger clien
 
 	logger.Task().Info(ctx, "Attaching test results...")
 	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	results = applyTestQuarantine(ctx, comm, logger, td, results)
 
 	if err := attachTestResults(ctx, conf, td, comm, results); err != nil {
 		return errors.Wrap(err, "sending test results")
@@ -39,6 +41,43 @@ func sendTestResults(ctx context.Context, comm client.Communicator, logger clien
 	return nil
 }
 
+// applyTestQuarantine marks failing results of tests that are quarantined in
+// the task's project as quarantined failures so that they do not fail the
+// task. If the quarantined tests cannot be retrieved, the results are
+// returned unmodified.
+func applyTestQuarantine(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, td client.TaskData, results []testresult.TestResult) []testresult.TestResult {
+	quarantined, err := comm.GetQuarantinedTests(ctx, td)
+	if err != nil {
+		logger.Task().Warning(ctx, errors.Wrap(err, "getting quarantined tests, test failures will not be quarantined"))
+		return results
+	}
+	if len(quarantined) == 0 {
+		return results
+	}
+
+	quarantinedSet := make(map[string]bool, len(quarantined))
+	for _, name := range quarantined {
+		quarantinedSet[name] = true
+	}
+
+	var numQuarantined int
+	for i, res := range results {
+		if res.Status != evergreen.TestFailedStatus {
+			continue
+		}
+		if !quarantinedSet[res.TestName] && !quarantinedSet[res.GetDisplayTestName()] {
+			continue
+		}
+		results[i].Status = testquarantine.ApplyToStatus(res.Status)
+		numQuarantined++
+	}
+	if numQuarantined > 0 {
+		logger.Task().Infof(ctx, "%d failing test(s) are quarantined and will not fail the task.", numQuarantined)
+	}
+
+	return results
+}
+
 // sendTestLogsAndResults sends the test logs and test results to backend
 // logging and results services. Test logs are uploaded in parallel using a
 // worker pool for improved performance.
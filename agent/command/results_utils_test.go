@@ -20,6 +20,7 @@ import (
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip/sometimes"
 	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -198,3 +199,43 @@ func saveTestResults(t *testing.T, ctx context.Context, testBucket pail.Bucket,
 	require.NoError(t, svc.AppendTestResultMetadata(resultTestutil.MakeAppendTestResultMetadataReq(ctx, savedResults, tr.ID)))
 	return savedResults
 }
+
+func TestApplyTestQuarantine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf := &internal.TaskConfig{Task: task.Task{Id: "id", Secret: "secret"}}
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	makeResults := func() []testresult.TestResult {
+		return []testresult.TestResult{
+			{TestName: "flaky", Status: evergreen.TestFailedStatus},
+			{TestName: "renamed", DisplayTestName: "flaky_display", Status: evergreen.TestFailedStatus},
+			{TestName: "broken", Status: evergreen.TestFailedStatus},
+			{TestName: "flaky_pass", Status: evergreen.TestSucceededStatus},
+		}
+	}
+
+	t.Run("QuarantinesFailingTests", func(t *testing.T) {
+		comm := client.NewMock("url")
+		comm.QuarantinedTests = []string{"flaky", "flaky_display", "flaky_pass"}
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		results := applyTestQuarantine(ctx, comm, logger, td, makeResults())
+		require.Len(t, results, 4)
+		assert.Equal(t, evergreen.TestQuarantinedFailedStatus, results[0].Status)
+		assert.Equal(t, evergreen.TestQuarantinedFailedStatus, results[1].Status)
+		assert.Equal(t, evergreen.TestFailedStatus, results[2].Status)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[3].Status)
+	})
+	t.Run("LeavesResultsUnmodifiedOnError", func(t *testing.T) {
+		comm := client.NewMock("url")
+		comm.QuarantinedTests = []string{"flaky"}
+		comm.GetQuarantinedTestsErr = errors.New("error")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		results := applyTestQuarantine(ctx, comm, logger, td, makeResults())
+		assert.Equal(t, makeResults(), results)
+	})
+}
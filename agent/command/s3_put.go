@@ -651,6 +651,8 @@ func (s3pc *s3put) attachFiles(ctx context.Context, comm client.Communicator, up
 			ExternalID:      s3pc.externalID,
 			Bucket:          bucket,
 			FileKey:         fileKey,
+			Region:          s3pc.Region,
+			Command:         s3pc.Name(),
 			ContentType:     s3pc.ContentType,
 			FileSize:        uploadInfo.FileSizeBytes,
 			PutRequests:     uploadInfo.PutRequests,
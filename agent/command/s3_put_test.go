@@ -512,6 +512,8 @@ func TestContentTypeSaved(t *testing.T) {
 	assert.Len(t, files, 2)
 	for _, file := range files {
 		assert.Equal(t, file.ContentType, s.ContentType)
+		assert.Equal(t, artifact.S3PutCommand, file.Command)
+		assert.Equal(t, s.Region, file.Region)
 	}
 }
 
@@ -77,6 +77,15 @@ type testSelectionGet struct {
 	// a comma-separated list of strategy names to use.
 	Strategies string `mapstructure:"strategies" plugin:"expand"`
 
+	// CoverageRuns is an optional string that specifies the number of
+	// consecutive runs over which the built-in RandomSample strategy runs
+	// every test. It only applies when Evergreen selects tests itself
+	// rather than using the test selection service.
+	CoverageRuns string `mapstructure:"coverage_runs" plugin:"expand"`
+
+	// coverageRuns is the parsed int value of CoverageRuns.
+	coverageRuns int
+
 	base
 }
 
@@ -101,6 +110,12 @@ func (c *testSelectionGet) validate() error {
 		c.rate = rate
 	}
 	catcher.NewWhen(len(c.Tests) > 0 && c.TestsFile != "", "cannot specify both tests and tests_file")
+	if c.CoverageRuns != "" {
+		runs, err := strconv.Atoi(c.CoverageRuns)
+		catcher.Add(err)
+		catcher.NewWhen(runs < 1, "coverage runs must be positive")
+		c.coverageRuns = runs
+	}
 	return catcher.Resolve()
 }
 
@@ -171,6 +186,7 @@ func (c *testSelectionGet) Execute(ctx context.Context, comm client.Communicator
 		TaskID:       conf.Task.Id,
 		TaskName:     conf.Task.DisplayName,
 		Tests:        c.Tests,
+		CoverageRuns: c.coverageRuns,
 	}
 
 	if c.Strategies != "" {
@@ -52,6 +52,25 @@ func TestTestSelectionGet(t *testing.T) {
 		}
 		assert.Error(t, cmd.ParseParams(params))
 	})
+	t.Run("ParsesCoverageRuns", func(t *testing.T) {
+		cmd := &testSelectionGet{}
+		params := map[string]any{
+			"output_file":   "test.json",
+			"coverage_runs": "10",
+		}
+		require.NoError(t, cmd.ParseParams(params))
+		assert.Equal(t, 10, cmd.coverageRuns)
+	})
+	t.Run("ParseFailsWithInvalidCoverageRuns", func(t *testing.T) {
+		for _, runs := range []string{"0", "-1", "often"} {
+			cmd := &testSelectionGet{}
+			params := map[string]any{
+				"output_file":   "test.json",
+				"coverage_runs": runs,
+			}
+			assert.Error(t, cmd.ParseParams(params), runs)
+		}
+	})
 
 	for tName, tCase := range map[string]func(t *testing.T, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer){
 		"SkipsWhenTestSelectionNotAllowed": func(t *testing.T, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
@@ -134,6 +153,14 @@ func TestTestSelectionGet(t *testing.T) {
 			assert.True(t, comm.SelectTestsCalled)
 			assert.Equal(t, tests, comm.SelectTestsRequest.Tests)
 		},
+		"PassesCoverageRunsToAPI": func(t *testing.T, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			cmd := &testSelectionGet{OutputFile: "test.json", Strategies: "RandomSample", CoverageRuns: "8"}
+			require.NoError(t, cmd.Execute(t.Context(), comm, logger, conf))
+
+			assert.True(t, comm.SelectTestsCalled)
+			assert.Equal(t, []string{"RandomSample"}, comm.SelectTestsRequest.Strategies)
+			assert.Equal(t, 8, comm.SelectTestsRequest.CoverageRuns)
+		},
 		"HandlesAPIErrors": func(t *testing.T, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
 			cmd := &testSelectionGet{OutputFile: "test.json"}
 
@@ -548,6 +548,28 @@ func (c *baseCommunicator) SetResultsInfo(ctx context.Context, taskData TaskData
 	return nil
 }
 
+// GetQuarantinedTests returns the names of the tests that are quarantined in
+// the task's project.
+func (c *baseCommunicator) GetQuarantinedTests(ctx context.Context, taskData TaskData) ([]string, error) {
+	info := requestInfo{
+		method:   http.MethodGet,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("quarantined_tests")
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrap(err, "getting quarantined tests").Error())
+	}
+	defer resp.Body.Close()
+
+	var testNames []string
+	if err = utility.ReadJSON(resp.Body, &testNames); err != nil {
+		return nil, errors.Wrap(err, "reading quarantined tests from response")
+	}
+
+	return testNames, nil
+}
+
 func (c *baseCommunicator) NewPush(ctx context.Context, taskData TaskData, req *apimodels.S3CopyRequest) (*model.PushLog, error) {
 	newPushLog := model.PushLog{}
 	info := requestInfo{
@@ -77,6 +77,9 @@ type SharedCommunicator interface {
 	GetPerfMonitoringURL(context.Context) (string, error)
 	// SetResultsInfo sets the test results information in the task.
 	SetResultsInfo(context.Context, TaskData, bool) error
+	// GetQuarantinedTests returns the names of the tests that are quarantined
+	// in the task's project.
+	GetQuarantinedTests(context.Context, TaskData) ([]string, error)
 
 	// DisableHost signals to the app server that the host should be disabled.
 	DisableHost(ctx context.Context, hostID string, info apimodels.DisableInfo) error
@@ -94,6 +94,9 @@ type Mock struct {
 	LastMessageSent  time.Time
 	DownstreamParams []patchModel.Parameter
 
+	QuarantinedTests       []string
+	GetQuarantinedTestsErr error
+
 	// SelectTests mock fields
 	SelectTestsCalled   bool
 	SelectTestsRequest  restmodel.SelectTestsRequest
@@ -442,6 +445,13 @@ func (c *Mock) SetResultsInfo(ctx context.Context, _ TaskData, failed bool) erro
 	return nil
 }
 
+func (c *Mock) GetQuarantinedTests(ctx context.Context, _ TaskData) ([]string, error) {
+	if c.GetQuarantinedTestsErr != nil {
+		return nil, c.GetQuarantinedTestsErr
+	}
+	return c.QuarantinedTests, nil
+}
+
 // DisableHost signals to the app server that the host should be disabled.
 func (c *Mock) DisableHost(ctx context.Context, hostID string, info apimodels.DisableInfo) error {
 	return nil
@@ -60,11 +60,27 @@ func (r *redactingSender) Send(ctx context.Context, m message.Composer) {
 		allRedacted = getAllRedacted(r.expansions, r.internalRedactions, r.expansionsToRedact)
 	}
 
+	r.Sender.Send(ctx, message.NewDefaultMessage(m.Priority(), redact(msg, allRedacted)))
+}
+
+// RedactString redacts the string in the same way that a redacting sender
+// with the given options redacts log messages.
+func RedactString(s string, opts RedactionOptions) string {
+	if opts.Expansions == nil {
+		opts.Expansions = &util.DynamicExpansions{}
+	}
+	if opts.InternalRedactions == nil {
+		opts.InternalRedactions = &util.DynamicExpansions{}
+	}
+
+	return redact(s, getAllRedacted(opts.Expansions, opts.InternalRedactions, append(opts.Redacted, globals.ExpansionsToRedact...)))
+}
+
+func redact(msg string, allRedacted []util.RedactInfo) string {
 	for _, info := range allRedacted {
 		msg = strings.ReplaceAll(msg, info.Value, fmt.Sprintf(redactedVariableTemplate, info.Key))
 	}
-
-	r.Sender.Send(ctx, message.NewDefaultMessage(m.Priority(), msg))
+	return msg
 }
 
 // NewRedactingSender wraps the provided sender with a sender that redacts
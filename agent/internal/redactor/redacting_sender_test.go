@@ -102,6 +102,7 @@ func TestRedactingSender(t *testing.T) {
 			s := NewRedactingSender(wrappedSender, opts)
 			s.Send(context.Background(), message.NewDefaultMessage(level.Info, test.inputString))
 			assert.Equal(t, test.expected, wrappedSender.GetMessage().Message.String())
+			assert.Equal(t, test.expected, RedactString(test.inputString, opts))
 		})
 	}
 }
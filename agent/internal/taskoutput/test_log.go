@@ -322,6 +322,8 @@ func (s testLogSpec) getParser() log.LineParser {
 				Data:      data,
 			}, nil
 		}
+	case testLogFormatJSON:
+		return log.ParseStructuredLine
 	default:
 		// Use the default log line parser.
 		return nil
@@ -339,11 +341,17 @@ const (
 	// Unix timestamp in nanoseconds and one or more whitespace characters.
 	// 		1575743479637000000 This is a log line.
 	testLogFormatTextTimestamp testLogFormat = "text-timestamp"
+	// testLogFormatJSON is a JSON object per line. The fields of each line
+	// are preserved so that the log can be filtered by field, and the
+	// line's priority is taken from its "level" field. Lines that are not
+	// JSON objects are stored as plain text.
+	// 		{"level": "warn", "component": "replset", "msg": "This is a log line."}
+	testLogFormatJSON testLogFormat = "json"
 )
 
 func (f testLogFormat) validate() error {
 	switch f {
-	case testLogFormatDefault, testLogFormatTextTimestamp:
+	case testLogFormatDefault, testLogFormatTextTimestamp, testLogFormatJSON:
 		return nil
 	default:
 		return errors.Errorf("unrecognized test log format '%s'", f)
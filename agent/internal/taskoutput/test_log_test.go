@@ -357,6 +357,31 @@ func TestTestLogSpecGetParser(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "JSON",
+			spec: testLogSpec{Format: testLogFormatJSON},
+			test: func(t *testing.T, parser log.LineParser) {
+				t.Run("ParseStructuredLine", func(t *testing.T) {
+					line, err := parser("{\"level\": \"warn\", \"component\": \"replset\", \"msg\": \"This is a log line.\"}\n")
+					require.NoError(t, err)
+					assert.Equal(t, level.Warning, line.Priority)
+					assert.Zero(t, line.Timestamp)
+					assert.Equal(t, `{"level":"warn","component":"replset","msg":"This is a log line."}`, line.Data)
+				})
+				t.Run("ParseStructuredLineWithoutLevel", func(t *testing.T) {
+					line, err := parser(`{"msg": "This is a log line."}`)
+					require.NoError(t, err)
+					assert.Zero(t, line.Priority)
+					assert.Equal(t, `{"msg":"This is a log line."}`, line.Data)
+				})
+				t.Run("ParsePlainTextLine", func(t *testing.T) {
+					line, err := parser("This is a log line.\n")
+					require.NoError(t, err)
+					assert.Zero(t, line.Priority)
+					assert.Equal(t, "This is a log line.", line.Data)
+				})
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			test.test(t, test.spec.getParser())
@@ -378,6 +403,10 @@ func TestTestLogFormatValidate(t *testing.T) {
 			name:   "TextTimestamp",
 			format: testLogFormatTextTimestamp,
 		},
+		{
+			name:   "JSON",
+			format: testLogFormatJSON,
+		},
 		{
 			name:   "Invalid",
 			format: testLogFormat("invalid"),
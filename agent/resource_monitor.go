@@ -9,7 +9,9 @@ import (
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
 )
 
 const (
@@ -19,6 +21,11 @@ const (
 	// sustainedSampleCount is the number of consecutive samples above threshold
 	// required to mark a resource as constrained. 20 samples = 5 minutes of sustained usage at 15s intervals.
 	sustainedSampleCount = 20
+	// maxResourceUsagePoints is the maximum number of points kept in the
+	// usage timeseries. Once it's reached, adjacent points are merged so that
+	// each point covers twice as long an interval. 240 points covers an hour
+	// at the sampling interval.
+	maxResourceUsagePoints = 240
 )
 
 type resourceMonitor struct {
@@ -33,9 +40,27 @@ type resourceMonitor struct {
 	peakCPUPercent    float64
 	peakMemoryPercent float64
 
+	// diskDevices are the disks whose I/O is measured. If empty, all disks
+	// are measured.
+	diskDevices      []string
+	numCPUs          int
+	totalMemoryBytes uint64
+	points           []apimodels.ResourceUsagePoint
+	lastSampleTime   time.Time
+	lastIO           *ioCounters
+
 	logger grip.Journaler
 }
 
+// ioCounters are the cumulative bytes transferred by the host's disks and
+// network interfaces.
+type ioCounters struct {
+	diskReadBytes  uint64
+	diskWriteBytes uint64
+	netRecvBytes   uint64
+	netSentBytes   uint64
+}
+
 func newResourceMonitor(logger grip.Journaler) *resourceMonitor {
 	if logger == nil {
 		logger = grip.NewJournaler("resource_monitor")
@@ -45,8 +70,21 @@ func newResourceMonitor(logger grip.Journaler) *resourceMonitor {
 	}
 }
 
-// start samples CPU and memory usage at regular intervals until the context is cancelled.
-func (rm *resourceMonitor) start(ctx context.Context) {
+// start samples resource usage at regular intervals until the context is
+// cancelled.
+func (rm *resourceMonitor) start(ctx context.Context, diskDevices []string) {
+	numCPUs, err := cpu.CountsWithContext(ctx, true)
+	rm.logger.Debug(ctx, errors.Wrap(err, "counting CPUs"))
+	io, err := rm.readIOCounters(ctx)
+	rm.logger.Debug(ctx, errors.Wrap(err, "sampling I/O usage"))
+
+	rm.mu.Lock()
+	rm.diskDevices = diskDevices
+	rm.numCPUs = numCPUs
+	rm.lastSampleTime = time.Now()
+	rm.lastIO = io
+	rm.mu.Unlock()
+
 	ticker := time.NewTicker(resourceMonitorInterval)
 	defer ticker.Stop()
 
@@ -65,9 +103,11 @@ func (rm *resourceMonitor) sample(ctx context.Context) {
 	// so it should be kept low to avoid long delays. We expect exactly 1
 	// result because we pass percpu=false.
 	cpuPercents, err := cpu.PercentWithContext(ctx, 200*time.Millisecond, false)
+	var cpuPercent *float64
 	if err != nil {
 		rm.logger.Debug(ctx, errors.Wrap(err, "sampling CPU usage"))
 	} else if len(cpuPercents) > 0 {
+		cpuPercent = &cpuPercents[0]
 		rm.recordCPU(cpuPercents[0])
 	} else {
 		rm.logger.Warning(ctx, "CPU usage sampling returned empty result")
@@ -79,6 +119,119 @@ func (rm *resourceMonitor) sample(ctx context.Context) {
 	} else if memStat != nil {
 		rm.recordMemory(memStat.UsedPercent)
 	}
+
+	io, err := rm.readIOCounters(ctx)
+	rm.logger.Debug(ctx, errors.Wrap(err, "sampling I/O usage"))
+
+	// A point without CPU and memory usage isn't useful for right-sizing.
+	if cpuPercent != nil && memStat != nil {
+		rm.recordUsage(time.Now(), *cpuPercent, memStat.UsedPercent, memStat.Total, io)
+	}
+}
+
+// readIOCounters returns the host's cumulative disk and network I/O. It
+// returns nil if the I/O can't be read.
+func (rm *resourceMonitor) readIOCounters(ctx context.Context) (*ioCounters, error) {
+	rm.mu.Lock()
+	diskDevices := rm.diskDevices
+	rm.mu.Unlock()
+
+	diskCounters, err := disk.IOCountersWithContext(ctx, diskDevices...)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting disk I/O counters")
+	}
+	netCounters, err := net.IOCountersWithContext(ctx, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting network I/O counters")
+	}
+
+	io := &ioCounters{}
+	for _, c := range diskCounters {
+		io.diskReadBytes += c.ReadBytes
+		io.diskWriteBytes += c.WriteBytes
+	}
+	// Passing pernic=false returns a single entry for all interfaces.
+	for _, c := range netCounters {
+		io.netRecvBytes += c.BytesRecv
+		io.netSentBytes += c.BytesSent
+	}
+	return io, nil
+}
+
+// recordUsage adds a point to the usage timeseries covering the interval since
+// the previous sample. io may be nil if I/O couldn't be read, in which case the
+// point has no I/O usage.
+func (rm *resourceMonitor) recordUsage(now time.Time, cpuPercent, memoryPercent float64, totalMemoryBytes uint64, io *ioCounters) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	start := rm.lastSampleTime
+	if start.IsZero() || !start.Before(now) {
+		start = now.Add(-resourceMonitorInterval)
+	}
+	point := apimodels.ResourceUsagePoint{
+		Time:          start,
+		Duration:      now.Sub(start),
+		CPUPercent:    cpuPercent,
+		MemoryPercent: memoryPercent,
+	}
+	if io != nil && rm.lastIO != nil {
+		secs := point.Duration.Seconds()
+		point.DiskReadBytesPerSec = counterRate(rm.lastIO.diskReadBytes, io.diskReadBytes, secs)
+		point.DiskWriteBytesPerSec = counterRate(rm.lastIO.diskWriteBytes, io.diskWriteBytes, secs)
+		point.NetRecvBytesPerSec = counterRate(rm.lastIO.netRecvBytes, io.netRecvBytes, secs)
+		point.NetSentBytesPerSec = counterRate(rm.lastIO.netSentBytes, io.netSentBytes, secs)
+	}
+
+	rm.totalMemoryBytes = totalMemoryBytes
+	rm.lastSampleTime = now
+	rm.lastIO = io
+	rm.points = append(rm.points, point)
+	if len(rm.points) > maxResourceUsagePoints {
+		rm.points = downsampleResourceUsage(rm.points)
+	}
+}
+
+// counterRate returns the per-second rate of change of a cumulative counter.
+// Counters that went backwards, e.g. because a device was removed, have no
+// rate.
+func counterRate(prev, cur uint64, secs float64) float64 {
+	if cur < prev || secs <= 0 {
+		return 0
+	}
+	return float64(cur-prev) / secs
+}
+
+// downsampleResourceUsage halves the number of points by merging each pair of
+// adjacent points into one that covers both intervals.
+func downsampleResourceUsage(points []apimodels.ResourceUsagePoint) []apimodels.ResourceUsagePoint {
+	merged := make([]apimodels.ResourceUsagePoint, 0, (len(points)+1)/2)
+	for i := 0; i < len(points); i += 2 {
+		if i+1 == len(points) {
+			merged = append(merged, points[i])
+			break
+		}
+		a, b := points[i], points[i+1]
+		total := a.Duration + b.Duration
+		// Weight each point by the length of its interval.
+		avg := func(x, y float64) float64 {
+			if total <= 0 {
+				return (x + y) / 2
+			}
+			return (x*float64(a.Duration) + y*float64(b.Duration)) / float64(total)
+		}
+		merged = append(merged, apimodels.ResourceUsagePoint{
+			Time:                 a.Time,
+			Duration:             total,
+			CPUPercent:           avg(a.CPUPercent, b.CPUPercent),
+			MemoryPercent:        avg(a.MemoryPercent, b.MemoryPercent),
+			DiskReadBytesPerSec:  avg(a.DiskReadBytesPerSec, b.DiskReadBytesPerSec),
+			DiskWriteBytesPerSec: avg(a.DiskWriteBytesPerSec, b.DiskWriteBytesPerSec),
+			NetRecvBytesPerSec:   avg(a.NetRecvBytesPerSec, b.NetRecvBytesPerSec),
+			NetSentBytesPerSec:   avg(a.NetSentBytesPerSec, b.NetSentBytesPerSec),
+		})
+	}
+	return merged
 }
 
 func (rm *resourceMonitor) recordCPU(percent float64) {
@@ -132,3 +285,19 @@ func (rm *resourceMonitor) report() *apimodels.ResourceConstraintInfo {
 		PeakMemoryPercent: rm.peakMemoryPercent,
 	}
 }
+
+// usage returns the resource usage timeseries, or nil if no usage was sampled.
+func (rm *resourceMonitor) usage() *apimodels.ResourceUsage {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if len(rm.points) == 0 {
+		return nil
+	}
+
+	return &apimodels.ResourceUsage{
+		NumCPUs:          rm.numCPUs,
+		TotalMemoryBytes: rm.totalMemoryBytes,
+		Points:           append([]apimodels.ResourceUsagePoint{}, rm.points...),
+	}
+}
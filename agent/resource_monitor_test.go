@@ -2,7 +2,9 @@ package agent
 
 import (
 	"testing"
+	"time"
 
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,3 +135,86 @@ func TestResourceMonitorBothConstrained(t *testing.T) {
 	assert.InDelta(t, 95.0, info.PeakCPUPercent, 0.01)
 	assert.InDelta(t, 93.0, info.PeakMemoryPercent, 0.01)
 }
+
+func TestResourceMonitorUsage(t *testing.T) {
+	start := time.Now()
+
+	t.Run("NilWithoutSamples", func(t *testing.T) {
+		assert.Nil(t, newResourceMonitor(nil).usage())
+	})
+
+	t.Run("RecordsIORates", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.lastSampleTime = start
+		rm.lastIO = &ioCounters{diskReadBytes: 100, diskWriteBytes: 100, netRecvBytes: 100, netSentBytes: 100}
+		rm.recordUsage(start.Add(10*time.Second), 40, 60, 1024, &ioCounters{diskReadBytes: 1100, diskWriteBytes: 200, netRecvBytes: 600, netSentBytes: 50})
+
+		usage := rm.usage()
+		require.NotNil(t, usage)
+		assert.EqualValues(t, 1024, usage.TotalMemoryBytes)
+		require.Len(t, usage.Points, 1)
+		p := usage.Points[0]
+		assert.True(t, p.Time.Equal(start))
+		assert.Equal(t, 10*time.Second, p.Duration)
+		assert.InDelta(t, 40, p.CPUPercent, 0.01)
+		assert.InDelta(t, 60, p.MemoryPercent, 0.01)
+		assert.InDelta(t, 100, p.DiskReadBytesPerSec, 0.01)
+		assert.InDelta(t, 10, p.DiskWriteBytesPerSec, 0.01)
+		assert.InDelta(t, 50, p.NetRecvBytesPerSec, 0.01)
+		assert.Zero(t, p.NetSentBytesPerSec, "counter that went backwards should have no rate")
+	})
+
+	t.Run("NoIORatesWithoutCounters", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.lastSampleTime = start
+		rm.recordUsage(start.Add(resourceMonitorInterval), 40, 60, 1024, &ioCounters{diskReadBytes: 1000})
+		rm.recordUsage(start.Add(2*resourceMonitorInterval), 40, 60, 1024, nil)
+
+		usage := rm.usage()
+		require.NotNil(t, usage)
+		require.Len(t, usage.Points, 2)
+		assert.Zero(t, usage.Points[0].DiskReadBytesPerSec)
+		assert.Zero(t, usage.Points[1].DiskReadBytesPerSec)
+	})
+
+	t.Run("DownsamplesLongTimeseries", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.lastSampleTime = start
+		numSamples := 2*maxResourceUsagePoints + 1
+		for i := 1; i <= numSamples; i++ {
+			cpuPercent := 0.0
+			if i%2 == 0 {
+				cpuPercent = 100
+			}
+			rm.recordUsage(start.Add(time.Duration(i)*resourceMonitorInterval), cpuPercent, 50, 1024, nil)
+		}
+
+		usage := rm.usage()
+		require.NotNil(t, usage)
+		assert.LessOrEqual(t, len(usage.Points), maxResourceUsagePoints)
+		var covered time.Duration
+		for i, p := range usage.Points {
+			covered += p.Duration
+			if i > 0 {
+				prev := usage.Points[i-1]
+				assert.True(t, prev.Time.Add(prev.Duration).Equal(p.Time), "points should be contiguous")
+			}
+		}
+		assert.Equal(t, time.Duration(numSamples)*resourceMonitorInterval, covered)
+		assert.InDelta(t, 50, usage.Points[0].CPUPercent, 0.01, "merged points should average the samples")
+	})
+}
+
+func TestDownsampleResourceUsage(t *testing.T) {
+	start := time.Now()
+	merged := downsampleResourceUsage([]apimodels.ResourceUsagePoint{
+		{Time: start, Duration: time.Second, CPUPercent: 10},
+		{Time: start.Add(time.Second), Duration: 3 * time.Second, CPUPercent: 50},
+		{Time: start.Add(4 * time.Second), Duration: time.Second, CPUPercent: 70},
+	})
+	require.Len(t, merged, 2)
+	assert.True(t, merged[0].Time.Equal(start))
+	assert.Equal(t, 4*time.Second, merged[0].Duration)
+	assert.InDelta(t, 40, merged[0].CPUPercent, 0.01, "average should be weighted by duration")
+	assert.InDelta(t, 70, merged[1].CPUPercent, 0.01)
+}
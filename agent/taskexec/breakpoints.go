@@ -0,0 +1,150 @@
+package taskexec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+// Breakpoint pauses a continuous run (i.e. run-all or run-until) before a
+// step that matches all of its criteria.
+type Breakpoint struct {
+	ID int `json:"id"`
+	// FunctionName matches steps that are part of the named function.
+	FunctionName string `json:"function_name,omitempty"`
+	// CommandName matches steps that run the command type, such as
+	// "shell.exec".
+	CommandName string `json:"command_name,omitempty"`
+	// DisplayName matches steps whose display name contains it.
+	DisplayName string `json:"display_name,omitempty"`
+	// Condition, if set, only matches steps when the expansion condition
+	// holds at the time the step is about to run.
+	Condition *BreakpointCondition `json:"condition,omitempty"`
+}
+
+// BreakpointCondition compares the value of an expansion.
+type BreakpointCondition struct {
+	Expansion string `json:"expansion"`
+	// Equals determines whether the expansion must be equal or not equal to
+	// the value.
+	Equals bool   `json:"equals"`
+	Value  string `json:"value"`
+}
+
+// ParseBreakpointCondition parses a condition of the form "key==value" or
+// "key!=value".
+func ParseBreakpointCondition(condition string) (*BreakpointCondition, error) {
+	for _, op := range []string{"!=", "=="} {
+		key, value, found := strings.Cut(condition, op)
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, errors.Errorf("condition '%s' is missing an expansion name", condition)
+		}
+		return &BreakpointCondition{
+			Expansion: key,
+			Equals:    op == "==",
+			Value:     strings.TrimSpace(value),
+		}, nil
+	}
+	return nil, errors.Errorf("condition '%s' must be of the form 'key==value' or 'key!=value'", condition)
+}
+
+func (c BreakpointCondition) String() string {
+	op := "!="
+	if c.Equals {
+		op = "=="
+	}
+	return fmt.Sprintf("%s%s%s", c.Expansion, op, c.Value)
+}
+
+func (c BreakpointCondition) holds(expansions util.Expansions) bool {
+	return (expansions.Get(c.Expansion) == c.Value) == c.Equals
+}
+
+// Validate checks that the breakpoint has at least one criterion.
+func (bp Breakpoint) Validate() error {
+	if bp.FunctionName == "" && bp.CommandName == "" && bp.DisplayName == "" && bp.Condition == nil {
+		return errors.New("breakpoint must specify a function name, command name, display name, or condition")
+	}
+	return nil
+}
+
+// String describes the breakpoint's criteria.
+func (bp Breakpoint) String() string {
+	var criteria []string
+	if bp.FunctionName != "" {
+		criteria = append(criteria, fmt.Sprintf("function '%s'", bp.FunctionName))
+	}
+	if bp.CommandName != "" {
+		criteria = append(criteria, fmt.Sprintf("command '%s'", bp.CommandName))
+	}
+	if bp.DisplayName != "" {
+		criteria = append(criteria, fmt.Sprintf("display name containing '%s'", bp.DisplayName))
+	}
+	if bp.Condition != nil {
+		criteria = append(criteria, fmt.Sprintf("if %s", bp.Condition.String()))
+	}
+	return strings.Join(criteria, ", ")
+}
+
+func (bp Breakpoint) matches(cmd CommandInfo, expansions util.Expansions) bool {
+	if bp.FunctionName != "" && cmd.FunctionName != bp.FunctionName {
+		return false
+	}
+	if bp.CommandName != "" && cmd.CommandName != bp.CommandName {
+		return false
+	}
+	if bp.DisplayName != "" && !strings.Contains(cmd.DisplayName, bp.DisplayName) {
+		return false
+	}
+	if bp.Condition != nil && !bp.Condition.holds(expansions) {
+		return false
+	}
+	return true
+}
+
+// AddBreakpoint adds a breakpoint and returns it with its assigned ID.
+func (ds *DebugState) AddBreakpoint(bp Breakpoint) (Breakpoint, error) {
+	if err := bp.Validate(); err != nil {
+		return Breakpoint{}, err
+	}
+	ds.lastBreakpointID++
+	bp.ID = ds.lastBreakpointID
+	ds.Breakpoints = append(ds.Breakpoints, bp)
+	return bp, nil
+}
+
+// RemoveBreakpoint removes the breakpoint with the given ID.
+func (ds *DebugState) RemoveBreakpoint(id int) error {
+	for i, bp := range ds.Breakpoints {
+		if bp.ID == id {
+			ds.Breakpoints = append(ds.Breakpoints[:i], ds.Breakpoints[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Errorf("breakpoint %d not found", id)
+}
+
+// ClearBreakpoints removes all breakpoints.
+func (ds *DebugState) ClearBreakpoints() {
+	ds.Breakpoints = []Breakpoint{}
+}
+
+// matchingBreakpoint returns the first breakpoint that matches the step at
+// the given index, or nil if none match.
+func (ds *DebugState) matchingBreakpoint(index int, expansions util.Expansions) *Breakpoint {
+	if index < 0 || index >= len(ds.CommandList) {
+		return nil
+	}
+	for i := range ds.Breakpoints {
+		if ds.Breakpoints[i].matches(ds.CommandList[index], expansions) {
+			return &ds.Breakpoints[i]
+		}
+	}
+	return nil
+}
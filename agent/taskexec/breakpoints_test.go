@@ -0,0 +1,190 @@
+package taskexec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBreakpointCondition(t *testing.T) {
+	t.Run("Equals", func(t *testing.T) {
+		c, err := ParseBreakpointCondition("distro_id==ubuntu2204")
+		require.NoError(t, err)
+		assert.Equal(t, "distro_id", c.Expansion)
+		assert.True(t, c.Equals)
+		assert.Equal(t, "ubuntu2204", c.Value)
+	})
+	t.Run("NotEquals", func(t *testing.T) {
+		c, err := ParseBreakpointCondition("edition != enterprise")
+		require.NoError(t, err)
+		assert.Equal(t, "edition", c.Expansion)
+		assert.False(t, c.Equals)
+		assert.Equal(t, "enterprise", c.Value)
+	})
+	t.Run("EmptyValue", func(t *testing.T) {
+		c, err := ParseBreakpointCondition("flag==")
+		require.NoError(t, err)
+		assert.Equal(t, "", c.Value)
+	})
+	t.Run("MissingOperator", func(t *testing.T) {
+		_, err := ParseBreakpointCondition("distro_id=ubuntu2204")
+		assert.Error(t, err)
+	})
+	t.Run("MissingExpansion", func(t *testing.T) {
+		_, err := ParseBreakpointCondition("==ubuntu2204")
+		assert.Error(t, err)
+	})
+}
+
+func TestBreakpointMatches(t *testing.T) {
+	cmd := CommandInfo{
+		CommandName:  "shell.exec",
+		FunctionName: "run-tests",
+		DisplayName:  "'shell.exec' in function 'run-tests' (step 2.1 of 3)",
+	}
+	expansions := util.Expansions{"edition": "enterprise"}
+
+	assert.True(t, Breakpoint{FunctionName: "run-tests"}.matches(cmd, expansions))
+	assert.False(t, Breakpoint{FunctionName: "compile"}.matches(cmd, expansions))
+	assert.True(t, Breakpoint{CommandName: "shell.exec"}.matches(cmd, expansions))
+	assert.False(t, Breakpoint{CommandName: "s3.put"}.matches(cmd, expansions))
+	assert.True(t, Breakpoint{DisplayName: "step 2.1"}.matches(cmd, expansions))
+	assert.True(t, Breakpoint{Condition: &BreakpointCondition{Expansion: "edition", Equals: true, Value: "enterprise"}}.matches(cmd, expansions))
+	assert.False(t, Breakpoint{Condition: &BreakpointCondition{Expansion: "edition", Equals: false, Value: "enterprise"}}.matches(cmd, expansions))
+	assert.False(t, Breakpoint{
+		CommandName: "shell.exec",
+		Condition:   &BreakpointCondition{Expansion: "edition", Equals: true, Value: "community"},
+	}.matches(cmd, expansions), "all criteria must match")
+}
+
+func TestDebugStateBreakpoints(t *testing.T) {
+	ds := NewDebugState()
+
+	_, err := ds.AddBreakpoint(Breakpoint{})
+	assert.Error(t, err)
+
+	first, err := ds.AddBreakpoint(Breakpoint{CommandName: "shell.exec"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.ID)
+	second, err := ds.AddBreakpoint(Breakpoint{FunctionName: "compile"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.ID)
+
+	require.NoError(t, ds.RemoveBreakpoint(first.ID))
+	assert.Error(t, ds.RemoveBreakpoint(first.ID))
+	require.Len(t, ds.Breakpoints, 1)
+	assert.Equal(t, second.ID, ds.Breakpoints[0].ID)
+
+	third, err := ds.AddBreakpoint(Breakpoint{DisplayName: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, third.ID, "IDs should not be reused")
+
+	ds.ClearBreakpoints()
+	assert.Empty(t, ds.Breakpoints)
+}
+
+func TestRunWithBreakpoints(t *testing.T) {
+	const yamlContent = `
+functions:
+  run-tests:
+    - command: shell.exec
+      params:
+        script: echo "run tests"
+post:
+  - command: shell.exec
+    params:
+      script: echo "post"
+tasks:
+  - name: test-task
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "compile"
+      - func: run-tests
+      - command: shell.exec
+        params:
+          script: ${fail_command|true}
+      - command: shell.exec
+        params:
+          script: echo "after failure"
+`
+	setup := func(t *testing.T) *LocalExecutor {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{WorkingDir: tmpDir})
+		require.NoError(t, err)
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+		require.NoError(t, executor.PrepareTask(t.Context(), "test-task", ""))
+		require.Len(t, executor.debugState.CommandList, 5)
+		return executor
+	}
+
+	t.Run("PausesAtFunctionBreakpoint", func(t *testing.T) {
+		executor := setup(t)
+		_, err := executor.AddBreakpoint(t.Context(), Breakpoint{FunctionName: "run-tests"})
+		require.NoError(t, err)
+
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.Equal(t, 1, executor.debugState.CurrentStepIndex)
+
+		// Resuming runs past the breakpoint the run is paused at.
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.False(t, executor.debugState.HasMoreSteps())
+	})
+	t.Run("PausesAtConditionalBreakpointOnlyWhenConditionHolds", func(t *testing.T) {
+		executor := setup(t)
+		condition, err := ParseBreakpointCondition("stop==yes")
+		require.NoError(t, err)
+		_, err = executor.AddBreakpoint(t.Context(), Breakpoint{CommandName: "shell.exec", Condition: condition})
+		require.NoError(t, err)
+
+		require.NoError(t, executor.RunUntil(t.Context(), 3))
+		assert.Equal(t, 3, executor.debugState.CurrentStepIndex)
+
+		executor.SetVariable("stop", "yes")
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.Equal(t, 4, executor.debugState.CurrentStepIndex)
+	})
+	t.Run("FailureStopsAtFailedStep", func(t *testing.T) {
+		executor := setup(t)
+		executor.SetVariable("fail_command", "exit 1")
+
+		assert.Error(t, executor.RunAll(t.Context()))
+		assert.Equal(t, 2, executor.debugState.CurrentStepIndex)
+		for _, stepIndex := range []int{3, 4} {
+			executed, _ := executor.debugState.GetStepExecution(stepIndex)
+			assert.False(t, executed, "steps after a failure should not run")
+		}
+
+		// Running again retries the failed step.
+		executor.SetVariable("fail_command", "true")
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.False(t, executor.debugState.HasMoreSteps())
+		require.NoError(t, executor.RunAll(t.Context()), "running with no steps left should do nothing")
+	})
+	t.Run("BreakOnFailurePausesBeforeTeardown", func(t *testing.T) {
+		executor := setup(t)
+		executor.SetVariable("fail_command", "exit 1")
+		executor.SetBreakOnFailure(t.Context(), true)
+
+		assert.Error(t, executor.RunAll(t.Context()))
+		assert.Equal(t, 4, executor.debugState.CurrentStepIndex)
+		executed, _ := executor.debugState.GetStepExecution(3)
+		assert.False(t, executed, "remaining task commands should be skipped after a failure")
+		executed, _ = executor.debugState.GetStepExecution(4)
+		assert.False(t, executed)
+
+		// Resuming runs the teardown.
+		require.NoError(t, executor.RunAll(t.Context()))
+		executed, success := executor.debugState.GetStepExecution(4)
+		assert.True(t, executed)
+		assert.True(t, success)
+	})
+}
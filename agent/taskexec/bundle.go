@@ -0,0 +1,380 @@
+package taskexec
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// BundleFormatVersion is the version of the task bundle format.
+const BundleFormatVersion = 1
+
+// Paths of the files in a task bundle.
+const (
+	BundleManifestFile    = "manifest.json"
+	BundleTaskFile        = "task.json"
+	BundleProjectFile     = "project.yml"
+	BundleExpansionsFile  = "expansions.json"
+	BundleHostFile        = "host.json"
+	BundleDistroFile      = "distro.json"
+	BundleArtifactsFile   = "artifacts.json"
+	BundleTestResultsFile = "test_results.json"
+	// BundleLogsDir contains the task logs, one file per log type.
+	BundleLogsDir = "logs"
+	// BundleTestLogsDir contains test logs, stored under their log path.
+	BundleTestLogsDir = "logs/tests"
+)
+
+// BundleManifest describes a task bundle, which is a gzipped tarball of a task
+// execution's debugging context: its logs, test results, artifacts,
+// expansions, host and distro, and the part of the project config that defines
+// the task.
+type BundleManifest struct {
+	FormatVersion int       `json:"format_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	TaskID        string    `json:"task_id"`
+	Execution     int       `json:"execution"`
+	TaskName      string    `json:"task_name"`
+	BuildVariant  string    `json:"build_variant"`
+	Project       string    `json:"project"`
+	Revision      string    `json:"revision"`
+	// RedactedExpansions are the names of the expansions whose values were
+	// redacted from the bundle.
+	RedactedExpansions []string `json:"redacted_expansions,omitempty"`
+	// Files are the paths of the files in the bundle, other than the
+	// manifest.
+	Files []string `json:"files"`
+}
+
+// BundleWriter writes a task bundle.
+type BundleWriter struct {
+	manifest BundleManifest
+	gz       *gzip.Writer
+	tw       *tar.Writer
+}
+
+// NewBundleWriter returns a writer that writes a task bundle described by the
+// manifest to w. The manifest is written when the writer is closed.
+func NewBundleWriter(w io.Writer, manifest BundleManifest) *BundleWriter {
+	manifest.FormatVersion = BundleFormatVersion
+	if manifest.CreatedAt.IsZero() {
+		manifest.CreatedAt = time.Now()
+	}
+	gz := gzip.NewWriter(w)
+	return &BundleWriter{
+		manifest: manifest,
+		gz:       gz,
+		tw:       tar.NewWriter(gz),
+	}
+}
+
+// AddJSON adds a file with the JSON representation of v to the bundle.
+func (b *BundleWriter) AddJSON(name string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "marshalling '%s'", name)
+	}
+	return b.AddBytes(name, data)
+}
+
+// AddBytes adds a file with the given contents to the bundle.
+func (b *BundleWriter) AddBytes(name string, data []byte) error {
+	return b.add(name, int64(len(data)), bytes.NewReader(data))
+}
+
+// AddFile adds the local file at the given path to the bundle.
+func (b *BundleWriter) AddFile(name, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "opening file '%s'", localPath)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "getting info for file '%s'", localPath)
+	}
+
+	return b.add(name, info.Size(), f)
+}
+
+func (b *BundleWriter) add(name string, size int64, r io.Reader) error {
+	name, err := cleanBundlePath(name)
+	if err != nil {
+		return err
+	}
+
+	if err := b.tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		ModTime:  b.manifest.CreatedAt,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return errors.Wrapf(err, "writing header for '%s'", name)
+	}
+	if _, err := io.Copy(b.tw, r); err != nil {
+		return errors.Wrapf(err, "writing '%s'", name)
+	}
+	b.manifest.Files = append(b.manifest.Files, name)
+
+	return nil
+}
+
+// Close writes the bundle's manifest and finishes writing the bundle.
+func (b *BundleWriter) Close() error {
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshalling manifest")
+	}
+	if err := b.tw.WriteHeader(&tar.Header{
+		Name:     BundleManifestFile,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  b.manifest.CreatedAt,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return errors.Wrap(err, "writing manifest header")
+	}
+	if _, err := b.tw.Write(data); err != nil {
+		return errors.Wrap(err, "writing manifest")
+	}
+	if err := b.tw.Close(); err != nil {
+		return errors.Wrap(err, "closing tar writer")
+	}
+	return errors.Wrap(b.gz.Close(), "closing gzip writer")
+}
+
+// cleanBundlePath returns the slash-separated path of a file in the bundle.
+// The path must be relative and stay within the bundle.
+func cleanBundlePath(name string) (string, error) {
+	cleaned := path.Clean(filepath.ToSlash(name))
+	if cleaned == "." || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", errors.Errorf("invalid bundle path '%s'", name)
+	}
+	return cleaned, nil
+}
+
+// ExtractBundle extracts the task bundle read from r into the directory and
+// returns its manifest.
+func ExtractBundle(r io.Reader, dir string) (*BundleManifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating gzip reader")
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "reading bundle")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name, err := cleanBundlePath(hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+		localPath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+			return nil, errors.Wrapf(err, "creating directory for '%s'", name)
+		}
+		if err := writeBundleFile(localPath, tr); err != nil {
+			return nil, err
+		}
+	}
+
+	return ReadBundleManifest(dir)
+}
+
+func writeBundleFile(localPath string, r io.Reader) error {
+	f, err := os.Create(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "creating file '%s'", localPath)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "writing file '%s'", localPath)
+	}
+	return errors.Wrapf(f.Close(), "closing file '%s'", localPath)
+}
+
+// ReadBundleManifest reads the manifest of the task bundle extracted into the
+// directory.
+func ReadBundleManifest(dir string) (*BundleManifest, error) {
+	manifest := &BundleManifest{}
+	if err := readBundleJSON(dir, BundleManifestFile, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion > BundleFormatVersion {
+		return nil, errors.Errorf("bundle format version %d is newer than the supported version %d; update the CLI to load it", manifest.FormatVersion, BundleFormatVersion)
+	}
+	return manifest, nil
+}
+
+func readBundleJSON(dir, name string, v any) error {
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		return errors.Wrapf(err, "reading bundle file '%s'", name)
+	}
+	return errors.Wrapf(json.Unmarshal(data, v), "unmarshalling bundle file '%s'", name)
+}
+
+// WriteBundleConfig adds the project config and expansions of the task fetched
+// from the server to the bundle. Expansion values are redacted the same way
+// they are redacted from task logs.
+func (e *LocalExecutor) WriteBundleConfig(b *BundleWriter) error {
+	if e.project == nil {
+		return errors.New("project not loaded")
+	}
+
+	project, err := taskProjectConfig(e.project, e.GetFetchedTaskName(), e.GetFetchedBuildVariant())
+	if err != nil {
+		return errors.Wrap(err, "getting task's project config")
+	}
+	projectYAML, err := yaml.Marshal(project)
+	if err != nil {
+		return errors.Wrap(err, "marshalling project config")
+	}
+	if err := b.AddBytes(BundleProjectFile, projectYAML); err != nil {
+		return err
+	}
+
+	expansions, redacted := e.redactedExpansions()
+	if err := b.AddJSON(BundleExpansionsFile, expansions); err != nil {
+		return err
+	}
+	b.manifest.RedactedExpansions = redacted
+
+	return nil
+}
+
+// redactedExpansions returns the task's expansions with secrets redacted along
+// with the names of the expansions whose values were redacted.
+func (e *LocalExecutor) redactedExpansions() (map[string]string, []string) {
+	opts := redactor.RedactionOptions{
+		Expansions:         e.taskConfig.NewExpansions,
+		Redacted:           e.taskConfig.Redacted,
+		InternalRedactions: e.taskConfig.InternalRedactions,
+	}
+
+	expansions := map[string]string{}
+	var redacted []string
+	for k, v := range e.taskConfig.Expansions.Map() {
+		expansions[k] = redactor.RedactString(v, opts)
+		if expansions[k] != v {
+			redacted = append(redacted, k)
+		}
+	}
+	sort.Strings(redacted)
+
+	return expansions, redacted
+}
+
+// taskProjectConfig returns the parts of the project needed to run the task on
+// the build variant: the task, the build variant with only that task, the
+// project's pre, post and timeout commands and the functions they call. Tasks
+// in task groups are listed on the build variant directly since the local
+// executor runs a single task with the project's pre and post.
+func taskProjectConfig(p *model.Project, taskName, variantName string) (*model.Project, error) {
+	pt := p.FindProjectTask(taskName)
+	if pt == nil {
+		return nil, errors.Errorf("task '%s' not found in project", taskName)
+	}
+	bv := p.FindBuildVariant(variantName)
+	if bv == nil {
+		return nil, errors.Errorf("build variant '%s' not found in project", variantName)
+	}
+	bvtu, err := bv.Get(taskName)
+	if err != nil {
+		if tg := p.FindTaskGroupForTask(variantName, taskName); tg != nil {
+			bvtu = model.BuildVariantTaskUnit{Name: taskName, Variant: variantName}
+		} else {
+			return nil, errors.Wrapf(err, "task '%s' is not defined on build variant '%s'", taskName, variantName)
+		}
+	}
+
+	config := *p
+	config.Tasks = []model.ProjectTask{*pt}
+	config.TaskGroups = nil
+	variant := *bv
+	variant.Tasks = []model.BuildVariantTaskUnit{bvtu}
+	variant.DisplayTasks = nil
+	config.BuildVariants = model.BuildVariants{variant}
+
+	config.Functions = map[string]*model.YAMLCommandSet{}
+	addFunctions := func(cmds []model.PluginCommandConf) {
+		for _, cmd := range cmds {
+			if fn, ok := p.Functions[cmd.Function]; ok {
+				config.Functions[cmd.Function] = fn
+			}
+		}
+	}
+	addFunctions(pt.Commands)
+	for _, cmds := range []*model.YAMLCommandSet{p.Pre, p.Post, p.Timeout} {
+		if cmds != nil {
+			addFunctions(cmds.List())
+		}
+	}
+
+	return &config, nil
+}
+
+// loadBundle loads the task's project config and expansions from a task
+// bundle extracted into the directory.
+func (e *LocalExecutor) loadBundle(ctx context.Context, dir string) error {
+	manifest, err := ReadBundleManifest(dir)
+	if err != nil {
+		return err
+	}
+
+	expansions := map[string]string{}
+	if err := readBundleJSON(dir, BundleExpansionsFile, &expansions); err != nil {
+		return err
+	}
+	for k, v := range expansions {
+		e.taskConfig.Expansions.Put(k, v)
+	}
+
+	if _, err := e.LoadProject(filepath.Join(dir, BundleProjectFile)); err != nil {
+		return errors.Wrap(err, "loading bundle project config")
+	}
+
+	e.taskConfig.Task = task.Task{
+		Id:           manifest.TaskID,
+		Execution:    manifest.Execution,
+		DisplayName:  manifest.TaskName,
+		BuildVariant: manifest.BuildVariant,
+		Project:      manifest.Project,
+		Revision:     manifest.Revision,
+		Secret:       mockSecret,
+	}
+
+	if len(manifest.RedactedExpansions) > 0 {
+		e.logger.Warningf(ctx, "The values of these expansions were redacted from the bundle, set them with set-var if the task needs them: %s", strings.Join(manifest.RedactedExpansions, ", "))
+	}
+	e.logger.Infof(ctx, "Loaded bundle for task '%s' execution %d", manifest.TaskID, manifest.Execution)
+
+	return nil
+}
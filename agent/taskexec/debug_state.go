@@ -14,12 +14,20 @@ type DebugState struct {
 	LoadedProject    *model.Project
 	SelectedTask     string
 	SelectedVariant  string
-	CustomVars       map[string]string
-	CommandList      []CommandInfo
-	WorkingDir       string
-	LastError        error
-	ExecutionHistory []executionRecord
-	ConfigPath       string
+	// IncludeDependencies indicates that the selected task's dependencies
+	// run before it.
+	IncludeDependencies bool
+	CustomVars          map[string]string
+	CommandList         []CommandInfo
+	WorkingDir          string
+	LastError           error
+	ExecutionHistory    []executionRecord
+	ConfigPath          string
+	Breakpoints         []Breakpoint
+	// BreakOnFailure pauses a continuous run before the teardown of a task
+	// whose step failed instead of continuing through the teardown.
+	BreakOnFailure   bool
+	lastBreakpointID int
 }
 
 // executionRecord tracks the execution of a single command
@@ -56,6 +64,10 @@ type CommandInfo struct {
 	BlockTotalCmds   int
 	FuncSubCmdNum    int
 	FuncTotalSubCmds int
+	// TaskName is the task the command belongs to. It is only set when the
+	// session runs more than one task, such as a task group or a task with
+	// its dependencies.
+	TaskName string
 }
 
 func (ci CommandInfo) stepNumber() string {
@@ -68,10 +80,15 @@ func (ci CommandInfo) stepNumber() string {
 // FullStepNumber returns the block-qualified step number string.
 // For main block commands it returns just the step number (e.g. "5" or "5.3").
 // For pre/post blocks it returns a qualified form (e.g. "pre:1.2").
+// If the session runs more than one task, the step number is further qualified
+// by the task name (e.g. "compile/5" or "compile/setup_task:1").
 func (ci CommandInfo) FullStepNumber() string {
 	step := ci.stepNumber()
 	if ci.BlockType != command.MainTaskBlock {
-		return fmt.Sprintf("%s:%s", ci.BlockType, step)
+		step = fmt.Sprintf("%s:%s", ci.BlockType, step)
+	}
+	if ci.TaskName != "" {
+		return fmt.Sprintf("%s/%s", ci.TaskName, step)
 	}
 	return step
 }
@@ -94,6 +111,7 @@ func NewDebugState() *DebugState {
 		CustomVars:       make(map[string]string),
 		CommandList:      []CommandInfo{},
 		ExecutionHistory: []executionRecord{},
+		Breakpoints:      []Breakpoint{},
 	}
 }
 
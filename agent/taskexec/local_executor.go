@@ -16,6 +16,7 @@ import (
 	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
 	agentutil "github.com/evergreen-ci/evergreen/agent/util"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/logging"
@@ -29,6 +30,9 @@ var noOpCommands = map[string]string{
 	"generate.tasks":                        "dynamic task generation is not supported in local execution",
 	"downstream_expansions.set":             "downstream expansions are not available in local execution",
 	evergreen.AttachXUnitResultsCommandName: "test result attachment is not supported in local execution",
+	evergreen.AttachTAPResultsCommandName:   "test result attachment is not supported in local execution",
+	evergreen.AttachTRXResultsCommandName:   "test result attachment is not supported in local execution",
+	evergreen.AttachCucumberJSONCommandName: "test result attachment is not supported in local execution",
 	evergreen.AttachResultsCommandName:      "result attachment is not supported in local execution",
 	"gotest.parse_files":                    "result attachment is not supported in local execution",
 	evergreen.AttachArtifactsCommandName:    "artifact attachment is not supported in local execution",
@@ -62,6 +66,15 @@ type executorBlock struct {
 	startIndex  int
 	endIndex    int
 	canFailTask bool
+	// taskName is the task that the block runs commands for. It is only set
+	// when the session runs more than one task.
+	taskName string
+	// isDependency indicates that the block runs a dependency of the
+	// selected task.
+	isDependency bool
+	// workDir is the working directory that the block runs in. If it's
+	// empty, the block runs in the session's working directory.
+	workDir string
 }
 
 // LocalExecutor implements task execution for local YAML files
@@ -92,6 +105,9 @@ type LocalExecutorOptions struct {
 	OAuthToken   string
 	SpawnHostID  string
 	LocalModules map[string]string
+	// BundleDir is the directory of an extracted task bundle to load the
+	// task from instead of fetching it from the server.
+	BundleDir string
 }
 
 // NewLocalExecutor creates a new local task executor
@@ -138,7 +154,11 @@ func NewLocalExecutor(ctx context.Context, opts LocalExecutorOptions) (*LocalExe
 		opts:           opts,
 	}
 
-	if opts.TaskID != "" {
+	if opts.BundleDir != "" {
+		if err := localExecutor.loadBundle(ctx, opts.BundleDir); err != nil {
+			return nil, errors.Wrap(err, "loading task bundle")
+		}
+	} else if opts.TaskID != "" {
 		if err := localExecutor.fetchTaskConfig(ctx, opts); err != nil {
 			return nil, errors.Wrap(err, "fetching task config")
 		}
@@ -203,7 +223,11 @@ func (e *LocalExecutor) ReloadProject(ctx context.Context, configPath string) (*
 	}
 
 	if e.debugState.SelectedTask != "" {
-		if err := e.PrepareTask(ctx, e.debugState.SelectedTask, e.debugState.SelectedVariant); err != nil {
+		if err := e.PrepareTaskWithOptions(ctx, PrepareTaskOptions{
+			TaskName:            e.debugState.SelectedTask,
+			VariantName:         e.debugState.SelectedVariant,
+			IncludeDependencies: e.debugState.IncludeDependencies,
+		}); err != nil {
 			return nil, errors.Wrap(err, "re-preparing task after reload")
 		}
 	}
@@ -256,18 +280,86 @@ func (e *LocalExecutor) RunUntil(ctx context.Context, untilIndex int) error {
 		untilIndex = maxIndex
 	}
 
-	for e.debugState.CurrentStepIndex < untilIndex {
-		if err := e.StepNext(ctx); err != nil {
-			e.logger.Errorf(ctx, "Step %s failed: %v", e.debugState.CommandList[e.debugState.CurrentStepIndex].FullStepNumber(), err)
-			return err
+	return e.runSteps(ctx, untilIndex)
+}
+
+// runSteps continuously executes steps up to but not including the given
+// index. It pauses before any step that matches a breakpoint, except for the
+// step it starts at so that a paused run can be resumed. If a step fails, the
+// run stops at the failed step so that running again retries it. If break on
+// failure is enabled, it instead skips the rest of the failed task's commands
+// and pauses before the task's teardown, like the agent would run it.
+func (e *LocalExecutor) runSteps(ctx context.Context, untilIndex int) error {
+	for first := true; e.debugState.CurrentStepIndex < untilIndex; first = false {
+		stepIndex := e.debugState.CurrentStepIndex
+		if !first {
+			if bp := e.debugState.matchingBreakpoint(stepIndex, e.taskConfig.Expansions); bp != nil {
+				e.pause(ctx, fmt.Sprintf("Paused at breakpoint %d (%s) before step %s.", bp.ID, bp.String(), e.debugState.CommandList[stepIndex].FullStepNumber()))
+				return nil
+			}
+		}
+
+		err := e.stepNext(ctx)
+		if err == nil {
+			continue
+		}
+		step := e.debugState.CommandList[stepIndex]
+		failedStep := step.FullStepNumber()
+		e.logger.Errorf(ctx, "Step %s failed: %v", failedStep, err)
+		if e.commandBlocks[step.BlockIndex].isDependency {
+			e.logger.Errorf(ctx, "Dependency '%s' failed, so the selected task would not run in Evergreen.", step.TaskName)
+			err = errors.Wrapf(err, "dependency '%s' failed", step.TaskName)
 		}
-		if e.debugState.CurrentStepIndex >= untilIndex {
-			break
+
+		if e.debugState.BreakOnFailure {
+			e.debugState.CurrentStepIndex = e.nextTeardownIndex(stepIndex)
+			msg := fmt.Sprintf("Paused after step %s failed.", failedStep)
+			if e.debugState.HasMoreSteps() {
+				msg = fmt.Sprintf("Paused before teardown after step %s failed. Use 'jump %s' to retry the failed step.", failedStep, failedStep)
+			}
+			e.pause(ctx, msg)
 		}
+		return err
 	}
 	return nil
 }
 
+// nextTeardownIndex returns the index of the step to run after the step at
+// the given index fails. If the step is not part of a teardown block, the
+// remaining steps in its task are skipped up to the task's teardown.
+func (e *LocalExecutor) nextTeardownIndex(failedIndex int) int {
+	failedBlockIdx := e.debugState.CommandList[failedIndex].BlockIndex
+	failedBlock := e.commandBlocks[failedBlockIdx]
+	if isTeardownBlock(failedBlock.blockType) {
+		return failedIndex + 1
+	}
+
+	for i := failedBlockIdx + 1; i < len(e.commandBlocks); i++ {
+		block := e.commandBlocks[i]
+		if block.taskName != failedBlock.taskName || isTeardownBlock(block.blockType) {
+			return block.startIndex
+		}
+	}
+	return len(e.debugState.CommandList)
+}
+
+func isTeardownBlock(blockType command.BlockType) bool {
+	switch blockType {
+	case command.PostBlock, command.TeardownTaskBlock, command.TeardownGroupBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// pause logs why a continuous run stopped.
+func (e *LocalExecutor) pause(ctx context.Context, msg string) {
+	e.logger.Info(ctx, msg)
+	if e.streamWriter != nil {
+		e.streamWriter.WriteChannelMessage(ExecChannel, msg)
+	}
+}
+
 // JumpTo moves to the specified step without executing
 func (e *LocalExecutor) JumpTo(index int) error {
 	if len(e.commandBlocks) == 0 {
@@ -364,6 +456,12 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 
 	// Only process the specific block (i.e. pre, main, post) containing our target command
 	block := e.commandBlocks[targetBlockIdx]
+	if block.taskName != "" {
+		e.taskConfig.Expansions.Put("task_name", block.taskName)
+	}
+	if block.workDir != "" {
+		defer e.useWorkDir(block.workDir)()
+	}
 	cmdBlock := executor.CommandBlock{
 		Block:       block.blockType,
 		Commands:    block.commands,
@@ -475,15 +573,26 @@ func (e *LocalExecutor) getNoOpMessage(cmdName string) string {
 	return cmdName + ": Skipping - command is not supported in local execution"
 }
 
-// RunAll executes all steps in a task
+// RunAll executes all steps in a task, pausing at breakpoints.
 func (e *LocalExecutor) RunAll(ctx context.Context) error {
-	for e.debugState.HasMoreSteps() {
-		if err := e.StepNext(ctx); err != nil {
-			e.logger.Warningf(ctx, "Step failed, continuing")
-			return err
-		}
+	return e.runSteps(ctx, len(e.debugState.CommandList))
+}
+
+// AddBreakpoint adds a breakpoint to the debug session.
+func (e *LocalExecutor) AddBreakpoint(ctx context.Context, bp Breakpoint) (Breakpoint, error) {
+	added, err := e.debugState.AddBreakpoint(bp)
+	if err != nil {
+		return Breakpoint{}, err
 	}
-	return nil
+	e.logger.Infof(ctx, "Added breakpoint %d: %s", added.ID, added.String())
+	return added, nil
+}
+
+// SetBreakOnFailure sets whether continuous runs pause before the teardown of
+// a task whose step failed.
+func (e *LocalExecutor) SetBreakOnFailure(ctx context.Context, enabled bool) {
+	e.debugState.BreakOnFailure = enabled
+	e.logger.Infof(ctx, "Set break on failure to %t", enabled)
 }
 
 // GetDebugState returns the current debug state
@@ -497,13 +606,13 @@ func (e *LocalExecutor) GetProject() *model.Project {
 }
 
 // GetFetchedTaskName returns the display name of the task fetched from
-// the server during initialization.
+// the server, or loaded from a task bundle, during initialization.
 func (e *LocalExecutor) GetFetchedTaskName() string {
 	return e.taskConfig.Task.DisplayName
 }
 
 // GetFetchedBuildVariant returns the build variant of the task fetched
-// from the server during initialization.
+// from the server, or loaded from a task bundle, during initialization.
 func (e *LocalExecutor) GetFetchedBuildVariant() string {
 	return e.taskConfig.Task.BuildVariant
 }
@@ -650,60 +759,108 @@ func (e *LocalExecutor) handleNoOpCommand(ctx context.Context, cmd command.Comma
 	e.logger.Infof(ctx, e.getNoOpMessage(cmd.Name()))
 }
 
+// PrepareTaskOptions configures the commands that run in a debug session.
+type PrepareTaskOptions struct {
+	// TaskName is the name of the task or task group to run.
+	TaskName string
+	// VariantName is the build variant whose expansions are applied. It is
+	// required to include dependencies.
+	VariantName string
+	// IncludeDependencies runs the dependencies of the task (or of the tasks
+	// in the task group) on the same build variant before the task itself.
+	IncludeDependencies bool
+}
+
 // PrepareTask prepares a task for execution by creating command blocks.
 // If variantName is provided, it validates the task exists on that variant
 // and applies the variant's expansions.
 func (e *LocalExecutor) PrepareTask(ctx context.Context, taskName, variantName string) error {
+	return e.PrepareTaskWithOptions(ctx, PrepareTaskOptions{
+		TaskName:    taskName,
+		VariantName: variantName,
+	})
+}
+
+// PrepareTaskWithOptions prepares a task or task group for execution by
+// creating command blocks. A task group runs setup_group, then each of its
+// tasks surrounded by setup_task and teardown_task, and finally
+// teardown_group. Like in the agent, all tasks in the selected task group
+// share the same working directory, while dependencies run in their own.
+func (e *LocalExecutor) PrepareTaskWithOptions(ctx context.Context, opts PrepareTaskOptions) error {
 	if e.project == nil {
 		return errors.New("project not loaded")
 	}
 
-	task := e.project.FindProjectTask(taskName)
-	if task == nil {
-		return errors.Errorf("task '%s' not found in project", taskName)
+	tg := e.project.FindTaskGroup(opts.TaskName)
+	taskNames := []string{opts.TaskName}
+	if tg != nil {
+		if len(tg.Tasks) == 0 {
+			return errors.Errorf("task group '%s' has no tasks", opts.TaskName)
+		}
+		taskNames = tg.Tasks
+	} else if e.project.FindProjectTask(opts.TaskName) == nil {
+		return errors.Errorf("task '%s' not found in project", opts.TaskName)
+	}
+	if opts.IncludeDependencies && opts.VariantName == "" {
+		return errors.New("a build variant is required to include dependencies")
 	}
 
-	e.debugState.SelectedTask = taskName
-	e.logger.Infof(ctx, "Preparing task: %s", taskName)
+	e.debugState.SelectedTask = opts.TaskName
+	e.debugState.IncludeDependencies = opts.IncludeDependencies
+	e.logger.Infof(ctx, "Preparing task: %s", opts.TaskName)
 
-	if variantName != "" {
-		bv := e.project.FindBuildVariant(variantName)
+	if opts.VariantName != "" {
+		bv := e.project.FindBuildVariant(opts.VariantName)
 		if bv == nil {
-			return errors.Errorf("build variant '%s' not found in project", variantName)
+			return errors.Errorf("build variant '%s' not found in project", opts.VariantName)
 		}
-		if _, err := bv.Get(taskName); err != nil {
-			return errors.Wrapf(err, "task '%s' is not defined on build variant '%s'", taskName, variantName)
+		if _, err := bv.Get(opts.TaskName); err != nil {
+			return errors.Wrapf(err, "task '%s' is not defined on build variant '%s'", opts.TaskName, opts.VariantName)
 		}
-		e.debugState.SelectedVariant = variantName
-		e.taskConfig.Expansions.Put("build_variant", variantName)
+		e.debugState.SelectedVariant = opts.VariantName
+		e.taskConfig.Expansions.Put("build_variant", opts.VariantName)
 		e.taskConfig.Expansions.Update(bv.Expansions)
-		e.logger.Infof(ctx, "Applied expansions from build variant: %s", variantName)
+		e.logger.Infof(ctx, "Applied expansions from build variant: %s", opts.VariantName)
 	}
 
-	// Build command blocks array with pre, main, and post blocks
 	var blocks []executorBlock
+	if opts.IncludeDependencies {
+		depNames, err := e.findDependencies(taskNames, opts.VariantName)
+		if err != nil {
+			return errors.Wrap(err, "finding dependencies")
+		}
+		depBlocks, err := e.dependencyBlocks(depNames, opts.VariantName)
+		if err != nil {
+			return errors.Wrap(err, "preparing dependencies")
+		}
+		blocks = append(blocks, depBlocks...)
+		e.logger.Infof(ctx, "Including %d dependencies: %s", len(depNames), strings.Join(depNames, ", "))
+	}
 
-	if e.project.Pre != nil && len(e.project.Pre.List()) > 0 {
+	// Steps only need to be qualified by task name if more than one task
+	// runs in the session.
+	qualifyTaskName := tg != nil || len(blocks) > 0
+	if tg != nil && tg.SetupGroup != nil && len(tg.SetupGroup.List()) > 0 {
 		blocks = append(blocks, executorBlock{
-			blockType:   command.PreBlock,
-			commands:    e.project.Pre,
-			canFailTask: e.project.PreErrorFailsTask,
+			blockType:   command.SetupGroupBlock,
+			commands:    tg.SetupGroup,
+			canFailTask: tg.SetupGroupCanFailTask,
 		})
 	}
-	blocks = append(blocks, executorBlock{
-		blockType: command.MainTaskBlock,
-		commands: &model.YAMLCommandSet{
-			MultiCommand: task.Commands,
-		},
-		canFailTask: true,
-	})
-	if e.project.Post != nil && len(e.project.Post.List()) > 0 {
+	for _, name := range taskNames {
+		taskBlocks, err := e.taskBlocks(name, tg, qualifyTaskName)
+		if err != nil {
+			return errors.Wrapf(err, "preparing task '%s'", name)
+		}
+		blocks = append(blocks, taskBlocks...)
+	}
+	if tg != nil && tg.TeardownGroup != nil && len(tg.TeardownGroup.List()) > 0 {
 		blocks = append(blocks, executorBlock{
-			blockType:   command.PostBlock,
-			commands:    e.project.Post,
-			canFailTask: e.project.PostErrorFailsTask,
+			blockType: command.TeardownGroupBlock,
+			commands:  tg.TeardownGroup,
 		})
 	}
+	e.taskConfig.TaskGroup = tg
 	e.commandBlocks = blocks
 	if err := e.rebuildCommandList(); err != nil {
 		return errors.Wrap(err, "rebuilding command list")
@@ -733,6 +890,199 @@ func (e *LocalExecutor) PrepareTask(ctx context.Context, taskName, variantName s
 	return nil
 }
 
+// taskBlocks returns the command blocks that run a single task. Tasks in a
+// task group run setup_task and teardown_task instead of the project's pre
+// and post.
+func (e *LocalExecutor) taskBlocks(taskName string, tg *model.TaskGroup, qualifyTaskName bool) ([]executorBlock, error) {
+	projectTask := e.project.FindProjectTask(taskName)
+	if projectTask == nil {
+		return nil, errors.Errorf("task '%s' not found in project", taskName)
+	}
+
+	var blockTaskName string
+	if qualifyTaskName {
+		blockTaskName = taskName
+	}
+
+	preBlock := executorBlock{
+		blockType:   command.PreBlock,
+		commands:    e.project.Pre,
+		canFailTask: e.project.PreErrorFailsTask,
+		taskName:    blockTaskName,
+	}
+	postBlock := executorBlock{
+		blockType:   command.PostBlock,
+		commands:    e.project.Post,
+		canFailTask: e.project.PostErrorFailsTask,
+		taskName:    blockTaskName,
+	}
+	if tg != nil {
+		preBlock.blockType = command.SetupTaskBlock
+		preBlock.commands = tg.SetupTask
+		preBlock.canFailTask = tg.SetupTaskCanFailTask
+		postBlock.blockType = command.TeardownTaskBlock
+		postBlock.commands = tg.TeardownTask
+		postBlock.canFailTask = tg.TeardownTaskCanFailTask
+	}
+
+	var blocks []executorBlock
+	if preBlock.commands != nil && len(preBlock.commands.List()) > 0 {
+		blocks = append(blocks, preBlock)
+	}
+	blocks = append(blocks, executorBlock{
+		blockType: command.MainTaskBlock,
+		commands: &model.YAMLCommandSet{
+			MultiCommand: projectTask.Commands,
+		},
+		canFailTask: true,
+		taskName:    blockTaskName,
+	})
+	if postBlock.commands != nil && len(postBlock.commands.List()) > 0 {
+		blocks = append(blocks, postBlock)
+	}
+
+	return blocks, nil
+}
+
+// dependencyBlocks returns the command blocks that run the given
+// dependencies in order. Consecutive dependencies in the same task group run
+// between the group's setup_group and teardown_group and share a working
+// directory, like on a host running the task group. The setup_group and
+// teardown_group steps are attributed to the first and last task that run
+// in the group. Every other dependency runs in its own working directory.
+func (e *LocalExecutor) dependencyBlocks(depNames []string, variantName string) ([]executorBlock, error) {
+	var blocks []executorBlock
+	for i := 0; i < len(depNames); {
+		tg := e.project.FindTaskGroupForTask(variantName, depNames[i])
+		end := i + 1
+		for tg != nil && end < len(depNames) {
+			nextTG := e.project.FindTaskGroupForTask(variantName, depNames[end])
+			if nextTG == nil || nextTG.Name != tg.Name {
+				break
+			}
+			end++
+		}
+
+		name := depNames[i]
+		var segment []executorBlock
+		if tg != nil {
+			name = tg.Name
+			if tg.SetupGroup != nil && len(tg.SetupGroup.List()) > 0 {
+				segment = append(segment, executorBlock{
+					blockType:   command.SetupGroupBlock,
+					commands:    tg.SetupGroup,
+					canFailTask: tg.SetupGroupCanFailTask,
+					taskName:    depNames[i],
+				})
+			}
+		}
+		for _, depName := range depNames[i:end] {
+			taskBlocks, err := e.taskBlocks(depName, tg, true)
+			if err != nil {
+				return nil, errors.Wrapf(err, "preparing dependency '%s'", depName)
+			}
+			segment = append(segment, taskBlocks...)
+		}
+		if tg != nil && tg.TeardownGroup != nil && len(tg.TeardownGroup.List()) > 0 {
+			segment = append(segment, executorBlock{
+				blockType: command.TeardownGroupBlock,
+				commands:  tg.TeardownGroup,
+				taskName:  depNames[end-1],
+			})
+		}
+
+		workDir, err := e.dependencyWorkDir(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating working directory for dependency '%s'", name)
+		}
+		for j := range segment {
+			segment[j].isDependency = true
+			segment[j].workDir = workDir
+		}
+		blocks = append(blocks, segment...)
+		i = end
+	}
+	return blocks, nil
+}
+
+// dependencyWorkDir creates and returns the working directory for the named
+// dependency or task group of dependencies. It's next to the session's
+// working directory so that the selected task's working directory only
+// contains its own files.
+func (e *LocalExecutor) dependencyWorkDir(name string) (string, error) {
+	base := e.workDir
+	if base == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return "", errors.Wrap(err, "getting current directory")
+		}
+		base = cwd
+	}
+	dir := filepath.Join(filepath.Dir(base), filepath.Base(base)+"-deps", name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", errors.Wrapf(err, "creating directory '%s'", dir)
+	}
+	return dir, nil
+}
+
+// useWorkDir runs the following commands in the given working directory. It
+// returns a function that restores the previous working directory.
+func (e *LocalExecutor) useWorkDir(dir string) func() {
+	prevWorkDir := e.taskConfig.WorkDir
+	prevExpansion, hadExpansion := e.taskConfig.Expansions.Get("workdir"), e.taskConfig.Expansions.Exists("workdir")
+	e.taskConfig.WorkDir = dir
+	e.taskConfig.Expansions.Put("workdir", dir)
+	return func() {
+		e.taskConfig.WorkDir = prevWorkDir
+		if hadExpansion {
+			e.taskConfig.Expansions.Put("workdir", prevExpansion)
+		} else {
+			e.taskConfig.Expansions.Remove("workdir")
+		}
+	}
+}
+
+// findDependencies returns the names of the tasks on the build variant that
+// the given tasks depend on, directly or transitively, ordered so that every
+// task comes after the tasks it depends on. Dependencies on other build
+// variants are not included because they would need that variant's
+// expansions.
+func (e *LocalExecutor) findDependencies(taskNames []string, variantName string) ([]string, error) {
+	g := e.project.DependencyGraph()
+	if cycles := g.Cycles(); len(cycles) > 0 {
+		return nil, errors.Errorf("project has dependency cycles: %s", cycles.String())
+	}
+	// Dependent tasks are sorted before the tasks they depend on.
+	sortedNodes, err := g.TopologicalStableSort()
+	if err != nil {
+		return nil, errors.Wrap(err, "sorting tasks by dependencies")
+	}
+
+	inSession := make(map[string]bool, len(taskNames))
+	for _, name := range taskNames {
+		inSession[name] = true
+	}
+	sameVariant := func(edge task.DependencyEdge) bool {
+		return edge.To.Variant == variantName
+	}
+
+	var deps []string
+	for i := len(sortedNodes) - 1; i >= 0; i-- {
+		node := sortedNodes[i]
+		if node.Variant != variantName || inSession[node.Name] {
+			continue
+		}
+		for _, name := range taskNames {
+			if g.DepthFirstSearch(task.TaskNode{Name: name, Variant: variantName}, node, sameVariant) {
+				deps = append(deps, node.Name)
+				break
+			}
+		}
+	}
+
+	return deps, nil
+}
+
 // rebuildCommandList rebuilds the flattened command list from command blocks
 func (e *LocalExecutor) rebuildCommandList() error {
 	e.debugState.CommandList = []CommandInfo{}
@@ -762,6 +1112,7 @@ func (e *LocalExecutor) rebuildCommandList() error {
 					BlockIndex:     blockIdx,
 					BlockCmdNum:    cmdIdx + 1,
 					BlockTotalCmds: len(commands),
+					TaskName:       block.taskName,
 				})
 				globalIndex++
 				continue
@@ -784,6 +1135,7 @@ func (e *LocalExecutor) rebuildCommandList() error {
 					BlockIndex:     blockIdx,
 					BlockCmdNum:    cmdIdx + 1,
 					BlockTotalCmds: len(commands),
+					TaskName:       block.taskName,
 				}
 				if cmd.Function != "" {
 					// rcmdIdx is 0-indexed but step numbers are 1-indexed to match
@@ -407,6 +407,271 @@ buildvariants:
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not defined on build variant")
 	})
+
+	t.Run("PreparesTaskGroup", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		yamlContent := `
+pre:
+  - command: shell.exec
+    params:
+      script: echo "pre"
+tasks:
+  - name: first-task
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "first"
+  - name: second-task
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "second"
+task_groups:
+  - name: my-group
+    setup_group:
+      - command: shell.exec
+        params:
+          script: echo "setup group"
+    setup_task:
+      - command: shell.exec
+        params:
+          script: echo "setup task"
+    teardown_task:
+      - command: shell.exec
+        params:
+          script: echo "teardown task"
+    teardown_group:
+      - command: shell.exec
+        params:
+          script: echo "teardown group"
+    tasks:
+      - first-task
+      - second-task
+buildvariants:
+  - name: ubuntu2204
+    tasks:
+      - name: my-group
+`
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{})
+		require.NoError(t, err)
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+
+		require.NoError(t, executor.PrepareTask(t.Context(), "my-group", "ubuntu2204"))
+		assert.Equal(t, "my-group", executor.debugState.SelectedTask)
+		require.NotNil(t, executor.taskConfig.TaskGroup)
+		assert.Equal(t, "my-group", executor.taskConfig.TaskGroup.Name)
+
+		var stepNumbers []string
+		for _, cmd := range executor.debugState.CommandList {
+			stepNumbers = append(stepNumbers, cmd.FullStepNumber())
+		}
+		assert.Equal(t, []string{
+			"setup_group:1",
+			"first-task/setup_task:1",
+			"first-task/1",
+			"first-task/teardown_task:1",
+			"second-task/setup_task:1",
+			"second-task/1",
+			"second-task/teardown_task:1",
+			"teardown_group:1",
+		}, stepNumbers)
+
+		idx, err := executor.debugState.ResolveStepNumber("second-task/1")
+		require.NoError(t, err)
+		assert.Equal(t, 5, idx)
+	})
+
+	t.Run("IncludesDependencies", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		yamlContent := `
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "compile"
+  - name: lint
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "lint"
+  - name: package
+    depends_on:
+      - name: compile
+      - name: lint
+        variant: other
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "package"
+  - name: test
+    depends_on:
+      - name: package
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "test"
+  - name: unrelated
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "unrelated"
+buildvariants:
+  - name: ubuntu2204
+    tasks:
+      - name: compile
+      - name: package
+      - name: test
+      - name: unrelated
+  - name: other
+    tasks:
+      - name: lint
+`
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		workDir := filepath.Join(tmpDir, "work")
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{WorkingDir: workDir})
+		require.NoError(t, err)
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+
+		require.NoError(t, executor.PrepareTaskWithOptions(t.Context(), PrepareTaskOptions{
+			TaskName:            "test",
+			VariantName:         "ubuntu2204",
+			IncludeDependencies: true,
+		}))
+		assert.True(t, executor.debugState.IncludeDependencies)
+
+		var stepNumbers []string
+		for _, cmd := range executor.debugState.CommandList {
+			stepNumbers = append(stepNumbers, cmd.FullStepNumber())
+		}
+		assert.Equal(t, []string{"compile/1", "package/1", "test/1"}, stepNumbers)
+
+		require.Len(t, executor.commandBlocks, 3)
+		for i, depName := range []string{"compile", "package"} {
+			block := executor.commandBlocks[i]
+			assert.True(t, block.isDependency)
+			assert.Equal(t, filepath.Join(tmpDir, "work-deps", depName), block.workDir, "each dependency should run in its own working directory")
+			assert.DirExists(t, block.workDir)
+		}
+		assert.False(t, executor.commandBlocks[2].isDependency)
+		assert.Empty(t, executor.commandBlocks[2].workDir, "selected task should run in the session's working directory")
+	})
+
+	t.Run("IncludesTaskGroupDependencies", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		yamlContent := `
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "compile"
+  - name: package
+    depends_on:
+      - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "package"
+  - name: test
+    depends_on:
+      - name: package
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "test"
+task_groups:
+  - name: build-group
+    max_hosts: 1
+    setup_group:
+      - command: shell.exec
+        params:
+          script: echo "setup group"
+    setup_task:
+      - command: shell.exec
+        params:
+          script: echo "setup task"
+    teardown_group:
+      - command: shell.exec
+        params:
+          script: echo "teardown group"
+    tasks:
+      - compile
+      - package
+buildvariants:
+  - name: ubuntu2204
+    tasks:
+      - name: build-group
+      - name: test
+`
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		workDir := filepath.Join(tmpDir, "work")
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{WorkingDir: workDir})
+		require.NoError(t, err)
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+
+		require.NoError(t, executor.PrepareTaskWithOptions(t.Context(), PrepareTaskOptions{
+			TaskName:            "test",
+			VariantName:         "ubuntu2204",
+			IncludeDependencies: true,
+		}))
+
+		var stepNumbers []string
+		for _, cmd := range executor.debugState.CommandList {
+			stepNumbers = append(stepNumbers, cmd.FullStepNumber())
+		}
+		assert.Equal(t, []string{
+			"compile/setup_group:1",
+			"compile/setup_task:1",
+			"compile/1",
+			"package/setup_task:1",
+			"package/1",
+			"package/teardown_group:1",
+			"test/1",
+		}, stepNumbers)
+
+		groupWorkDir := filepath.Join(tmpDir, "work-deps", "build-group")
+		for _, block := range executor.commandBlocks[:len(executor.commandBlocks)-1] {
+			assert.True(t, block.isDependency)
+			assert.Equal(t, groupWorkDir, block.workDir, "dependencies in the same task group should share a working directory")
+		}
+	})
+
+	t.Run("IncludeDependenciesRequiresVariant", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		yamlContent := `
+tasks:
+  - name: test-task
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "test"
+`
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{})
+		require.NoError(t, err)
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+
+		err = executor.PrepareTaskWithOptions(t.Context(), PrepareTaskOptions{
+			TaskName:            "test-task",
+			IncludeDependencies: true,
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "build variant is required")
+	})
 }
 
 func TestCommandInfoStepNumber(t *testing.T) {
@@ -446,6 +711,11 @@ func TestCommandInfoFullStepNumber(t *testing.T) {
 		ci := CommandInfo{BlockCmdNum: 3}
 		assert.Equal(t, "3", ci.FullStepNumber())
 	})
+
+	t.Run("QualifiedByTaskName", func(t *testing.T) {
+		ci := CommandInfo{BlockCmdNum: 1, BlockType: command.SetupTaskBlock, TaskName: "compile"}
+		assert.Equal(t, "compile/setup_task:1", ci.FullStepNumber())
+	})
 }
 
 func TestResolveStepNumber(t *testing.T) {
@@ -780,3 +1050,79 @@ tasks:
 		assert.True(t, isNoOp, "s3.put inside a function should be detected as a noOp command")
 	})
 }
+
+func TestRunWithDependencies(t *testing.T) {
+	yamlContent := `
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: touch compiled
+      - command: shell.exec
+        params:
+          script: ${fail_command|true}
+  - name: test
+    depends_on:
+      - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: touch tested
+buildvariants:
+  - name: ubuntu2204
+    tasks:
+      - name: compile
+      - name: test
+`
+	setup := func(t *testing.T) (*LocalExecutor, string) {
+		tmpDir := t.TempDir()
+		yamlFile := filepath.Join(tmpDir, "test.yml")
+		require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+		workDir := filepath.Join(tmpDir, "work")
+		executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{WorkingDir: workDir})
+		require.NoError(t, err)
+		require.NoError(t, executor.SetupWorkingDirectory(workDir))
+		_, err = executor.LoadProject(yamlFile)
+		require.NoError(t, err)
+		require.NoError(t, executor.PrepareTaskWithOptions(t.Context(), PrepareTaskOptions{
+			TaskName:            "test",
+			VariantName:         "ubuntu2204",
+			IncludeDependencies: true,
+		}))
+		require.Len(t, executor.debugState.CommandList, 3)
+		return executor, tmpDir
+	}
+
+	t.Run("DependencyRunsInItsOwnWorkingDirectory", func(t *testing.T) {
+		executor, tmpDir := setup(t)
+
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.False(t, executor.debugState.HasMoreSteps())
+
+		assert.FileExists(t, filepath.Join(tmpDir, "work-deps", "compile", "compiled"))
+		assert.NoFileExists(t, filepath.Join(tmpDir, "work", "compiled"))
+		assert.FileExists(t, filepath.Join(tmpDir, "work", "tested"))
+		assert.Equal(t, filepath.Join(tmpDir, "work"), executor.taskConfig.WorkDir)
+		assert.Equal(t, filepath.Join(tmpDir, "work"), executor.taskConfig.Expansions.Get("workdir"))
+	})
+	t.Run("FailedDependencyStopsBeforeTask", func(t *testing.T) {
+		executor, tmpDir := setup(t)
+		executor.SetVariable("fail_command", "exit 1")
+
+		err := executor.RunAll(t.Context())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dependency 'compile' failed")
+		assert.Equal(t, 1, executor.debugState.CurrentStepIndex)
+		executed, _ := executor.debugState.GetStepExecution(2)
+		assert.False(t, executed, "task should not run after its dependency failed")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "work", "tested"))
+
+		// Running again retries the failed dependency step.
+		executor.SetVariable("fail_command", "true")
+		require.NoError(t, executor.RunAll(t.Context()))
+		assert.False(t, executor.debugState.HasMoreSteps())
+		assert.FileExists(t, filepath.Join(tmpDir, "work", "tested"))
+	})
+}
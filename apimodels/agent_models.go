@@ -80,6 +80,9 @@ type TaskEndDetail struct {
 	TraceID              string                  `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
 	DiskDevices          []string                `bson:"disk_devices,omitempty" json:"disk_devices,omitempty"`
 	ResourceConstraints  *ResourceConstraintInfo `bson:"resource_constraints,omitempty" json:"resource_constraints,omitempty"`
+	// ResourceUsage is stored separately from the task, so it is not
+	// persisted with the rest of the details.
+	ResourceUsage *ResourceUsage `bson:"-" json:"resource_usage,omitempty"`
 }
 
 // FailingCommand represents a command that failed in a task.
@@ -100,6 +103,27 @@ type ResourceConstraintInfo struct {
 	PeakMemoryPercent float64 `bson:"peak_memory_percent,omitempty" json:"peak_memory_percent,omitempty"`
 }
 
+// ResourceUsage is a downsampled timeseries of the host's resource usage while a
+// task ran.
+type ResourceUsage struct {
+	NumCPUs          int                  `bson:"num_cpus" json:"num_cpus"`
+	TotalMemoryBytes uint64               `bson:"total_memory_bytes" json:"total_memory_bytes"`
+	Points           []ResourceUsagePoint `bson:"points" json:"points"`
+}
+
+// ResourceUsagePoint is the average resource usage over an interval starting
+// at Time.
+type ResourceUsagePoint struct {
+	Time                 time.Time     `bson:"time" json:"time"`
+	Duration             time.Duration `bson:"duration" json:"duration" swaggertype:"primitive,integer"`
+	CPUPercent           float64       `bson:"cpu_percent" json:"cpu_percent"`
+	MemoryPercent        float64       `bson:"memory_percent" json:"memory_percent"`
+	DiskReadBytesPerSec  float64       `bson:"disk_read_bytes_per_sec" json:"disk_read_bytes_per_sec"`
+	DiskWriteBytesPerSec float64       `bson:"disk_write_bytes_per_sec" json:"disk_write_bytes_per_sec"`
+	NetRecvBytesPerSec   float64       `bson:"net_recv_bytes_per_sec" json:"net_recv_bytes_per_sec"`
+	NetSentBytesPerSec   float64       `bson:"net_sent_bytes_per_sec" json:"net_sent_bytes_per_sec"`
+}
+
 // TimeoutProcessInfo contains process information collected when a task times out
 type TimeoutProcessInfo struct {
 	CurrentCommand    string    `bson:"current_command,omitempty" json:"current_command,omitempty"`
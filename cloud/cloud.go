@@ -136,7 +136,9 @@ func GetSettings(provider string) (ProviderSettings, error) {
 		return &StaticSettings{}, nil
 	case evergreen.ProviderNameMock:
 		return &MockProviderSettings{}, nil
-	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
+	case evergreen.ProviderNameProcess:
+		return &ProcessSettings{}, nil
+	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock, evergreen.ProviderNamePodman:
 		return &dockerSettings{}, nil
 	}
 	return nil, errors.Errorf("invalid provider name '%s'", provider)
@@ -174,6 +176,13 @@ func GetManager(ctx context.Context, env evergreen.Environment, mgrOpts ManagerO
 		provider = &dockerManager{env: env}
 	case evergreen.ProviderNameDockerMock:
 		provider = &dockerManager{env: env, client: &dockerClientMock{}}
+	case evergreen.ProviderNamePodman:
+		provider = &podmanManager{env: env}
+	case evergreen.ProviderNameProcess:
+		if !evergreen.ProcessProviderEnabled {
+			return nil, errors.Errorf("provider '%s' is not enabled in this build", mgrOpts.Provider)
+		}
+		provider = &processManager{}
 	default:
 		return nil, errors.Errorf("no known provider '%s'", mgrOpts.Provider)
 	}
@@ -0,0 +1,330 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// podmanManager implements the ContainerManager interface for Podman. It runs
+// containers on parent hosts that expose the Podman REST API, which allows
+// parents to run containers rootless and without a Docker daemon.
+type podmanManager struct {
+	client PodmanClient
+	env    evergreen.Environment
+}
+
+// SpawnHost creates and starts a new Podman container.
+func (m *podmanManager) SpawnHost(ctx context.Context, h *host.Host) (*host.Host, error) {
+	if h.Distro.Provider != evergreen.ProviderNamePodman {
+		return nil, errors.Errorf("can't spawn instance of provider '%s' for distro '%s': distro provider is '%s'", evergreen.ProviderNamePodman, h.Distro.Id, h.Distro.Provider)
+	}
+
+	if err := h.DockerOptions.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "container options not valid for host '%s'", h.Id)
+	}
+
+	parentHost, err := h.GetParent(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding parent of host '%s'", h.Id)
+	}
+
+	if err = m.client.CreateContainer(ctx, parentHost, h); err != nil {
+		err = errors.Wrapf(err, "creating container for host '%s'", h.Id)
+		grip.Info(ctx, message.WrapError(err, message.Fields{
+			"message": "spawn Podman container host failed",
+			"host_id": h.Id,
+		}))
+		return nil, err
+	}
+
+	if err = h.SetAgentRevision(ctx, evergreen.AgentVersion); err != nil {
+		return nil, errors.Wrapf(err, "setting agent revision on host '%s' to '%s'", h.Id, evergreen.AgentVersion)
+	}
+
+	// The setup was successful. Update the container host accordingly in the database.
+	if err := h.MarkAsProvisioned(ctx); err != nil {
+		return nil, errors.Wrapf(err, "marking host '%s' as provisioned", h.Id)
+	}
+
+	if err := m.client.StartContainer(ctx, parentHost, h.Id); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrapf(err, "starting container for host '%s'", h.Id)
+		if err := m.client.RemoveContainer(ctx, parentHost, h.Id); err != nil {
+			catcher.Wrap(err, "removing container due to failure to start container")
+		}
+		grip.Info(ctx, message.WrapError(catcher.Resolve(), message.Fields{
+			"message": "start Podman container host failed",
+			"host_id": h.Id,
+		}))
+		return nil, catcher.Resolve()
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message": "created and started Podman container",
+		"host_id": h.Id,
+	})
+
+	return h, nil
+}
+
+func (m *podmanManager) ModifyHost(context.Context, *host.Host, host.HostModifyOptions) error {
+	return errors.New("can't modify instances with Podman provider")
+}
+
+// GetInstanceState returns the status of a container. If the container has
+// stopped, the state reason includes the tail of its logs to explain why.
+func (m *podmanManager) GetInstanceState(ctx context.Context, h *host.Host) (CloudInstanceState, error) {
+	info := CloudInstanceState{Status: StatusUnknown}
+	parent, err := h.GetParent(ctx)
+	if err != nil {
+		return info, errors.Wrapf(err, "retrieving parent of host '%s'", h.Id)
+	}
+
+	container, err := m.client.GetContainer(ctx, parent, h.Id)
+	if err != nil {
+		if isPodmanNotFound(err) {
+			info.Status = StatusNonExistent
+			return info, nil
+		}
+		if isPodmanConnectionFailed(err) {
+			info.Status = StatusTerminated
+			return info, nil
+		}
+		return info, errors.Wrapf(err, "getting container information for host '%s'", h.Id)
+	}
+
+	info.Status = podmanToEvgStatus(container.State)
+	switch {
+	case container.State.Error != "":
+		info.StateReason = container.State.Error
+	case container.State.OOMKilled:
+		info.StateReason = "Out of memory"
+	case container.State.Status == "exited":
+		info.StateReason = fmt.Sprintf("Exited with code %d", container.State.ExitCode)
+	}
+	if info.Status == StatusStopped || info.Status == StatusTerminated {
+		logs, err := m.GetContainerLogs(ctx, h, podmanLogTailLines)
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not get logs for stopped Podman container",
+			"host_id": h.Id,
+			"parent":  parent.Id,
+		}))
+		if logs = strings.TrimSpace(logs); logs != "" {
+			info.StateReason = strings.TrimSpace(fmt.Sprintf("%s\n%s", info.StateReason, logs))
+		}
+	}
+
+	return info, nil
+}
+
+// GetContainerLogs returns up to the last tailLines lines of the container's
+// output.
+func (m *podmanManager) GetContainerLogs(ctx context.Context, h *host.Host, tailLines int) (string, error) {
+	parent, err := h.GetParent(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "retrieving parent of host '%s'", h.Id)
+	}
+	return m.client.GetContainerLogs(ctx, parent, h.Id, tailLines)
+}
+
+// GetDNSName does nothing, returning an empty string and no error.
+func (m *podmanManager) GetDNSName(ctx context.Context, h *host.Host) (string, error) {
+	return "", nil
+}
+
+// TerminateInstance destroys a container.
+func (m *podmanManager) TerminateInstance(ctx context.Context, h *host.Host, user, reason string) error {
+	if h.Status == evergreen.HostTerminated {
+		return errors.Errorf("cannot terminate host '%s' because it's already marked as terminated", h.Id)
+	}
+
+	parent, err := h.GetParent(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving parent for host '%s'", h.Id)
+	}
+
+	if err := m.client.RemoveContainer(ctx, parent, h.Id); err != nil && !isPodmanNotFound(err) {
+		return errors.Wrap(err, "removing container")
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":   "terminated Podman container",
+		"container": h.Id,
+	})
+
+	return h.Terminate(ctx, user, reason)
+}
+
+func (m *podmanManager) StopInstance(ctx context.Context, host *host.Host, shouldKeepOff bool, user string) error {
+	return errors.New("StopInstance is not supported for Podman provider")
+}
+
+func (m *podmanManager) StartInstance(ctx context.Context, host *host.Host, user string) error {
+	return errors.New("StartInstance is not supported for Podman provider")
+}
+
+func (m *podmanManager) RebootInstance(ctx context.Context, host *host.Host, user string) error {
+	return errors.New("RebootInstance is not supported for Podman provider")
+}
+
+// Configure populates a podmanManager by reading relevant settings from the
+// config object.
+func (m *podmanManager) Configure(ctx context.Context, s *evergreen.Settings) error {
+	if m.client == nil {
+		m.client = GetPodmanClient(s)
+	}
+
+	if err := m.client.Init(s.Providers.Podman.APIVersion); err != nil {
+		return errors.Wrap(err, "initializing Podman client")
+	}
+
+	if m.env == nil {
+		return errors.New("Podman manager requires a non-nil Evergreen environment")
+	}
+
+	return nil
+}
+
+func (m *podmanManager) AssociateIP(context.Context, *host.Host) error {
+	return errors.New("can't associate IP with Podman provider")
+}
+
+func (m *podmanManager) CleanupIP(context.Context, *host.Host) error {
+	return nil
+}
+
+// Cleanup is a noop for the Podman provider.
+func (m *podmanManager) Cleanup(context.Context) error {
+	return nil
+}
+
+func (m *podmanManager) AttachVolume(context.Context, *host.Host, *host.VolumeAttachment) error {
+	return errors.New("can't attach volume with Podman provider")
+}
+
+func (m *podmanManager) DetachVolume(context.Context, *host.Host, string) error {
+	return errors.New("can't detach volume with Podman provider")
+}
+
+func (m *podmanManager) CreateVolume(context.Context, *host.Volume) (*host.Volume, error) {
+	return nil, errors.New("can't create volume with Podman provider")
+}
+
+func (m *podmanManager) DeleteVolume(context.Context, *host.Volume) error {
+	return errors.New("can't delete volume with Podman provider")
+}
+
+func (m *podmanManager) ModifyVolume(context.Context, *host.Volume, *model.VolumeModifyOptions) error {
+	return errors.New("can't modify volume with Podman provider")
+}
+
+func (m *podmanManager) GetVolumeAttachment(context.Context, string) (*VolumeAttachment, error) {
+	return nil, errors.New("can't get volume attachment with Podman provider")
+}
+
+func (m *podmanManager) CheckInstanceType(context.Context, string) error {
+	return errors.New("can't specify instance type with Podman provider")
+}
+
+// TimeTilNextPayment returns the amount of time until the next payment is due
+// for the host. For Podman this is not relevant.
+func (m *podmanManager) TimeTilNextPayment(_ *host.Host) time.Duration {
+	return time.Duration(0)
+}
+
+// GetContainers returns the IDs of all running containers on the host.
+func (m *podmanManager) GetContainers(ctx context.Context, h *host.Host) ([]string, error) {
+	containers, err := m.client.ListContainers(ctx, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing containers")
+	}
+
+	ids := []string{}
+	for _, container := range containers {
+		if len(container.Names) == 0 {
+			continue
+		}
+		// Unlike Docker, Podman does not prefix container names with a slash.
+		ids = append(ids, strings.TrimPrefix(container.Names[0], "/"))
+	}
+
+	return ids, nil
+}
+
+// RemoveOldestImage finds the oldest image without running containers and
+// forcibly removes it.
+func (m *podmanManager) RemoveOldestImage(ctx context.Context, h *host.Host) error {
+	images, err := m.client.ListImages(ctx, h)
+	if err != nil {
+		return errors.Wrap(err, "listing images")
+	}
+	containers, err := m.client.ListContainers(ctx, h)
+	if err != nil {
+		return errors.Wrap(err, "listing containers")
+	}
+	imagesInUse := map[string]bool{}
+	for _, container := range containers {
+		imagesInUse[container.ImageID] = true
+	}
+
+	for i := len(images) - 1; i >= 0; i-- {
+		id := images[i].ID
+		if imagesInUse[id] {
+			continue
+		}
+		return errors.Wrapf(m.client.RemoveImage(ctx, h, id), "removing image '%s'", id)
+	}
+	return nil
+}
+
+// CalculateImageSpaceUsage returns the amount of bytes that images take up on
+// disk.
+func (m *podmanManager) CalculateImageSpaceUsage(ctx context.Context, h *host.Host) (int64, error) {
+	images, err := m.client.ListImages(ctx, h)
+	if err != nil {
+		return 0, errors.Wrap(err, "listing images")
+	}
+
+	spaceBytes := int64(0)
+	for _, image := range images {
+		spaceBytes += image.Size
+	}
+	return spaceBytes, nil
+}
+
+// GetContainerImage pulls or imports the container image onto the parent and
+// builds the image containing the Evergreen executable from it.
+func (m *podmanManager) GetContainerImage(ctx context.Context, parent *host.Host, options host.DockerOptions) error {
+	start := time.Now()
+	if !parent.HasContainers {
+		return errors.Errorf("host '%s' is not a container parent", parent.Id)
+	}
+
+	image, err := m.client.EnsureImageDownloaded(ctx, parent, options)
+	if err != nil {
+		return errors.Wrapf(err, "ensuring that image '%s' is downloaded on host '%s'", options.Image, parent.Id)
+	}
+
+	if _, err = m.client.BuildImageWithAgent(ctx, m.env.ClientConfig().S3URLPrefix, parent, image); err != nil {
+		return errors.Wrapf(err, "building image '%s' with agent on host '%s'", options.Image, parent.Id)
+	}
+	grip.Info(ctx, message.Fields{
+		"operation": "GetContainerImage",
+		"provider":  evergreen.ProviderNamePodman,
+		"host_id":   parent.Id,
+		"image":     image,
+		"duration":  time.Since(start),
+		"span":      time.Since(start).String(),
+	})
+
+	return nil
+}
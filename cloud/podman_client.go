@@ -0,0 +1,596 @@
+package cloud
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// PodmanClient wraps interactions with the Podman REST API on a parent host.
+type PodmanClient interface {
+	Init(string) error
+	EnsureImageDownloaded(context.Context, *host.Host, host.DockerOptions) (string, error)
+	BuildImageWithAgent(context.Context, string, *host.Host, string) (string, error)
+	CreateContainer(context.Context, *host.Host, *host.Host) error
+	GetContainer(context.Context, *host.Host, string) (*PodmanContainer, error)
+	GetContainerLogs(context.Context, *host.Host, string, int) (string, error)
+	ListContainers(context.Context, *host.Host) ([]PodmanContainerSummary, error)
+	RemoveImage(context.Context, *host.Host, string) error
+	RemoveContainer(context.Context, *host.Host, string) error
+	StartContainer(context.Context, *host.Host, string) error
+	ListImages(context.Context, *host.Host) ([]PodmanImageSummary, error)
+}
+
+// PodmanContainer is the subset of the Podman container inspect response that
+// Evergreen uses.
+type PodmanContainer struct {
+	ID    string               `json:"Id"`
+	Name  string               `json:"Name"`
+	State PodmanContainerState `json:"State"`
+}
+
+// PodmanContainerState is the state of a Podman container.
+type PodmanContainerState struct {
+	Status     string `json:"Status"`
+	Running    bool   `json:"Running"`
+	Paused     bool   `json:"Paused"`
+	Restarting bool   `json:"Restarting"`
+	OOMKilled  bool   `json:"OOMKilled"`
+	Dead       bool   `json:"Dead"`
+	ExitCode   int    `json:"ExitCode"`
+	Error      string `json:"Error"`
+}
+
+// PodmanContainerSummary is the subset of a Podman container list entry that
+// Evergreen uses.
+type PodmanContainerSummary struct {
+	ID      string   `json:"Id"`
+	Names   []string `json:"Names"`
+	ImageID string   `json:"ImageID"`
+}
+
+// PodmanImageSummary is the subset of a Podman image list entry that Evergreen
+// uses.
+type PodmanImageSummary struct {
+	ID      string `json:"Id"`
+	Size    int64  `json:"Size"`
+	Created int64  `json:"Created"`
+}
+
+// podmanErrorResponse is the error body returned by the Podman REST API.
+type podmanErrorResponse struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+// podmanAPIError is an unsuccessful response from the Podman REST API.
+type podmanAPIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *podmanAPIError) Error() string {
+	return fmt.Sprintf("Podman API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// isPodmanNotFound returns whether the error is a Podman API error indicating
+// that the requested resource does not exist.
+func isPodmanNotFound(err error) bool {
+	var apiErr *podmanAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// isPodmanConnectionFailed returns whether the error is a failure to connect
+// to the Podman service.
+func isPodmanConnectionFailed(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+const (
+	// defaultPodmanAPIVersion is the libpod API version used if none is
+	// configured.
+	defaultPodmanAPIVersion = "v4.0.0"
+	// podmanLogTailLines is the default number of log lines to fetch when
+	// reporting why a container stopped.
+	podmanLogTailLines = 20
+)
+
+type podmanClientImpl struct {
+	// apiVersion is the version of the libpod API.
+	apiVersion        string
+	httpClient        *http.Client
+	importHTTPClient  *http.Client
+	evergreenSettings *evergreen.Settings
+}
+
+// GetPodmanClient returns a client for the Podman REST API.
+func GetPodmanClient(s *evergreen.Settings) PodmanClient {
+	return &podmanClientImpl{evergreenSettings: s}
+}
+
+// Init sets the libpod API version to use for API calls to the Podman service.
+func (c *podmanClientImpl) Init(apiVersion string) error {
+	c.apiVersion = apiVersion
+	if c.apiVersion == "" {
+		c.apiVersion = defaultPodmanAPIVersion
+	}
+	if !strings.HasPrefix(c.apiVersion, "v") {
+		c.apiVersion = "v" + c.apiVersion
+	}
+
+	var err error
+	c.httpClient, err = c.getHTTPClient(0)
+	if err != nil {
+		return errors.Wrap(err, "creating HTTP client")
+	}
+	c.importHTTPClient, err = c.getHTTPClient(imageImportTimeout)
+	if err != nil {
+		return errors.Wrap(err, "creating HTTP client for importing images")
+	}
+	return nil
+}
+
+// getHTTPClient returns an HTTP client for Podman.
+func (c *podmanClientImpl) getHTTPClient(timeout time.Duration) (*http.Client, error) {
+	client := utility.GetHTTPClient()
+
+	if timeout > 0 {
+		client.Timeout = timeout
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return client, errors.New("type assertion failed: transport is not an *http.Transport")
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+
+	return client, nil
+}
+
+// endpoint returns the URL of the libpod API route on the host. The Podman
+// service must be exposed over TLS at the container pool's port on the host
+// machine.
+func (c *podmanClientImpl) endpoint(h *host.Host, route string, query url.Values) (string, error) {
+	if h == nil {
+		return "", errors.New("host cannot be nil")
+	}
+	if h.Host == "" {
+		return "", errors.New("host DNS name must not be blank")
+	}
+	port := uint16(0)
+	if h.ContainerPoolSettings != nil {
+		port = h.ContainerPoolSettings.Port
+	}
+	u := url.URL{
+		Scheme:   "https",
+		Host:     fmt.Sprintf("%s:%d", h.Host, port),
+		Path:     path.Join("/", c.apiVersion, "libpod", route),
+		RawQuery: query.Encode(),
+	}
+	return u.String(), nil
+}
+
+// do makes a request to the libpod API on the host and returns the response if
+// it was successful. The caller is responsible for closing the response body.
+func (c *podmanClientImpl) do(ctx context.Context, client *http.Client, h *host.Host, method, route string, query url.Values, body any) (*http.Response, error) {
+	endpoint, err := c.endpoint(h, route, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "building Podman API endpoint")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshalling request body")
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if client == nil {
+		client = c.httpClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to Podman service on host '%s'", h.Id)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		apiErr := &podmanAPIError{StatusCode: resp.StatusCode}
+		errResp := podmanErrorResponse{}
+		if err := utility.ReadJSON(resp.Body, &errResp); err == nil && errResp.Message != "" {
+			apiErr.Message = errResp.Message
+		} else {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return nil, apiErr
+	}
+
+	return resp, nil
+}
+
+// doJSON makes a request to the libpod API on the host and decodes the JSON
+// response into out, if it's non-nil.
+func (c *podmanClientImpl) doJSON(ctx context.Context, h *host.Host, method, route string, query url.Values, body, out any) error {
+	resp, err := c.do(ctx, nil, h, method, route, query, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return errors.Wrap(err, "reading response body")
+	}
+	return errors.Wrap(utility.ReadJSON(resp.Body, out), "reading JSON response body")
+}
+
+// podmanStreamMessage is a message in a streamed Podman response, such as an
+// image pull or build.
+type podmanStreamMessage struct {
+	Stream string `json:"stream"`
+	Error  string `json:"error"`
+}
+
+// readPodmanStream reads a streamed JSON response until it ends and returns
+// the first error reported in the stream, if any.
+func readPodmanStream(r io.Reader) error {
+	decoder := json.NewDecoder(r)
+	for {
+		msg := podmanStreamMessage{}
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "decoding streamed response")
+		}
+		if msg.Error != "" {
+			return errors.New(msg.Error)
+		}
+	}
+}
+
+// podmanImageName returns the local name of the base image described by the
+// options. Imported images are named after the file in the URL, while pulled
+// images keep their reference.
+func podmanImageName(options host.DockerOptions) string {
+	if options.Method == distro.DockerImageBuildTypeImport {
+		baseName := path.Base(options.Image)
+		return strings.TrimSuffix(baseName, filepath.Ext(baseName))
+	}
+	return options.Image
+}
+
+// podmanProvisionedImage returns the name of the image containing the
+// Evergreen executable that is built from the base image. Tags and digests in
+// the base image are folded into the name so that the provisioned image can
+// have its own tag.
+func podmanProvisionedImage(baseImage string) string {
+	name := strings.NewReplacer(":", "-", "@", "-").Replace(baseImage)
+	return fmt.Sprintf(provisionedImageTag, name)
+}
+
+// EnsureImageDownloaded checks if the image already exists on the host and, if
+// not, either pulls it from its registry or imports it from a remote tarball,
+// depending on the image build method.
+func (c *podmanClientImpl) EnsureImageDownloaded(ctx context.Context, h *host.Host, options host.DockerOptions) (string, error) {
+	imageName := podmanImageName(options)
+
+	resp, err := c.do(ctx, nil, h, http.MethodGet, path.Join("images", imageName, "exists"), nil, nil)
+	if err == nil {
+		resp.Body.Close()
+		return imageName, nil
+	}
+	if !isPodmanNotFound(err) {
+		return "", errors.Wrapf(err, "checking if image '%s' exists", imageName)
+	}
+
+	start := time.Now()
+	switch options.Method {
+	case distro.DockerImageBuildTypeImport:
+		query := url.Values{}
+		query.Set("url", options.Image)
+		query.Set("reference", imageName)
+		resp, err = c.do(ctx, c.importHTTPClient, h, http.MethodPost, "images/import", query, nil)
+		if err != nil {
+			return "", errors.Wrapf(err, "importing image from '%s'", options.Image)
+		}
+		defer resp.Body.Close()
+		if _, err = io.Copy(io.Discard, resp.Body); err != nil {
+			return "", errors.Wrap(err, "reading image import response")
+		}
+	case distro.DockerImageBuildTypePull, "":
+		query := url.Values{}
+		query.Set("reference", imageName)
+		query.Set("quiet", "true")
+		resp, err = c.do(ctx, c.importHTTPClient, h, http.MethodPost, "images/pull", query, nil)
+		if err != nil {
+			return "", errors.Wrapf(err, "pulling image '%s'", imageName)
+		}
+		defer resp.Body.Close()
+		if err = readPodmanStream(resp.Body); err != nil {
+			return "", errors.Wrapf(err, "pulling image '%s'", imageName)
+		}
+	default:
+		return "", errors.Errorf("unrecognized image build method '%s'", options.Method)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"operation":     "EnsureImageDownloaded",
+		"method":        options.Method,
+		"image":         imageName,
+		"host_id":       h.Id,
+		"duration_secs": time.Since(start).Seconds(),
+	})
+
+	return imageName, nil
+}
+
+// BuildImageWithAgent builds a new image on the host from the base image that
+// adds the Evergreen executable, using the same Dockerfile as the Docker
+// provider.
+func (c *podmanClientImpl) BuildImageWithAgent(ctx context.Context, s3URLPrefix string, h *host.Host, baseImage string) (string, error) {
+	const dockerfileRoute = "dockerfile"
+
+	provisionedImage := podmanProvisionedImage(baseImage)
+	buildArgs, err := json.Marshal(map[string]string{
+		"BASE_IMAGE":          baseImage,
+		"EXECUTABLE_SUB_PATH": h.Distro.ExecutableSubPath(),
+		"BINARY_NAME":         h.Distro.BinaryName(),
+		"URL":                 s3URLPrefix,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling build arguments")
+	}
+
+	query := url.Values{}
+	query.Set("remote", strings.Join([]string{
+		c.evergreenSettings.Api.URL,
+		evergreen.APIRoutePrefix,
+		dockerfileRoute,
+	}, "/"))
+	query.Set("t", provisionedImage)
+	query.Set("rm", "true")
+	query.Set("buildargs", string(buildArgs))
+
+	grip.Info(ctx, makeDockerLogMessage("PodmanImageBuild", h.Id, message.Fields{
+		"base_image":     baseImage,
+		"dockerfile_url": query.Get("remote"),
+	}))
+
+	resp, err := c.do(ctx, c.importHTTPClient, h, http.MethodPost, "build", query, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "building image from base image '%s'", baseImage)
+	}
+	defer resp.Body.Close()
+
+	// Wait for the build to finish. Otherwise, creating the container can
+	// fail because the image doesn't exist yet.
+	if err = readPodmanStream(resp.Body); err != nil {
+		return "", errors.Wrapf(err, "building image from base image '%s'", baseImage)
+	}
+
+	return provisionedImage, nil
+}
+
+// podmanCreateContainerRequest is the subset of the libpod container spec that
+// Evergreen sets when creating a container.
+type podmanCreateContainerRequest struct {
+	Name    string            `json:"name"`
+	Image   string            `json:"image"`
+	Command []string          `json:"command,omitempty"`
+	Env     map[string]string `json:"env,omitempty"`
+}
+
+// CreateContainer creates a new container running the Evergreen agent.
+func (c *podmanClientImpl) CreateContainer(ctx context.Context, parentHost, containerHost *host.Host) error {
+	provisionedImage := podmanProvisionedImage(podmanImageName(containerHost.DockerOptions))
+
+	var agentCmdParts []string
+	if containerHost.DockerOptions.Command != "" {
+		agentCmdParts = append(agentCmdParts, containerHost.DockerOptions.Command)
+	} else if !containerHost.SpawnOptions.SpawnedByTask {
+		// Generate the host secret for container if none exists.
+		if containerHost.Secret == "" {
+			if err := containerHost.CreateSecret(ctx, false); err != nil {
+				return errors.Wrapf(err, "creating secret for '%s'", containerHost.Id)
+			}
+		}
+		pathToExecutable := filepath.Join("/", "evergreen")
+		if parentHost.Distro.IsWindows() {
+			pathToExecutable += ".exe"
+		}
+		agentCmdParts = containerHost.AgentCommand(c.evergreenSettings, pathToExecutable)
+		containerHost.DockerOptions.Command = strings.Join(agentCmdParts, "\n")
+		containerHost.DockerOptions.EnvironmentVars = append(containerHost.DockerOptions.EnvironmentVars, containerHost.AgentEnvSlice()...)
+	}
+
+	env := map[string]string{}
+	for _, envVar := range containerHost.DockerOptions.EnvironmentVars {
+		key, value, _ := strings.Cut(envVar, "=")
+		env[key] = value
+	}
+
+	req := podmanCreateContainerRequest{
+		Name:    containerHost.Id,
+		Image:   provisionedImage,
+		Command: agentCmdParts,
+		Env:     env,
+	}
+
+	grip.Info(ctx, makeDockerLogMessage("PodmanContainerCreate", parentHost.Id, message.Fields{"image": provisionedImage}))
+
+	if err := c.doJSON(ctx, parentHost, http.MethodPost, "containers/create", nil, req, nil); err != nil {
+		grip.Error(ctx, message.WrapError(err, message.Fields{
+			"message":   "Podman create API call failed",
+			"container": containerHost.Id,
+			"parent":    parentHost.Id,
+			"image":     provisionedImage,
+		}))
+		return errors.Wrapf(err, "Podman create API call failed for container '%s' on parent '%s'", containerHost.Id, parentHost.Id)
+	}
+
+	return nil
+}
+
+// GetContainer returns information on the container with the given ID on the
+// host.
+func (c *podmanClientImpl) GetContainer(ctx context.Context, h *host.Host, containerID string) (*PodmanContainer, error) {
+	container := &PodmanContainer{}
+	if err := c.doJSON(ctx, h, http.MethodGet, path.Join("containers", containerID, "json"), nil, nil, container); err != nil {
+		return nil, errors.Wrapf(err, "inspecting container '%s'", containerID)
+	}
+	return container, nil
+}
+
+// GetContainerLogs returns up to the last tailLines lines of the container's
+// combined output.
+func (c *podmanClientImpl) GetContainerLogs(ctx context.Context, h *host.Host, containerID string, tailLines int) (string, error) {
+	query := url.Values{}
+	query.Set("stdout", "true")
+	query.Set("stderr", "true")
+	if tailLines > 0 {
+		query.Set("tail", strconv.Itoa(tailLines))
+	}
+	resp, err := c.do(ctx, nil, h, http.MethodGet, path.Join("containers", containerID, "logs"), query, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting logs for container '%s'", containerID)
+	}
+	defer resp.Body.Close()
+
+	logs, err := demuxPodmanLogs(resp.Body)
+	return logs, errors.Wrapf(err, "reading logs for container '%s'", containerID)
+}
+
+// demuxPodmanLogs reads container logs, which are multiplexed into frames with
+// an 8-byte header for containers without a TTY. If the logs are not
+// multiplexed, they are returned as is.
+func demuxPodmanLogs(r io.Reader) (string, error) {
+	reader := bufio.NewReader(r)
+	var out strings.Builder
+	for {
+		header, err := reader.Peek(8)
+		if len(header) == 0 && err == io.EOF {
+			return out.String(), nil
+		}
+		// The first byte of a frame header is the stream type (0-2) and the
+		// next three bytes are always zero.
+		if len(header) < 8 || header[0] > 2 || header[1] != 0 || header[2] != 0 || header[3] != 0 {
+			rest, err := io.ReadAll(reader)
+			out.Write(rest)
+			return out.String(), err
+		}
+		if _, err = reader.Discard(8); err != nil {
+			return out.String(), err
+		}
+		size := int64(binary.BigEndian.Uint32(header[4:8]))
+		if _, err = io.CopyN(&out, reader, size); err != nil {
+			return out.String(), err
+		}
+	}
+}
+
+// ListContainers lists all running containers on the host.
+func (c *podmanClientImpl) ListContainers(ctx context.Context, h *host.Host) ([]PodmanContainerSummary, error) {
+	var containers []PodmanContainerSummary
+	if err := c.doJSON(ctx, h, http.MethodGet, "containers/json", nil, nil, &containers); err != nil {
+		return nil, errors.Wrap(err, "listing containers")
+	}
+	return containers, nil
+}
+
+// ListImages lists all images on the host from most to least recently
+// created.
+func (c *podmanClientImpl) ListImages(ctx context.Context, h *host.Host) ([]PodmanImageSummary, error) {
+	var images []PodmanImageSummary
+	if err := c.doJSON(ctx, h, http.MethodGet, "images/json", nil, nil, &images); err != nil {
+		return nil, errors.Wrap(err, "listing images")
+	}
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].Created > images[j].Created
+	})
+	return images, nil
+}
+
+// RemoveImage forcibly removes an image from the host.
+func (c *podmanClientImpl) RemoveImage(ctx context.Context, h *host.Host, imageID string) error {
+	query := url.Values{}
+	query.Set("force", "true")
+	if err := c.doJSON(ctx, h, http.MethodDelete, path.Join("images", imageID), query, nil, nil); err != nil {
+		return errors.Wrapf(err, "removing image '%s'", imageID)
+	}
+	return nil
+}
+
+// RemoveContainer forcibly removes a running or stopped container from the
+// host.
+func (c *podmanClientImpl) RemoveContainer(ctx context.Context, h *host.Host, containerID string) error {
+	query := url.Values{}
+	query.Set("force", "true")
+	if err := c.doJSON(ctx, h, http.MethodDelete, path.Join("containers", containerID), query, nil, nil); err != nil {
+		return errors.Wrapf(err, "removing container '%s'", containerID)
+	}
+	return nil
+}
+
+// StartContainer starts a stopped or new container on the host.
+func (c *podmanClientImpl) StartContainer(ctx context.Context, h *host.Host, containerID string) error {
+	if err := c.doJSON(ctx, h, http.MethodPost, path.Join("containers", containerID, "start"), nil, nil, nil); err != nil {
+		return errors.Wrapf(err, "starting container '%s'", containerID)
+	}
+	return nil
+}
+
+// podmanToEvgStatus converts a Podman container state to an Evergreen cloud
+// provider status.
+func podmanToEvgStatus(s PodmanContainerState) CloudStatus {
+	switch {
+	case s.Running:
+		return StatusRunning
+	case s.Paused:
+		return StatusStopped
+	case s.Restarting:
+		return StatusInitializing
+	case s.OOMKilled || s.Dead:
+		return StatusTerminated
+	}
+	switch s.Status {
+	case "created", "configured", "initialized":
+		return StatusInitializing
+	case "stopping":
+		return StatusStopping
+	case "stopped", "exited":
+		return StatusStopped
+	case "removing":
+		return StatusTerminated
+	}
+	return StatusUnknown
+}
@@ -0,0 +1,137 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodmanToEvgStatus(t *testing.T) {
+	for state, expected := range map[PodmanContainerState]CloudStatus{
+		{Running: true, Status: "running"}:  StatusRunning,
+		{Paused: true, Status: "paused"}:    StatusStopped,
+		{Status: "created"}:                 StatusInitializing,
+		{Status: "stopping"}:                StatusStopping,
+		{Status: "exited", ExitCode: 1}:     StatusStopped,
+		{OOMKilled: true, Status: "exited"}: StatusTerminated,
+		{Status: "removing"}:                StatusTerminated,
+		{Status: "unknown"}:                 StatusUnknown,
+	} {
+		assert.Equal(t, expected, podmanToEvgStatus(state), "state %+v", state)
+	}
+}
+
+func TestDemuxPodmanLogs(t *testing.T) {
+	frame := func(stream byte, data string) []byte {
+		header := make([]byte, 8)
+		header[0] = stream
+		binary.BigEndian.PutUint32(header[4:], uint32(len(data)))
+		return append(header, data...)
+	}
+
+	t.Run("Multiplexed", func(t *testing.T) {
+		var buf bytes.Buffer
+		buf.Write(frame(1, "hello\n"))
+		buf.Write(frame(2, "error\n"))
+		logs, err := demuxPodmanLogs(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, "hello\nerror\n", logs)
+	})
+	t.Run("Raw", func(t *testing.T) {
+		logs, err := demuxPodmanLogs(bytes.NewBufferString("tty output\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "tty output\n", logs)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		logs, err := demuxPodmanLogs(&bytes.Buffer{})
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+}
+
+func TestPodmanProvisionedImage(t *testing.T) {
+	assert.Equal(t, "ubuntu-22.04:provisioned", podmanProvisionedImage("ubuntu:22.04"))
+	assert.Equal(t, "image:provisioned", podmanProvisionedImage("image"))
+}
+
+func TestPodmanClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v4.0.0/libpod/containers/exists/json", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(PodmanContainer{
+			ID:    "exists",
+			Name:  "exists",
+			State: PodmanContainerState{Status: "exited", ExitCode: 2},
+		}))
+	})
+	mux.HandleFunc("GET /v4.0.0/libpod/containers/missing/json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		assert.NoError(t, json.NewEncoder(w).Encode(podmanErrorResponse{Message: "no such container", Response: http.StatusNotFound}))
+	})
+	mux.HandleFunc("GET /v4.0.0/libpod/images/json", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode([]PodmanImageSummary{
+			{ID: "old", Size: 1, Created: 1},
+			{ID: "new", Size: 2, Created: 2},
+		}))
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	hostName, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	parent := &host.Host{
+		Id:                    "parent",
+		Host:                  hostName,
+		HasContainers:         true,
+		ContainerPoolSettings: &evergreen.ContainerPool{Port: uint16(port)},
+	}
+
+	client := &podmanClientImpl{
+		apiVersion: defaultPodmanAPIVersion,
+		httpClient: server.Client(),
+	}
+
+	t.Run("GetContainer", func(t *testing.T) {
+		container, err := client.GetContainer(ctx, parent, "exists")
+		require.NoError(t, err)
+		assert.Equal(t, "exists", container.ID)
+		assert.Equal(t, StatusStopped, podmanToEvgStatus(container.State))
+		assert.Equal(t, 2, container.State.ExitCode)
+	})
+	t.Run("GetContainerNotFound", func(t *testing.T) {
+		_, err := client.GetContainer(ctx, parent, "missing")
+		require.Error(t, err)
+		assert.True(t, isPodmanNotFound(err))
+		assert.Contains(t, err.Error(), "no such container")
+	})
+	t.Run("ListImagesSortsNewestFirst", func(t *testing.T) {
+		images, err := client.ListImages(ctx, parent)
+		require.NoError(t, err)
+		require.Len(t, images, 2)
+		assert.Equal(t, "new", images[0].ID)
+		assert.Equal(t, "old", images[1].ID)
+	})
+	t.Run("ConnectionFailed", func(t *testing.T) {
+		unreachable := *parent
+		unreachable.ContainerPoolSettings = &evergreen.ContainerPool{Port: 1}
+		_, err := client.GetContainer(ctx, &unreachable, "exists")
+		require.Error(t, err)
+		assert.True(t, isPodmanConnectionFailed(err))
+		assert.False(t, isPodmanNotFound(err))
+	})
+}
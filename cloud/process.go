@@ -0,0 +1,486 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// processPIDFileName is the name of the file in a process host's
+	// directory that contains the agent's process ID.
+	processPIDFileName = "agent.pid"
+	// processOutputFileName is the name of the file in a process host's
+	// directory that contains the agent's standard output and error.
+	processOutputFileName = "agent.out"
+	// processOutputTailBytes is the amount of agent output included in the
+	// state reason of a process host whose agent has exited.
+	processOutputTailBytes = 2048
+	// processTerminateTimeout is how long to wait for an agent process to
+	// exit after asking it to shut down before killing it.
+	processTerminateTimeout = 10 * time.Second
+)
+
+// processAgents tracks the agent processes that this app server started, so
+// that they are reaped when they exit and can be waited on when their hosts
+// are terminated.
+var processAgents = struct {
+	mu     sync.Mutex
+	agents map[string]*processAgent
+}{agents: map[string]*processAgent{}}
+
+// processAgent is a running agent process for a process host.
+type processAgent struct {
+	cmd *exec.Cmd
+	// exited is closed once the process has exited and been reaped.
+	exited chan struct{}
+}
+
+// trackProcessAgent records the host's agent process and reaps it once it
+// exits.
+func trackProcessAgent(hostID string, cmd *exec.Cmd) {
+	agent := &processAgent{cmd: cmd, exited: make(chan struct{})}
+
+	processAgents.mu.Lock()
+	processAgents.agents[hostID] = agent
+	processAgents.mu.Unlock()
+
+	go func() {
+		_ = cmd.Wait()
+		close(agent.exited)
+
+		processAgents.mu.Lock()
+		defer processAgents.mu.Unlock()
+		if processAgents.agents[hostID] == agent {
+			delete(processAgents.agents, hostID)
+		}
+	}()
+}
+
+// getProcessAgent returns the host's agent process if this app server started
+// it and it has not exited yet.
+func getProcessAgent(hostID string) *processAgent {
+	processAgents.mu.Lock()
+	defer processAgents.mu.Unlock()
+	return processAgents.agents[hostID]
+}
+
+// stop asks the agent process to shut down and kills it if it does not exit
+// in time. It returns once the process has been reaped.
+func (a *processAgent) stop(ctx context.Context) error {
+	// Signaling fails if the process has already exited or if the platform
+	// doesn't support it, in which case it's killed below.
+	_ = a.cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-a.exited:
+		return nil
+	case <-time.After(processTerminateTimeout):
+	case <-ctx.Done():
+	}
+	if err := a.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return errors.Wrapf(err, "killing process %d", a.cmd.Process.Pid)
+	}
+	<-a.exited
+	return nil
+}
+
+// ProcessSettings are the distro provider settings for process hosts.
+type ProcessSettings struct {
+	// RootDir is the directory in which each host gets its own directory. If
+	// unset, it defaults to a directory in the system's temporary directory.
+	RootDir string `mapstructure:"root_dir" json:"root_dir" bson:"root_dir,omitempty"`
+}
+
+// Validate checks that the settings from the configuration are valid.
+func (s *ProcessSettings) Validate() error {
+	if s.RootDir != "" && !filepath.IsAbs(s.RootDir) {
+		return errors.Errorf("root directory '%s' must be absolute", s.RootDir)
+	}
+	return nil
+}
+
+func (s *ProcessSettings) FromDistroSettings(d distro.Distro, _ string) error {
+	if len(d.ProviderSettingsList) != 0 {
+		bytes, err := d.ProviderSettingsList[0].MarshalBSON()
+		if err != nil {
+			return errors.Wrap(err, "marshalling provider setting into BSON")
+		}
+		if err := bson.Unmarshal(bytes, s); err != nil {
+			return errors.Wrap(err, "unmarshalling BSON into provider settings")
+		}
+	}
+	return nil
+}
+
+func (s *ProcessSettings) rootDir() string {
+	if s.RootDir != "" {
+		return s.RootDir
+	}
+	return filepath.Join(os.TempDir(), "evergreen-process-hosts")
+}
+
+// processManager runs each host as an agent process on the app server's
+// machine with its own working directory. It lets the host allocator,
+// provisioning and task dispatch run end-to-end without any cloud
+// infrastructure, so it is meant for integration testing with a single app
+// server rather than for production use. It is only available in builds with
+// the processprovider build tag. Agents always run the app server's own
+// executable. Process distros must use the "none" bootstrap method since there
+// is nothing to provision over SSH.
+type processManager struct {
+	settings *evergreen.Settings
+	// binary overrides the executable that runs the agent for testing.
+	binary string
+}
+
+// Configure stores the settings used to connect agents to the app server.
+func (m *processManager) Configure(ctx context.Context, s *evergreen.Settings) error {
+	if s == nil {
+		return errors.New("process manager requires non-nil Evergreen settings")
+	}
+	m.settings = s
+	return nil
+}
+
+// SpawnHost creates a directory for the host and starts an agent process in
+// it.
+func (m *processManager) SpawnHost(ctx context.Context, h *host.Host) (*host.Host, error) {
+	if h.Distro.Provider != evergreen.ProviderNameProcess {
+		return nil, errors.Errorf("can't spawn instance of provider '%s' for distro '%s': distro provider is '%s'", evergreen.ProviderNameProcess, h.Distro.Id, h.Distro.Provider)
+	}
+
+	settings := &ProcessSettings{}
+	if err := settings.FromDistroSettings(h.Distro, ""); err != nil {
+		return nil, errors.Wrapf(err, "getting provider settings for distro '%s'", h.Distro.Id)
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid provider settings for distro '%s'", h.Distro.Id)
+	}
+	binary, err := m.binaryPath()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting agent binary")
+	}
+
+	dir := processHostDir(settings, h)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating directory for host '%s'", h.Id)
+	}
+
+	statusPort, err := findFreePort()
+	if err != nil {
+		return nil, errors.Wrap(err, "finding port for agent status server")
+	}
+
+	h.Host = "localhost"
+	h.Secret = utility.RandomString()
+	h.AgentRevision = evergreen.AgentVersion
+
+	output, err := os.Create(filepath.Join(dir, processOutputFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating agent output file")
+	}
+	defer output.Close()
+
+	cmd := exec.Command(binary,
+		"agent",
+		fmt.Sprintf("--api_server=%s", m.settings.Api.URL),
+		"--mode=host",
+		fmt.Sprintf("--provider=%s", evergreen.ProviderNameProcess),
+		"--log_output=file",
+		fmt.Sprintf("--log_prefix=%s", filepath.Join(dir, "agent")),
+		processAgentWorkingDirFlag(dir),
+		fmt.Sprintf("--status_port=%d", statusPort),
+	)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), h.AgentEnvSlice()...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	setAgentProcessAttributes(cmd)
+	if err = cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "starting agent process for host '%s'", h.Id)
+	}
+	trackProcessAgent(h.Id, cmd)
+
+	if err = os.WriteFile(filepath.Join(dir, processPIDFileName), []byte(strconv.Itoa(cmd.Process.Pid)), 0644); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "writing agent process ID file")
+		if agent := getProcessAgent(h.Id); agent != nil {
+			catcher.Wrap(agent.stop(ctx), "stopping agent process")
+		}
+		return nil, catcher.Resolve()
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":     "started agent process",
+		"host_id":     h.Id,
+		"distro":      h.Distro.Id,
+		"pid":         cmd.Process.Pid,
+		"dir":         dir,
+		"status_port": statusPort,
+	})
+
+	return h, nil
+}
+
+func (m *processManager) ModifyHost(context.Context, *host.Host, host.HostModifyOptions) error {
+	return errors.New("can't modify instances with process provider")
+}
+
+// GetInstanceState returns running if the host's agent process is alive. If
+// the agent has exited, the state reason includes the end of its output.
+func (m *processManager) GetInstanceState(ctx context.Context, h *host.Host) (CloudInstanceState, error) {
+	info := CloudInstanceState{Status: StatusUnknown}
+	dir, err := m.hostDir(h)
+	if err != nil {
+		return info, err
+	}
+
+	pid, err := readProcessPID(dir)
+	if os.IsNotExist(errors.Cause(err)) {
+		info.Status = StatusNonExistent
+		return info, nil
+	}
+	if err != nil {
+		return info, errors.Wrapf(err, "reading agent process ID for host '%s'", h.Id)
+	}
+
+	if isHostAgentProcess(pid, dir) {
+		info.Status = StatusRunning
+		return info, nil
+	}
+
+	info.Status = StatusStopped
+	info.StateReason = "agent process exited"
+	if tail := readFileTail(filepath.Join(dir, processOutputFileName), processOutputTailBytes); tail != "" {
+		info.StateReason = fmt.Sprintf("%s:\n%s", info.StateReason, tail)
+	}
+	return info, nil
+}
+
+// TerminateInstance kills the host's agent process and removes its
+// directory.
+func (m *processManager) TerminateInstance(ctx context.Context, h *host.Host, user, reason string) error {
+	if h.Status == evergreen.HostTerminated {
+		return errors.Errorf("cannot terminate host '%s' because it's already marked as terminated", h.Id)
+	}
+
+	dir, err := m.hostDir(h)
+	if err != nil {
+		return err
+	}
+
+	pid, err := readProcessPID(dir)
+	if err != nil && !os.IsNotExist(errors.Cause(err)) {
+		return errors.Wrapf(err, "reading agent process ID for host '%s'", h.Id)
+	}
+	if agent := getProcessAgent(h.Id); agent != nil {
+		if err := agent.stop(ctx); err != nil {
+			return errors.Wrapf(err, "stopping agent process for host '%s'", h.Id)
+		}
+	} else if err == nil && isHostAgentProcess(pid, dir) {
+		// The agent was started by a previous run of the app server.
+		if err := killProcess(pid); err != nil {
+			return errors.Wrapf(err, "killing agent process %d for host '%s'", pid, h.Id)
+		}
+	} else if err == nil && processIsRunning(pid) {
+		grip.Warning(ctx, message.Fields{
+			"message": "not killing process because it is not the host's agent, so its process ID was likely reused",
+			"host_id": h.Id,
+			"pid":     pid,
+		})
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "removing directory for host '%s'", h.Id)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message": "terminated agent process",
+		"host_id": h.Id,
+		"pid":     pid,
+	})
+
+	return h.Terminate(ctx, user, reason)
+}
+
+func (m *processManager) StopInstance(ctx context.Context, h *host.Host, shouldKeepOff bool, user string) error {
+	return errors.New("StopInstance is not supported for process provider")
+}
+
+func (m *processManager) StartInstance(ctx context.Context, h *host.Host, user string) error {
+	return errors.New("StartInstance is not supported for process provider")
+}
+
+func (m *processManager) RebootInstance(ctx context.Context, h *host.Host, user string) error {
+	return errors.New("RebootInstance is not supported for process provider")
+}
+
+// GetDNSName returns localhost since every process host runs on the app
+// server's machine.
+func (m *processManager) GetDNSName(ctx context.Context, h *host.Host) (string, error) {
+	return "localhost", nil
+}
+
+func (m *processManager) AttachVolume(context.Context, *host.Host, *host.VolumeAttachment) error {
+	return errors.New("can't attach volume with process provider")
+}
+
+func (m *processManager) DetachVolume(context.Context, *host.Host, string) error {
+	return errors.New("can't detach volume with process provider")
+}
+
+func (m *processManager) CreateVolume(context.Context, *host.Volume) (*host.Volume, error) {
+	return nil, errors.New("can't create volume with process provider")
+}
+
+func (m *processManager) DeleteVolume(context.Context, *host.Volume) error {
+	return errors.New("can't delete volume with process provider")
+}
+
+func (m *processManager) ModifyVolume(context.Context, *host.Volume, *model.VolumeModifyOptions) error {
+	return errors.New("can't modify volume with process provider")
+}
+
+func (m *processManager) GetVolumeAttachment(context.Context, string) (*VolumeAttachment, error) {
+	return nil, errors.New("can't get volume attachment with process provider")
+}
+
+func (m *processManager) CheckInstanceType(context.Context, string) error {
+	return errors.New("can't specify instance type with process provider")
+}
+
+// TimeTilNextPayment returns the amount of time until the next payment is due
+// for the host. For process hosts this is not relevant.
+func (m *processManager) TimeTilNextPayment(*host.Host) time.Duration {
+	return time.Duration(0)
+}
+
+func (m *processManager) AssociateIP(context.Context, *host.Host) error {
+	return errors.New("can't associate IP with process provider")
+}
+
+func (m *processManager) CleanupIP(context.Context, *host.Host) error {
+	return nil
+}
+
+// Cleanup is a noop for the process provider.
+func (m *processManager) Cleanup(context.Context) error {
+	return nil
+}
+
+// binaryPath returns the executable that runs the agent, which is the app
+// server's own executable.
+func (m *processManager) binaryPath() (string, error) {
+	if m.binary != "" {
+		return m.binary, nil
+	}
+	binary, err := os.Executable()
+	return binary, errors.Wrap(err, "getting path to current executable")
+}
+
+func (m *processManager) hostDir(h *host.Host) (string, error) {
+	settings := &ProcessSettings{}
+	if err := settings.FromDistroSettings(h.Distro, ""); err != nil {
+		return "", errors.Wrapf(err, "getting provider settings for distro '%s'", h.Distro.Id)
+	}
+	return processHostDir(settings, h), nil
+}
+
+// processHostDir returns the directory that contains everything belonging to
+// the process host.
+func processHostDir(settings *ProcessSettings, h *host.Host) string {
+	return filepath.Join(settings.rootDir(), h.Id)
+}
+
+func readProcessPID(dir string) (int, error) {
+	contents, err := os.ReadFile(filepath.Join(dir, processPIDFileName))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(contents)))
+	return pid, errors.Wrap(err, "parsing process ID")
+}
+
+// processAgentWorkingDirFlag returns the agent flag that sets the working
+// directory for the process host in the given directory. Since each host has
+// its own directory, the flag identifies the host's agent process.
+func processAgentWorkingDirFlag(dir string) string {
+	return fmt.Sprintf("--working_directory=%s", filepath.Join(dir, "work"))
+}
+
+// isHostAgentProcess returns whether the process with the given ID is the agent
+// for the process host in the given directory. The process ID file can outlive
+// the agent, so a running process with that ID may be an unrelated process
+// that reused it.
+func isHostAgentProcess(pid int, dir string) bool {
+	if !processIsRunning(pid) {
+		return false
+	}
+	args, err := processCommandLine(pid)
+	if err != nil {
+		return false
+	}
+	return utility.StringSliceContains(args, processAgentWorkingDirFlag(dir))
+}
+
+// killProcess asks the process to shut down gracefully, falling back to
+// killing it if that is not supported.
+func killProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return errors.Wrapf(err, "finding process %d", pid)
+	}
+	defer proc.Release()
+	if err := proc.Signal(syscall.SIGTERM); err == nil {
+		return nil
+	}
+	return errors.Wrapf(proc.Kill(), "killing process %d", pid)
+}
+
+// readFileTail returns up to the last n bytes of the file, or an empty string
+// if it can't be read.
+func readFileTail(path string, n int64) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return ""
+	}
+	offset := max(info.Size()-n, 0)
+	buf := make([]byte, info.Size()-offset)
+	if _, err := f.ReadAt(buf, offset); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(buf))
+}
+
+// findFreePort returns a port on the loopback interface that is not in use.
+func findFreePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.Wrap(err, "listening on free port")
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,29 @@
+//go:build linux
+
+package cloud
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// setAgentProcessAttributes makes the agent process shut down when the app
+// server exits so that it is not left running without being tracked.
+func setAgentProcessAttributes(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Pdeathsig: syscall.SIGTERM}
+}
+
+// processCommandLine returns the arguments that the process with the given ID
+// was started with.
+func processCommandLine(pid int) ([]string, error) {
+	contents, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading command line of process %d", pid)
+	}
+	return strings.Split(strings.TrimRight(string(contents), "\x00"), "\x00"), nil
+}
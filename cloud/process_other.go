@@ -0,0 +1,27 @@
+//go:build !linux
+
+package cloud
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// setAgentProcessAttributes is a no-op on platforms that can't shut down the
+// agent process when the app server exits. Agents that outlive the app server
+// are still found by their process ID file when their hosts are terminated.
+func setAgentProcessAttributes(cmd *exec.Cmd) {}
+
+// processCommandLine returns the arguments that the process with the given ID
+// was started with. The arguments are split on whitespace, so an argument
+// containing spaces does not match its original value.
+func processCommandLine(pid int) ([]string, error) {
+	out, err := exec.Command("ps", "-o", "args=", "-p", strconv.Itoa(pid)).Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting command line of process %d", pid)
+	}
+	return strings.Fields(string(out)), nil
+}
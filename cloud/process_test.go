@@ -0,0 +1,150 @@
+package cloud
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestProcessSettings(t *testing.T) {
+	t.Run("FromDistroSettings", func(t *testing.T) {
+		bytes, err := bson.Marshal(ProcessSettings{RootDir: "/tmp/hosts"})
+		require.NoError(t, err)
+		doc := birch.Document{}
+		require.NoError(t, doc.UnmarshalBSON(bytes))
+
+		settings := &ProcessSettings{}
+		require.NoError(t, settings.FromDistroSettings(distro.Distro{ProviderSettingsList: []*birch.Document{&doc}}, ""))
+		assert.Equal(t, "/tmp/hosts", settings.rootDir())
+		assert.NoError(t, settings.Validate())
+	})
+	t.Run("DefaultsWithoutSettings", func(t *testing.T) {
+		settings := &ProcessSettings{}
+		require.NoError(t, settings.FromDistroSettings(distro.Distro{}, ""))
+		assert.NoError(t, settings.Validate())
+		assert.NotEmpty(t, settings.rootDir())
+	})
+	t.Run("RelativeRootDirIsInvalid", func(t *testing.T) {
+		assert.Error(t, (&ProcessSettings{RootDir: "hosts"}).Validate())
+	})
+}
+
+func TestProcessManager(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script as the agent binary")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	settings := &evergreen.Settings{}
+	settings.Api.URL = "http://localhost:9090"
+
+	rootDir := t.TempDir()
+	binary := filepath.Join(rootDir, "fake-evergreen")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\necho \"agent started with $@\"\nsleep 60\n"), 0755))
+
+	bytes, err := bson.Marshal(ProcessSettings{RootDir: rootDir})
+	require.NoError(t, err)
+	doc := birch.Document{}
+	require.NoError(t, doc.UnmarshalBSON(bytes))
+
+	h := &host.Host{
+		Id: "process-host",
+		Distro: distro.Distro{
+			Id:                   "process-distro",
+			Provider:             evergreen.ProviderNameProcess,
+			ProviderSettingsList: []*birch.Document{&doc},
+		},
+	}
+
+	m := &processManager{binary: binary}
+	require.NoError(t, m.Configure(ctx, settings))
+
+	state, err := m.GetInstanceState(ctx, h)
+	require.NoError(t, err)
+	assert.Equal(t, StatusNonExistent, state.Status, "host should not exist before it is spawned")
+
+	_, err = m.SpawnHost(ctx, h)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", h.Host)
+	assert.NotEmpty(t, h.Secret)
+	assert.DirExists(t, filepath.Join(rootDir, h.Id))
+
+	state, err = m.GetInstanceState(ctx, h)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, state.Status)
+
+	pid, err := readProcessPID(filepath.Join(rootDir, h.Id))
+	require.NoError(t, err)
+	assert.True(t, isHostAgentProcess(pid, filepath.Join(rootDir, h.Id)))
+	require.Eventually(t, func() bool {
+		output, err := os.ReadFile(filepath.Join(rootDir, h.Id, processOutputFileName))
+		return err == nil && len(output) > 0
+	}, 10*time.Second, 100*time.Millisecond, "agent should write output before it is killed")
+	require.NoError(t, killProcess(pid))
+	assert.Eventually(t, func() bool {
+		state, err = m.GetInstanceState(ctx, h)
+		return err == nil && state.Status == StatusStopped
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Contains(t, state.StateReason, "agent started with agent --api_server=http://localhost:9090")
+	assert.Eventually(t, func() bool {
+		return getProcessAgent(h.Id) == nil
+	}, 10*time.Second, 100*time.Millisecond, "agent process should be reaped once it exits")
+
+	t.Run("StopWaitsForAgentToExit", func(t *testing.T) {
+		other := &host.Host{Id: "process-host-to-stop", Distro: h.Distro}
+		_, err := m.SpawnHost(ctx, other)
+		require.NoError(t, err)
+		agent := getProcessAgent(other.Id)
+		require.NotNil(t, agent)
+
+		require.NoError(t, agent.stop(ctx))
+		select {
+		case <-agent.exited:
+		default:
+			assert.Fail(t, "agent process should have exited")
+		}
+		state, err := m.GetInstanceState(ctx, other)
+		require.NoError(t, err)
+		assert.Equal(t, StatusStopped, state.Status)
+	})
+
+	t.Run("ReusedProcessIDIsNotTheAgent", func(t *testing.T) {
+		other := &host.Host{Id: "process-host-with-reused-pid", Distro: h.Distro}
+		dir := filepath.Join(rootDir, other.Id)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+
+		unrelated := exec.Command("sleep", "60")
+		require.NoError(t, unrelated.Start())
+		defer func() {
+			_ = unrelated.Process.Kill()
+			_ = unrelated.Wait()
+		}()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, processPIDFileName), []byte(strconv.Itoa(unrelated.Process.Pid)), 0644))
+
+		assert.False(t, isHostAgentProcess(unrelated.Process.Pid, dir))
+		state, err := m.GetInstanceState(ctx, other)
+		require.NoError(t, err)
+		assert.Equal(t, StatusStopped, state.Status)
+	})
+
+	t.Run("SpawnFailsForOtherProviders", func(t *testing.T) {
+		other := &host.Host{Id: "other", Distro: distro.Distro{Provider: evergreen.ProviderNameStatic}}
+		_, err := m.SpawnHost(ctx, other)
+		assert.Error(t, err)
+	})
+}
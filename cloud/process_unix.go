@@ -0,0 +1,17 @@
+//go:build !windows
+
+package cloud
+
+import (
+	"os"
+	"syscall"
+)
+
+// processIsRunning returns whether a process with the given ID exists.
+func processIsRunning(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return proc.Signal(syscall.Signal(0)) == nil
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package cloud
+
+import "os"
+
+// processIsRunning returns whether a process with the given ID exists. On
+// Windows, finding a process fails if it does not exist.
+func processIsRunning(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	_ = proc.Release()
+	return true
+}
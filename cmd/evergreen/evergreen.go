@@ -69,6 +69,10 @@ func buildApp() *cli.App {
 		operations.Fetch(),
 		operations.Evaluate(),
 		operations.Validate(),
+		operations.GenerateDryRun(),
+		operations.CostReport(),
+		operations.SearchLogs(),
+		operations.Bisect(),
 		operations.List(),
 		operations.LastGreen(),
 		operations.LastRevision(),
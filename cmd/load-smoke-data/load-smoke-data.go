@@ -13,6 +13,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
@@ -80,6 +81,13 @@ func insertFileDocsToDB(ctx context.Context, fn string, db *mongo.Database) erro
 		}); err != nil {
 			return errors.Wrap(err, "creating host index")
 		}
+	case artifact.Collection:
+		if _, err = collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{Keys: artifact.ExpirationIndex},
+			{Keys: artifact.FileLocationIndex},
+		}); err != nil {
+			return errors.Wrap(err, "creating artifact indexes")
+		}
 	}
 	scanner := bufio.NewScanner(file)
 	// Set the max buffer size to the max size of a Mongo document (16MB).
@@ -12,12 +12,14 @@ import (
 var (
 	cloudProvidersAWSKey    = bsonutil.MustHaveTag(CloudProviders{}, "AWS")
 	cloudProvidersDockerKey = bsonutil.MustHaveTag(CloudProviders{}, "Docker")
+	cloudProvidersPodmanKey = bsonutil.MustHaveTag(CloudProviders{}, "Podman")
 )
 
 // CloudProviders stores configuration settings for the supported cloud host providers.
 type CloudProviders struct {
 	AWS    AWSConfig    `bson:"aws" json:"aws" yaml:"aws"`
 	Docker DockerConfig `bson:"docker" json:"docker" yaml:"docker"`
+	Podman PodmanConfig `bson:"podman" json:"podman" yaml:"podman"`
 }
 
 func (c *CloudProviders) SectionId() string { return "providers" }
@@ -31,6 +33,7 @@ func (c *CloudProviders) Set(ctx context.Context) error {
 		"$set": bson.M{
 			cloudProvidersAWSKey:    c.AWS,
 			cloudProvidersDockerKey: c.Docker,
+			cloudProvidersPodmanKey: c.Podman,
 		}}), "updating config section '%s'", c.SectionId(),
 	)
 }
@@ -153,3 +156,9 @@ type AWSClientType string
 type DockerConfig struct {
 	APIVersion string `bson:"api_version" json:"api_version" yaml:"api_version"`
 }
+
+// PodmanConfig stores settings for Podman.
+type PodmanConfig struct {
+	// APIVersion is the version of the libpod REST API to use.
+	APIVersion string `bson:"api_version" json:"api_version" yaml:"api_version"`
+}
@@ -904,6 +904,12 @@ func (e *envState) initThirdPartySenders(ctx context.Context, tracer trace.Trace
 	}
 	e.senders[SenderEvergreenWebhook] = sender
 
+	sender, err = util.NewChatLogger()
+	if err != nil {
+		return errors.Wrap(err, "setting up chat logger")
+	}
+	e.senders[SenderChat] = sender
+
 	sender, err = send.NewGenericLogger("evergreen", levelInfo)
 	if err != nil {
 		return errors.Wrap(err, "setting up Evergreen generic logger")
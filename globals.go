@@ -156,6 +156,9 @@ const (
 	TestSkippedStatus        = "skip"
 	TestSucceededStatus      = "pass"
 	TestTimedOutStatus       = "timeout"
+	// TestQuarantinedFailedStatus indicates that a test failed, but the test
+	// is quarantined in its project, so the failure does not fail the task.
+	TestQuarantinedFailedStatus = "quarantined-failed"
 
 	BuildStarted   = "started"
 	BuildCreated   = "created"
@@ -235,6 +238,9 @@ const (
 	// AutoRestartActivator represents the activator for tasks that have been
 	// automatically restarted via the retry_on_failure command flag.
 	AutoRestartActivator = "automatic_restart"
+	// PreemptionTaskActivator represents the caller that aborts and requeues
+	// low priority tasks so that starved high priority tasks can run.
+	PreemptionTaskActivator = "preemption"
 
 	// UnderwaterTaskUnscheduler is the caller associated with unscheduling
 	// and disabling tasks older than the task.UnschedulableThreshold from
@@ -259,7 +265,8 @@ const (
 	PowerShellSetupScriptName     = "setup.ps1"
 	PowerShellTempSetupScriptName = "setup-temp.ps1"
 
-	PlannerVersionTunable = "tunable"
+	PlannerVersionTunable   = "tunable"
+	PlannerVersionFairShare = "fair-share"
 
 	DispatcherVersionRevisedWithDependencies = "revised-with-dependencies"
 
@@ -377,6 +384,7 @@ const (
 var TestFailureStatuses = []string{
 	TestFailedStatus,
 	TestSilentlyFailedStatus,
+	TestQuarantinedFailedStatus,
 }
 
 var TaskStatuses = []string{
@@ -658,6 +666,8 @@ const (
 	ProviderNameEc2Fleet    = "ec2-fleet"
 	ProviderNameDocker      = "docker"
 	ProviderNameDockerMock  = "docker-mock"
+	ProviderNamePodman      = "podman"
+	ProviderNameProcess     = "process"
 	ProviderNameStatic      = "static"
 	ProviderNameMock        = "mock"
 
@@ -686,6 +696,12 @@ func IsDockerProvider(provider string) bool {
 		provider == ProviderNameDockerMock
 }
 
+// IsContainerProvider returns true if the provider runs hosts as containers on
+// parent hosts.
+func IsContainerProvider(provider string) bool {
+	return IsDockerProvider(provider) || provider == ProviderNamePodman
+}
+
 // EC2Tenancy represents the physical hardware tenancy for EC2 hosts.
 type EC2Tenancy string
 
@@ -711,6 +727,7 @@ var (
 		ProviderNameEc2Fleet,
 		ProviderNameMock,
 		ProviderNameDocker,
+		ProviderNamePodman,
 	}
 
 	// ProviderUserSpawnable includes all cloud provider types where a user can
@@ -723,6 +740,7 @@ var (
 
 	ProviderContainer = []string{
 		ProviderNameDocker,
+		ProviderNamePodman,
 	}
 )
 
@@ -858,12 +876,18 @@ const (
 	AttachResultsCommandName      = "attach.results"
 	AttachArtifactsCommandName    = "attach.artifacts"
 	AttachXUnitResultsCommandName = "attach.xunit_results"
+	AttachTAPResultsCommandName   = "attach.tap_results"
+	AttachTRXResultsCommandName   = "attach.trx_results"
+	AttachCucumberJSONCommandName = "attach.cucumber_json"
 )
 
 var AttachCommands = []string{
 	AttachResultsCommandName,
 	AttachArtifactsCommandName,
 	AttachXUnitResultsCommandName,
+	AttachTAPResultsCommandName,
+	AttachTRXResultsCommandName,
+	AttachCucumberJSONCommandName,
 }
 
 type SenderKey int
@@ -879,12 +903,15 @@ const (
 	SenderJIRAComment
 	SenderEmail
 	SenderGeneric
+	// SenderChat sends messages to chat services' incoming webhooks, such as
+	// Microsoft Teams channels.
+	SenderChat
 )
 
 func (k SenderKey) Validate() error {
 	switch k {
 	case SenderGithubStatus, SenderEvergreenWebhook, SenderSlack, SenderJIRAComment, SenderJIRAIssue,
-		SenderEmail, SenderGeneric:
+		SenderEmail, SenderGeneric, SenderChat:
 		return nil
 	default:
 		return errors.New("invalid sender defined")
@@ -907,6 +934,8 @@ func (k SenderKey) String() string {
 		return "jira-issue"
 	case SenderGeneric:
 		return "generic"
+	case SenderChat:
+		return "chat"
 	default:
 		return "<error:unknown>"
 	}
@@ -953,6 +982,7 @@ var (
 		ElapsedBuildActivator,
 		ElapsedTaskActivator,
 		GenerateTasksActivator,
+		PreemptionTaskActivator,
 	}
 
 	// UpHostStatus is a list of all host statuses that are considered up.
@@ -1063,6 +1093,7 @@ var (
 	// Set of valid PlannerSettings.Version strings that can be user set via the API
 	ValidTaskPlannerVersions = []string{
 		PlannerVersionTunable,
+		PlannerVersionFairShare,
 	}
 
 	// Set of valid DispatchSettings.Version strings that can be user set via the API
@@ -8,7 +8,10 @@ import (
 
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
+	evgModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/utility"
 )
 
 // AvailableRegions is the resolver for the availableRegions field.
@@ -19,6 +22,41 @@ func (r *distroResolver) AvailableRegions(ctx context.Context, obj *model.APIDis
 	return availableRegions, nil
 }
 
+// ProjectShareUsage is the resolver for the projectShareUsage field.
+func (r *distroResolver) ProjectShareUsage(ctx context.Context, obj *model.APIDistro) ([]*model.APIProjectShareUsage, error) {
+	distroID := utility.FromStringPtr(obj.Name)
+	taskQueue, err := evgModel.LoadTaskQueue(ctx, distroID)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("loading task queue for distro '%s': %s", distroID, err.Error()))
+	}
+	queuedProjectIDs := []string{}
+	if taskQueue != nil {
+		for _, item := range taskQueue.Queue {
+			queuedProjectIDs = append(queuedProjectIDs, item.Project)
+		}
+	}
+
+	d := obj.ToService()
+	usages, err := scheduler.GetProjectShareUsage(ctx, d, utility.UniqueStrings(queuedProjectIDs))
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting project share usage for distro '%s': %s", distroID, err.Error()))
+	}
+
+	apiUsages := []*model.APIProjectShareUsage{}
+	for _, usage := range usages {
+		apiUsages = append(apiUsages, &model.APIProjectShareUsage{
+			ProjectID:      utility.ToStringPtr(usage.ProjectID),
+			RepoID:         utility.ToStringPtr(usage.RepoID),
+			Share:          usage.Share,
+			TargetFraction: usage.TargetFraction,
+			HostTime:       model.NewAPIDuration(usage.HostTime),
+			UsageFraction:  usage.UsageFraction,
+			Factor:         usage.Factor,
+		})
+	}
+	return apiUsages, nil
+}
+
 // ProviderSettingsList is the resolver for the providerSettingsList field.
 func (r *distroResolver) ProviderSettingsList(ctx context.Context, obj *model.APIDistro) ([]map[string]any, error) {
 	settings := []map[string]any{}
@@ -59,6 +97,12 @@ func (r *plannerSettingsInputResolver) TargetTime(ctx context.Context, obj *mode
 	return nil
 }
 
+// WaitLimit is the resolver for the waitLimit field.
+func (r *preemptionSettingsInputResolver) WaitLimit(ctx context.Context, obj *model.APIPreemptionSettings, data int) error {
+	obj.WaitLimit = model.NewAPIDuration(time.Duration(data) * time.Millisecond)
+	return nil
+}
+
 // Distro returns DistroResolver implementation.
 func (r *Resolver) Distro() DistroResolver { return &distroResolver{r} }
 
@@ -75,7 +119,13 @@ func (r *Resolver) PlannerSettingsInput() PlannerSettingsInputResolver {
 	return &plannerSettingsInputResolver{r}
 }
 
+// PreemptionSettingsInput returns PreemptionSettingsInputResolver implementation.
+func (r *Resolver) PreemptionSettingsInput() PreemptionSettingsInputResolver {
+	return &preemptionSettingsInputResolver{r}
+}
+
 type distroResolver struct{ *Resolver }
 type distroInputResolver struct{ *Resolver }
 type hostAllocatorSettingsInputResolver struct{ *Resolver }
 type plannerSettingsInputResolver struct{ *Resolver }
+type preemptionSettingsInputResolver struct{ *Resolver }
@@ -87,6 +87,7 @@ type ResolverRoot interface {
 	HostAllocatorSettingsInput() HostAllocatorSettingsInputResolver
 	JiraNotificationsConfigInput() JiraNotificationsConfigInputResolver
 	PlannerSettingsInput() PlannerSettingsInputResolver
+	PreemptionSettingsInput() PreemptionSettingsInputResolver
 	ProjectSettingsInput() ProjectSettingsInputResolver
 	RepoSettingsInput() RepoSettingsInputResolver
 	SleepScheduleInput() SleepScheduleInputResolver
@@ -140,6 +141,7 @@ type ComplexityRoot struct {
 		BuildVariantDisplayName func(childComplexity int) int
 		NewVersion              func(childComplexity int) int
 		PrClosed                func(childComplexity int) int
+		Reason                  func(childComplexity int) int
 		TaskDisplayName         func(childComplexity int) int
 		TaskID                  func(childComplexity int) int
 		User                    func(childComplexity int) int
@@ -270,6 +272,11 @@ type ComplexityRoot struct {
 		WebhookConfigured func(childComplexity int) int
 	}
 
+	ArtifactRetentionSettings struct {
+		MainlineRetentionDays func(childComplexity int) int
+		PatchRetentionDays    func(childComplexity int) int
+	}
+
 	AssociatedLink struct {
 		Link func(childComplexity int) int
 		Name func(childComplexity int) int
@@ -366,6 +373,10 @@ type ComplexityRoot struct {
 		DBURL  func(childComplexity int) int
 	}
 
+	ChatSubscriber struct {
+		URL func(childComplexity int) int
+	}
+
 	ChildPatchAlias struct {
 		Alias   func(childComplexity int) int
 		PatchID func(childComplexity int) int
@@ -386,6 +397,7 @@ type ComplexityRoot struct {
 	CloudProviderConfig struct {
 		AWS    func(childComplexity int) int
 		Docker func(childComplexity int) int
+		Podman func(childComplexity int) int
 	}
 
 	CommitQueueParams struct {
@@ -417,6 +429,12 @@ type ComplexityRoot struct {
 		Total                         func(childComplexity int) int
 	}
 
+	CostBudgetSettings struct {
+		AlertThresholds func(childComplexity int) int
+		MonthlyBudget   func(childComplexity int) int
+		VersionBudget   func(childComplexity int) int
+	}
+
 	CostConfig struct {
 		EBSCost             func(childComplexity int) int
 		FinanceFormula      func(childComplexity int) int
@@ -430,6 +448,25 @@ type ComplexityRoot struct {
 		SavingsPlanRate func(childComplexity int) int
 	}
 
+	CriticalPath struct {
+		Estimated  func(childComplexity int) int
+		FinishTime func(childComplexity int) int
+		Makespan   func(childComplexity int) int
+		StartTime  func(childComplexity int) int
+		Tasks      func(childComplexity int) int
+	}
+
+	CriticalPathTask struct {
+		BuildVariant  func(childComplexity int) int
+		Contribution  func(childComplexity int) int
+		DisplayName   func(childComplexity int) int
+		Estimated     func(childComplexity int) int
+		ExecutionTime func(childComplexity int) int
+		QueueWait     func(childComplexity int) int
+		Status        func(childComplexity int) int
+		TaskID        func(childComplexity int) int
+	}
+
 	CursorSettings struct {
 		KeyConfigured func(childComplexity int) int
 		KeyLastFour   func(childComplexity int) int
@@ -493,6 +530,8 @@ type ComplexityRoot struct {
 		Name                  func(childComplexity int) int
 		Note                  func(childComplexity int) int
 		PlannerSettings       func(childComplexity int) int
+		PreemptionSettings    func(childComplexity int) int
+		ProjectShareUsage     func(childComplexity int) int
 		Provider              func(childComplexity int) int
 		ProviderAccount       func(childComplexity int) int
 		ProviderSettingsList  func(childComplexity int) int
@@ -608,6 +647,7 @@ type ComplexityRoot struct {
 		ResourceType   func(childComplexity int) int
 		Selectors      func(childComplexity int) int
 		Subscriber     func(childComplexity int) int
+		Template       func(childComplexity int) int
 		Trigger        func(childComplexity int) int
 		TriggerData    func(childComplexity int) int
 	}
@@ -961,6 +1001,12 @@ type ComplexityRoot struct {
 		Version   func(childComplexity int) int
 	}
 
+	LogStorageSettings struct {
+		CompactLogs           func(childComplexity int) int
+		MainlineRetentionDays func(childComplexity int) int
+		PatchRetentionDays    func(childComplexity int) int
+	}
+
 	LoggerConfig struct {
 		Buffer         func(childComplexity int) int
 		DefaultLevel   func(childComplexity int) int
@@ -1058,12 +1104,14 @@ type ComplexityRoot struct {
 		MoveAnnotationIssue           func(childComplexity int, taskID string, execution int, apiIssue model.APIIssueLink, isIssue bool) int
 		OverrideTaskDependencies      func(childComplexity int, taskID string) int
 		PromoteVarsToRepo             func(childComplexity int, opts PromoteVarsToRepoInput) int
+		QuarantineProjectTest         func(childComplexity int, opts QuarantineProjectTestInput) int
 		QuarantineTest                func(childComplexity int, opts QuarantineTestInput) int
 		RefreshGitHubStatuses         func(childComplexity int, opts RefreshGitHubStatusesInput) int
 		RemoveAnnotationIssue         func(childComplexity int, taskID string, execution int, apiIssue model.APIIssueLink, isIssue bool) int
 		RemoveFavoriteProject         func(childComplexity int, opts RemoveFavoriteProjectInput) int
 		RemovePublicKey               func(childComplexity int, keyName string) int
 		RemoveVolume                  func(childComplexity int, volumeID string) int
+		ReplayWebhookDelivery         func(childComplexity int, subscriptionID string, notificationID string) int
 		ReprovisionToNew              func(childComplexity int, hostIds []string) int
 		ResetAPIKey                   func(childComplexity int) int
 		RestartAdminTasks             func(childComplexity int, opts model1.RestartOptions) int
@@ -1088,6 +1136,7 @@ type ComplexityRoot struct {
 		SetVersionPriority            func(childComplexity int, versionID string, priority int) int
 		SpawnHost                     func(childComplexity int, spawnHostInput *SpawnHostInput) int
 		SpawnVolume                   func(childComplexity int, spawnVolumeInput SpawnVolumeInput) int
+		UnquarantineProjectTest       func(childComplexity int, opts UnquarantineProjectTestInput) int
 		UnscheduleTask                func(childComplexity int, taskID string) int
 		UnscheduleVersionTasks        func(childComplexity int, versionID string, abort bool) int
 		UpdateBetaFeatures            func(childComplexity int, opts UpdateBetaFeaturesInput) int
@@ -1311,22 +1360,35 @@ type ComplexityRoot struct {
 		NumDependentsFactor       func(childComplexity int) int
 		PatchFactor               func(childComplexity int) int
 		PatchTimeInQueueFactor    func(childComplexity int) int
+		ProjectShares             func(childComplexity int) int
 		TargetTime                func(childComplexity int) int
 		Version                   func(childComplexity int) int
 	}
 
+	PodmanConfig struct {
+		APIVersion func(childComplexity int) int
+	}
+
 	PreconditionScript struct {
 		Path   func(childComplexity int) int
 		Script func(childComplexity int) int
 	}
 
+	PreemptionSettings struct {
+		Enabled           func(childComplexity int) int
+		PriorityThreshold func(childComplexity int) int
+		WaitLimit         func(childComplexity int) int
+	}
+
 	Project struct {
 		Admins                             func(childComplexity int) int
+		ArtifactRetention                  func(childComplexity int) int
 		Banner                             func(childComplexity int) int
 		BatchTime                          func(childComplexity int) int
 		Branch                             func(childComplexity int) int
 		BuildBaronSettings                 func(childComplexity int) int
 		CommitQueue                        func(childComplexity int) int
+		CostBudget                         func(childComplexity int) int
 		DeactivatePrevious                 func(childComplexity int) int
 		DebugSpawnHostsDisabled            func(childComplexity int) int
 		DisabledStatsCache                 func(childComplexity int) int
@@ -1346,6 +1408,7 @@ type ComplexityRoot struct {
 		Id                                 func(childComplexity int) int
 		Identifier                         func(childComplexity int) int
 		IsFavorite                         func(childComplexity int) int
+		LogStorage                         func(childComplexity int) int
 		ManualPRTestingEnabled             func(childComplexity int) int
 		NotifyOnBuildFailure               func(childComplexity int) int
 		OldestAllowedMergeBase             func(childComplexity int) int
@@ -1363,6 +1426,7 @@ type ComplexityRoot struct {
 		RepoRefId                          func(childComplexity int) int
 		RepotrackerDisabled                func(childComplexity int) int
 		RepotrackerError                   func(childComplexity int) int
+		ResourceRecommendations            func(childComplexity int) int
 		Restricted                         func(childComplexity int) int
 		RunEveryMainlineCommit             func(childComplexity int) int
 		SpawnHostScriptPath                func(childComplexity int) int
@@ -1483,6 +1547,21 @@ type ComplexityRoot struct {
 		Vars                  func(childComplexity int) int
 	}
 
+	ProjectShare struct {
+		ID    func(childComplexity int) int
+		Share func(childComplexity int) int
+	}
+
+	ProjectShareUsage struct {
+		Factor         func(childComplexity int) int
+		HostTime       func(childComplexity int) int
+		ProjectID      func(childComplexity int) int
+		RepoID         func(childComplexity int) int
+		Share          func(childComplexity int) int
+		TargetFraction func(childComplexity int) int
+		UsageFraction  func(childComplexity int) int
+	}
+
 	ProjectTasksPair struct {
 		AllowedBVs   func(childComplexity int) int
 		AllowedTasks func(childComplexity int) int
@@ -1505,6 +1584,16 @@ type ComplexityRoot struct {
 		Success func(childComplexity int) int
 	}
 
+	QuarantinedTest struct {
+		CreatedAt func(childComplexity int) int
+		CreatedBy func(childComplexity int) int
+		FlipRate  func(childComplexity int) int
+		ProjectID func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Source    func(childComplexity int) int
+		TestName  func(childComplexity int) int
+	}
+
 	Query struct {
 		AWSRegions               func(childComplexity int) int
 		AdminEvents              func(childComplexity int, opts AdminEventsInput) int
@@ -1538,6 +1627,7 @@ type ComplexityRoot struct {
 		ProjectEvents            func(childComplexity int, projectIdentifier string, limit *int, before *time.Time) int
 		ProjectSettings          func(childComplexity int, projectIdentifier string) int
 		Projects                 func(childComplexity int) int
+		QuarantinedTests         func(childComplexity int, projectIdentifier string) int
 		RepoEvents               func(childComplexity int, repoID string, limit *int, before *time.Time) int
 		RepoSettings             func(childComplexity int, repoID string) int
 		SpruceConfig             func(childComplexity int) int
@@ -1554,6 +1644,7 @@ type ComplexityRoot struct {
 		Version                  func(childComplexity int, versionID string) int
 		ViewableProjectRefs      func(childComplexity int) int
 		Waterfall                func(childComplexity int, options WaterfallOptions) int
+		WebhookDeliveries        func(childComplexity int, subscriptionID string, status *string) int
 	}
 
 	RefreshGitHubStatusesPayload struct {
@@ -1664,6 +1755,36 @@ type ComplexityRoot struct {
 		VirtualMemoryKB func(childComplexity int) int
 	}
 
+	ResourceRecommendation struct {
+		BuildVariant        func(childComplexity int) int
+		CreatedAt           func(childComplexity int) int
+		CurrentDistro       func(childComplexity int) int
+		Direction           func(childComplexity int) int
+		NumExecutions       func(childComplexity int) int
+		PeakMemoryPercent   func(childComplexity int) int
+		RecommendedDistro   func(childComplexity int) int
+		SustainedCPUPercent func(childComplexity int) int
+		TaskName            func(childComplexity int) int
+	}
+
+	ResourceRecommendationDistro struct {
+		DistroID    func(childComplexity int) int
+		HourlyRate  func(childComplexity int) int
+		MemoryBytes func(childComplexity int) int
+		NumCPUs     func(childComplexity int) int
+	}
+
+	ResourceUsagePoint struct {
+		CPUPercent           func(childComplexity int) int
+		DiskReadBytesPerSec  func(childComplexity int) int
+		DiskWriteBytesPerSec func(childComplexity int) int
+		DurationSecs         func(childComplexity int) int
+		MemoryPercent        func(childComplexity int) int
+		NetRecvBytesPerSec   func(childComplexity int) int
+		NetSentBytesPerSec   func(childComplexity int) int
+		Time                 func(childComplexity int) int
+	}
+
 	RestartAdminTasksPayload struct {
 		NumRestartedTasks func(childComplexity int) int
 	}
@@ -1860,12 +1981,14 @@ type ComplexityRoot struct {
 	}
 
 	Subscriber struct {
+		ChatSubscriber        func(childComplexity int) int
 		EmailSubscriber       func(childComplexity int) int
 		GithubCheckSubscriber func(childComplexity int) int
 		GithubPRSubscriber    func(childComplexity int) int
 		JiraCommentSubscriber func(childComplexity int) int
 		JiraIssueSubscriber   func(childComplexity int) int
 		SlackSubscriber       func(childComplexity int) int
+		TeamsSubscriber       func(childComplexity int) int
 		WebhookSubscriber     func(childComplexity int) int
 	}
 
@@ -1948,6 +2071,7 @@ type ComplexityRoot struct {
 		ProjectIdentifier       func(childComplexity int) int
 		Requester               func(childComplexity int) int
 		ResetWhenFinished       func(childComplexity int) int
+		ResourceUsage           func(childComplexity int) int
 		Revision                func(childComplexity int) int
 		ScheduledTime           func(childComplexity int) int
 		SpawnHostLink           func(childComplexity int) int
@@ -2113,6 +2237,16 @@ type ComplexityRoot struct {
 		Version           func(childComplexity int) int
 	}
 
+	TaskResourceUsage struct {
+		DistroID            func(childComplexity int) int
+		InstanceType        func(childComplexity int) int
+		NumCPUs             func(childComplexity int) int
+		PeakMemoryPercent   func(childComplexity int) int
+		Points              func(childComplexity int) int
+		SustainedCPUPercent func(childComplexity int) int
+		TotalMemoryBytes    func(childComplexity int) int
+	}
+
 	TaskSpecifier struct {
 		PatchAlias   func(childComplexity int) int
 		TaskRegex    func(childComplexity int) int
@@ -2137,6 +2271,10 @@ type ComplexityRoot struct {
 		TotalTestCount          func(childComplexity int) int
 	}
 
+	TeamsSubscriber struct {
+		URL func(childComplexity int) int
+	}
+
 	TestLog struct {
 		LineNum       func(childComplexity int) int
 		LogsToMerge   func(childComplexity int) int
@@ -2310,6 +2448,7 @@ type ComplexityRoot struct {
 
 	Version struct {
 		Activated                func(childComplexity int) int
+		ArtifactsPinned          func(childComplexity int) int
 		Author                   func(childComplexity int) int
 		AuthorEmail              func(childComplexity int) int
 		BaseVersion              func(childComplexity int) int
@@ -2319,6 +2458,7 @@ type ComplexityRoot struct {
 		ChildVersions            func(childComplexity int) int
 		Cost                     func(childComplexity int) int
 		CreateTime               func(childComplexity int) int
+		CriticalPath             func(childComplexity int) int
 		Errors                   func(childComplexity int) int
 		ExternalLinksForMetadata func(childComplexity int) int
 		FinishTime               func(childComplexity int) int
@@ -2439,6 +2579,24 @@ type ComplexityRoot struct {
 		Secret   func(childComplexity int) int
 	}
 
+	WebhookAttempt struct {
+		Error      func(childComplexity int) int
+		Latency    func(childComplexity int) int
+		StatusCode func(childComplexity int) int
+		Time       func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		AttemptCount   func(childComplexity int) int
+		Attempts       func(childComplexity int) int
+		Error          func(childComplexity int) int
+		LastAttemptAt  func(childComplexity int) int
+		NotificationID func(childComplexity int) int
+		Status         func(childComplexity int) int
+		SubscriptionID func(childComplexity int) int
+		URL            func(childComplexity int) int
+	}
+
 	WebhookHeader struct {
 		Key   func(childComplexity int) int
 		Value func(childComplexity int) int
@@ -2481,6 +2639,8 @@ type CostResolver interface {
 type DistroResolver interface {
 	AvailableRegions(ctx context.Context, obj *model.APIDistro) ([]string, error)
 
+	ProjectShareUsage(ctx context.Context, obj *model.APIDistro) ([]*model.APIProjectShareUsage, error)
+
 	ProviderSettingsList(ctx context.Context, obj *model.APIDistro) ([]map[string]any, error)
 }
 type HostResolver interface {
@@ -2548,9 +2708,11 @@ type MutationResolver interface {
 	DetachProjectFromRepo(ctx context.Context, projectID string) (*model.APIProjectRef, error)
 	ForceRepotrackerRun(ctx context.Context, projectID string) (bool, error)
 	PromoteVarsToRepo(ctx context.Context, opts PromoteVarsToRepoInput) (bool, error)
+	QuarantineProjectTest(ctx context.Context, opts QuarantineProjectTestInput) (*model.APIQuarantinedTest, error)
 	SaveProjectSettingsForSection(ctx context.Context, projectSettings *model.APIProjectSettings, section ProjectSettingsSection) (*model.APIProjectSettings, error)
 	SaveRepoSettingsForSection(ctx context.Context, repoSettings *model.APIProjectSettings, section ProjectSettingsSection) (*model.APIProjectSettings, error)
 	SetLastRevision(ctx context.Context, opts SetLastRevisionInput) (*SetLastRevisionPayload, error)
+	UnquarantineProjectTest(ctx context.Context, opts UnquarantineProjectTestInput) (bool, error)
 	AttachVolumeToHost(ctx context.Context, volumeAndHost VolumeHost) (bool, error)
 	DetachVolumeFromHost(ctx context.Context, volumeID string) (bool, error)
 	EditSpawnHost(ctx context.Context, spawnHost *EditSpawnHostInput) (*model.APIHost, error)
@@ -2575,6 +2737,7 @@ type MutationResolver interface {
 	DeleteSubscriptions(ctx context.Context, subscriptionIds []string) (int, error)
 	RemoveFavoriteProject(ctx context.Context, opts RemoveFavoriteProjectInput) (*model.APIProjectRef, error)
 	RemovePublicKey(ctx context.Context, keyName string) ([]*model.APIPubKey, error)
+	ReplayWebhookDelivery(ctx context.Context, subscriptionID string, notificationID string) (*model.APIWebhookDelivery, error)
 	ResetAPIKey(ctx context.Context) (*UserConfig, error)
 	SaveSubscription(ctx context.Context, subscription model.APISubscription) (bool, error)
 	SetCursorAPIKey(ctx context.Context, apiKey string) (*SetCursorAPIKeyPayload, error)
@@ -2630,6 +2793,8 @@ type ProjectResolver interface {
 	IsFavorite(ctx context.Context, obj *model.APIProjectRef) (bool, error)
 
 	Patches(ctx context.Context, obj *model.APIProjectRef, patchesInput PatchesInput) (*Patches, error)
+
+	ResourceRecommendations(ctx context.Context, obj *model.APIProjectRef) ([]*model.APIResourceRecommendation, error)
 }
 type ProjectLiteResolver interface {
 	IsFavorite(ctx context.Context, obj *model1.ProjectRef) (bool, error)
@@ -2671,6 +2836,7 @@ type QueryResolver interface {
 	Projects(ctx context.Context) ([]*GroupedProjects, error)
 	ProjectEvents(ctx context.Context, projectIdentifier string, limit *int, before *time.Time) (*ProjectEvents, error)
 	ProjectSettings(ctx context.Context, projectIdentifier string) (*model.APIProjectSettings, error)
+	QuarantinedTests(ctx context.Context, projectIdentifier string) ([]*model.APIQuarantinedTest, error)
 	RepoEvents(ctx context.Context, repoID string, limit *int, before *time.Time) (*ProjectEvents, error)
 	RepoSettings(ctx context.Context, repoID string) (*model.APIProjectSettings, error)
 	ViewableProjectRefs(ctx context.Context) ([]*GroupedProjects, error)
@@ -2685,6 +2851,7 @@ type QueryResolver interface {
 	MyPublicKeys(ctx context.Context) ([]*model.APIPubKey, error)
 	User(ctx context.Context, userID *string) (*model.APIDBUser, error)
 	UserConfig(ctx context.Context) (*UserConfig, error)
+	WebhookDeliveries(ctx context.Context, subscriptionID string, status *string) ([]*model.APIWebhookDelivery, error)
 	BuildVariantsForTaskName(ctx context.Context, projectIdentifier string, taskName string) ([]*task.BuildVariantTuple, error)
 	MainlineCommits(ctx context.Context, options MainlineCommitsOptions, buildVariantOptions *BuildVariantOptions) (*MainlineCommits, error)
 	TaskNamesForBuildVariant(ctx context.Context, projectIdentifier string, buildVariant string) ([]string, error)
@@ -2773,6 +2940,8 @@ type TaskResolver interface {
 
 	ProjectIdentifier(ctx context.Context, obj *model.APITask) (*string, error)
 
+	ResourceUsage(ctx context.Context, obj *model.APITask) (*model.APITaskResourceUsage, error)
+
 	SpawnHostLink(ctx context.Context, obj *model.APITask) (*string, error)
 
 	TaskLogs(ctx context.Context, obj *model.APITask) (*TaskLogs, error)
@@ -2814,6 +2983,8 @@ type VersionResolver interface {
 	ChildVersions(ctx context.Context, obj *model.APIVersion) ([]*model.APIVersion, error)
 	Cost(ctx context.Context, obj *model.APIVersion) (*cost.Cost, error)
 
+	CriticalPath(ctx context.Context, obj *model.APIVersion) (*model.APICriticalPath, error)
+
 	ExternalLinksForMetadata(ctx context.Context, obj *model.APIVersion) ([]*ExternalLinkForMetadata, error)
 
 	GeneratedTaskCounts(ctx context.Context, obj *model.APIVersion) ([]*GeneratedTaskCountResults, error)
@@ -2867,6 +3038,9 @@ type JiraNotificationsConfigInputResolver interface {
 type PlannerSettingsInputResolver interface {
 	TargetTime(ctx context.Context, obj *model.APIPlannerSettings, data int) error
 }
+type PreemptionSettingsInputResolver interface {
+	WaitLimit(ctx context.Context, obj *model.APIPreemptionSettings, data int) error
+}
 type ProjectSettingsInputResolver interface {
 	ProjectID(ctx context.Context, obj *model.APIProjectSettings, data string) error
 }
@@ -3035,6 +3209,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.AbortInfo.PrClosed(childComplexity), true
+	case "AbortInfo.reason":
+		if e.complexity.AbortInfo.Reason == nil {
+			break
+		}
+
+		return e.complexity.AbortInfo.Reason(childComplexity), true
 	case "AbortInfo.taskDisplayName":
 		if e.complexity.AbortInfo.TaskDisplayName == nil {
 			break
@@ -3651,6 +3831,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Annotation.WebhookConfigured(childComplexity), true
 
+	case "ArtifactRetentionSettings.mainlineRetentionDays":
+		if e.complexity.ArtifactRetentionSettings.MainlineRetentionDays == nil {
+			break
+		}
+
+		return e.complexity.ArtifactRetentionSettings.MainlineRetentionDays(childComplexity), true
+	case "ArtifactRetentionSettings.patchRetentionDays":
+		if e.complexity.ArtifactRetentionSettings.PatchRetentionDays == nil {
+			break
+		}
+
+		return e.complexity.ArtifactRetentionSettings.PatchRetentionDays(childComplexity), true
+
 	case "AssociatedLink.link":
 		if e.complexity.AssociatedLink.Link == nil {
 			break
@@ -4023,6 +4216,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CedarConfig.DBURL(childComplexity), true
 
+	case "ChatSubscriber.url":
+		if e.complexity.ChatSubscriber.URL == nil {
+			break
+		}
+
+		return e.complexity.ChatSubscriber.URL(childComplexity), true
+
 	case "ChildPatchAlias.alias":
 		if e.complexity.ChildPatchAlias.Alias == nil {
 			break
@@ -4086,6 +4286,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.CloudProviderConfig.Docker(childComplexity), true
+	case "CloudProviderConfig.podman":
+		if e.complexity.CloudProviderConfig.Podman == nil {
+			break
+		}
+
+		return e.complexity.CloudProviderConfig.Podman(childComplexity), true
 
 	case "CommitQueueParams.enabled":
 		if e.complexity.CommitQueueParams.Enabled == nil {
@@ -4193,6 +4399,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Cost.Total(childComplexity), true
 
+	case "CostBudgetSettings.alertThresholds":
+		if e.complexity.CostBudgetSettings.AlertThresholds == nil {
+			break
+		}
+
+		return e.complexity.CostBudgetSettings.AlertThresholds(childComplexity), true
+	case "CostBudgetSettings.monthlyBudget":
+		if e.complexity.CostBudgetSettings.MonthlyBudget == nil {
+			break
+		}
+
+		return e.complexity.CostBudgetSettings.MonthlyBudget(childComplexity), true
+	case "CostBudgetSettings.versionBudget":
+		if e.complexity.CostBudgetSettings.VersionBudget == nil {
+			break
+		}
+
+		return e.complexity.CostBudgetSettings.VersionBudget(childComplexity), true
+
 	case "CostConfig.ebsCost":
 		if e.complexity.CostConfig.EBSCost == nil {
 			break
@@ -4237,6 +4462,86 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CostData.SavingsPlanRate(childComplexity), true
 
+	case "CriticalPath.estimated":
+		if e.complexity.CriticalPath.Estimated == nil {
+			break
+		}
+
+		return e.complexity.CriticalPath.Estimated(childComplexity), true
+	case "CriticalPath.finishTime":
+		if e.complexity.CriticalPath.FinishTime == nil {
+			break
+		}
+
+		return e.complexity.CriticalPath.FinishTime(childComplexity), true
+	case "CriticalPath.makespan":
+		if e.complexity.CriticalPath.Makespan == nil {
+			break
+		}
+
+		return e.complexity.CriticalPath.Makespan(childComplexity), true
+	case "CriticalPath.startTime":
+		if e.complexity.CriticalPath.StartTime == nil {
+			break
+		}
+
+		return e.complexity.CriticalPath.StartTime(childComplexity), true
+	case "CriticalPath.tasks":
+		if e.complexity.CriticalPath.Tasks == nil {
+			break
+		}
+
+		return e.complexity.CriticalPath.Tasks(childComplexity), true
+
+	case "CriticalPathTask.buildVariant":
+		if e.complexity.CriticalPathTask.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.BuildVariant(childComplexity), true
+	case "CriticalPathTask.contribution":
+		if e.complexity.CriticalPathTask.Contribution == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.Contribution(childComplexity), true
+	case "CriticalPathTask.displayName":
+		if e.complexity.CriticalPathTask.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.DisplayName(childComplexity), true
+	case "CriticalPathTask.estimated":
+		if e.complexity.CriticalPathTask.Estimated == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.Estimated(childComplexity), true
+	case "CriticalPathTask.executionTime":
+		if e.complexity.CriticalPathTask.ExecutionTime == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.ExecutionTime(childComplexity), true
+	case "CriticalPathTask.queueWait":
+		if e.complexity.CriticalPathTask.QueueWait == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.QueueWait(childComplexity), true
+	case "CriticalPathTask.status":
+		if e.complexity.CriticalPathTask.Status == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.Status(childComplexity), true
+	case "CriticalPathTask.taskId":
+		if e.complexity.CriticalPathTask.TaskID == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.TaskID(childComplexity), true
+
 	case "CursorSettings.keyConfigured":
 		if e.complexity.CursorSettings.KeyConfigured == nil {
 			break
@@ -4473,6 +4778,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Distro.PlannerSettings(childComplexity), true
+	case "Distro.preemptionSettings":
+		if e.complexity.Distro.PreemptionSettings == nil {
+			break
+		}
+
+		return e.complexity.Distro.PreemptionSettings(childComplexity), true
+	case "Distro.projectShareUsage":
+		if e.complexity.Distro.ProjectShareUsage == nil {
+			break
+		}
+
+		return e.complexity.Distro.ProjectShareUsage(childComplexity), true
 	case "Distro.provider":
 		if e.complexity.Distro.Provider == nil {
 			break
@@ -4874,6 +5191,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.GeneralSubscription.Subscriber(childComplexity), true
+	case "GeneralSubscription.template":
+		if e.complexity.GeneralSubscription.Template == nil {
+			break
+		}
+
+		return e.complexity.GeneralSubscription.Template(childComplexity), true
 	case "GeneralSubscription.trigger":
 		if e.complexity.GeneralSubscription.Trigger == nil {
 			break
@@ -6195,6 +6518,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.LogMessage.Version(childComplexity), true
 
+	case "LogStorageSettings.compactLogs":
+		if e.complexity.LogStorageSettings.CompactLogs == nil {
+			break
+		}
+
+		return e.complexity.LogStorageSettings.CompactLogs(childComplexity), true
+	case "LogStorageSettings.mainlineRetentionDays":
+		if e.complexity.LogStorageSettings.MainlineRetentionDays == nil {
+			break
+		}
+
+		return e.complexity.LogStorageSettings.MainlineRetentionDays(childComplexity), true
+	case "LogStorageSettings.patchRetentionDays":
+		if e.complexity.LogStorageSettings.PatchRetentionDays == nil {
+			break
+		}
+
+		return e.complexity.LogStorageSettings.PatchRetentionDays(childComplexity), true
+
 	case "LoggerConfig.buffer":
 		if e.complexity.LoggerConfig.Buffer == nil {
 			break
@@ -6753,6 +7095,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.PromoteVarsToRepo(childComplexity, args["opts"].(PromoteVarsToRepoInput)), true
+	case "Mutation.quarantineProjectTest":
+		if e.complexity.Mutation.QuarantineProjectTest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_quarantineProjectTest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.QuarantineProjectTest(childComplexity, args["opts"].(QuarantineProjectTestInput)), true
 	case "Mutation.quarantineTest":
 		if e.complexity.Mutation.QuarantineTest == nil {
 			break
@@ -6819,6 +7172,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.RemoveVolume(childComplexity, args["volumeId"].(string)), true
+	case "Mutation.replayWebhookDelivery":
+		if e.complexity.Mutation.ReplayWebhookDelivery == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_replayWebhookDelivery_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReplayWebhookDelivery(childComplexity, args["subscriptionId"].(string), args["notificationId"].(string)), true
 	case "Mutation.reprovisionToNew":
 		if e.complexity.Mutation.ReprovisionToNew == nil {
 			break
@@ -7078,6 +7442,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.SpawnVolume(childComplexity, args["spawnVolumeInput"].(SpawnVolumeInput)), true
+	case "Mutation.unquarantineProjectTest":
+		if e.complexity.Mutation.UnquarantineProjectTest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unquarantineProjectTest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnquarantineProjectTest(childComplexity, args["opts"].(UnquarantineProjectTestInput)), true
 	case "Mutation.unscheduleTask":
 		if e.complexity.Mutation.UnscheduleTask == nil {
 			break
@@ -8017,6 +8392,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.PlannerSettings.PatchTimeInQueueFactor(childComplexity), true
+	case "PlannerSettings.projectShares":
+		if e.complexity.PlannerSettings.ProjectShares == nil {
+			break
+		}
+
+		return e.complexity.PlannerSettings.ProjectShares(childComplexity), true
 	case "PlannerSettings.targetTime":
 		if e.complexity.PlannerSettings.TargetTime == nil {
 			break
@@ -8030,6 +8411,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PlannerSettings.Version(childComplexity), true
 
+	case "PodmanConfig.apiVersion":
+		if e.complexity.PodmanConfig.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.PodmanConfig.APIVersion(childComplexity), true
+
 	case "PreconditionScript.path":
 		if e.complexity.PreconditionScript.Path == nil {
 			break
@@ -8043,12 +8431,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PreconditionScript.Script(childComplexity), true
 
+	case "PreemptionSettings.enabled":
+		if e.complexity.PreemptionSettings.Enabled == nil {
+			break
+		}
+
+		return e.complexity.PreemptionSettings.Enabled(childComplexity), true
+	case "PreemptionSettings.priorityThreshold":
+		if e.complexity.PreemptionSettings.PriorityThreshold == nil {
+			break
+		}
+
+		return e.complexity.PreemptionSettings.PriorityThreshold(childComplexity), true
+	case "PreemptionSettings.waitLimit":
+		if e.complexity.PreemptionSettings.WaitLimit == nil {
+			break
+		}
+
+		return e.complexity.PreemptionSettings.WaitLimit(childComplexity), true
+
 	case "Project.admins":
 		if e.complexity.Project.Admins == nil {
 			break
 		}
 
 		return e.complexity.Project.Admins(childComplexity), true
+	case "Project.artifactRetention":
+		if e.complexity.Project.ArtifactRetention == nil {
+			break
+		}
+
+		return e.complexity.Project.ArtifactRetention(childComplexity), true
 	case "Project.banner":
 		if e.complexity.Project.Banner == nil {
 			break
@@ -8079,6 +8492,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Project.CommitQueue(childComplexity), true
+	case "Project.costBudget":
+		if e.complexity.Project.CostBudget == nil {
+			break
+		}
+
+		return e.complexity.Project.CostBudget(childComplexity), true
 	case "Project.deactivatePrevious":
 		if e.complexity.Project.DeactivatePrevious == nil {
 			break
@@ -8193,6 +8612,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Project.IsFavorite(childComplexity), true
+	case "Project.logStorage":
+		if e.complexity.Project.LogStorage == nil {
+			break
+		}
+
+		return e.complexity.Project.LogStorage(childComplexity), true
 	case "Project.manualPrTestingEnabled":
 		if e.complexity.Project.ManualPRTestingEnabled == nil {
 			break
@@ -8300,6 +8725,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Project.RepotrackerError(childComplexity), true
+	case "Project.resourceRecommendations":
+		if e.complexity.Project.ResourceRecommendations == nil {
+			break
+		}
+
+		return e.complexity.Project.ResourceRecommendations(childComplexity), true
 	case "Project.restricted":
 		if e.complexity.Project.Restricted == nil {
 			break
@@ -8839,6 +9270,62 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ProjectSettings.Vars(childComplexity), true
 
+	case "ProjectShare.id":
+		if e.complexity.ProjectShare.ID == nil {
+			break
+		}
+
+		return e.complexity.ProjectShare.ID(childComplexity), true
+	case "ProjectShare.share":
+		if e.complexity.ProjectShare.Share == nil {
+			break
+		}
+
+		return e.complexity.ProjectShare.Share(childComplexity), true
+
+	case "ProjectShareUsage.factor":
+		if e.complexity.ProjectShareUsage.Factor == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.Factor(childComplexity), true
+	case "ProjectShareUsage.hostTime":
+		if e.complexity.ProjectShareUsage.HostTime == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.HostTime(childComplexity), true
+	case "ProjectShareUsage.projectId":
+		if e.complexity.ProjectShareUsage.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.ProjectID(childComplexity), true
+	case "ProjectShareUsage.repoId":
+		if e.complexity.ProjectShareUsage.RepoID == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.RepoID(childComplexity), true
+	case "ProjectShareUsage.share":
+		if e.complexity.ProjectShareUsage.Share == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.Share(childComplexity), true
+	case "ProjectShareUsage.targetFraction":
+		if e.complexity.ProjectShareUsage.TargetFraction == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.TargetFraction(childComplexity), true
+	case "ProjectShareUsage.usageFraction":
+		if e.complexity.ProjectShareUsage.UsageFraction == nil {
+			break
+		}
+
+		return e.complexity.ProjectShareUsage.UsageFraction(childComplexity), true
+
 	case "ProjectTasksPair.allowedBVs":
 		if e.complexity.ProjectTasksPair.AllowedBVs == nil {
 			break
@@ -8903,6 +9390,49 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.QuarantineTestPayload.Success(childComplexity), true
 
+	case "QuarantinedTest.createdAt":
+		if e.complexity.QuarantinedTest.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.CreatedAt(childComplexity), true
+	case "QuarantinedTest.createdBy":
+		if e.complexity.QuarantinedTest.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.CreatedBy(childComplexity), true
+	case "QuarantinedTest.flipRate":
+		if e.complexity.QuarantinedTest.FlipRate == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.FlipRate(childComplexity), true
+	case "QuarantinedTest.projectId":
+		if e.complexity.QuarantinedTest.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.ProjectID(childComplexity), true
+	case "QuarantinedTest.reason":
+		if e.complexity.QuarantinedTest.Reason == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.Reason(childComplexity), true
+	case "QuarantinedTest.source":
+		if e.complexity.QuarantinedTest.Source == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.Source(childComplexity), true
+	case "QuarantinedTest.testName":
+		if e.complexity.QuarantinedTest.TestName == nil {
+			break
+		}
+
+		return e.complexity.QuarantinedTest.TestName(childComplexity), true
+
 	case "Query.awsRegions":
 		if e.complexity.Query.AWSRegions == nil {
 			break
@@ -9205,6 +9735,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Projects(childComplexity), true
+	case "Query.quarantinedTests":
+		if e.complexity.Query.QuarantinedTests == nil {
+			break
+		}
+
+		args, err := ec.field_Query_quarantinedTests_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QuarantinedTests(childComplexity, args["projectIdentifier"].(string)), true
 	case "Query.repoEvents":
 		if e.complexity.Query.RepoEvents == nil {
 			break
@@ -9356,6 +9897,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Waterfall(childComplexity, args["options"].(WaterfallOptions)), true
+	case "Query.webhookDeliveries":
+		if e.complexity.Query.WebhookDeliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_webhookDeliveries_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WebhookDeliveries(childComplexity, args["subscriptionId"].(string), args["status"].(*string)), true
 
 	case "RefreshGitHubStatusesPayload.success":
 		if e.complexity.RefreshGitHubStatusesPayload.Success == nil {
@@ -9818,6 +10370,135 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ResourceLimits.VirtualMemoryKB(childComplexity), true
 
+	case "ResourceRecommendation.buildVariant":
+		if e.complexity.ResourceRecommendation.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.BuildVariant(childComplexity), true
+	case "ResourceRecommendation.createdAt":
+		if e.complexity.ResourceRecommendation.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.CreatedAt(childComplexity), true
+	case "ResourceRecommendation.currentDistro":
+		if e.complexity.ResourceRecommendation.CurrentDistro == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.CurrentDistro(childComplexity), true
+	case "ResourceRecommendation.direction":
+		if e.complexity.ResourceRecommendation.Direction == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.Direction(childComplexity), true
+	case "ResourceRecommendation.numExecutions":
+		if e.complexity.ResourceRecommendation.NumExecutions == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.NumExecutions(childComplexity), true
+	case "ResourceRecommendation.peakMemoryPercent":
+		if e.complexity.ResourceRecommendation.PeakMemoryPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.PeakMemoryPercent(childComplexity), true
+	case "ResourceRecommendation.recommendedDistro":
+		if e.complexity.ResourceRecommendation.RecommendedDistro == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.RecommendedDistro(childComplexity), true
+	case "ResourceRecommendation.sustainedCpuPercent":
+		if e.complexity.ResourceRecommendation.SustainedCPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.SustainedCPUPercent(childComplexity), true
+	case "ResourceRecommendation.taskName":
+		if e.complexity.ResourceRecommendation.TaskName == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendation.TaskName(childComplexity), true
+
+	case "ResourceRecommendationDistro.distroId":
+		if e.complexity.ResourceRecommendationDistro.DistroID == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendationDistro.DistroID(childComplexity), true
+	case "ResourceRecommendationDistro.hourlyRate":
+		if e.complexity.ResourceRecommendationDistro.HourlyRate == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendationDistro.HourlyRate(childComplexity), true
+	case "ResourceRecommendationDistro.memoryBytes":
+		if e.complexity.ResourceRecommendationDistro.MemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendationDistro.MemoryBytes(childComplexity), true
+	case "ResourceRecommendationDistro.numCpus":
+		if e.complexity.ResourceRecommendationDistro.NumCPUs == nil {
+			break
+		}
+
+		return e.complexity.ResourceRecommendationDistro.NumCPUs(childComplexity), true
+
+	case "ResourceUsagePoint.cpuPercent":
+		if e.complexity.ResourceUsagePoint.CPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.CPUPercent(childComplexity), true
+	case "ResourceUsagePoint.diskReadBytesPerSec":
+		if e.complexity.ResourceUsagePoint.DiskReadBytesPerSec == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.DiskReadBytesPerSec(childComplexity), true
+	case "ResourceUsagePoint.diskWriteBytesPerSec":
+		if e.complexity.ResourceUsagePoint.DiskWriteBytesPerSec == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.DiskWriteBytesPerSec(childComplexity), true
+	case "ResourceUsagePoint.durationSecs":
+		if e.complexity.ResourceUsagePoint.DurationSecs == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.DurationSecs(childComplexity), true
+	case "ResourceUsagePoint.memoryPercent":
+		if e.complexity.ResourceUsagePoint.MemoryPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.MemoryPercent(childComplexity), true
+	case "ResourceUsagePoint.netRecvBytesPerSec":
+		if e.complexity.ResourceUsagePoint.NetRecvBytesPerSec == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.NetRecvBytesPerSec(childComplexity), true
+	case "ResourceUsagePoint.netSentBytesPerSec":
+		if e.complexity.ResourceUsagePoint.NetSentBytesPerSec == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.NetSentBytesPerSec(childComplexity), true
+	case "ResourceUsagePoint.time":
+		if e.complexity.ResourceUsagePoint.Time == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsagePoint.Time(childComplexity), true
+
 	case "RestartAdminTasksPayload.numRestartedTasks":
 		if e.complexity.RestartAdminTasksPayload.NumRestartedTasks == nil {
 			break
@@ -10478,6 +11159,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Subnet.SubnetID(childComplexity), true
 
+	case "Subscriber.chatSubscriber":
+		if e.complexity.Subscriber.ChatSubscriber == nil {
+			break
+		}
+
+		return e.complexity.Subscriber.ChatSubscriber(childComplexity), true
 	case "Subscriber.emailSubscriber":
 		if e.complexity.Subscriber.EmailSubscriber == nil {
 			break
@@ -10514,6 +11201,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Subscriber.SlackSubscriber(childComplexity), true
+	case "Subscriber.teamsSubscriber":
+		if e.complexity.Subscriber.TeamsSubscriber == nil {
+			break
+		}
+
+		return e.complexity.Subscriber.TeamsSubscriber(childComplexity), true
 	case "Subscriber.webhookSubscriber":
 		if e.complexity.Subscriber.WebhookSubscriber == nil {
 			break
@@ -10972,6 +11665,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Task.ResetWhenFinished(childComplexity), true
+	case "Task.resourceUsage":
+		if e.complexity.Task.ResourceUsage == nil {
+			break
+		}
+
+		return e.complexity.Task.ResourceUsage(childComplexity), true
 	case "Task.revision":
 		if e.complexity.Task.Revision == nil {
 			break
@@ -11650,6 +12349,49 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TaskQueueItem.Version(childComplexity), true
 
+	case "TaskResourceUsage.distroId":
+		if e.complexity.TaskResourceUsage.DistroID == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.DistroID(childComplexity), true
+	case "TaskResourceUsage.instanceType":
+		if e.complexity.TaskResourceUsage.InstanceType == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.InstanceType(childComplexity), true
+	case "TaskResourceUsage.numCpus":
+		if e.complexity.TaskResourceUsage.NumCPUs == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.NumCPUs(childComplexity), true
+	case "TaskResourceUsage.peakMemoryPercent":
+		if e.complexity.TaskResourceUsage.PeakMemoryPercent == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.PeakMemoryPercent(childComplexity), true
+	case "TaskResourceUsage.points":
+		if e.complexity.TaskResourceUsage.Points == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.Points(childComplexity), true
+	case "TaskResourceUsage.sustainedCpuPercent":
+		if e.complexity.TaskResourceUsage.SustainedCPUPercent == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.SustainedCPUPercent(childComplexity), true
+	case "TaskResourceUsage.totalMemoryBytes":
+		if e.complexity.TaskResourceUsage.TotalMemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.TotalMemoryBytes(childComplexity), true
+
 	case "TaskSpecifier.patchAlias":
 		if e.complexity.TaskSpecifier.PatchAlias == nil {
 			break
@@ -11726,6 +12468,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TaskTestResultSample.TotalTestCount(childComplexity), true
 
+	case "TeamsSubscriber.url":
+		if e.complexity.TeamsSubscriber.URL == nil {
+			break
+		}
+
+		return e.complexity.TeamsSubscriber.URL(childComplexity), true
+
 	case "TestLog.lineNum":
 		if e.complexity.TestLog.LineNum == nil {
 			break
@@ -12423,6 +13172,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Version.Activated(childComplexity), true
+	case "Version.artifactsPinned":
+		if e.complexity.Version.ArtifactsPinned == nil {
+			break
+		}
+
+		return e.complexity.Version.ArtifactsPinned(childComplexity), true
 	case "Version.author":
 		if e.complexity.Version.Author == nil {
 			break
@@ -12487,6 +13242,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Version.CreateTime(childComplexity), true
+	case "Version.criticalPath":
+		if e.complexity.Version.CriticalPath == nil {
+			break
+		}
+
+		return e.complexity.Version.CriticalPath(childComplexity), true
 	case "Version.errors":
 		if e.complexity.Version.Errors == nil {
 			break
@@ -13058,6 +13819,80 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Webhook.Secret(childComplexity), true
 
+	case "WebhookAttempt.error":
+		if e.complexity.WebhookAttempt.Error == nil {
+			break
+		}
+
+		return e.complexity.WebhookAttempt.Error(childComplexity), true
+	case "WebhookAttempt.latency":
+		if e.complexity.WebhookAttempt.Latency == nil {
+			break
+		}
+
+		return e.complexity.WebhookAttempt.Latency(childComplexity), true
+	case "WebhookAttempt.statusCode":
+		if e.complexity.WebhookAttempt.StatusCode == nil {
+			break
+		}
+
+		return e.complexity.WebhookAttempt.StatusCode(childComplexity), true
+	case "WebhookAttempt.time":
+		if e.complexity.WebhookAttempt.Time == nil {
+			break
+		}
+
+		return e.complexity.WebhookAttempt.Time(childComplexity), true
+
+	case "WebhookDelivery.attemptCount":
+		if e.complexity.WebhookDelivery.AttemptCount == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.AttemptCount(childComplexity), true
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+	case "WebhookDelivery.error":
+		if e.complexity.WebhookDelivery.Error == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Error(childComplexity), true
+	case "WebhookDelivery.lastAttemptAt":
+		if e.complexity.WebhookDelivery.LastAttemptAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.LastAttemptAt(childComplexity), true
+	case "WebhookDelivery.notificationId":
+		if e.complexity.WebhookDelivery.NotificationID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.NotificationID(childComplexity), true
+	case "WebhookDelivery.status":
+		if e.complexity.WebhookDelivery.Status == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Status(childComplexity), true
+	case "WebhookDelivery.subscriptionId":
+		if e.complexity.WebhookDelivery.SubscriptionID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.SubscriptionID(childComplexity), true
+	case "WebhookDelivery.url":
+		if e.complexity.WebhookDelivery.URL == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.URL(childComplexity), true
+
 	case "WebhookHeader.key":
 		if e.complexity.WebhookHeader.Key == nil {
 			break
@@ -13153,6 +13988,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAmboyDBConfigInput,
 		ec.unmarshalInputAmboyNamedQueueConfigInput,
 		ec.unmarshalInputAmboyRetryConfigInput,
+		ec.unmarshalInputArtifactRetentionSettingsInput,
 		ec.unmarshalInputAuthConfigInput,
 		ec.unmarshalInputAuthUserInput,
 		ec.unmarshalInputBetaFeaturesInput,
@@ -13162,12 +13998,14 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputBuildBaronSettingsInput,
 		ec.unmarshalInputBuildVariantOptions,
 		ec.unmarshalInputCedarConfigInput,
+		ec.unmarshalInputChatSubscriberInput,
 		ec.unmarshalInputCloudProviderConfigInput,
 		ec.unmarshalInputCommitQueueParamsInput,
 		ec.unmarshalInputContainerPoolInput,
 		ec.unmarshalInputContainerPoolsConfigInput,
 		ec.unmarshalInputCopyDistroInput,
 		ec.unmarshalInputCopyProjectInput,
+		ec.unmarshalInputCostBudgetSettingsInput,
 		ec.unmarshalInputCostConfigInput,
 		ec.unmarshalInputCostDataInput,
 		ec.unmarshalInputCreateDistroInput,
@@ -13215,6 +14053,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputJiraNotificationsProjectInput,
 		ec.unmarshalInputKanopyAuthConfigInput,
 		ec.unmarshalInputLogBufferingInput,
+		ec.unmarshalInputLogStorageSettingsInput,
 		ec.unmarshalInputLoggerConfigInput,
 		ec.unmarshalInputMainlineCommitsOptions,
 		ec.unmarshalInputMetadataLinkInput,
@@ -13240,17 +14079,21 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputPeriodicBuildInput,
 		ec.unmarshalInputPersistentDNSConfigInput,
 		ec.unmarshalInputPlannerSettingsInput,
+		ec.unmarshalInputPodmanConfigInput,
 		ec.unmarshalInputPreconditionScriptInput,
+		ec.unmarshalInputPreemptionSettingsInput,
 		ec.unmarshalInputProjectAliasInput,
 		ec.unmarshalInputProjectBannerInput,
 		ec.unmarshalInputProjectCreationConfigInput,
 		ec.unmarshalInputProjectInput,
 		ec.unmarshalInputProjectPermissionsOptions,
 		ec.unmarshalInputProjectSettingsInput,
+		ec.unmarshalInputProjectShareInput,
 		ec.unmarshalInputProjectTasksPairInput,
 		ec.unmarshalInputProjectVarsInput,
 		ec.unmarshalInputPromoteVarsToRepoInput,
 		ec.unmarshalInputPublicKeyInput,
+		ec.unmarshalInputQuarantineProjectTestInput,
 		ec.unmarshalInputQuarantineTestInput,
 		ec.unmarshalInputRefreshGitHubStatusesInput,
 		ec.unmarshalInputReleaseModeConfigInput,
@@ -13298,6 +14141,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputTaskLimitsConfigInput,
 		ec.unmarshalInputTaskPriority,
 		ec.unmarshalInputTaskSpecifierInput,
+		ec.unmarshalInputTeamsSubscriberInput,
 		ec.unmarshalInputTestFilter,
 		ec.unmarshalInputTestFilterOptions,
 		ec.unmarshalInputTestSelectionConfigInput,
@@ -13308,6 +14152,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputTriggerAliasInput,
 		ec.unmarshalInputTriggerConfigInput,
 		ec.unmarshalInputUIConfigInput,
+		ec.unmarshalInputUnquarantineProjectTestInput,
 		ec.unmarshalInputUpdateBetaFeaturesInput,
 		ec.unmarshalInputUpdateParsleySettingsInput,
 		ec.unmarshalInputUpdateSpawnHostStatusInput,
@@ -14408,6 +15253,17 @@ func (ec *executionContext) field_Mutation_promoteVarsToRepo_args(ctx context.Co
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_quarantineProjectTest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "opts", ec.unmarshalNQuarantineProjectTestInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐQuarantineProjectTestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["opts"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_quarantineTest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -14489,6 +15345,22 @@ func (ec *executionContext) field_Mutation_removeVolume_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_replayWebhookDelivery_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "subscriptionId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["subscriptionId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "notificationId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["notificationId"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_reprovisionToNew_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -15342,6 +16214,17 @@ func (ec *executionContext) field_Mutation_spawnVolume_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_unquarantineProjectTest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "opts", ec.unmarshalNUnquarantineProjectTestInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐUnquarantineProjectTestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["opts"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_unscheduleTask_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -16535,40 +17418,30 @@ func (ec *executionContext) field_Query_project_argsProjectIdentifier(
 	}
 }
 
-func (ec *executionContext) field_Query_repoEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_quarantinedTests_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 
-	arg0, err := ec.field_Query_repoEvents_argsRepoID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["repoId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "before", ec.unmarshalOTime2ᚖtimeᚐTime)
+	arg0, err := ec.field_Query_quarantinedTests_argsProjectIdentifier(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["before"] = arg2
+	args["projectIdentifier"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_repoEvents_argsRepoID(
+func (ec *executionContext) field_Query_quarantinedTests_argsProjectIdentifier(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["repoId"]; !ok {
+	if _, ok := rawArgs["projectIdentifier"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("repoId"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
 	directive0 := func(ctx context.Context) (any, error) {
-		tmp, ok := rawArgs["repoId"]
+		tmp, ok := rawArgs["projectIdentifier"]
 		if !ok {
 			var zeroVal string
 			return zeroVal, nil
@@ -16577,7 +17450,7 @@ func (ec *executionContext) field_Query_repoEvents_argsRepoID(
 	}
 
 	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
 		if err != nil {
 			var zeroVal string
 			return zeroVal, err
@@ -16607,92 +17480,40 @@ func (ec *executionContext) field_Query_repoEvents_argsRepoID(
 	}
 }
 
-func (ec *executionContext) field_Query_repoSettings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_repoEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 
-	arg0, err := ec.field_Query_repoSettings_argsRepoID(ctx, rawArgs)
+	arg0, err := ec.field_Query_repoEvents_argsRepoID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["repoId"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_repoSettings_argsRepoID(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["repoId"]; !ok {
-		var zeroVal string
-		return zeroVal, nil
-	}
-
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("repoId"))
-	directive0 := func(ctx context.Context) (any, error) {
-		tmp, ok := rawArgs["repoId"]
-		if !ok {
-			var zeroVal string
-			return zeroVal, nil
-		}
-		return ec.unmarshalNString2string(ctx, tmp)
-	}
-
-	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
-		if err != nil {
-			var zeroVal string
-			return zeroVal, err
-		}
-		access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
-		if err != nil {
-			var zeroVal string
-			return zeroVal, err
-		}
-		if ec.directives.RequireProjectAccess == nil {
-			var zeroVal string
-			return zeroVal, errors.New("directive requireProjectAccess is not implemented")
-		}
-		return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, permission, access)
-	}
-
-	tmp, err := directive1(ctx)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
 	if err != nil {
-		var zeroVal string
-		return zeroVal, graphql.ErrorOnPath(ctx, err)
-	}
-	if data, ok := tmp.(string); ok {
-		return data, nil
-	} else {
-		var zeroVal string
-		return zeroVal, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+		return nil, err
 	}
-}
-
-func (ec *executionContext) field_Query_taskAllExecutions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-
-	arg0, err := ec.field_Query_taskAllExecutions_argsTaskID(ctx, rawArgs)
+	args["limit"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "before", ec.unmarshalOTime2ᚖtimeᚐTime)
 	if err != nil {
 		return nil, err
 	}
-	args["taskId"] = arg0
+	args["before"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_taskAllExecutions_argsTaskID(
+func (ec *executionContext) field_Query_repoEvents_argsRepoID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["taskId"]; !ok {
+	if _, ok := rawArgs["repoId"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("taskId"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("repoId"))
 	directive0 := func(ctx context.Context) (any, error) {
-		tmp, ok := rawArgs["taskId"]
+		tmp, ok := rawArgs["repoId"]
 		if !ok {
 			var zeroVal string
 			return zeroVal, nil
@@ -16701,7 +17522,7 @@ func (ec *executionContext) field_Query_taskAllExecutions_argsTaskID(
 	}
 
 	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
 		if err != nil {
 			var zeroVal string
 			return zeroVal, err
@@ -16731,57 +17552,30 @@ func (ec *executionContext) field_Query_taskAllExecutions_argsTaskID(
 	}
 }
 
-func (ec *executionContext) field_Query_taskHistoryByCreateTime_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTaskHistoryOpts2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTaskHistoryOpts)
-	if err != nil {
-		return nil, err
-	}
-	args["options"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_taskHistory_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTaskHistoryOpts2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTaskHistoryOpts)
-	if err != nil {
-		return nil, err
-	}
-	args["options"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_taskNamesForBuildVariant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_repoSettings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 
-	arg0, err := ec.field_Query_taskNamesForBuildVariant_argsProjectIdentifier(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["projectIdentifier"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "buildVariant", ec.unmarshalNString2string)
+	arg0, err := ec.field_Query_repoSettings_argsRepoID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["buildVariant"] = arg1
+	args["repoId"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_taskNamesForBuildVariant_argsProjectIdentifier(
+func (ec *executionContext) field_Query_repoSettings_argsRepoID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["projectIdentifier"]; !ok {
+	if _, ok := rawArgs["repoId"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("repoId"))
 	directive0 := func(ctx context.Context) (any, error) {
-		tmp, ok := rawArgs["projectIdentifier"]
+		tmp, ok := rawArgs["repoId"]
 		if !ok {
 			var zeroVal string
 			return zeroVal, nil
@@ -16790,7 +17584,7 @@ func (ec *executionContext) field_Query_taskNamesForBuildVariant_argsProjectIden
 	}
 
 	directive1 := func(ctx context.Context) (any, error) {
-		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
 		if err != nil {
 			var zeroVal string
 			return zeroVal, err
@@ -16820,40 +17614,191 @@ func (ec *executionContext) field_Query_taskNamesForBuildVariant_argsProjectIden
 	}
 }
 
-func (ec *executionContext) field_Query_taskTestSample_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_taskAllExecutions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 
-	arg0, err := ec.field_Query_taskTestSample_argsVersionID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["versionId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "taskIds", ec.unmarshalNString2ᚕstringᚄ)
-	if err != nil {
-		return nil, err
-	}
-	args["taskIds"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "filters", ec.unmarshalNTestFilter2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestFilterᚄ)
+	arg0, err := ec.field_Query_taskAllExecutions_argsTaskID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["filters"] = arg2
+	args["taskId"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_taskTestSample_argsVersionID(
+func (ec *executionContext) field_Query_taskAllExecutions_argsTaskID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["versionId"]; !ok {
+	if _, ok := rawArgs["taskId"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("versionId"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("taskId"))
 	directive0 := func(ctx context.Context) (any, error) {
-		tmp, ok := rawArgs["versionId"]
+		tmp, ok := rawArgs["taskId"]
+		if !ok {
+			var zeroVal string
+			return zeroVal, nil
+		}
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	directive1 := func(ctx context.Context) (any, error) {
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		if ec.directives.RequireProjectAccess == nil {
+			var zeroVal string
+			return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+		}
+		return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, permission, access)
+	}
+
+	tmp, err := directive1(ctx)
+	if err != nil {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, err)
+	}
+	if data, ok := tmp.(string); ok {
+		return data, nil
+	} else {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+	}
+}
+
+func (ec *executionContext) field_Query_taskHistoryByCreateTime_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTaskHistoryOpts2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTaskHistoryOpts)
+	if err != nil {
+		return nil, err
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_taskHistory_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTaskHistoryOpts2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTaskHistoryOpts)
+	if err != nil {
+		return nil, err
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_taskNamesForBuildVariant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+
+	arg0, err := ec.field_Query_taskNamesForBuildVariant_argsProjectIdentifier(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["projectIdentifier"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "buildVariant", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["buildVariant"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_taskNamesForBuildVariant_argsProjectIdentifier(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["projectIdentifier"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+	directive0 := func(ctx context.Context) (any, error) {
+		tmp, ok := rawArgs["projectIdentifier"]
+		if !ok {
+			var zeroVal string
+			return zeroVal, nil
+		}
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	directive1 := func(ctx context.Context) (any, error) {
+		permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "TASKS")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "VIEW")
+		if err != nil {
+			var zeroVal string
+			return zeroVal, err
+		}
+		if ec.directives.RequireProjectAccess == nil {
+			var zeroVal string
+			return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+		}
+		return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, permission, access)
+	}
+
+	tmp, err := directive1(ctx)
+	if err != nil {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, err)
+	}
+	if data, ok := tmp.(string); ok {
+		return data, nil
+	} else {
+		var zeroVal string
+		return zeroVal, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+	}
+}
+
+func (ec *executionContext) field_Query_taskTestSample_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+
+	arg0, err := ec.field_Query_taskTestSample_argsVersionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["versionId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "taskIds", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["taskIds"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "filters", ec.unmarshalNTestFilter2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestFilterᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["filters"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_taskTestSample_argsVersionID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["versionId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("versionId"))
+	directive0 := func(ctx context.Context) (any, error) {
+		tmp, ok := rawArgs["versionId"]
 		if !ok {
 			var zeroVal string
 			return zeroVal, nil
@@ -17043,6 +17988,22 @@ func (ec *executionContext) field_Query_waterfall_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_webhookDeliveries_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "subscriptionId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["subscriptionId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Task_tests_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -17939,6 +18900,35 @@ func (ec *executionContext) fieldContext_AbortInfo_prClosed(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _AbortInfo_reason(ctx context.Context, field graphql.CollectedField, obj *AbortInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AbortInfo_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AbortInfo_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AbortInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AbortInfo_taskDisplayName(ctx context.Context, field graphql.CollectedField, obj *AbortInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -19532,6 +20522,8 @@ func (ec *executionContext) fieldContext_AdminSettings_providers(_ context.Conte
 				return ec.fieldContext_CloudProviderConfig_aws(ctx, field)
 			case "docker":
 				return ec.fieldContext_CloudProviderConfig_docker(ctx, field)
+			case "podman":
+				return ec.fieldContext_CloudProviderConfig_podman(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CloudProviderConfig", field.Name)
 		},
@@ -20411,6 +21403,8 @@ func (ec *executionContext) fieldContext_AdminTasksToRestartPayload_tasksToResta
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -21573,6 +22567,64 @@ func (ec *executionContext) fieldContext_Annotation_webhookConfigured(_ context.
 	return fc, nil
 }
 
+func (ec *executionContext) _ArtifactRetentionSettings_mainlineRetentionDays(ctx context.Context, field graphql.CollectedField, obj *model.APIArtifactRetentionSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ArtifactRetentionSettings_mainlineRetentionDays,
+		func(ctx context.Context) (any, error) {
+			return obj.MainlineRetentionDays, nil
+		},
+		nil,
+		ec.marshalOInt2int,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ArtifactRetentionSettings_mainlineRetentionDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ArtifactRetentionSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ArtifactRetentionSettings_patchRetentionDays(ctx context.Context, field graphql.CollectedField, obj *model.APIArtifactRetentionSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ArtifactRetentionSettings_patchRetentionDays,
+		func(ctx context.Context) (any, error) {
+			return obj.PatchRetentionDays, nil
+		},
+		nil,
+		ec.marshalOInt2int,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ArtifactRetentionSettings_patchRetentionDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ArtifactRetentionSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AssociatedLink_name(ctx context.Context, field graphql.CollectedField, obj *model.APIAssociatedLink) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -23581,6 +24633,35 @@ func (ec *executionContext) fieldContext_CedarConfig_dbName(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _ChatSubscriber_url(ctx context.Context, field graphql.CollectedField, obj *model.APIChatSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChatSubscriber_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChatSubscriber_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChatSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ChildPatchAlias_alias(ctx context.Context, field graphql.CollectedField, obj *model.APIChildPatchAlias) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -23911,6 +24992,39 @@ func (ec *executionContext) fieldContext_CloudProviderConfig_docker(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _CloudProviderConfig_podman(ctx context.Context, field graphql.CollectedField, obj *model.APICloudProviders) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CloudProviderConfig_podman,
+		func(ctx context.Context) (any, error) {
+			return obj.Podman, nil
+		},
+		nil,
+		ec.marshalOPodmanConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPodmanConfig,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CloudProviderConfig_podman(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CloudProviderConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_PodmanConfig_apiVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PodmanConfig", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CommitQueueParams_enabled(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -24414,6 +25528,93 @@ func (ec *executionContext) fieldContext_Cost_adjustedS3LogStorageCost(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _CostBudgetSettings_alertThresholds(ctx context.Context, field graphql.CollectedField, obj *model.APICostBudgetSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CostBudgetSettings_alertThresholds,
+		func(ctx context.Context) (any, error) {
+			return obj.AlertThresholds, nil
+		},
+		nil,
+		ec.marshalOInt2ᚕintᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CostBudgetSettings_alertThresholds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CostBudgetSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CostBudgetSettings_monthlyBudget(ctx context.Context, field graphql.CollectedField, obj *model.APICostBudgetSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CostBudgetSettings_monthlyBudget,
+		func(ctx context.Context) (any, error) {
+			return obj.MonthlyBudget, nil
+		},
+		nil,
+		ec.marshalOFloat2float64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CostBudgetSettings_monthlyBudget(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CostBudgetSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CostBudgetSettings_versionBudget(ctx context.Context, field graphql.CollectedField, obj *model.APICostBudgetSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CostBudgetSettings_versionBudget,
+		func(ctx context.Context) (any, error) {
+			return obj.VersionBudget, nil
+		},
+		nil,
+		ec.marshalOFloat2float64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CostBudgetSettings_versionBudget(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CostBudgetSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CostConfig_financeFormula(ctx context.Context, field graphql.CollectedField, obj *model.APICostConfig) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -24627,6 +25828,401 @@ func (ec *executionContext) fieldContext_CostData_savingsPlanRate(_ context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _CriticalPath_estimated(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPath_estimated,
+		func(ctx context.Context) (any, error) {
+			return obj.Estimated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPath_estimated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPath_finishTime(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPath_finishTime,
+		func(ctx context.Context) (any, error) {
+			return obj.FinishTime, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPath_finishTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPath_makespan(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPath_makespan,
+		func(ctx context.Context) (any, error) {
+			return obj.Makespan, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPath_makespan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPath_startTime(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPath_startTime,
+		func(ctx context.Context) (any, error) {
+			return obj.StartTime, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPath_startTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPath_tasks(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPath_tasks,
+		func(ctx context.Context) (any, error) {
+			return obj.Tasks, nil
+		},
+		nil,
+		ec.marshalNCriticalPathTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTaskᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPath_tasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_CriticalPathTask_buildVariant(ctx, field)
+			case "contribution":
+				return ec.fieldContext_CriticalPathTask_contribution(ctx, field)
+			case "displayName":
+				return ec.fieldContext_CriticalPathTask_displayName(ctx, field)
+			case "estimated":
+				return ec.fieldContext_CriticalPathTask_estimated(ctx, field)
+			case "executionTime":
+				return ec.fieldContext_CriticalPathTask_executionTime(ctx, field)
+			case "queueWait":
+				return ec.fieldContext_CriticalPathTask_queueWait(ctx, field)
+			case "status":
+				return ec.fieldContext_CriticalPathTask_status(ctx, field)
+			case "taskId":
+				return ec.fieldContext_CriticalPathTask_taskId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CriticalPathTask", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_buildVariant,
+		func(ctx context.Context) (any, error) {
+			return obj.BuildVariant, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_contribution(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_contribution,
+		func(ctx context.Context) (any, error) {
+			return obj.Contribution, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_contribution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_displayName,
+		func(ctx context.Context) (any, error) {
+			return obj.DisplayName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_estimated(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_estimated,
+		func(ctx context.Context) (any, error) {
+			return obj.Estimated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_estimated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_executionTime(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_executionTime,
+		func(ctx context.Context) (any, error) {
+			return obj.ExecutionTime, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_executionTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_queueWait(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_queueWait,
+		func(ctx context.Context) (any, error) {
+			return obj.QueueWait, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_queueWait(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_status(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_taskId,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CursorSettings_keyConfigured(ctx context.Context, field graphql.CollectedField, obj *CursorSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -25779,6 +27375,8 @@ func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Contex
 				return ec.fieldContext_PlannerSettings_patchFactor(ctx, field)
 			case "patchTimeInQueueFactor":
 				return ec.fieldContext_PlannerSettings_patchTimeInQueueFactor(ctx, field)
+			case "projectShares":
+				return ec.fieldContext_PlannerSettings_projectShares(ctx, field)
 			case "targetTime":
 				return ec.fieldContext_PlannerSettings_targetTime(ctx, field)
 			case "version":
@@ -25790,6 +27388,88 @@ func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Distro_preemptionSettings(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Distro_preemptionSettings,
+		func(ctx context.Context) (any, error) {
+			return obj.PreemptionSettings, nil
+		},
+		nil,
+		ec.marshalNPreemptionSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPreemptionSettings,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Distro_preemptionSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Distro",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "enabled":
+				return ec.fieldContext_PreemptionSettings_enabled(ctx, field)
+			case "priorityThreshold":
+				return ec.fieldContext_PreemptionSettings_priorityThreshold(ctx, field)
+			case "waitLimit":
+				return ec.fieldContext_PreemptionSettings_waitLimit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PreemptionSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Distro_projectShareUsage(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Distro_projectShareUsage,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Distro().ProjectShareUsage(ctx, obj)
+		},
+		nil,
+		ec.marshalNProjectShareUsage2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareUsageᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Distro_projectShareUsage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Distro",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "factor":
+				return ec.fieldContext_ProjectShareUsage_factor(ctx, field)
+			case "hostTime":
+				return ec.fieldContext_ProjectShareUsage_hostTime(ctx, field)
+			case "projectId":
+				return ec.fieldContext_ProjectShareUsage_projectId(ctx, field)
+			case "repoId":
+				return ec.fieldContext_ProjectShareUsage_repoId(ctx, field)
+			case "share":
+				return ec.fieldContext_ProjectShareUsage_share(ctx, field)
+			case "targetFraction":
+				return ec.fieldContext_ProjectShareUsage_targetFraction(ctx, field)
+			case "usageFraction":
+				return ec.fieldContext_ProjectShareUsage_usageFraction(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProjectShareUsage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Distro_provider(ctx context.Context, field graphql.CollectedField, obj *model.APIDistro) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -27742,6 +29422,35 @@ func (ec *executionContext) fieldContext_GeneralSubscription_subscriber(_ contex
 	return fc, nil
 }
 
+func (ec *executionContext) _GeneralSubscription_template(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GeneralSubscription_template,
+		func(ctx context.Context) (any, error) {
+			return obj.Template, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GeneralSubscription_template(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GeneralSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _GeneralSubscription_trigger(ctx context.Context, field graphql.CollectedField, obj *model.APISubscription) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -29248,6 +30957,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -29507,6 +31218,8 @@ func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Cont
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -29517,6 +31230,8 @@ func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Cont
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -29553,6 +31268,8 @@ func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Cont
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -29587,6 +31304,8 @@ func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Cont
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -32423,6 +34142,10 @@ func (ec *executionContext) fieldContext_Image_distros(_ context.Context, field
 				return ec.fieldContext_Distro_note(ctx, field)
 			case "plannerSettings":
 				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "preemptionSettings":
+				return ec.fieldContext_Distro_preemptionSettings(ctx, field)
+			case "projectShareUsage":
+				return ec.fieldContext_Distro_projectShareUsage(ctx, field)
 			case "provider":
 				return ec.fieldContext_Distro_provider(ctx, field)
 			case "providerAccount":
@@ -32751,6 +34474,8 @@ func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -34974,6 +36699,93 @@ func (ec *executionContext) fieldContext_LogMessage_version(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _LogStorageSettings_compactLogs(ctx context.Context, field graphql.CollectedField, obj *model.APILogStorageSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LogStorageSettings_compactLogs,
+		func(ctx context.Context) (any, error) {
+			return obj.CompactLogs, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LogStorageSettings_compactLogs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogStorageSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogStorageSettings_mainlineRetentionDays(ctx context.Context, field graphql.CollectedField, obj *model.APILogStorageSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LogStorageSettings_mainlineRetentionDays,
+		func(ctx context.Context) (any, error) {
+			return obj.MainlineRetentionDays, nil
+		},
+		nil,
+		ec.marshalOInt2int,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LogStorageSettings_mainlineRetentionDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogStorageSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogStorageSettings_patchRetentionDays(ctx context.Context, field graphql.CollectedField, obj *model.APILogStorageSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LogStorageSettings_patchRetentionDays,
+		func(ctx context.Context) (any, error) {
+			return obj.PatchRetentionDays, nil
+		},
+		nil,
+		ec.marshalOInt2int,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LogStorageSettings_patchRetentionDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogStorageSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _LoggerConfig_buffer(ctx context.Context, field graphql.CollectedField, obj *model.APILoggerConfig) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -35487,6 +37299,8 @@ func (ec *executionContext) fieldContext_LogkeeperBuild_task(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -35763,6 +37577,8 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_rolledUpVersions(
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -35781,6 +37597,8 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_rolledUpVersions(
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -35880,6 +37698,8 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_version(_ context
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -35898,6 +37718,8 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_version(_ context
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -37648,6 +39470,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToNewRepo(ctx con
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -37658,6 +39482,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToNewRepo(ctx con
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -37694,6 +39520,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToNewRepo(ctx con
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -37728,6 +39556,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToNewRepo(ctx con
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -37797,6 +39627,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToRepo(ctx contex
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -37807,6 +39639,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToRepo(ctx contex
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -37843,6 +39677,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToRepo(ctx contex
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -37877,6 +39713,8 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToRepo(ctx contex
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -37946,6 +39784,8 @@ func (ec *executionContext) fieldContext_Mutation_createProject(ctx context.Cont
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -37956,6 +39796,8 @@ func (ec *executionContext) fieldContext_Mutation_createProject(ctx context.Cont
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -37992,6 +39834,8 @@ func (ec *executionContext) fieldContext_Mutation_createProject(ctx context.Cont
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -38026,6 +39870,8 @@ func (ec *executionContext) fieldContext_Mutation_createProject(ctx context.Cont
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -38095,6 +39941,8 @@ func (ec *executionContext) fieldContext_Mutation_copyProject(ctx context.Contex
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -38105,6 +39953,8 @@ func (ec *executionContext) fieldContext_Mutation_copyProject(ctx context.Contex
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -38141,6 +39991,8 @@ func (ec *executionContext) fieldContext_Mutation_copyProject(ctx context.Contex
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -38175,6 +40027,8 @@ func (ec *executionContext) fieldContext_Mutation_copyProject(ctx context.Contex
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -38412,6 +40266,8 @@ func (ec *executionContext) fieldContext_Mutation_detachProjectFromRepo(ctx cont
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -38422,6 +40278,8 @@ func (ec *executionContext) fieldContext_Mutation_detachProjectFromRepo(ctx cont
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -38458,6 +40316,8 @@ func (ec *executionContext) fieldContext_Mutation_detachProjectFromRepo(ctx cont
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -38492,6 +40352,8 @@ func (ec *executionContext) fieldContext_Mutation_detachProjectFromRepo(ctx cont
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -38614,6 +40476,63 @@ func (ec *executionContext) fieldContext_Mutation_promoteVarsToRepo(ctx context.
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_quarantineProjectTest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_quarantineProjectTest,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().QuarantineProjectTest(ctx, fc.Args["opts"].(QuarantineProjectTestInput))
+		},
+		nil,
+		ec.marshalNQuarantinedTest2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTest,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_quarantineProjectTest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "projectId":
+				return ec.fieldContext_QuarantinedTest_projectId(ctx, field)
+			case "testName":
+				return ec.fieldContext_QuarantinedTest_testName(ctx, field)
+			case "source":
+				return ec.fieldContext_QuarantinedTest_source(ctx, field)
+			case "reason":
+				return ec.fieldContext_QuarantinedTest_reason(ctx, field)
+			case "flipRate":
+				return ec.fieldContext_QuarantinedTest_flipRate(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_QuarantinedTest_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_QuarantinedTest_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type QuarantinedTest", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_quarantineProjectTest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_saveProjectSettingsForSection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -38769,6 +40688,47 @@ func (ec *executionContext) fieldContext_Mutation_setLastRevision(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_unquarantineProjectTest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_unquarantineProjectTest,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UnquarantineProjectTest(ctx, fc.Args["opts"].(UnquarantineProjectTestInput))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_unquarantineProjectTest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_unquarantineProjectTest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_attachVolumeToHost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -39481,6 +41441,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -39706,6 +41668,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -39931,6 +41895,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40156,6 +42122,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40381,6 +42349,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40606,6 +42576,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriorities(ctx context.
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40831,6 +42803,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40961,6 +42935,8 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteProject(ctx context
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -40971,6 +42947,8 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteProject(ctx context
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -41007,6 +42985,8 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteProject(ctx context
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -41041,6 +43021,8 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteProject(ctx context
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -41260,6 +43242,8 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -41270,6 +43254,8 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -41306,6 +43292,8 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -41340,6 +43328,8 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -41427,6 +43417,65 @@ func (ec *executionContext) fieldContext_Mutation_removePublicKey(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_replayWebhookDelivery(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_replayWebhookDelivery,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ReplayWebhookDelivery(ctx, fc.Args["subscriptionId"].(string), fc.Args["notificationId"].(string))
+		},
+		nil,
+		ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDelivery,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_replayWebhookDelivery(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "attemptCount":
+				return ec.fieldContext_WebhookDelivery_attemptCount(ctx, field)
+			case "attempts":
+				return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+			case "error":
+				return ec.fieldContext_WebhookDelivery_error(ctx, field)
+			case "lastAttemptAt":
+				return ec.fieldContext_WebhookDelivery_lastAttemptAt(ctx, field)
+			case "notificationId":
+				return ec.fieldContext_WebhookDelivery_notificationId(ctx, field)
+			case "status":
+				return ec.fieldContext_WebhookDelivery_status(ctx, field)
+			case "subscriptionId":
+				return ec.fieldContext_WebhookDelivery_subscriptionId(ctx, field)
+			case "url":
+				return ec.fieldContext_WebhookDelivery_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDelivery", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_replayWebhookDelivery_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_resetAPIKey(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -41812,6 +43861,8 @@ func (ec *executionContext) fieldContext_Mutation_restartVersions(ctx context.Co
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -41830,6 +43881,8 @@ func (ec *executionContext) fieldContext_Mutation_restartVersions(ctx context.Co
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -42081,6 +44134,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -44837,6 +46892,8 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(_ context.Context
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -44847,6 +46904,8 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(_ context.Context
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -44883,6 +46942,8 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(_ context.Context
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -44917,6 +46978,8 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(_ context.Context
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -45317,6 +47380,8 @@ func (ec *executionContext) fieldContext_Patch_versionFull(_ context.Context, fi
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -45335,6 +47400,8 @@ func (ec *executionContext) fieldContext_Patch_versionFull(_ context.Context, fi
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -46892,6 +48959,41 @@ func (ec *executionContext) fieldContext_PlannerSettings_patchTimeInQueueFactor(
 	return fc, nil
 }
 
+func (ec *executionContext) _PlannerSettings_projectShares(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlannerSettings_projectShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ProjectShares, nil
+		},
+		nil,
+		ec.marshalNProjectShare2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlannerSettings_projectShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlannerSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ProjectShare_id(ctx, field)
+			case "share":
+				return ec.fieldContext_ProjectShare_share(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProjectShare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PlannerSettings_targetTime(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -46950,6 +49052,35 @@ func (ec *executionContext) fieldContext_PlannerSettings_version(_ context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _PodmanConfig_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.APIPodmanConfig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PodmanConfig_apiVersion,
+		func(ctx context.Context) (any, error) {
+			return obj.APIVersion, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PodmanConfig_apiVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodmanConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PreconditionScript_path(ctx context.Context, field graphql.CollectedField, obj *model.APIPreconditionScript) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -47008,6 +49139,93 @@ func (ec *executionContext) fieldContext_PreconditionScript_script(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _PreemptionSettings_enabled(ctx context.Context, field graphql.CollectedField, obj *model.APIPreemptionSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PreemptionSettings_enabled,
+		func(ctx context.Context) (any, error) {
+			return obj.Enabled, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PreemptionSettings_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PreemptionSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PreemptionSettings_priorityThreshold(ctx context.Context, field graphql.CollectedField, obj *model.APIPreemptionSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PreemptionSettings_priorityThreshold,
+		func(ctx context.Context) (any, error) {
+			return obj.PriorityThreshold, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PreemptionSettings_priorityThreshold(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PreemptionSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PreemptionSettings_waitLimit(ctx context.Context, field graphql.CollectedField, obj *model.APIPreemptionSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PreemptionSettings_waitLimit,
+		func(ctx context.Context) (any, error) {
+			return obj.WaitLimit, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PreemptionSettings_waitLimit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PreemptionSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_id(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -47066,6 +49284,41 @@ func (ec *executionContext) fieldContext_Project_admins(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_artifactRetention(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_artifactRetention,
+		func(ctx context.Context) (any, error) {
+			return obj.ArtifactRetention, nil
+		},
+		nil,
+		ec.marshalOArtifactRetentionSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIArtifactRetentionSettings,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_artifactRetention(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mainlineRetentionDays":
+				return ec.fieldContext_ArtifactRetentionSettings_mainlineRetentionDays(ctx, field)
+			case "patchRetentionDays":
+				return ec.fieldContext_ArtifactRetentionSettings_patchRetentionDays(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ArtifactRetentionSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_banner(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -47243,6 +49496,43 @@ func (ec *executionContext) fieldContext_Project_commitQueue(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_costBudget(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_costBudget,
+		func(ctx context.Context) (any, error) {
+			return obj.CostBudget, nil
+		},
+		nil,
+		ec.marshalOCostBudgetSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBudgetSettings,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_costBudget(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "alertThresholds":
+				return ec.fieldContext_CostBudgetSettings_alertThresholds(ctx, field)
+			case "monthlyBudget":
+				return ec.fieldContext_CostBudgetSettings_monthlyBudget(ctx, field)
+			case "versionBudget":
+				return ec.fieldContext_CostBudgetSettings_versionBudget(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostBudgetSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_deactivatePrevious(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -47779,6 +50069,43 @@ func (ec *executionContext) fieldContext_Project_isFavorite(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_logStorage(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_logStorage,
+		func(ctx context.Context) (any, error) {
+			return obj.LogStorage, nil
+		},
+		nil,
+		ec.marshalOLogStorageSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogStorageSettings,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_logStorage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "compactLogs":
+				return ec.fieldContext_LogStorageSettings_compactLogs(ctx, field)
+			case "mainlineRetentionDays":
+				return ec.fieldContext_LogStorageSettings_mainlineRetentionDays(ctx, field)
+			case "patchRetentionDays":
+				return ec.fieldContext_LogStorageSettings_patchRetentionDays(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogStorageSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_manualPrTestingEnabled(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -48342,6 +50669,55 @@ func (ec *executionContext) fieldContext_Project_repotrackerError(_ context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_resourceRecommendations(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_resourceRecommendations,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Project().ResourceRecommendations(ctx, obj)
+		},
+		nil,
+		ec.marshalNResourceRecommendation2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceRecommendationᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_resourceRecommendations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_ResourceRecommendation_buildVariant(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ResourceRecommendation_createdAt(ctx, field)
+			case "currentDistro":
+				return ec.fieldContext_ResourceRecommendation_currentDistro(ctx, field)
+			case "direction":
+				return ec.fieldContext_ResourceRecommendation_direction(ctx, field)
+			case "numExecutions":
+				return ec.fieldContext_ResourceRecommendation_numExecutions(ctx, field)
+			case "peakMemoryPercent":
+				return ec.fieldContext_ResourceRecommendation_peakMemoryPercent(ctx, field)
+			case "recommendedDistro":
+				return ec.fieldContext_ResourceRecommendation_recommendedDistro(ctx, field)
+			case "sustainedCpuPercent":
+				return ec.fieldContext_ResourceRecommendation_sustainedCpuPercent(ctx, field)
+			case "taskName":
+				return ec.fieldContext_ResourceRecommendation_taskName(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceRecommendation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_restricted(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -49518,6 +51894,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_projectRef(_ conte
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -49528,6 +51906,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_projectRef(_ conte
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -49564,6 +51944,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_projectRef(_ conte
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -49598,6 +51980,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_projectRef(_ conte
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -49663,6 +52047,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_subscriptions(_ co
 				return ec.fieldContext_GeneralSubscription_selectors(ctx, field)
 			case "subscriber":
 				return ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
+			case "template":
+				return ec.fieldContext_GeneralSubscription_template(ctx, field)
 			case "trigger":
 				return ec.fieldContext_GeneralSubscription_trigger(ctx, field)
 			case "triggerData":
@@ -51126,6 +53512,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_projectRef(_ context.Co
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -51136,6 +53524,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_projectRef(_ context.Co
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -51172,6 +53562,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_projectRef(_ context.Co
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -51206,6 +53598,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_projectRef(_ context.Co
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -51271,6 +53665,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_subscriptions(_ context
 				return ec.fieldContext_GeneralSubscription_selectors(ctx, field)
 			case "subscriber":
 				return ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
+			case "template":
+				return ec.fieldContext_GeneralSubscription_template(ctx, field)
 			case "trigger":
 				return ec.fieldContext_GeneralSubscription_trigger(ctx, field)
 			case "triggerData":
@@ -51321,6 +53717,267 @@ func (ec *executionContext) fieldContext_ProjectSettings_vars(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _ProjectShare_id(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShare_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShare_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShare_share(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShare_share,
+		func(ctx context.Context) (any, error) {
+			return obj.Share, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShare_share(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_factor(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_factor,
+		func(ctx context.Context) (any, error) {
+			return obj.Factor, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_factor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_hostTime(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_hostTime,
+		func(ctx context.Context) (any, error) {
+			return obj.HostTime, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_hostTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_projectId(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_projectId,
+		func(ctx context.Context) (any, error) {
+			return obj.ProjectID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_projectId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_repoId(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_repoId,
+		func(ctx context.Context) (any, error) {
+			return obj.RepoID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_repoId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_share(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_share,
+		func(ctx context.Context) (any, error) {
+			return obj.Share, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_share(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_targetFraction(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_targetFraction,
+		func(ctx context.Context) (any, error) {
+			return obj.TargetFraction, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_targetFraction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectShareUsage_usageFraction(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectShareUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProjectShareUsage_usageFraction,
+		func(ctx context.Context) (any, error) {
+			return obj.UsageFraction, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProjectShareUsage_usageFraction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectShareUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ProjectTasksPair_projectId(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectTasksPair) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -51611,6 +54268,209 @@ func (ec *executionContext) fieldContext_QuarantineTestPayload_success(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _QuarantinedTest_projectId(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_projectId,
+		func(ctx context.Context) (any, error) {
+			return obj.ProjectID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_projectId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_testName(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_testName,
+		func(ctx context.Context) (any, error) {
+			return obj.TestName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_testName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_source(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_reason(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_flipRate(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_flipRate,
+		func(ctx context.Context) (any, error) {
+			return obj.FlipRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_flipRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_createdBy,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuarantinedTest_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.APIQuarantinedTest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuarantinedTest_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuarantinedTest_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuarantinedTest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_bbGetCreatedTickets(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -52227,6 +55087,10 @@ func (ec *executionContext) fieldContext_Query_distro(ctx context.Context, field
 				return ec.fieldContext_Distro_note(ctx, field)
 			case "plannerSettings":
 				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "preemptionSettings":
+				return ec.fieldContext_Distro_preemptionSettings(ctx, field)
+			case "projectShareUsage":
+				return ec.fieldContext_Distro_projectShareUsage(ctx, field)
 			case "provider":
 				return ec.fieldContext_Distro_provider(ctx, field)
 			case "providerAccount":
@@ -52391,6 +55255,10 @@ func (ec *executionContext) fieldContext_Query_distros(ctx context.Context, fiel
 				return ec.fieldContext_Distro_note(ctx, field)
 			case "plannerSettings":
 				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "preemptionSettings":
+				return ec.fieldContext_Distro_preemptionSettings(ctx, field)
+			case "projectShareUsage":
+				return ec.fieldContext_Distro_projectShareUsage(ctx, field)
 			case "provider":
 				return ec.fieldContext_Distro_provider(ctx, field)
 			case "providerAccount":
@@ -52931,6 +55799,8 @@ func (ec *executionContext) fieldContext_Query_project(ctx context.Context, fiel
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -52941,6 +55811,8 @@ func (ec *executionContext) fieldContext_Query_project(ctx context.Context, fiel
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -52977,6 +55849,8 @@ func (ec *executionContext) fieldContext_Query_project(ctx context.Context, fiel
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -53011,6 +55885,8 @@ func (ec *executionContext) fieldContext_Query_project(ctx context.Context, fiel
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -53190,6 +56066,63 @@ func (ec *executionContext) fieldContext_Query_projectSettings(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_quarantinedTests(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_quarantinedTests,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().QuarantinedTests(ctx, fc.Args["projectIdentifier"].(string))
+		},
+		nil,
+		ec.marshalNQuarantinedTest2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTestᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_quarantinedTests(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "projectId":
+				return ec.fieldContext_QuarantinedTest_projectId(ctx, field)
+			case "testName":
+				return ec.fieldContext_QuarantinedTest_testName(ctx, field)
+			case "source":
+				return ec.fieldContext_QuarantinedTest_source(ctx, field)
+			case "reason":
+				return ec.fieldContext_QuarantinedTest_reason(ctx, field)
+			case "flipRate":
+				return ec.fieldContext_QuarantinedTest_flipRate(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_QuarantinedTest_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_QuarantinedTest_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type QuarantinedTest", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_quarantinedTests_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_repoEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -53742,6 +56675,8 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -53967,6 +56902,8 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -54256,6 +57193,65 @@ func (ec *executionContext) fieldContext_Query_userConfig(_ context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_webhookDeliveries,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().WebhookDeliveries(ctx, fc.Args["subscriptionId"].(string), fc.Args["status"].(*string))
+		},
+		nil,
+		ec.marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDeliveryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "attemptCount":
+				return ec.fieldContext_WebhookDelivery_attemptCount(ctx, field)
+			case "attempts":
+				return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+			case "error":
+				return ec.fieldContext_WebhookDelivery_error(ctx, field)
+			case "lastAttemptAt":
+				return ec.fieldContext_WebhookDelivery_lastAttemptAt(ctx, field)
+			case "notificationId":
+				return ec.fieldContext_WebhookDelivery_notificationId(ctx, field)
+			case "status":
+				return ec.fieldContext_WebhookDelivery_status(ctx, field)
+			case "subscriptionId":
+				return ec.fieldContext_WebhookDelivery_subscriptionId(ctx, field)
+			case "url":
+				return ec.fieldContext_WebhookDelivery_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDelivery", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_webhookDeliveries_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_buildVariantsForTaskName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -54604,6 +57600,8 @@ func (ec *executionContext) fieldContext_Query_version(ctx context.Context, fiel
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -54622,6 +57620,8 @@ func (ec *executionContext) fieldContext_Query_version(ctx context.Context, fiel
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -56873,6 +59873,8 @@ func (ec *executionContext) fieldContext_RepoSettings_subscriptions(_ context.Co
 				return ec.fieldContext_GeneralSubscription_selectors(ctx, field)
 			case "subscriber":
 				return ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
+			case "template":
+				return ec.fieldContext_GeneralSubscription_template(ctx, field)
 			case "trigger":
 				return ec.fieldContext_GeneralSubscription_trigger(ctx, field)
 			case "triggerData":
@@ -57364,6 +60366,635 @@ func (ec *executionContext) fieldContext_ResourceLimits_virtualMemoryKb(_ contex
 	return fc, nil
 }
 
+func (ec *executionContext) _ResourceRecommendation_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_buildVariant,
+		func(ctx context.Context) (any, error) {
+			return obj.BuildVariant, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2ᚖtimeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_currentDistro(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_currentDistro,
+		func(ctx context.Context) (any, error) {
+			return obj.CurrentDistro, nil
+		},
+		nil,
+		ec.marshalNResourceRecommendationDistro2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistroOption,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_currentDistro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "distroId":
+				return ec.fieldContext_ResourceRecommendationDistro_distroId(ctx, field)
+			case "hourlyRate":
+				return ec.fieldContext_ResourceRecommendationDistro_hourlyRate(ctx, field)
+			case "memoryBytes":
+				return ec.fieldContext_ResourceRecommendationDistro_memoryBytes(ctx, field)
+			case "numCpus":
+				return ec.fieldContext_ResourceRecommendationDistro_numCpus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceRecommendationDistro", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_direction(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_direction,
+		func(ctx context.Context) (any, error) {
+			return obj.Direction, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_direction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_numExecutions(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_numExecutions,
+		func(ctx context.Context) (any, error) {
+			return obj.NumExecutions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_numExecutions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_peakMemoryPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_peakMemoryPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.PeakMemoryPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_peakMemoryPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_recommendedDistro(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_recommendedDistro,
+		func(ctx context.Context) (any, error) {
+			return obj.RecommendedDistro, nil
+		},
+		nil,
+		ec.marshalNResourceRecommendationDistro2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistroOption,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_recommendedDistro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "distroId":
+				return ec.fieldContext_ResourceRecommendationDistro_distroId(ctx, field)
+			case "hourlyRate":
+				return ec.fieldContext_ResourceRecommendationDistro_hourlyRate(ctx, field)
+			case "memoryBytes":
+				return ec.fieldContext_ResourceRecommendationDistro_memoryBytes(ctx, field)
+			case "numCpus":
+				return ec.fieldContext_ResourceRecommendationDistro_numCpus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceRecommendationDistro", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_sustainedCpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_sustainedCpuPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.SustainedCPUPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_sustainedCpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendation_taskName(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceRecommendation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendation_taskName,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendation_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendationDistro_distroId(ctx context.Context, field graphql.CollectedField, obj *model.APIDistroOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendationDistro_distroId,
+		func(ctx context.Context) (any, error) {
+			return obj.DistroID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendationDistro_distroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendationDistro",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendationDistro_hourlyRate(ctx context.Context, field graphql.CollectedField, obj *model.APIDistroOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendationDistro_hourlyRate,
+		func(ctx context.Context) (any, error) {
+			return obj.HourlyRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendationDistro_hourlyRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendationDistro",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendationDistro_memoryBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIDistroOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendationDistro_memoryBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.MemoryBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendationDistro_memoryBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendationDistro",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceRecommendationDistro_numCpus(ctx context.Context, field graphql.CollectedField, obj *model.APIDistroOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceRecommendationDistro_numCpus,
+		func(ctx context.Context) (any, error) {
+			return obj.NumCPUs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceRecommendationDistro_numCpus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceRecommendationDistro",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_cpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_cpuPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.CPUPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_cpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_diskReadBytesPerSec(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_diskReadBytesPerSec,
+		func(ctx context.Context) (any, error) {
+			return obj.DiskReadBytesPerSec, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_diskReadBytesPerSec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_diskWriteBytesPerSec(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_diskWriteBytesPerSec,
+		func(ctx context.Context) (any, error) {
+			return obj.DiskWriteBytesPerSec, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_diskWriteBytesPerSec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_durationSecs(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_durationSecs,
+		func(ctx context.Context) (any, error) {
+			return obj.DurationSecs, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_durationSecs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_memoryPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_memoryPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.MemoryPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_memoryPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_netRecvBytesPerSec(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_netRecvBytesPerSec,
+		func(ctx context.Context) (any, error) {
+			return obj.NetRecvBytesPerSec, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_netRecvBytesPerSec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_netSentBytesPerSec(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_netSentBytesPerSec,
+		func(ctx context.Context) (any, error) {
+			return obj.NetSentBytesPerSec, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_netSentBytesPerSec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsagePoint_time(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsagePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsagePoint_time,
+		func(ctx context.Context) (any, error) {
+			return obj.Time, nil
+		},
+		nil,
+		ec.marshalNTime2ᚖtimeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsagePoint_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsagePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RestartAdminTasksPayload_numRestartedTasks(ctx context.Context, field graphql.CollectedField, obj *RestartAdminTasksPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -58125,6 +61756,10 @@ func (ec *executionContext) fieldContext_SaveDistroPayload_distro(_ context.Cont
 				return ec.fieldContext_Distro_note(ctx, field)
 			case "plannerSettings":
 				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "preemptionSettings":
+				return ec.fieldContext_Distro_preemptionSettings(ctx, field)
+			case "projectShareUsage":
+				return ec.fieldContext_Distro_projectShareUsage(ctx, field)
 			case "provider":
 				return ec.fieldContext_Distro_provider(ctx, field)
 			case "providerAccount":
@@ -60238,6 +63873,8 @@ func (ec *executionContext) fieldContext_SpruceConfig_providers(_ context.Contex
 				return ec.fieldContext_CloudProviderConfig_aws(ctx, field)
 			case "docker":
 				return ec.fieldContext_CloudProviderConfig_docker(ctx, field)
+			case "podman":
+				return ec.fieldContext_CloudProviderConfig_podman(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CloudProviderConfig", field.Name)
 		},
@@ -60711,6 +64348,39 @@ func (ec *executionContext) fieldContext_Subnet_subnetId(_ context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscriber_chatSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscriber_chatSubscriber,
+		func(ctx context.Context) (any, error) {
+			return obj.ChatSubscriber, nil
+		},
+		nil,
+		ec.marshalOChatSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatSubscriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscriber_chatSubscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "url":
+				return ec.fieldContext_ChatSubscriber_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatSubscriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscriber_emailSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -60909,6 +64579,39 @@ func (ec *executionContext) fieldContext_Subscriber_slackSubscriber(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscriber_teamsSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscriber_teamsSubscriber,
+		func(ctx context.Context) (any, error) {
+			return obj.TeamsSubscriber, nil
+		},
+		nil,
+		ec.marshalOTeamsSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscriber_teamsSubscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "url":
+				return ec.fieldContext_TeamsSubscriber_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TeamsSubscriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscriber_webhookSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -60976,6 +64679,8 @@ func (ec *executionContext) fieldContext_SubscriberWrapper_subscriber(_ context.
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "chatSubscriber":
+				return ec.fieldContext_Subscriber_chatSubscriber(ctx, field)
 			case "emailSubscriber":
 				return ec.fieldContext_Subscriber_emailSubscriber(ctx, field)
 			case "githubCheckSubscriber":
@@ -60988,6 +64693,8 @@ func (ec *executionContext) fieldContext_SubscriberWrapper_subscriber(_ context.
 				return ec.fieldContext_Subscriber_jiraIssueSubscriber(ctx, field)
 			case "slackSubscriber":
 				return ec.fieldContext_Subscriber_slackSubscriber(ctx, field)
+			case "teamsSubscriber":
+				return ec.fieldContext_Subscriber_teamsSubscriber(ctx, field)
 			case "webhookSubscriber":
 				return ec.fieldContext_Subscriber_webhookSubscriber(ctx, field)
 			}
@@ -61085,6 +64792,8 @@ func (ec *executionContext) fieldContext_Task_abortInfo(_ context.Context, field
 				return ec.fieldContext_AbortInfo_newVersion(ctx, field)
 			case "prClosed":
 				return ec.fieldContext_AbortInfo_prClosed(ctx, field)
+			case "reason":
+				return ec.fieldContext_AbortInfo_reason(ctx, field)
 			case "taskDisplayName":
 				return ec.fieldContext_AbortInfo_taskDisplayName(ctx, field)
 			case "taskID":
@@ -61489,6 +65198,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -62289,6 +66000,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -62690,6 +66403,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(_ context.Conte
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63112,6 +66827,8 @@ func (ec *executionContext) fieldContext_Task_generator(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63596,6 +67313,8 @@ func (ec *executionContext) fieldContext_Task_nextTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63809,6 +67528,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskCompleted(_ context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64022,6 +67743,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskFailing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64235,6 +67958,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskPassing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64642,6 +68367,8 @@ func (ec *executionContext) fieldContext_Task_prevTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64855,6 +68582,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskCompleted(_ context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65068,6 +68797,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskFailing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65281,6 +69012,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskPassing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65383,6 +69116,8 @@ func (ec *executionContext) fieldContext_Task_project(_ context.Context, field g
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -65393,6 +69128,8 @@ func (ec *executionContext) fieldContext_Task_project(_ context.Context, field g
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -65429,6 +69166,8 @@ func (ec *executionContext) fieldContext_Task_project(_ context.Context, field g
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -65463,6 +69202,8 @@ func (ec *executionContext) fieldContext_Task_project(_ context.Context, field g
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -65608,6 +69349,51 @@ func (ec *executionContext) fieldContext_Task_resetWhenFinished(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_resourceUsage(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Task_resourceUsage,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Task().ResourceUsage(ctx, obj)
+		},
+		nil,
+		ec.marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Task_resourceUsage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "distroId":
+				return ec.fieldContext_TaskResourceUsage_distroId(ctx, field)
+			case "instanceType":
+				return ec.fieldContext_TaskResourceUsage_instanceType(ctx, field)
+			case "numCpus":
+				return ec.fieldContext_TaskResourceUsage_numCpus(ctx, field)
+			case "peakMemoryPercent":
+				return ec.fieldContext_TaskResourceUsage_peakMemoryPercent(ctx, field)
+			case "points":
+				return ec.fieldContext_TaskResourceUsage_points(ctx, field)
+			case "sustainedCpuPercent":
+				return ec.fieldContext_TaskResourceUsage_sustainedCpuPercent(ctx, field)
+			case "totalMemoryBytes":
+				return ec.fieldContext_TaskResourceUsage_totalMemoryBytes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskResourceUsage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_revision(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -66296,6 +70082,8 @@ func (ec *executionContext) fieldContext_Task_versionMetadata(_ context.Context,
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -66314,6 +70102,8 @@ func (ec *executionContext) fieldContext_Task_versionMetadata(_ context.Context,
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -67614,6 +71404,8 @@ func (ec *executionContext) fieldContext_TaskHistory_tasks(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -67862,6 +71654,8 @@ func (ec *executionContext) fieldContext_TaskHistoryByCreateTime_tasks(_ context
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -69514,6 +73308,227 @@ func (ec *executionContext) fieldContext_TaskQueueItem_version(_ context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskResourceUsage_distroId(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_distroId,
+		func(ctx context.Context) (any, error) {
+			return obj.DistroID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_distroId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_instanceType(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_instanceType,
+		func(ctx context.Context) (any, error) {
+			return obj.InstanceType, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_instanceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_numCpus(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_numCpus,
+		func(ctx context.Context) (any, error) {
+			return obj.NumCPUs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_numCpus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_peakMemoryPercent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_peakMemoryPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.PeakMemoryPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_peakMemoryPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_points(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNResourceUsagePoint2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsagePointᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cpuPercent":
+				return ec.fieldContext_ResourceUsagePoint_cpuPercent(ctx, field)
+			case "diskReadBytesPerSec":
+				return ec.fieldContext_ResourceUsagePoint_diskReadBytesPerSec(ctx, field)
+			case "diskWriteBytesPerSec":
+				return ec.fieldContext_ResourceUsagePoint_diskWriteBytesPerSec(ctx, field)
+			case "durationSecs":
+				return ec.fieldContext_ResourceUsagePoint_durationSecs(ctx, field)
+			case "memoryPercent":
+				return ec.fieldContext_ResourceUsagePoint_memoryPercent(ctx, field)
+			case "netRecvBytesPerSec":
+				return ec.fieldContext_ResourceUsagePoint_netRecvBytesPerSec(ctx, field)
+			case "netSentBytesPerSec":
+				return ec.fieldContext_ResourceUsagePoint_netSentBytesPerSec(ctx, field)
+			case "time":
+				return ec.fieldContext_ResourceUsagePoint_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsagePoint", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_sustainedCpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_sustainedCpuPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.SustainedCPUPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_sustainedCpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_totalMemoryBytes(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskResourceUsage_totalMemoryBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalMemoryBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_totalMemoryBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskSpecifier_patchAlias(ctx context.Context, field graphql.CollectedField, obj *model.APITaskSpecifier) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -69894,6 +73909,35 @@ func (ec *executionContext) fieldContext_TaskTestResultSample_totalTestCount(_ c
 	return fc, nil
 }
 
+func (ec *executionContext) _TeamsSubscriber_url(ctx context.Context, field graphql.CollectedField, obj *model.APITeamsSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TeamsSubscriber_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TeamsSubscriber_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeamsSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TestLog_lineNum(ctx context.Context, field graphql.CollectedField, obj *model.TestLogs) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -72169,6 +76213,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -72300,6 +76346,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_version(_ context.Conte
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -72318,6 +76366,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_version(_ context.Conte
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -72818,6 +76868,8 @@ func (ec *executionContext) fieldContext_User_subscriptions(_ context.Context, f
 				return ec.fieldContext_GeneralSubscription_selectors(ctx, field)
 			case "subscriber":
 				return ec.fieldContext_GeneralSubscription_subscriber(ctx, field)
+			case "template":
+				return ec.fieldContext_GeneralSubscription_template(ctx, field)
 			case "trigger":
 				return ec.fieldContext_GeneralSubscription_trigger(ctx, field)
 			case "triggerData":
@@ -73644,6 +77696,35 @@ func (ec *executionContext) fieldContext_Version_activated(_ context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _Version_artifactsPinned(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Version_artifactsPinned,
+		func(ctx context.Context) (any, error) {
+			return obj.ArtifactsPinned, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Version_artifactsPinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Version",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Version_author(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -73730,6 +77811,8 @@ func (ec *executionContext) fieldContext_Version_baseVersion(_ context.Context,
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -73748,6 +77831,8 @@ func (ec *executionContext) fieldContext_Version_baseVersion(_ context.Context,
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -73974,6 +78059,8 @@ func (ec *executionContext) fieldContext_Version_childVersions(_ context.Context
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -73992,6 +78079,8 @@ func (ec *executionContext) fieldContext_Version_childVersions(_ context.Context
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -74141,6 +78230,47 @@ func (ec *executionContext) fieldContext_Version_createTime(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Version_criticalPath(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Version_criticalPath,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Version().CriticalPath(ctx, obj)
+		},
+		nil,
+		ec.marshalOCriticalPath2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPath,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Version_criticalPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Version",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "estimated":
+				return ec.fieldContext_CriticalPath_estimated(ctx, field)
+			case "finishTime":
+				return ec.fieldContext_CriticalPath_finishTime(ctx, field)
+			case "makespan":
+				return ec.fieldContext_CriticalPath_makespan(ctx, field)
+			case "startTime":
+				return ec.fieldContext_CriticalPath_startTime(ctx, field)
+			case "tasks":
+				return ec.fieldContext_CriticalPath_tasks(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CriticalPath", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Version_ingestTime(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -74717,6 +78847,8 @@ func (ec *executionContext) fieldContext_Version_previousVersion(_ context.Conte
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -74735,6 +78867,8 @@ func (ec *executionContext) fieldContext_Version_previousVersion(_ context.Conte
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -74892,6 +79026,8 @@ func (ec *executionContext) fieldContext_Version_projectMetadata(_ context.Conte
 				return ec.fieldContext_Project_id(ctx, field)
 			case "admins":
 				return ec.fieldContext_Project_admins(ctx, field)
+			case "artifactRetention":
+				return ec.fieldContext_Project_artifactRetention(ctx, field)
 			case "banner":
 				return ec.fieldContext_Project_banner(ctx, field)
 			case "batchTime":
@@ -74902,6 +79038,8 @@ func (ec *executionContext) fieldContext_Version_projectMetadata(_ context.Conte
 				return ec.fieldContext_Project_buildBaronSettings(ctx, field)
 			case "commitQueue":
 				return ec.fieldContext_Project_commitQueue(ctx, field)
+			case "costBudget":
+				return ec.fieldContext_Project_costBudget(ctx, field)
 			case "deactivatePrevious":
 				return ec.fieldContext_Project_deactivatePrevious(ctx, field)
 			case "debugSpawnHostsDisabled":
@@ -74938,6 +79076,8 @@ func (ec *executionContext) fieldContext_Version_projectMetadata(_ context.Conte
 				return ec.fieldContext_Project_identifier(ctx, field)
 			case "isFavorite":
 				return ec.fieldContext_Project_isFavorite(ctx, field)
+			case "logStorage":
+				return ec.fieldContext_Project_logStorage(ctx, field)
 			case "manualPrTestingEnabled":
 				return ec.fieldContext_Project_manualPrTestingEnabled(ctx, field)
 			case "notifyOnBuildFailure":
@@ -74972,6 +79112,8 @@ func (ec *executionContext) fieldContext_Version_projectMetadata(_ context.Conte
 				return ec.fieldContext_Project_repotrackerDisabled(ctx, field)
 			case "repotrackerError":
 				return ec.fieldContext_Project_repotrackerError(ctx, field)
+			case "resourceRecommendations":
+				return ec.fieldContext_Project_resourceRecommendations(ctx, field)
 			case "restricted":
 				return ec.fieldContext_Project_restricted(ctx, field)
 			case "runEveryMainlineCommit":
@@ -76410,6 +80552,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -77005,6 +81149,8 @@ func (ec *executionContext) fieldContext_Waterfall_flattenedVersions(_ context.C
 				return ec.fieldContext_Version_id(ctx, field)
 			case "activated":
 				return ec.fieldContext_Version_activated(ctx, field)
+			case "artifactsPinned":
+				return ec.fieldContext_Version_artifactsPinned(ctx, field)
 			case "author":
 				return ec.fieldContext_Version_author(ctx, field)
 			case "authorEmail":
@@ -77023,6 +81169,8 @@ func (ec *executionContext) fieldContext_Waterfall_flattenedVersions(_ context.C
 				return ec.fieldContext_Version_cost(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "ingestTime":
 				return ec.fieldContext_Version_ingestTime(ctx, field)
 			case "errors":
@@ -77669,6 +81817,364 @@ func (ec *executionContext) fieldContext_Webhook_secret(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _WebhookAttempt_error(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookAttempt) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookAttempt_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookAttempt_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookAttempt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookAttempt_latency(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookAttempt) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookAttempt_latency,
+		func(ctx context.Context) (any, error) {
+			return obj.Latency, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookAttempt_latency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookAttempt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookAttempt_statusCode(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookAttempt) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookAttempt_statusCode,
+		func(ctx context.Context) (any, error) {
+			return obj.StatusCode, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookAttempt_statusCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookAttempt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookAttempt_time(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookAttempt) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookAttempt_time,
+		func(ctx context.Context) (any, error) {
+			return obj.Time, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookAttempt_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookAttempt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_attemptCount(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_attemptCount,
+		func(ctx context.Context) (any, error) {
+			return obj.AttemptCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_attemptCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_attempts,
+		func(ctx context.Context) (any, error) {
+			return obj.Attempts, nil
+		},
+		nil,
+		ec.marshalNWebhookAttempt2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookAttemptᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_attempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "error":
+				return ec.fieldContext_WebhookAttempt_error(ctx, field)
+			case "latency":
+				return ec.fieldContext_WebhookAttempt_latency(ctx, field)
+			case "statusCode":
+				return ec.fieldContext_WebhookAttempt_statusCode(ctx, field)
+			case "time":
+				return ec.fieldContext_WebhookAttempt_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookAttempt", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_error(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_lastAttemptAt(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_lastAttemptAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastAttemptAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_lastAttemptAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_notificationId(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_notificationId,
+		func(ctx context.Context) (any, error) {
+			return obj.NotificationID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_notificationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_status(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_subscriptionId(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_subscriptionId,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_subscriptionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_url(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _WebhookHeader_key(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookHeader) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -80576,6 +85082,40 @@ func (ec *executionContext) unmarshalInputAmboyRetryConfigInput(ctx context.Cont
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputArtifactRetentionSettingsInput(ctx context.Context, obj any) (model.APIArtifactRetentionSettings, error) {
+	var it model.APIArtifactRetentionSettings
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"mainlineRetentionDays", "patchRetentionDays"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "mainlineRetentionDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mainlineRetentionDays"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MainlineRetentionDays = data
+		case "patchRetentionDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("patchRetentionDays"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PatchRetentionDays = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputAuthConfigInput(ctx context.Context, obj any) (model.APIAuthConfig, error) {
 	var it model.APIAuthConfig
 	asMap := map[string]any{}
@@ -81309,6 +85849,33 @@ func (ec *executionContext) unmarshalInputCedarConfigInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputChatSubscriberInput(ctx context.Context, obj any) (model.APIChatSubscriber, error) {
+	var it model.APIChatSubscriber
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCloudProviderConfigInput(ctx context.Context, obj any) (model.APICloudProviders, error) {
 	var it model.APICloudProviders
 	asMap := map[string]any{}
@@ -81316,7 +85883,7 @@ func (ec *executionContext) unmarshalInputCloudProviderConfigInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"aws", "docker"}
+	fieldsInOrder := [...]string{"aws", "docker", "podman"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -81337,6 +85904,13 @@ func (ec *executionContext) unmarshalInputCloudProviderConfigInput(ctx context.C
 				return it, err
 			}
 			it.Docker = data
+		case "podman":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("podman"))
+			data, err := ec.unmarshalOPodmanConfigInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPodmanConfig(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Podman = data
 		}
 	}
 
@@ -81536,6 +86110,47 @@ func (ec *executionContext) unmarshalInputCopyProjectInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCostBudgetSettingsInput(ctx context.Context, obj any) (model.APICostBudgetSettings, error) {
+	var it model.APICostBudgetSettings
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"alertThresholds", "monthlyBudget", "versionBudget"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "alertThresholds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("alertThresholds"))
+			data, err := ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AlertThresholds = data
+		case "monthlyBudget":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("monthlyBudget"))
+			data, err := ec.unmarshalOFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MonthlyBudget = data
+		case "versionBudget":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("versionBudget"))
+			data, err := ec.unmarshalOFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VersionBudget = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCostConfigInput(ctx context.Context, obj any) (model.APICostConfig, error) {
 	var it model.APICostConfig
 	asMap := map[string]any{}
@@ -82169,7 +86784,7 @@ func (ec *executionContext) unmarshalInputDistroInput(ctx context.Context, obj a
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"adminOnly", "aliases", "arch", "authorizedKeysFile", "bootstrapSettings", "containerPool", "disabled", "disableShallowClone", "dispatcherSettings", "execUser", "expansions", "finderSettings", "homeVolumeSettings", "hostAllocatorSettings", "iceCreamSettings", "costData", "imageId", "isCluster", "isVirtualWorkStation", "mountpoints", "name", "note", "plannerSettings", "provider", "providerAccount", "providerSettingsList", "setup", "setupAsSudo", "singleTaskDistro", "sshOptions", "taskHostOverrides", "user", "userSpawnAllowed", "validProjects", "warningNote", "workDir"}
+	fieldsInOrder := [...]string{"adminOnly", "aliases", "arch", "authorizedKeysFile", "bootstrapSettings", "containerPool", "disabled", "disableShallowClone", "dispatcherSettings", "execUser", "expansions", "finderSettings", "homeVolumeSettings", "hostAllocatorSettings", "iceCreamSettings", "costData", "imageId", "isCluster", "isVirtualWorkStation", "mountpoints", "name", "note", "plannerSettings", "preemptionSettings", "provider", "providerAccount", "providerSettingsList", "setup", "setupAsSudo", "singleTaskDistro", "sshOptions", "taskHostOverrides", "user", "userSpawnAllowed", "validProjects", "warningNote", "workDir"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -82359,6 +86974,13 @@ func (ec *executionContext) unmarshalInputDistroInput(ctx context.Context, obj a
 				return it, err
 			}
 			it.PlannerSettings = data
+		case "preemptionSettings":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preemptionSettings"))
+			data, err := ec.unmarshalOPreemptionSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPreemptionSettings(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PreemptionSettings = data
 		case "provider":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("provider"))
 			data, err := ec.unmarshalNProvider2ᚖstring(ctx, v)
@@ -84035,6 +88657,47 @@ func (ec *executionContext) unmarshalInputLogBufferingInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLogStorageSettingsInput(ctx context.Context, obj any) (model.APILogStorageSettings, error) {
+	var it model.APILogStorageSettings
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"compactLogs", "mainlineRetentionDays", "patchRetentionDays"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "compactLogs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("compactLogs"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompactLogs = data
+		case "mainlineRetentionDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mainlineRetentionDays"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MainlineRetentionDays = data
+		case "patchRetentionDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("patchRetentionDays"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PatchRetentionDays = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoggerConfigInput(ctx context.Context, obj any) (model.APILoggerConfig, error) {
 	var it model.APILoggerConfig
 	asMap := map[string]any{}
@@ -85388,7 +90051,7 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "targetTime", "version"}
+	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "projectShares", "targetTime", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -85451,6 +90114,13 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 				return it, err
 			}
 			it.PatchTimeInQueueFactor = data
+		case "projectShares":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectShares"))
+			data, err := ec.unmarshalOProjectShareInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProjectShares = data
 		case "targetTime":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetTime"))
 			data, err := ec.unmarshalNInt2int(ctx, v)
@@ -85473,6 +90143,33 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputPodmanConfigInput(ctx context.Context, obj any) (model.APIPodmanConfig, error) {
+	var it model.APIPodmanConfig
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"apiVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "apiVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("apiVersion"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.APIVersion = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputPreconditionScriptInput(ctx context.Context, obj any) (model.APIPreconditionScript, error) {
 	var it model.APIPreconditionScript
 	asMap := map[string]any{}
@@ -85507,6 +90204,49 @@ func (ec *executionContext) unmarshalInputPreconditionScriptInput(ctx context.Co
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputPreemptionSettingsInput(ctx context.Context, obj any) (model.APIPreemptionSettings, error) {
+	var it model.APIPreemptionSettings
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"enabled", "priorityThreshold", "waitLimit"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "enabled":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Enabled = data
+		case "priorityThreshold":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priorityThreshold"))
+			data, err := ec.unmarshalNInt2int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PriorityThreshold = data
+		case "waitLimit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("waitLimit"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.PreemptionSettingsInput().WaitLimit(ctx, &it, data); err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputProjectAliasInput(ctx context.Context, obj any) (model.APIProjectAlias, error) {
 	var it model.APIProjectAlias
 	asMap := map[string]any{}
@@ -85679,7 +90419,7 @@ func (ec *executionContext) unmarshalInputProjectInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "admins", "banner", "batchTime", "branch", "buildBaronSettings", "commitQueue", "deactivatePrevious", "debugSpawnHostsDisabled", "disabledStatsCache", "dispatchingDisabled", "displayName", "enabled", "externalLinks", "githubChecksEnabled", "githubDynamicTokenPermissionGroups", "githubPermissionGroupByRequester", "githubPRTriggerAliases", "githubMQTriggerAliases", "gitTagAuthorizedTeams", "gitTagAuthorizedUsers", "gitTagVersionsEnabled", "identifier", "manualPrTestingEnabled", "notifyOnBuildFailure", "oldestAllowedMergeBase", "owner", "parsleyFilters", "patchingDisabled", "patchTriggerAliases", "perfEnabled", "periodicBuilds", "projectHealthView", "prTestingEnabled", "remotePath", "repo", "repotrackerDisabled", "restricted", "runEveryMainlineCommit", "spawnHostScriptPath", "stepbackDisabled", "stepbackBisect", "taskAnnotationSettings", "testSelection", "tracksPushEvents", "triggers", "versionControlEnabled", "workstationConfig"}
+	fieldsInOrder := [...]string{"id", "admins", "artifactRetention", "banner", "batchTime", "branch", "buildBaronSettings", "commitQueue", "costBudget", "deactivatePrevious", "debugSpawnHostsDisabled", "disabledStatsCache", "dispatchingDisabled", "displayName", "enabled", "externalLinks", "githubChecksEnabled", "githubDynamicTokenPermissionGroups", "githubPermissionGroupByRequester", "githubPRTriggerAliases", "githubMQTriggerAliases", "gitTagAuthorizedTeams", "gitTagAuthorizedUsers", "gitTagVersionsEnabled", "identifier", "logStorage", "manualPrTestingEnabled", "notifyOnBuildFailure", "oldestAllowedMergeBase", "owner", "parsleyFilters", "patchingDisabled", "patchTriggerAliases", "perfEnabled", "periodicBuilds", "projectHealthView", "prTestingEnabled", "remotePath", "repo", "repotrackerDisabled", "restricted", "runEveryMainlineCommit", "spawnHostScriptPath", "stepbackDisabled", "stepbackBisect", "taskAnnotationSettings", "testSelection", "tracksPushEvents", "triggers", "versionControlEnabled", "workstationConfig"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -85700,6 +90440,13 @@ func (ec *executionContext) unmarshalInputProjectInput(ctx context.Context, obj
 				return it, err
 			}
 			it.Admins = data
+		case "artifactRetention":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("artifactRetention"))
+			data, err := ec.unmarshalOArtifactRetentionSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIArtifactRetentionSettings(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ArtifactRetention = data
 		case "banner":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("banner"))
 			data, err := ec.unmarshalOProjectBannerInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectBanner(ctx, v)
@@ -85735,6 +90482,13 @@ func (ec *executionContext) unmarshalInputProjectInput(ctx context.Context, obj
 				return it, err
 			}
 			it.CommitQueue = data
+		case "costBudget":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("costBudget"))
+			data, err := ec.unmarshalOCostBudgetSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBudgetSettings(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CostBudget = data
 		case "deactivatePrevious":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deactivatePrevious"))
 			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
@@ -85847,6 +90601,13 @@ func (ec *executionContext) unmarshalInputProjectInput(ctx context.Context, obj
 				return it, err
 			}
 			it.Identifier = data
+		case "logStorage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("logStorage"))
+			data, err := ec.unmarshalOLogStorageSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogStorageSettings(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogStorage = data
 		case "manualPrTestingEnabled":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("manualPrTestingEnabled"))
 			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
@@ -86185,6 +90946,40 @@ func (ec *executionContext) unmarshalInputProjectSettingsInput(ctx context.Conte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputProjectShareInput(ctx context.Context, obj any) (model.APIProjectShare, error) {
+	var it model.APIProjectShare
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "share"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "share":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("share"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Share = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputProjectTasksPairInput(ctx context.Context, obj any) (model.APIProjectTasksPair, error) {
 	var it model.APIProjectTasksPair
 	asMap := map[string]any{}
@@ -86367,6 +91162,72 @@ func (ec *executionContext) unmarshalInputPublicKeyInput(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputQuarantineProjectTestInput(ctx context.Context, obj any) (QuarantineProjectTestInput, error) {
+	var it QuarantineProjectTestInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"projectIdentifier", "testName", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "projectIdentifier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+			directive0 := func(ctx context.Context) (any, error) { return ec.unmarshalNString2string(ctx, v) }
+
+			directive1 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
+				if err != nil {
+					var zeroVal string
+					return zeroVal, err
+				}
+				access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "EDIT")
+				if err != nil {
+					var zeroVal string
+					return zeroVal, err
+				}
+				if ec.directives.RequireProjectAccess == nil {
+					var zeroVal string
+					return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+				}
+				return ec.directives.RequireProjectAccess(ctx, obj, directive0, permission, access)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.ProjectIdentifier = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "testName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("testName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TestName = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputQuarantineTestInput(ctx context.Context, obj any) (QuarantineTestInput, error) {
 	var it QuarantineTestInput
 	asMap := map[string]any{}
@@ -88561,7 +93422,7 @@ func (ec *executionContext) unmarshalInputSubscriberInput(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"target", "type", "webhookSubscriber", "jiraIssueSubscriber"}
+	fieldsInOrder := [...]string{"target", "type", "webhookSubscriber", "jiraIssueSubscriber", "teamsSubscriber", "chatSubscriber"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -88598,6 +93459,20 @@ func (ec *executionContext) unmarshalInputSubscriberInput(ctx context.Context, o
 				return it, err
 			}
 			it.JiraIssueSubscriber = data
+		case "teamsSubscriber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamsSubscriber"))
+			data, err := ec.unmarshalOTeamsSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TeamsSubscriber = data
+		case "chatSubscriber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chatSubscriber"))
+			data, err := ec.unmarshalOChatSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatSubscriber(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ChatSubscriber = data
 		}
 	}
 
@@ -88611,7 +93486,7 @@ func (ec *executionContext) unmarshalInputSubscriptionInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "owner_type", "owner", "regex_selectors", "resource_type", "selectors", "subscriber", "trigger_data", "trigger"}
+	fieldsInOrder := [...]string{"id", "owner_type", "owner", "regex_selectors", "resource_type", "selectors", "subscriber", "template", "trigger_data", "trigger"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -88667,6 +93542,13 @@ func (ec *executionContext) unmarshalInputSubscriptionInput(ctx context.Context,
 				return it, err
 			}
 			it.Subscriber = data
+		case "template":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("template"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Template = data
 		case "trigger_data":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trigger_data"))
 			data, err := ec.unmarshalNStringMap2map(ctx, v)
@@ -89183,6 +94065,33 @@ func (ec *executionContext) unmarshalInputTaskSpecifierInput(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputTeamsSubscriberInput(ctx context.Context, obj any) (model.APITeamsSubscriber, error) {
+	var it model.APITeamsSubscriber
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputTestFilter(ctx context.Context, obj any) (TestFilter, error) {
 	var it TestFilter
 	asMap := map[string]any{}
@@ -89756,6 +94665,65 @@ func (ec *executionContext) unmarshalInputUIConfigInput(ctx context.Context, obj
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputUnquarantineProjectTestInput(ctx context.Context, obj any) (UnquarantineProjectTestInput, error) {
+	var it UnquarantineProjectTestInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"projectIdentifier", "testName"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "projectIdentifier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectIdentifier"))
+			directive0 := func(ctx context.Context) (any, error) { return ec.unmarshalNString2string(ctx, v) }
+
+			directive1 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNProjectPermission2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectPermission(ctx, "SETTINGS")
+				if err != nil {
+					var zeroVal string
+					return zeroVal, err
+				}
+				access, err := ec.unmarshalNAccessLevel2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐAccessLevel(ctx, "EDIT")
+				if err != nil {
+					var zeroVal string
+					return zeroVal, err
+				}
+				if ec.directives.RequireProjectAccess == nil {
+					var zeroVal string
+					return zeroVal, errors.New("directive requireProjectAccess is not implemented")
+				}
+				return ec.directives.RequireProjectAccess(ctx, obj, directive0, permission, access)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.ProjectIdentifier = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "testName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("testName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TestName = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpdateBetaFeaturesInput(ctx context.Context, obj any) (UpdateBetaFeaturesInput, error) {
 	var it UpdateBetaFeaturesInput
 	asMap := map[string]any{}
@@ -90765,6 +95733,11 @@ func (ec *executionContext) _AbortInfo(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "reason":
+			out.Values[i] = ec._AbortInfo_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "taskDisplayName":
 			out.Values[i] = ec._AbortInfo_taskDisplayName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -91431,6 +96404,44 @@ func (ec *executionContext) _Annotation(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var artifactRetentionSettingsImplementors = []string{"ArtifactRetentionSettings"}
+
+func (ec *executionContext) _ArtifactRetentionSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APIArtifactRetentionSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, artifactRetentionSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ArtifactRetentionSettings")
+		case "mainlineRetentionDays":
+			out.Values[i] = ec._ArtifactRetentionSettings_mainlineRetentionDays(ctx, field, obj)
+		case "patchRetentionDays":
+			out.Values[i] = ec._ArtifactRetentionSettings_patchRetentionDays(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var associatedLinkImplementors = []string{"AssociatedLink"}
 
 func (ec *executionContext) _AssociatedLink(ctx context.Context, sel ast.SelectionSet, obj *model.APIAssociatedLink) graphql.Marshaler {
@@ -91847,126 +96858,170 @@ func (ec *executionContext) _Build(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var buildBaronImplementors = []string{"BuildBaron"}
-
-func (ec *executionContext) _BuildBaron(ctx context.Context, sel ast.SelectionSet, obj *BuildBaron) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, buildBaronImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("BuildBaron")
-		case "bbTicketCreationDefined":
-			out.Values[i] = ec._BuildBaron_bbTicketCreationDefined(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "buildBaronConfigured":
-			out.Values[i] = ec._BuildBaron_buildBaronConfigured(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "searchReturnInfo":
-			out.Values[i] = ec._BuildBaron_searchReturnInfo(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var buildBaronSettingsImplementors = []string{"BuildBaronSettings"}
-
-func (ec *executionContext) _BuildBaronSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APIBuildBaronSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, buildBaronSettingsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("BuildBaronSettings")
-		case "bfSuggestionFeaturesURL":
-			out.Values[i] = ec._BuildBaronSettings_bfSuggestionFeaturesURL(ctx, field, obj)
-		case "bfSuggestionPassword":
-			out.Values[i] = ec._BuildBaronSettings_bfSuggestionPassword(ctx, field, obj)
-		case "bfSuggestionServer":
-			out.Values[i] = ec._BuildBaronSettings_bfSuggestionServer(ctx, field, obj)
-		case "bfSuggestionTimeoutSecs":
-			out.Values[i] = ec._BuildBaronSettings_bfSuggestionTimeoutSecs(ctx, field, obj)
-		case "bfSuggestionUsername":
-			out.Values[i] = ec._BuildBaronSettings_bfSuggestionUsername(ctx, field, obj)
-		case "ticketCreateProject":
-			out.Values[i] = ec._BuildBaronSettings_ticketCreateProject(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "ticketSearchProjects":
-			out.Values[i] = ec._BuildBaronSettings_ticketSearchProjects(ctx, field, obj)
-		case "ticketCreateIssueType":
-			out.Values[i] = ec._BuildBaronSettings_ticketCreateIssueType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var buildVariantTupleImplementors = []string{"BuildVariantTuple"}
+var buildBaronImplementors = []string{"BuildBaron"}
+
+func (ec *executionContext) _BuildBaron(ctx context.Context, sel ast.SelectionSet, obj *BuildBaron) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, buildBaronImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BuildBaron")
+		case "bbTicketCreationDefined":
+			out.Values[i] = ec._BuildBaron_bbTicketCreationDefined(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "buildBaronConfigured":
+			out.Values[i] = ec._BuildBaron_buildBaronConfigured(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "searchReturnInfo":
+			out.Values[i] = ec._BuildBaron_searchReturnInfo(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var buildBaronSettingsImplementors = []string{"BuildBaronSettings"}
+
+func (ec *executionContext) _BuildBaronSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APIBuildBaronSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, buildBaronSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BuildBaronSettings")
+		case "bfSuggestionFeaturesURL":
+			out.Values[i] = ec._BuildBaronSettings_bfSuggestionFeaturesURL(ctx, field, obj)
+		case "bfSuggestionPassword":
+			out.Values[i] = ec._BuildBaronSettings_bfSuggestionPassword(ctx, field, obj)
+		case "bfSuggestionServer":
+			out.Values[i] = ec._BuildBaronSettings_bfSuggestionServer(ctx, field, obj)
+		case "bfSuggestionTimeoutSecs":
+			out.Values[i] = ec._BuildBaronSettings_bfSuggestionTimeoutSecs(ctx, field, obj)
+		case "bfSuggestionUsername":
+			out.Values[i] = ec._BuildBaronSettings_bfSuggestionUsername(ctx, field, obj)
+		case "ticketCreateProject":
+			out.Values[i] = ec._BuildBaronSettings_ticketCreateProject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ticketSearchProjects":
+			out.Values[i] = ec._BuildBaronSettings_ticketSearchProjects(ctx, field, obj)
+		case "ticketCreateIssueType":
+			out.Values[i] = ec._BuildBaronSettings_ticketCreateIssueType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var buildVariantTupleImplementors = []string{"BuildVariantTuple"}
+
+func (ec *executionContext) _BuildVariantTuple(ctx context.Context, sel ast.SelectionSet, obj *task.BuildVariantTuple) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, buildVariantTupleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BuildVariantTuple")
+		case "buildVariant":
+			out.Values[i] = ec._BuildVariantTuple_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._BuildVariantTuple_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cedarConfigImplementors = []string{"CedarConfig"}
 
-func (ec *executionContext) _BuildVariantTuple(ctx context.Context, sel ast.SelectionSet, obj *task.BuildVariantTuple) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, buildVariantTupleImplementors)
+func (ec *executionContext) _CedarConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APICedarConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cedarConfigImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("BuildVariantTuple")
-		case "buildVariant":
-			out.Values[i] = ec._BuildVariantTuple_buildVariant(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CedarConfig")
+		case "dbUrl":
+			out.Values[i] = ec._CedarConfig_dbUrl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "displayName":
-			out.Values[i] = ec._BuildVariantTuple_displayName(ctx, field, obj)
+		case "dbName":
+			out.Values[i] = ec._CedarConfig_dbName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -91993,24 +97048,19 @@ func (ec *executionContext) _BuildVariantTuple(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var cedarConfigImplementors = []string{"CedarConfig"}
+var chatSubscriberImplementors = []string{"ChatSubscriber"}
 
-func (ec *executionContext) _CedarConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APICedarConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cedarConfigImplementors)
+func (ec *executionContext) _ChatSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APIChatSubscriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatSubscriberImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CedarConfig")
-		case "dbUrl":
-			out.Values[i] = ec._CedarConfig_dbUrl(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "dbName":
-			out.Values[i] = ec._CedarConfig_dbName(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ChatSubscriber")
+		case "url":
+			out.Values[i] = ec._ChatSubscriber_url(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -92176,6 +97226,8 @@ func (ec *executionContext) _CloudProviderConfig(ctx context.Context, sel ast.Se
 			out.Values[i] = ec._CloudProviderConfig_aws(ctx, field, obj)
 		case "docker":
 			out.Values[i] = ec._CloudProviderConfig_docker(ctx, field, obj)
+		case "podman":
+			out.Values[i] = ec._CloudProviderConfig_podman(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -92452,6 +97504,46 @@ func (ec *executionContext) _Cost(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
+var costBudgetSettingsImplementors = []string{"CostBudgetSettings"}
+
+func (ec *executionContext) _CostBudgetSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APICostBudgetSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costBudgetSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostBudgetSettings")
+		case "alertThresholds":
+			out.Values[i] = ec._CostBudgetSettings_alertThresholds(ctx, field, obj)
+		case "monthlyBudget":
+			out.Values[i] = ec._CostBudgetSettings_monthlyBudget(ctx, field, obj)
+		case "versionBudget":
+			out.Values[i] = ec._CostBudgetSettings_versionBudget(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var costConfigImplementors = []string{"CostConfig"}
 
 func (ec *executionContext) _CostConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APICostConfig) graphql.Marshaler {
@@ -92534,6 +97626,133 @@ func (ec *executionContext) _CostData(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var criticalPathImplementors = []string{"CriticalPath"}
+
+func (ec *executionContext) _CriticalPath(ctx context.Context, sel ast.SelectionSet, obj *model.APICriticalPath) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, criticalPathImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CriticalPath")
+		case "estimated":
+			out.Values[i] = ec._CriticalPath_estimated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finishTime":
+			out.Values[i] = ec._CriticalPath_finishTime(ctx, field, obj)
+		case "makespan":
+			out.Values[i] = ec._CriticalPath_makespan(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startTime":
+			out.Values[i] = ec._CriticalPath_startTime(ctx, field, obj)
+		case "tasks":
+			out.Values[i] = ec._CriticalPath_tasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var criticalPathTaskImplementors = []string{"CriticalPathTask"}
+
+func (ec *executionContext) _CriticalPathTask(ctx context.Context, sel ast.SelectionSet, obj *model.APICriticalPathTask) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, criticalPathTaskImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CriticalPathTask")
+		case "buildVariant":
+			out.Values[i] = ec._CriticalPathTask_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contribution":
+			out.Values[i] = ec._CriticalPathTask_contribution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._CriticalPathTask_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "estimated":
+			out.Values[i] = ec._CriticalPathTask_estimated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "executionTime":
+			out.Values[i] = ec._CriticalPathTask_executionTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queueWait":
+			out.Values[i] = ec._CriticalPathTask_queueWait(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._CriticalPathTask_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._CriticalPathTask_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var cursorSettingsImplementors = []string{"CursorSettings"}
 
 func (ec *executionContext) _CursorSettings(ctx context.Context, sel ast.SelectionSet, obj *CursorSettings) graphql.Marshaler {
@@ -93021,6 +98240,47 @@ func (ec *executionContext) _Distro(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "preemptionSettings":
+			out.Values[i] = ec._Distro_preemptionSettings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "projectShareUsage":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Distro_projectShareUsage(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "provider":
 			out.Values[i] = ec._Distro_provider(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -93910,6 +99170,8 @@ func (ec *executionContext) _GeneralSubscription(ctx context.Context, sel ast.Se
 			}
 		case "subscriber":
 			out.Values[i] = ec._GeneralSubscription_subscriber(ctx, field, obj)
+		case "template":
+			out.Values[i] = ec._GeneralSubscription_template(ctx, field, obj)
 		case "trigger":
 			out.Values[i] = ec._GeneralSubscription_trigger(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -96912,6 +102174,46 @@ func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var logStorageSettingsImplementors = []string{"LogStorageSettings"}
+
+func (ec *executionContext) _LogStorageSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APILogStorageSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logStorageSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogStorageSettings")
+		case "compactLogs":
+			out.Values[i] = ec._LogStorageSettings_compactLogs(ctx, field, obj)
+		case "mainlineRetentionDays":
+			out.Values[i] = ec._LogStorageSettings_mainlineRetentionDays(ctx, field, obj)
+		case "patchRetentionDays":
+			out.Values[i] = ec._LogStorageSettings_patchRetentionDays(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var loggerConfigImplementors = []string{"LoggerConfig"}
 
 func (ec *executionContext) _LoggerConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APILoggerConfig) graphql.Marshaler {
@@ -97632,6 +102934,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "quarantineProjectTest":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_quarantineProjectTest(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "saveProjectSettingsForSection":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_saveProjectSettingsForSection(ctx, field)
@@ -97653,6 +102962,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "unquarantineProjectTest":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_unquarantineProjectTest(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "attachVolumeToHost":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_attachVolumeToHost(ctx, field)
@@ -97821,6 +103137,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "replayWebhookDelivery":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_replayWebhookDelivery(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "resetAPIKey":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_resetAPIKey(ctx, field)
@@ -100043,6 +105366,11 @@ func (ec *executionContext) _PlannerSettings(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "projectShares":
+			out.Values[i] = ec._PlannerSettings_projectShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "targetTime":
 			out.Values[i] = ec._PlannerSettings_targetTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -100076,6 +105404,42 @@ func (ec *executionContext) _PlannerSettings(ctx context.Context, sel ast.Select
 	return out
 }
 
+var podmanConfigImplementors = []string{"PodmanConfig"}
+
+func (ec *executionContext) _PodmanConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIPodmanConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, podmanConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PodmanConfig")
+		case "apiVersion":
+			out.Values[i] = ec._PodmanConfig_apiVersion(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var preconditionScriptImplementors = []string{"PreconditionScript"}
 
 func (ec *executionContext) _PreconditionScript(ctx context.Context, sel ast.SelectionSet, obj *model.APIPreconditionScript) graphql.Marshaler {
@@ -100120,6 +105484,55 @@ func (ec *executionContext) _PreconditionScript(ctx context.Context, sel ast.Sel
 	return out
 }
 
+var preemptionSettingsImplementors = []string{"PreemptionSettings"}
+
+func (ec *executionContext) _PreemptionSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APIPreemptionSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, preemptionSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PreemptionSettings")
+		case "enabled":
+			out.Values[i] = ec._PreemptionSettings_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "priorityThreshold":
+			out.Values[i] = ec._PreemptionSettings_priorityThreshold(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "waitLimit":
+			out.Values[i] = ec._PreemptionSettings_waitLimit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var projectImplementors = []string{"Project"}
 
 func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectRef) graphql.Marshaler {
@@ -100138,6 +105551,8 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			}
 		case "admins":
 			out.Values[i] = ec._Project_admins(ctx, field, obj)
+		case "artifactRetention":
+			out.Values[i] = ec._Project_artifactRetention(ctx, field, obj)
 		case "banner":
 			out.Values[i] = ec._Project_banner(ctx, field, obj)
 		case "batchTime":
@@ -100160,6 +105575,8 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "costBudget":
+			out.Values[i] = ec._Project_costBudget(ctx, field, obj)
 		case "deactivatePrevious":
 			out.Values[i] = ec._Project_deactivatePrevious(ctx, field, obj)
 		case "debugSpawnHostsDisabled":
@@ -100239,6 +105656,8 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "logStorage":
+			out.Values[i] = ec._Project_logStorage(ctx, field, obj)
 		case "manualPrTestingEnabled":
 			out.Values[i] = ec._Project_manualPrTestingEnabled(ctx, field, obj)
 		case "notifyOnBuildFailure":
@@ -100325,6 +105744,42 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Project_repotrackerDisabled(ctx, field, obj)
 		case "repotrackerError":
 			out.Values[i] = ec._Project_repotrackerError(ctx, field, obj)
+		case "resourceRecommendations":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Project_resourceRecommendations(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "restricted":
 			out.Values[i] = ec._Project_restricted(ctx, field, obj)
 		case "runEveryMainlineCommit":
@@ -101170,6 +106625,116 @@ func (ec *executionContext) _ProjectSettings(ctx context.Context, sel ast.Select
 	return out
 }
 
+var projectShareImplementors = []string{"ProjectShare"}
+
+func (ec *executionContext) _ProjectShare(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectShare) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, projectShareImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProjectShare")
+		case "id":
+			out.Values[i] = ec._ProjectShare_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "share":
+			out.Values[i] = ec._ProjectShare_share(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var projectShareUsageImplementors = []string{"ProjectShareUsage"}
+
+func (ec *executionContext) _ProjectShareUsage(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectShareUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, projectShareUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProjectShareUsage")
+		case "factor":
+			out.Values[i] = ec._ProjectShareUsage_factor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hostTime":
+			out.Values[i] = ec._ProjectShareUsage_hostTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "projectId":
+			out.Values[i] = ec._ProjectShareUsage_projectId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repoId":
+			out.Values[i] = ec._ProjectShareUsage_repoId(ctx, field, obj)
+		case "share":
+			out.Values[i] = ec._ProjectShareUsage_share(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetFraction":
+			out.Values[i] = ec._ProjectShareUsage_targetFraction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "usageFraction":
+			out.Values[i] = ec._ProjectShareUsage_usageFraction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var projectTasksPairImplementors = []string{"ProjectTasksPair"}
 
 func (ec *executionContext) _ProjectTasksPair(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectTasksPair) graphql.Marshaler {
@@ -101412,6 +106977,66 @@ func (ec *executionContext) _QuarantineTestPayload(ctx context.Context, sel ast.
 	return out
 }
 
+var quarantinedTestImplementors = []string{"QuarantinedTest"}
+
+func (ec *executionContext) _QuarantinedTest(ctx context.Context, sel ast.SelectionSet, obj *model.APIQuarantinedTest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, quarantinedTestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("QuarantinedTest")
+		case "projectId":
+			out.Values[i] = ec._QuarantinedTest_projectId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testName":
+			out.Values[i] = ec._QuarantinedTest_testName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._QuarantinedTest_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._QuarantinedTest_reason(ctx, field, obj)
+		case "flipRate":
+			out.Values[i] = ec._QuarantinedTest_flipRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdBy":
+			out.Values[i] = ec._QuarantinedTest_createdBy(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._QuarantinedTest_createdAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -101940,6 +107565,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "quarantinedTests":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_quarantinedTests(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "repoEvents":
 			field := field
@@ -102236,6 +107883,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "webhookDeliveries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhookDeliveries(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "buildVariantsForTaskName":
 			field := field
@@ -103078,68 +108747,216 @@ func (ec *executionContext) _RepoSettings(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var repoTestSelectionSettingsImplementors = []string{"RepoTestSelectionSettings"}
+var repoTestSelectionSettingsImplementors = []string{"RepoTestSelectionSettings"}
+
+func (ec *executionContext) _RepoTestSelectionSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APITestSelectionSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repoTestSelectionSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepoTestSelectionSettings")
+		case "allowed":
+			out.Values[i] = ec._RepoTestSelectionSettings_allowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defaultEnabled":
+			out.Values[i] = ec._RepoTestSelectionSettings_defaultEnabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var repoWorkstationConfigImplementors = []string{"RepoWorkstationConfig"}
+
+func (ec *executionContext) _RepoWorkstationConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIWorkstationConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repoWorkstationConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepoWorkstationConfig")
+		case "gitClone":
+			out.Values[i] = ec._RepoWorkstationConfig_gitClone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setupCommands":
+			out.Values[i] = ec._RepoWorkstationConfig_setupCommands(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var repotrackerConfigImplementors = []string{"RepotrackerConfig"}
+
+func (ec *executionContext) _RepotrackerConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepoTrackerConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepotrackerConfig")
+		case "numNewRepoRevisionsToFetch":
+			out.Values[i] = ec._RepotrackerConfig_numNewRepoRevisionsToFetch(ctx, field, obj)
+		case "maxRepoRevisionsToSearch":
+			out.Values[i] = ec._RepotrackerConfig_maxRepoRevisionsToSearch(ctx, field, obj)
+		case "maxConcurrentRequests":
+			out.Values[i] = ec._RepotrackerConfig_maxConcurrentRequests(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var repotrackerErrorImplementors = []string{"RepotrackerError"}
+
+func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepositoryErrorDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepotrackerError")
+		case "exists":
+			out.Values[i] = ec._RepotrackerError_exists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "invalidRevision":
+			out.Values[i] = ec._RepotrackerError_invalidRevision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mergeBaseRevision":
+			out.Values[i] = ec._RepotrackerError_mergeBaseRevision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var resourceLimitsImplementors = []string{"ResourceLimits"}
 
-func (ec *executionContext) _RepoTestSelectionSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APITestSelectionSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repoTestSelectionSettingsImplementors)
+func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepoTestSelectionSettings")
-		case "allowed":
-			out.Values[i] = ec._RepoTestSelectionSettings_allowed(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceLimits")
+		case "lockedMemoryKb":
+			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "defaultEnabled":
-			out.Values[i] = ec._RepoTestSelectionSettings_defaultEnabled(ctx, field, obj)
+		case "numFiles":
+			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var repoWorkstationConfigImplementors = []string{"RepoWorkstationConfig"}
-
-func (ec *executionContext) _RepoWorkstationConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIWorkstationConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repoWorkstationConfigImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepoWorkstationConfig")
-		case "gitClone":
-			out.Values[i] = ec._RepoWorkstationConfig_gitClone(ctx, field, obj)
+		case "numProcesses":
+			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numTasks":
+			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "virtualMemoryKb":
+			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "setupCommands":
-			out.Values[i] = ec._RepoWorkstationConfig_setupCommands(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -103163,23 +108980,62 @@ func (ec *executionContext) _RepoWorkstationConfig(ctx context.Context, sel ast.
 	return out
 }
 
-var repotrackerConfigImplementors = []string{"RepotrackerConfig"}
+var resourceRecommendationImplementors = []string{"ResourceRecommendation"}
 
-func (ec *executionContext) _RepotrackerConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepoTrackerConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerConfigImplementors)
+func (ec *executionContext) _ResourceRecommendation(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceRecommendation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceRecommendationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepotrackerConfig")
-		case "numNewRepoRevisionsToFetch":
-			out.Values[i] = ec._RepotrackerConfig_numNewRepoRevisionsToFetch(ctx, field, obj)
-		case "maxRepoRevisionsToSearch":
-			out.Values[i] = ec._RepotrackerConfig_maxRepoRevisionsToSearch(ctx, field, obj)
-		case "maxConcurrentRequests":
-			out.Values[i] = ec._RepotrackerConfig_maxConcurrentRequests(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceRecommendation")
+		case "buildVariant":
+			out.Values[i] = ec._ResourceRecommendation_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._ResourceRecommendation_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currentDistro":
+			out.Values[i] = ec._ResourceRecommendation_currentDistro(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "direction":
+			out.Values[i] = ec._ResourceRecommendation_direction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numExecutions":
+			out.Values[i] = ec._ResourceRecommendation_numExecutions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakMemoryPercent":
+			out.Values[i] = ec._ResourceRecommendation_peakMemoryPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recommendedDistro":
+			out.Values[i] = ec._ResourceRecommendation_recommendedDistro(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sustainedCpuPercent":
+			out.Values[i] = ec._ResourceRecommendation_sustainedCpuPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskName":
+			out.Values[i] = ec._ResourceRecommendation_taskName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -103203,29 +109059,34 @@ func (ec *executionContext) _RepotrackerConfig(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var repotrackerErrorImplementors = []string{"RepotrackerError"}
+var resourceRecommendationDistroImplementors = []string{"ResourceRecommendationDistro"}
 
-func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepositoryErrorDetails) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerErrorImplementors)
+func (ec *executionContext) _ResourceRecommendationDistro(ctx context.Context, sel ast.SelectionSet, obj *model.APIDistroOption) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceRecommendationDistroImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepotrackerError")
-		case "exists":
-			out.Values[i] = ec._RepotrackerError_exists(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceRecommendationDistro")
+		case "distroId":
+			out.Values[i] = ec._ResourceRecommendationDistro_distroId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "invalidRevision":
-			out.Values[i] = ec._RepotrackerError_invalidRevision(ctx, field, obj)
+		case "hourlyRate":
+			out.Values[i] = ec._ResourceRecommendationDistro_hourlyRate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "mergeBaseRevision":
-			out.Values[i] = ec._RepotrackerError_mergeBaseRevision(ctx, field, obj)
+		case "memoryBytes":
+			out.Values[i] = ec._ResourceRecommendationDistro_memoryBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numCpus":
+			out.Values[i] = ec._ResourceRecommendationDistro_numCpus(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -103252,39 +109113,54 @@ func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var resourceLimitsImplementors = []string{"ResourceLimits"}
+var resourceUsagePointImplementors = []string{"ResourceUsagePoint"}
 
-func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
+func (ec *executionContext) _ResourceUsagePoint(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceUsagePoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceUsagePointImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResourceLimits")
-		case "lockedMemoryKb":
-			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceUsagePoint")
+		case "cpuPercent":
+			out.Values[i] = ec._ResourceUsagePoint_cpuPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numFiles":
-			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
+		case "diskReadBytesPerSec":
+			out.Values[i] = ec._ResourceUsagePoint_diskReadBytesPerSec(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numProcesses":
-			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+		case "diskWriteBytesPerSec":
+			out.Values[i] = ec._ResourceUsagePoint_diskWriteBytesPerSec(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numTasks":
-			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+		case "durationSecs":
+			out.Values[i] = ec._ResourceUsagePoint_durationSecs(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "virtualMemoryKb":
-			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
+		case "memoryPercent":
+			out.Values[i] = ec._ResourceUsagePoint_memoryPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netRecvBytesPerSec":
+			out.Values[i] = ec._ResourceUsagePoint_netRecvBytesPerSec(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netSentBytesPerSec":
+			out.Values[i] = ec._ResourceUsagePoint_netSentBytesPerSec(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._ResourceUsagePoint_time(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -104731,6 +110607,8 @@ func (ec *executionContext) _Subscriber(ctx context.Context, sel ast.SelectionSe
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Subscriber")
+		case "chatSubscriber":
+			out.Values[i] = ec._Subscriber_chatSubscriber(ctx, field, obj)
 		case "emailSubscriber":
 			out.Values[i] = ec._Subscriber_emailSubscriber(ctx, field, obj)
 		case "githubCheckSubscriber":
@@ -104743,6 +110621,8 @@ func (ec *executionContext) _Subscriber(ctx context.Context, sel ast.SelectionSe
 			out.Values[i] = ec._Subscriber_jiraIssueSubscriber(ctx, field, obj)
 		case "slackSubscriber":
 			out.Values[i] = ec._Subscriber_slackSubscriber(ctx, field, obj)
+		case "teamsSubscriber":
+			out.Values[i] = ec._Subscriber_teamsSubscriber(ctx, field, obj)
 		case "webhookSubscriber":
 			out.Values[i] = ec._Subscriber_webhookSubscriber(ctx, field, obj)
 		default:
@@ -106362,6 +112242,39 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "resourceUsage":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_resourceUsage(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "revision":
 			out.Values[i] = ec._Task_revision(ctx, field, obj)
 		case "scheduledTime":
@@ -107726,66 +113639,132 @@ func (ec *executionContext) _TaskQueueDistro(ctx context.Context, sel ast.Select
 	return out
 }
 
-var taskQueueItemImplementors = []string{"TaskQueueItem"}
+var taskQueueItemImplementors = []string{"TaskQueueItem"}
+
+func (ec *executionContext) _TaskQueueItem(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskQueueItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskQueueItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskQueueItem")
+		case "id":
+			out.Values[i] = ec._TaskQueueItem_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "buildVariant":
+			out.Values[i] = ec._TaskQueueItem_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._TaskQueueItem_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expectedDuration":
+			out.Values[i] = ec._TaskQueueItem_expectedDuration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "priority":
+			out.Values[i] = ec._TaskQueueItem_priority(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "project":
+			out.Values[i] = ec._TaskQueueItem_project(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "projectIdentifier":
+			out.Values[i] = ec._TaskQueueItem_projectIdentifier(ctx, field, obj)
+		case "requester":
+			out.Values[i] = ec._TaskQueueItem_requester(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activatedBy":
+			out.Values[i] = ec._TaskQueueItem_activatedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revision":
+			out.Values[i] = ec._TaskQueueItem_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._TaskQueueItem_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var taskResourceUsageImplementors = []string{"TaskResourceUsage"}
 
-func (ec *executionContext) _TaskQueueItem(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskQueueItem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, taskQueueItemImplementors)
+func (ec *executionContext) _TaskResourceUsage(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskResourceUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskResourceUsageImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TaskQueueItem")
-		case "id":
-			out.Values[i] = ec._TaskQueueItem_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "buildVariant":
-			out.Values[i] = ec._TaskQueueItem_buildVariant(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "displayName":
-			out.Values[i] = ec._TaskQueueItem_displayName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "expectedDuration":
-			out.Values[i] = ec._TaskQueueItem_expectedDuration(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "priority":
-			out.Values[i] = ec._TaskQueueItem_priority(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TaskResourceUsage")
+		case "distroId":
+			out.Values[i] = ec._TaskResourceUsage_distroId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "project":
-			out.Values[i] = ec._TaskQueueItem_project(ctx, field, obj)
+		case "instanceType":
+			out.Values[i] = ec._TaskResourceUsage_instanceType(ctx, field, obj)
+		case "numCpus":
+			out.Values[i] = ec._TaskResourceUsage_numCpus(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "projectIdentifier":
-			out.Values[i] = ec._TaskQueueItem_projectIdentifier(ctx, field, obj)
-		case "requester":
-			out.Values[i] = ec._TaskQueueItem_requester(ctx, field, obj)
+		case "peakMemoryPercent":
+			out.Values[i] = ec._TaskResourceUsage_peakMemoryPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "activatedBy":
-			out.Values[i] = ec._TaskQueueItem_activatedBy(ctx, field, obj)
+		case "points":
+			out.Values[i] = ec._TaskResourceUsage_points(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "revision":
-			out.Values[i] = ec._TaskQueueItem_revision(ctx, field, obj)
+		case "sustainedCpuPercent":
+			out.Values[i] = ec._TaskResourceUsage_sustainedCpuPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "version":
-			out.Values[i] = ec._TaskQueueItem_version(ctx, field, obj)
+		case "totalMemoryBytes":
+			out.Values[i] = ec._TaskResourceUsage_totalMemoryBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -108002,6 +113981,45 @@ func (ec *executionContext) _TaskTestResultSample(ctx context.Context, sel ast.S
 	return out
 }
 
+var teamsSubscriberImplementors = []string{"TeamsSubscriber"}
+
+func (ec *executionContext) _TeamsSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APITeamsSubscriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamsSubscriberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamsSubscriber")
+		case "url":
+			out.Values[i] = ec._TeamsSubscriber_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var testLogImplementors = []string{"TestLog"}
 
 func (ec *executionContext) _TestLog(ctx context.Context, sel ast.SelectionSet, obj *model.TestLogs) graphql.Marshaler {
@@ -109237,6 +115255,11 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			}
 		case "activated":
 			out.Values[i] = ec._Version_activated(ctx, field, obj)
+		case "artifactsPinned":
+			out.Values[i] = ec._Version_artifactsPinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "author":
 			out.Values[i] = ec._Version_author(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -109422,6 +115445,39 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "criticalPath":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Version_criticalPath(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "ingestTime":
 			out.Values[i] = ec._Version_ingestTime(ctx, field, obj)
 		case "errors":
@@ -110802,6 +116858,122 @@ func (ec *executionContext) _Webhook(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var webhookAttemptImplementors = []string{"WebhookAttempt"}
+
+func (ec *executionContext) _WebhookAttempt(ctx context.Context, sel ast.SelectionSet, obj *model.APIWebhookAttempt) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookAttemptImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookAttempt")
+		case "error":
+			out.Values[i] = ec._WebhookAttempt_error(ctx, field, obj)
+		case "latency":
+			out.Values[i] = ec._WebhookAttempt_latency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "statusCode":
+			out.Values[i] = ec._WebhookAttempt_statusCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._WebhookAttempt_time(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
+
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *model.APIWebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
+		case "attemptCount":
+			out.Values[i] = ec._WebhookDelivery_attemptCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._WebhookDelivery_error(ctx, field, obj)
+		case "lastAttemptAt":
+			out.Values[i] = ec._WebhookDelivery_lastAttemptAt(ctx, field, obj)
+		case "notificationId":
+			out.Values[i] = ec._WebhookDelivery_notificationId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._WebhookDelivery_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subscriptionId":
+			out.Values[i] = ec._WebhookDelivery_subscriptionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "url":
+			out.Values[i] = ec._WebhookDelivery_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var webhookHeaderImplementors = []string{"WebhookHeader"}
 
 func (ec *executionContext) _WebhookHeader(ctx context.Context, sel ast.SelectionSet, obj *model.APIWebhookHeader) graphql.Marshaler {
@@ -112016,7 +118188,95 @@ func (ec *executionContext) marshalNContainerPool2ᚕgithubᚗcomᚋevergreenᚑ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNContainerPool2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx, sel, v[i])
+			ret[i] = ec.marshalNContainerPool2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNContainerPoolInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx context.Context, v any) (model.APIContainerPool, error) {
+	res, err := ec.unmarshalInputContainerPoolInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNContainerPoolInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPoolᚄ(ctx context.Context, v any) ([]model.APIContainerPool, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.APIContainerPool, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNContainerPoolInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNCopyDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐCopyDistroOpts(ctx context.Context, v any) (model.CopyDistroOpts, error) {
+	res, err := ec.unmarshalInputCopyDistroInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCopyProjectInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐCopyProjectOpts(ctx context.Context, v any) (model.CopyProjectOpts, error) {
+	res, err := ec.unmarshalInputCopyProjectInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCreateDistroInput(ctx context.Context, v any) (CreateDistroInput, error) {
+	res, err := ec.unmarshalInputCreateDistroInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateProjectInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRef(ctx context.Context, v any) (model.APIProjectRef, error) {
+	res, err := ec.unmarshalInputCreateProjectInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCriticalPathTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTask(ctx context.Context, sel ast.SelectionSet, v model.APICriticalPathTask) graphql.Marshaler {
+	return ec._CriticalPathTask(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCriticalPathTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTaskᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APICriticalPathTask) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCriticalPathTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTask(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -112036,46 +118296,6 @@ func (ec *executionContext) marshalNContainerPool2ᚕgithubᚗcomᚋevergreenᚑ
 	return ret
 }
 
-func (ec *executionContext) unmarshalNContainerPoolInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx context.Context, v any) (model.APIContainerPool, error) {
-	res, err := ec.unmarshalInputContainerPoolInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNContainerPoolInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPoolᚄ(ctx context.Context, v any) ([]model.APIContainerPool, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]model.APIContainerPool, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNContainerPoolInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIContainerPool(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNCopyDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐCopyDistroOpts(ctx context.Context, v any) (model.CopyDistroOpts, error) {
-	res, err := ec.unmarshalInputCopyDistroInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCopyProjectInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐCopyProjectOpts(ctx context.Context, v any) (model.CopyProjectOpts, error) {
-	res, err := ec.unmarshalInputCopyProjectInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCreateDistroInput(ctx context.Context, v any) (CreateDistroInput, error) {
-	res, err := ec.unmarshalInputCreateDistroInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateProjectInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectRef(ctx context.Context, v any) (model.APIProjectRef, error) {
-	res, err := ec.unmarshalInputCreateProjectInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
 func (ec *executionContext) unmarshalNCursorParams2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCursorParams(ctx context.Context, v any) (*CursorParams, error) {
 	res, err := ec.unmarshalInputCursorParams(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
@@ -115058,10 +121278,12 @@ func (ec *executionContext) marshalNPlannerVersion2ᚖstring(ctx context.Context
 
 var (
 	unmarshalNPlannerVersion2ᚖstring = map[string]string{
-		"TUNABLE": evergreen.PlannerVersionTunable,
+		"TUNABLE":    evergreen.PlannerVersionTunable,
+		"FAIR_SHARE": evergreen.PlannerVersionFairShare,
 	}
 	marshalNPlannerVersion2ᚖstring = map[string]string{
-		evergreen.PlannerVersionTunable: "TUNABLE",
+		evergreen.PlannerVersionTunable:   "TUNABLE",
+		evergreen.PlannerVersionFairShare: "FAIR_SHARE",
 	}
 )
 
@@ -115133,6 +121355,10 @@ func (ec *executionContext) unmarshalNPreconditionScriptInput2ᚕgithubᚗcomᚋ
 	return res, nil
 }
 
+func (ec *executionContext) marshalNPreemptionSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPreemptionSettings(ctx context.Context, sel ast.SelectionSet, v model.APIPreemptionSettings) graphql.Marshaler {
+	return ec._PreemptionSettings(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNPriorityLevel2ᚖstring(ctx context.Context, v any) (*string, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := unmarshalNPriorityLevel2ᚖstring[tmp]
@@ -115450,6 +121676,113 @@ func (ec *executionContext) marshalNProjectSettingsSection2githubᚗcomᚋevergr
 	return v
 }
 
+func (ec *executionContext) marshalNProjectShare2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShare(ctx context.Context, sel ast.SelectionSet, v model.APIProjectShare) graphql.Marshaler {
+	return ec._ProjectShare(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProjectShare2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIProjectShare) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProjectShare2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShare(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNProjectShareInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShare(ctx context.Context, v any) (model.APIProjectShare, error) {
+	res, err := ec.unmarshalInputProjectShareInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNProjectShareUsage2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareUsageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIProjectShareUsage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProjectShareUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareUsage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNProjectShareUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareUsage(ctx context.Context, sel ast.SelectionSet, v *model.APIProjectShareUsage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ProjectShareUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNProjectTasksPair2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectTasksPair(ctx context.Context, sel ast.SelectionSet, v model.APIProjectTasksPair) graphql.Marshaler {
 	return ec._ProjectTasksPair(ctx, sel, &v)
 }
@@ -115551,12 +121884,16 @@ var (
 		"DOCKER":        evergreen.ProviderNameDocker,
 		"EC2_FLEET":     evergreen.ProviderNameEc2Fleet,
 		"EC2_ON_DEMAND": evergreen.ProviderNameEc2OnDemand,
+		"PODMAN":        evergreen.ProviderNamePodman,
+		"PROCESS":       evergreen.ProviderNameProcess,
 		"STATIC":        evergreen.ProviderNameStatic,
 	}
 	marshalNProvider2ᚖstring = map[string]string{
 		evergreen.ProviderNameDocker:      "DOCKER",
 		evergreen.ProviderNameEc2Fleet:    "EC2_FLEET",
 		evergreen.ProviderNameEc2OnDemand: "EC2_ON_DEMAND",
+		evergreen.ProviderNamePodman:      "PODMAN",
+		evergreen.ProviderNameProcess:     "PROCESS",
 		evergreen.ProviderNameStatic:      "STATIC",
 	}
 )
@@ -115625,6 +121962,11 @@ func (ec *executionContext) unmarshalNPublicKeyInput2ᚖgithubᚗcomᚋevergreen
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNQuarantineProjectTestInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐQuarantineProjectTestInput(ctx context.Context, v any) (QuarantineProjectTestInput, error) {
+	res, err := ec.unmarshalInputQuarantineProjectTestInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNQuarantineTestInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐQuarantineTestInput(ctx context.Context, v any) (QuarantineTestInput, error) {
 	res, err := ec.unmarshalInputQuarantineTestInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -115644,6 +121986,64 @@ func (ec *executionContext) marshalNQuarantineTestPayload2ᚖgithubᚗcomᚋever
 	return ec._QuarantineTestPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNQuarantinedTest2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTest(ctx context.Context, sel ast.SelectionSet, v model.APIQuarantinedTest) graphql.Marshaler {
+	return ec._QuarantinedTest(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNQuarantinedTest2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTestᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIQuarantinedTest) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNQuarantinedTest2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTest(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNQuarantinedTest2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIQuarantinedTest(ctx context.Context, sel ast.SelectionSet, v *model.APIQuarantinedTest) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._QuarantinedTest(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNRefreshGitHubStatusesInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRefreshGitHubStatusesInput(ctx context.Context, v any) (RefreshGitHubStatusesInput, error) {
 	res, err := ec.unmarshalInputRefreshGitHubStatusesInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -115714,6 +122114,112 @@ func (ec *executionContext) unmarshalNResourceLimitsInput2githubᚗcomᚋevergre
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNResourceRecommendation2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceRecommendationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIResourceRecommendation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNResourceRecommendation2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceRecommendation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNResourceRecommendation2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceRecommendation(ctx context.Context, sel ast.SelectionSet, v *model.APIResourceRecommendation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ResourceRecommendation(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNResourceRecommendationDistro2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistroOption(ctx context.Context, sel ast.SelectionSet, v model.APIDistroOption) graphql.Marshaler {
+	return ec._ResourceRecommendationDistro(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNResourceUsagePoint2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsagePoint(ctx context.Context, sel ast.SelectionSet, v model.APIResourceUsagePoint) graphql.Marshaler {
+	return ec._ResourceUsagePoint(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNResourceUsagePoint2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsagePointᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIResourceUsagePoint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNResourceUsagePoint2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsagePoint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNRestartAdminTasksOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐRestartOptions(ctx context.Context, v any) (model1.RestartOptions, error) {
 	res, err := ec.unmarshalInputRestartAdminTasksOptions(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -116983,6 +123489,11 @@ func (ec *executionContext) marshalNUIConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋ
 	return ec._UIConfig(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNUnquarantineProjectTestInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐUnquarantineProjectTestInput(ctx context.Context, v any) (UnquarantineProjectTestInput, error) {
+	res, err := ec.unmarshalInputUnquarantineProjectTestInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateBetaFeaturesInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐUpdateBetaFeaturesInput(ctx context.Context, v any) (UpdateBetaFeaturesInput, error) {
 	res, err := ec.unmarshalInputUpdateBetaFeaturesInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -117069,7 +123580,279 @@ func (ec *executionContext) marshalNVariantTask2ᚕgithubᚗcomᚋevergreenᚑci
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNVariantTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐVariantTask(ctx, sel, v[i])
+			ret[i] = ec.marshalNVariantTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐVariantTask(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNVariantTasks2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasksᚄ(ctx context.Context, v any) ([]*VariantTasks, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*VariantTasks, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNVariantTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasks(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNVariantTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasks(ctx context.Context, v any) (*VariantTasks, error) {
+	res, err := ec.unmarshalInputVariantTasks(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx context.Context, sel ast.SelectionSet, v model.APIVersion) graphql.Marshaler {
+	return ec._Version(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNVersion2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIVersion) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx context.Context, sel ast.SelectionSet, v *model.APIVersion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Version(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNVersionLite2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersion(ctx context.Context, sel ast.SelectionSet, v model1.Version) graphql.Marshaler {
+	return ec._VersionLite(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNVersionLite2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersion(ctx context.Context, sel ast.SelectionSet, v *model1.Version) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VersionLite(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNVersionTasks2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVersionTasks(ctx context.Context, sel ast.SelectionSet, v VersionTasks) graphql.Marshaler {
+	return ec._VersionTasks(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNVersionTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVersionTasks(ctx context.Context, sel ast.SelectionSet, v *VersionTasks) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VersionTasks(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNVersionToRestart2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestartᚄ(ctx context.Context, v any) ([]*model1.VersionToRestart, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model1.VersionToRestart, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNVersionToRestart2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestart(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNVersionToRestart2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestart(ctx context.Context, v any) (*model1.VersionToRestart, error) {
+	res, err := ec.unmarshalInputVersionToRestart(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolumeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIVolume) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx context.Context, sel ast.SelectionSet, v *model.APIVolume) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Volume(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNVolumeHost2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVolumeHost(ctx context.Context, v any) (VolumeHost, error) {
+	res, err := ec.unmarshalInputVolumeHost(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaterfall2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfall(ctx context.Context, sel ast.SelectionSet, v Waterfall) graphql.Marshaler {
+	return ec._Waterfall(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWaterfall2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfall(ctx context.Context, sel ast.SelectionSet, v *Waterfall) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Waterfall(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNWaterfallBuild2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallBuild(ctx context.Context, sel ast.SelectionSet, v *model1.WaterfallBuild) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WaterfallBuild(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWaterfallOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfallOptions(ctx context.Context, v any) (WaterfallOptions, error) {
+	res, err := ec.unmarshalInputWaterfallOptions(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaterfallPagination2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfallPagination(ctx context.Context, sel ast.SelectionSet, v *WaterfallPagination) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WaterfallPagination(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNWaterfallTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTask(ctx context.Context, sel ast.SelectionSet, v model1.WaterfallTask) graphql.Marshaler {
+	return ec._WaterfallTask(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWaterfallTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTaskᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.WaterfallTask) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWaterfallTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTask(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -117089,31 +123872,15 @@ func (ec *executionContext) marshalNVariantTask2ᚕgithubᚗcomᚋevergreenᚑci
 	return ret
 }
 
-func (ec *executionContext) unmarshalNVariantTasks2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasksᚄ(ctx context.Context, v any) ([]*VariantTasks, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*VariantTasks, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNVariantTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasks(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNVariantTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVariantTasks(ctx context.Context, v any) (*VariantTasks, error) {
-	res, err := ec.unmarshalInputVariantTasks(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNWebhook2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebHook(ctx context.Context, sel ast.SelectionSet, v model.APIWebHook) graphql.Marshaler {
+	return ec._Webhook(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx context.Context, sel ast.SelectionSet, v model.APIVersion) graphql.Marshaler {
-	return ec._Version(ctx, sel, &v)
+func (ec *executionContext) marshalNWebhookAttempt2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookAttempt(ctx context.Context, sel ast.SelectionSet, v model.APIWebhookAttempt) graphql.Marshaler {
+	return ec._WebhookAttempt(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNVersion2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIVersion) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookAttempt2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookAttemptᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIWebhookAttempt) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -117137,7 +123904,7 @@ func (ec *executionContext) marshalNVersion2ᚕᚖgithubᚗcomᚋevergreenᚑci
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx, sel, v[i])
+			ret[i] = ec.marshalNWebhookAttempt2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookAttempt(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -117157,65 +123924,11 @@ func (ec *executionContext) marshalNVersion2ᚕᚖgithubᚗcomᚋevergreenᚑci
 	return ret
 }
 
-func (ec *executionContext) marshalNVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersion(ctx context.Context, sel ast.SelectionSet, v *model.APIVersion) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Version(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNVersionLite2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersion(ctx context.Context, sel ast.SelectionSet, v model1.Version) graphql.Marshaler {
-	return ec._VersionLite(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNVersionLite2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersion(ctx context.Context, sel ast.SelectionSet, v *model1.Version) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._VersionLite(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNVersionTasks2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVersionTasks(ctx context.Context, sel ast.SelectionSet, v VersionTasks) graphql.Marshaler {
-	return ec._VersionTasks(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNVersionTasks2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVersionTasks(ctx context.Context, sel ast.SelectionSet, v *VersionTasks) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._VersionTasks(ctx, sel, v)
+func (ec *executionContext) marshalNWebhookDelivery2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v model.APIWebhookDelivery) graphql.Marshaler {
+	return ec._WebhookDelivery(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNVersionToRestart2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestartᚄ(ctx context.Context, v any) ([]*model1.VersionToRestart, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*model1.VersionToRestart, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNVersionToRestart2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestart(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNVersionToRestart2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionToRestart(ctx context.Context, v any) (*model1.VersionToRestart, error) {
-	res, err := ec.unmarshalInputVersionToRestart(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolumeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIVolume) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDeliveryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIWebhookDelivery) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -117239,7 +123952,7 @@ func (ec *executionContext) marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑci
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx, sel, v[i])
+			ret[i] = ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDelivery(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -117259,110 +123972,14 @@ func (ec *executionContext) marshalNVolume2ᚕᚖgithubᚗcomᚋevergreenᚑci
 	return ret
 }
 
-func (ec *executionContext) marshalNVolume2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVolume(ctx context.Context, sel ast.SelectionSet, v *model.APIVolume) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Volume(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNVolumeHost2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐVolumeHost(ctx context.Context, v any) (VolumeHost, error) {
-	res, err := ec.unmarshalInputVolumeHost(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNWaterfall2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfall(ctx context.Context, sel ast.SelectionSet, v Waterfall) graphql.Marshaler {
-	return ec._Waterfall(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNWaterfall2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfall(ctx context.Context, sel ast.SelectionSet, v *Waterfall) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Waterfall(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNWaterfallBuild2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallBuild(ctx context.Context, sel ast.SelectionSet, v *model1.WaterfallBuild) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._WaterfallBuild(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNWaterfallOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfallOptions(ctx context.Context, v any) (WaterfallOptions, error) {
-	res, err := ec.unmarshalInputWaterfallOptions(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNWaterfallPagination2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐWaterfallPagination(ctx context.Context, sel ast.SelectionSet, v *WaterfallPagination) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookDelivery2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *model.APIWebhookDelivery) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._WaterfallPagination(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNWaterfallTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTask(ctx context.Context, sel ast.SelectionSet, v model1.WaterfallTask) graphql.Marshaler {
-	return ec._WaterfallTask(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNWaterfallTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTaskᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.WaterfallTask) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
-	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalNWaterfallTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐWaterfallTask(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
-	}
-	wg.Wait()
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
-	return ret
-}
-
-func (ec *executionContext) marshalNWebhook2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebHook(ctx context.Context, sel ast.SelectionSet, v model.APIWebHook) graphql.Marshaler {
-	return ec._Webhook(ctx, sel, &v)
+	return ec._WebhookDelivery(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNWebhookHeader2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookHeader(ctx context.Context, sel ast.SelectionSet, v model.APIWebhookHeader) graphql.Marshaler {
@@ -117784,6 +124401,15 @@ func (ec *executionContext) marshalOAnnotation2ᚖgithubᚗcomᚋevergreenᚑci
 	return ec._Annotation(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOArtifactRetentionSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIArtifactRetentionSettings(ctx context.Context, sel ast.SelectionSet, v model.APIArtifactRetentionSettings) graphql.Marshaler {
+	return ec._ArtifactRetentionSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalOArtifactRetentionSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIArtifactRetentionSettings(ctx context.Context, v any) (model.APIArtifactRetentionSettings, error) {
+	res, err := ec.unmarshalInputArtifactRetentionSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOAuthConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIAuthConfig(ctx context.Context, sel ast.SelectionSet, v *model.APIAuthConfig) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -117994,6 +124620,21 @@ func (ec *executionContext) unmarshalOCedarConfigInput2ᚖgithubᚗcomᚋevergre
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOChatSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatSubscriber(ctx context.Context, sel ast.SelectionSet, v *model.APIChatSubscriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ChatSubscriber(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOChatSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatSubscriber(ctx context.Context, v any) (*model.APIChatSubscriber, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputChatSubscriberInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOChildPatchAlias2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChildPatchAliasᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIChildPatchAlias) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -118141,6 +124782,15 @@ func (ec *executionContext) marshalOCost2ᚖgithubᚗcomᚋevergreenᚑciᚋever
 	return ec._Cost(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOCostBudgetSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBudgetSettings(ctx context.Context, sel ast.SelectionSet, v model.APICostBudgetSettings) graphql.Marshaler {
+	return ec._CostBudgetSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalOCostBudgetSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostBudgetSettings(ctx context.Context, v any) (model.APICostBudgetSettings, error) {
+	res, err := ec.unmarshalInputCostBudgetSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOCostConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICostConfig(ctx context.Context, sel ast.SelectionSet, v *model.APICostConfig) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -118165,6 +124815,13 @@ func (ec *executionContext) unmarshalOCostDataInput2githubᚗcomᚋevergreenᚑc
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOCriticalPath2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPath(ctx context.Context, sel ast.SelectionSet, v *model.APICriticalPath) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CriticalPath(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCursorSettings2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCursorSettings(ctx context.Context, sel ast.SelectionSet, v *CursorSettings) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -119397,6 +126054,15 @@ func (ec *executionContext) marshalOLogBuffering2ᚖgithubᚗcomᚋevergreenᚑc
 	return ec._LogBuffering(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOLogStorageSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogStorageSettings(ctx context.Context, sel ast.SelectionSet, v model.APILogStorageSettings) graphql.Marshaler {
+	return ec._LogStorageSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalOLogStorageSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogStorageSettings(ctx context.Context, v any) (model.APILogStorageSettings, error) {
+	res, err := ec.unmarshalInputLogStorageSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOLoggerConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILoggerConfig(ctx context.Context, sel ast.SelectionSet, v *model.APILoggerConfig) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -119993,6 +126659,26 @@ func (ec *executionContext) unmarshalOPersistentDNSConfigInput2ᚖgithubᚗcom
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOPodmanConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPodmanConfig(ctx context.Context, sel ast.SelectionSet, v *model.APIPodmanConfig) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PodmanConfig(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPodmanConfigInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPodmanConfig(ctx context.Context, v any) (*model.APIPodmanConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPodmanConfigInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOPreemptionSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPreemptionSettings(ctx context.Context, v any) (model.APIPreemptionSettings, error) {
+	res, err := ec.unmarshalInputPreemptionSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOPreferredAuthType2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -120257,6 +126943,24 @@ func (ec *executionContext) unmarshalOProjectSettingsInput2ᚖgithubᚗcomᚋeve
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOProjectShareInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShareᚄ(ctx context.Context, v any) ([]model.APIProjectShare, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.APIProjectShare, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNProjectShareInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectShare(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOProjectVars2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVars(ctx context.Context, sel ast.SelectionSet, v model.APIProjectVars) graphql.Marshaler {
 	return ec._ProjectVars(ctx, sel, &v)
 }
@@ -121075,6 +127779,13 @@ func (ec *executionContext) marshalOTaskOwnerTeam2ᚖgithubᚗcomᚋevergreenᚑ
 	return ec._TaskOwnerTeam(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage(ctx context.Context, sel ast.SelectionSet, v *model.APITaskResourceUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TaskResourceUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTaskSpecifier2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskSpecifierᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APITaskSpecifier) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -121176,6 +127887,21 @@ func (ec *executionContext) marshalOTaskTestResultSample2ᚕᚖgithubᚗcomᚋev
 	return ret
 }
 
+func (ec *executionContext) marshalOTeamsSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx context.Context, sel ast.SelectionSet, v *model.APITeamsSubscriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TeamsSubscriber(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOTeamsSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx context.Context, v any) (*model.APITeamsSubscriber, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTeamsSubscriberInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOTestFilterOptions2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestFilterOptions(ctx context.Context, v any) (*TestFilterOptions, error) {
 	if v == nil {
 		return nil, nil
@@ -19,6 +19,7 @@ type AbortInfo struct {
 	BuildVariantDisplayName string `json:"buildVariantDisplayName"`
 	NewVersion              string `json:"newVersion"`
 	PrClosed                bool   `json:"prClosed"`
+	Reason                  string `json:"reason"`
 	TaskDisplayName         string `json:"taskDisplayName"`
 	TaskID                  string `json:"taskID"`
 	User                    string `json:"user"`
@@ -428,6 +429,12 @@ type PublicKeyInput struct {
 	Name string `json:"name"`
 }
 
+type QuarantineProjectTestInput struct {
+	ProjectIdentifier string  `json:"projectIdentifier"`
+	TestName          string  `json:"testName"`
+	Reason            *string `json:"reason,omitempty"`
+}
+
 type QuarantineTestInput struct {
 	TaskID   string `json:"taskId"`
 	TestName string `json:"testName"`
@@ -551,12 +558,14 @@ type SpawnVolumeInput struct {
 }
 
 type Subscriber struct {
+	ChatSubscriber        *model.APIChatSubscriber        `json:"chatSubscriber,omitempty"`
 	EmailSubscriber       *string                         `json:"emailSubscriber,omitempty"`
 	GithubCheckSubscriber *model.APIGithubCheckSubscriber `json:"githubCheckSubscriber,omitempty"`
 	GithubPRSubscriber    *model.APIGithubPRSubscriber    `json:"githubPRSubscriber,omitempty"`
 	JiraCommentSubscriber *string                         `json:"jiraCommentSubscriber,omitempty"`
 	JiraIssueSubscriber   *model.APIJIRAIssueSubscriber   `json:"jiraIssueSubscriber,omitempty"`
 	SlackSubscriber       *string                         `json:"slackSubscriber,omitempty"`
+	TeamsSubscriber       *model.APITeamsSubscriber       `json:"teamsSubscriber,omitempty"`
 	WebhookSubscriber     *model.APIWebhookSubscriber     `json:"webhookSubscriber,omitempty"`
 }
 
@@ -691,6 +700,11 @@ type TestSortOptions struct {
 	Direction SortDirection    `json:"direction"`
 }
 
+type UnquarantineProjectTestInput struct {
+	ProjectIdentifier string `json:"projectIdentifier"`
+	TestName          string `json:"testName"`
+}
+
 type UpdateBetaFeaturesInput struct {
 	BetaFeatures *model.APIBetaFeatures `json:"betaFeatures"`
 }
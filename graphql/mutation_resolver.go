@@ -24,6 +24,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/parsley"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -644,6 +645,43 @@ func (r *mutationResolver) PromoteVarsToRepo(ctx context.Context, opts PromoteVa
 	return true, nil
 }
 
+// QuarantineProjectTest is the resolver for the quarantineProjectTest field.
+func (r *mutationResolver) QuarantineProjectTest(ctx context.Context, opts QuarantineProjectTestInput) (*restModel.APIQuarantinedTest, error) {
+	projectRef, err := model.FindBranchProjectRef(ctx, opts.ProjectIdentifier)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching project '%s': %s", opts.ProjectIdentifier, err.Error()))
+	}
+	if projectRef == nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("project '%s' not found", opts.ProjectIdentifier))
+	}
+	if opts.TestName == "" {
+		return nil, InputValidationError.Send(ctx, "test name must be specified")
+	}
+
+	usr := mustHaveUser(ctx)
+	qt := testquarantine.QuarantinedTest{
+		ProjectID: projectRef.Id,
+		TestName:  opts.TestName,
+		Source:    testquarantine.SourceManual,
+		Reason:    utility.FromStringPtr(opts.Reason),
+		CreatedBy: usr.Username(),
+	}
+	if err = qt.Upsert(ctx); err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("quarantining test '%s' in project '%s': %s", opts.TestName, opts.ProjectIdentifier, err.Error()))
+	}
+	dbQT, err := testquarantine.FindOneByProjectAndTestName(ctx, projectRef.Id, opts.TestName)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching quarantined test '%s' in project '%s': %s", opts.TestName, opts.ProjectIdentifier, err.Error()))
+	}
+	if dbQT == nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("quarantined test '%s' in project '%s' not found after quarantining it", opts.TestName, opts.ProjectIdentifier))
+	}
+
+	res := &restModel.APIQuarantinedTest{}
+	res.BuildFromService(*dbQT)
+	return res, nil
+}
+
 // SaveProjectSettingsForSection is the resolver for the saveProjectSettingsForSection field.
 func (r *mutationResolver) SaveProjectSettingsForSection(ctx context.Context, projectSettings *restModel.APIProjectSettings, section ProjectSettingsSection) (*restModel.APIProjectSettings, error) {
 	projectId := utility.FromStringPtr(projectSettings.ProjectRef.Id)
@@ -699,6 +737,29 @@ func (r *mutationResolver) SetLastRevision(ctx context.Context, opts SetLastRevi
 	}, nil
 }
 
+// UnquarantineProjectTest is the resolver for the unquarantineProjectTest field.
+func (r *mutationResolver) UnquarantineProjectTest(ctx context.Context, opts UnquarantineProjectTestInput) (bool, error) {
+	projectRef, err := model.FindBranchProjectRef(ctx, opts.ProjectIdentifier)
+	if err != nil {
+		return false, InternalServerError.Send(ctx, fmt.Sprintf("fetching project '%s': %s", opts.ProjectIdentifier, err.Error()))
+	}
+	if projectRef == nil {
+		return false, ResourceNotFound.Send(ctx, fmt.Sprintf("project '%s' not found", opts.ProjectIdentifier))
+	}
+
+	qt, err := testquarantine.FindOneByProjectAndTestName(ctx, projectRef.Id, opts.TestName)
+	if err != nil {
+		return false, InternalServerError.Send(ctx, fmt.Sprintf("fetching quarantined test '%s' in project '%s': %s", opts.TestName, opts.ProjectIdentifier, err.Error()))
+	}
+	if qt == nil {
+		return false, ResourceNotFound.Send(ctx, fmt.Sprintf("test '%s' is not quarantined in project '%s'", opts.TestName, opts.ProjectIdentifier))
+	}
+	if err = testquarantine.Remove(ctx, projectRef.Id, opts.TestName); err != nil {
+		return false, InternalServerError.Send(ctx, fmt.Sprintf("removing test '%s' from quarantine in project '%s': %s", opts.TestName, opts.ProjectIdentifier, err.Error()))
+	}
+	return true, nil
+}
+
 // AttachVolumeToHost is the resolver for the attachVolumeToHost field.
 func (r *mutationResolver) AttachVolumeToHost(ctx context.Context, volumeAndHost VolumeHost) (bool, error) {
 	statusCode, err := cloud.AttachVolume(ctx, volumeAndHost.VolumeID, volumeAndHost.HostID)
@@ -1320,6 +1381,20 @@ func (r *mutationResolver) RemovePublicKey(ctx context.Context, keyName string)
 	return myPublicKeys, nil
 }
 
+// ReplayWebhookDelivery is the resolver for the replayWebhookDelivery field.
+func (r *mutationResolver) ReplayWebhookDelivery(ctx context.Context, subscriptionID string, notificationID string) (*restModel.APIWebhookDelivery, error) {
+	usr := mustHaveUser(ctx)
+	delivery, err := data.ReplayWebhookDelivery(ctx, usr, subscriptionID, notificationID)
+	if err != nil {
+		gimletErr, ok := err.(gimlet.ErrorResponse)
+		if ok {
+			return nil, mapHTTPStatusToGqlError(ctx, gimletErr.StatusCode, err)
+		}
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("replaying webhook delivery '%s': %s", notificationID, err.Error()))
+	}
+	return delivery, nil
+}
+
 // ResetAPIKey is the resolver for the resetAPIKey field.
 func (r *mutationResolver) ResetAPIKey(ctx context.Context) (*UserConfig, error) {
 	usr := mustHaveUser(ctx)
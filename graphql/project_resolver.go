@@ -2,8 +2,10 @@ package graphql
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/utility"
 )
@@ -23,6 +25,22 @@ func (r *projectResolver) Patches(ctx context.Context, obj *restModel.APIProject
 	return &Patches{}, nil
 }
 
+// ResourceRecommendations is the resolver for the resourceRecommendations field.
+func (r *projectResolver) ResourceRecommendations(ctx context.Context, obj *restModel.APIProjectRef) ([]*restModel.APIResourceRecommendation, error) {
+	projectID := utility.FromStringPtr(obj.Id)
+	recs, err := resourceusage.FindRecommendationsByProject(ctx, projectID)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding resource recommendations for project '%s': %s", projectID, err.Error()))
+	}
+	res := make([]*restModel.APIResourceRecommendation, 0, len(recs))
+	for _, rec := range recs {
+		apiRec := &restModel.APIResourceRecommendation{}
+		apiRec.BuildFromService(rec)
+		res = append(res, apiRec)
+	}
+	return res, nil
+}
+
 // IsFavorite is the resolver for the isFavorite field.
 func (r *projectLiteResolver) IsFavorite(ctx context.Context, obj *model.ProjectRef) (bool, error) {
 	usr := mustHaveUser(ctx)
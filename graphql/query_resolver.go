@@ -17,10 +17,12 @@ import (
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/plank"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
@@ -563,6 +565,29 @@ func (r *queryResolver) ProjectSettings(ctx context.Context, projectIdentifier s
 	return res, nil
 }
 
+// QuarantinedTests is the resolver for the quarantinedTests field.
+func (r *queryResolver) QuarantinedTests(ctx context.Context, projectIdentifier string) ([]*restModel.APIQuarantinedTest, error) {
+	projectRef, err := model.FindBranchProjectRef(ctx, projectIdentifier)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching project '%s': %s", projectIdentifier, err.Error()))
+	}
+	if projectRef == nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("project '%s' not found", projectIdentifier))
+	}
+
+	qts, err := testquarantine.FindByProject(ctx, projectRef.Id)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("fetching quarantined tests for project '%s': %s", projectIdentifier, err.Error()))
+	}
+	res := []*restModel.APIQuarantinedTest{}
+	for _, qt := range qts {
+		apiQT := &restModel.APIQuarantinedTest{}
+		apiQT.BuildFromService(qt)
+		res = append(res, apiQT)
+	}
+	return res, nil
+}
+
 // RepoEvents is the resolver for the repoEvents field.
 func (r *queryResolver) RepoEvents(ctx context.Context, repoID string, limit *int, before *time.Time) (*ProjectEvents, error) {
 	timestamp := time.Now()
@@ -860,6 +885,24 @@ func (r *queryResolver) UserConfig(ctx context.Context) (*UserConfig, error) {
 	return config, nil
 }
 
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, subscriptionID string, status *string) ([]*restModel.APIWebhookDelivery, error) {
+	usr := mustHaveUser(ctx)
+	deliveries, err := data.GetWebhookDeliveries(ctx, usr, subscriptionID, utility.FromStringPtr(status))
+	if err != nil {
+		gimletErr, ok := err.(gimlet.ErrorResponse)
+		if ok {
+			return nil, mapHTTPStatusToGqlError(ctx, gimletErr.StatusCode, err)
+		}
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting webhook deliveries for subscription '%s': %s", subscriptionID, err.Error()))
+	}
+	result := make([]*restModel.APIWebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		result = append(result, &deliveries[i])
+	}
+	return result, nil
+}
+
 // BuildVariantsForTaskName is the resolver for the buildVariantsForTaskName field.
 func (r *queryResolver) BuildVariantsForTaskName(ctx context.Context, projectIdentifier string, taskName string) ([]*task.BuildVariantTuple, error) {
 	pid, err := model.GetIdForProject(ctx, projectIdentifier)
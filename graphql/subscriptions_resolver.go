@@ -46,6 +46,20 @@ func (r *subscriberWrapperResolver) Subscriber(ctx context.Context, obj *model.A
 				event.JIRAIssueSubscriberType, err.Error()))
 		}
 		res.JiraIssueSubscriber = sub
+	case event.TeamsSubscriberType:
+		sub := &model.APITeamsSubscriber{}
+		if err := mapstructure.Decode(obj.Target, &sub); err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("building '%s' subscriber from service: %s",
+				event.TeamsSubscriberType, err.Error()))
+		}
+		res.TeamsSubscriber = sub
+	case event.ChatSubscriberType:
+		sub := &model.APIChatSubscriber{}
+		if err := mapstructure.Decode(obj.Target, &sub); err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("building '%s' subscriber from service: %s",
+				event.ChatSubscriberType, err.Error()))
+		}
+		res.ChatSubscriber = sub
 	case event.JIRACommentSubscriberType:
 		res.JiraCommentSubscriber = obj.Target.(*string)
 	case event.EmailSubscriberType:
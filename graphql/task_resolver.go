@@ -13,6 +13,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/cost"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -40,6 +41,7 @@ func (r *taskResolver) AbortInfo(ctx context.Context, obj *restModel.APITask) (*
 		TaskID:     obj.AbortInfo.TaskID,
 		NewVersion: obj.AbortInfo.NewVersion,
 		PrClosed:   obj.AbortInfo.PRClosed,
+		Reason:     obj.AbortInfo.Reason,
 	}
 
 	if len(obj.AbortInfo.TaskID) > 0 {
@@ -712,6 +714,21 @@ func (r *taskResolver) ProjectIdentifier(ctx context.Context, obj *restModel.API
 	return obj.ProjectIdentifier, nil
 }
 
+// ResourceUsage is the resolver for the resourceUsage field.
+func (r *taskResolver) ResourceUsage(ctx context.Context, obj *restModel.APITask) (*restModel.APITaskResourceUsage, error) {
+	taskID := utility.FromStringPtr(obj.Id)
+	usage, err := resourceusage.FindOneByTaskExecution(ctx, taskID, obj.Execution)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding resource usage for task '%s' with execution %d: %s", taskID, obj.Execution, err.Error()))
+	}
+	if usage == nil {
+		return nil, nil
+	}
+	apiUsage := &restModel.APITaskResourceUsage{}
+	apiUsage.BuildFromService(*usage)
+	return apiUsage, nil
+}
+
 // SpawnHostLink is the resolver for the spawnHostLink field.
 func (r *taskResolver) SpawnHostLink(ctx context.Context, obj *restModel.APITask) (*string, error) {
 	hostID := utility.FromStringPtr(obj.HostId)
@@ -801,8 +818,11 @@ func (r *taskResolver) Tests(ctx context.Context, obj *restModel.APITask, opts *
 	// Return early if it is known that there are no test results to return.
 	// Display tasks cannot take advantage of this optimization since they
 	// don't populate ResultsFailed.
+	// Quarantined failures do not populate ResultsFailed, so they cannot be
+	// ruled out this way.
 	if opts != nil && !obj.DisplayOnly && len(opts.Statuses) > 0 {
-		diffFailureStatuses := utility.GetSetDifference(opts.Statuses, evergreen.TestFailureStatuses)
+		resultsFailedStatuses := utility.GetSetDifference(evergreen.TestFailureStatuses, []string{evergreen.TestQuarantinedFailedStatus})
+		diffFailureStatuses := utility.GetSetDifference(opts.Statuses, resultsFailedStatuses)
 		if len(diffFailureStatuses) == 0 && !obj.ResultsFailed {
 			return &TaskTestResult{
 				TestResults:       []*restModel.APITest{},
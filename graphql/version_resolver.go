@@ -149,6 +149,18 @@ func (r *versionResolver) Cost(ctx context.Context, obj *restModel.APIVersion) (
 	return &rounded, nil
 }
 
+// CriticalPath is the resolver for the criticalPath field.
+func (r *versionResolver) CriticalPath(ctx context.Context, obj *restModel.APIVersion) (*restModel.APICriticalPath, error) {
+	versionID := utility.FromStringPtr(obj.Id)
+	cp, err := task.VersionCriticalPath(ctx, versionID)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("computing critical path for version '%s': %s", versionID, err.Error()))
+	}
+	apiCP := &restModel.APICriticalPath{}
+	apiCP.BuildFromService(*cp)
+	return apiCP, nil
+}
+
 // ExternalLinksForMetadata is the resolver for the externalLinksForMetadata field.
 func (r *versionResolver) ExternalLinksForMetadata(ctx context.Context, obj *restModel.APIVersion) ([]*ExternalLinkForMetadata, error) {
 	projectID := utility.FromStringPtr(obj.Project)
@@ -25,6 +25,8 @@ const (
 	TaskFailTransitionId     = "task_transition_failure"
 	FirstRegressionInVersion = "first_regression_in_version"
 	taskRegressionByTest     = "task-regression-by-test"
+	TestNewFailureId         = "test_new_failure"
+	TestConsecutiveFailureId = "test_consecutive_failure"
 )
 
 // Host triggers
@@ -35,6 +37,12 @@ const (
 	alertableInstanceTypeWarning          = "alertable_instance_type"
 )
 
+// Project triggers
+const (
+	monthlyCostBudgetTemplate = "monthly_cost_budget_%d"
+	versionCostBudgetTemplate = "version_cost_budget_%d"
+)
+
 const legacyAlertsSubscription = "legacy-alerts"
 
 type AlertRecord struct {
@@ -176,6 +184,19 @@ func FindByTaskRegressionTestAndOrderNumber(ctx context.Context, subscriptionID,
 	return FindOne(ctx, db.Query(q))
 }
 
+// FindByTestFailure finds the alert record of the given type for the test in
+// the task at the given revision.
+func FindByTestFailure(ctx context.Context, subscriptionID, alertType, testName, taskDisplayName, variant, projectID string, revisionOrderNumber int) (*AlertRecord, error) {
+	q := subscriptionIDQuery(subscriptionID)
+	q[TypeKey] = alertType
+	q[testNameKey] = testName
+	q[TaskNameKey] = taskDisplayName
+	q[VariantKey] = variant
+	q[ProjectIdKey] = projectID
+	q[RevisionOrderNumberKey] = revisionOrderNumber
+	return FindOne(ctx, db.Query(q).Limit(1))
+}
+
 // FindByMostRecentSpawnHostExpirationWithHours finds the most recent alert
 // record for a spawn host that is about to expire.
 func FindByMostRecentSpawnHostExpirationWithHours(ctx context.Context, hostID string, hours int) (*AlertRecord, error) {
@@ -213,6 +234,26 @@ func FindByMostRecentAlertableInstanceType(ctx context.Context, hostID string) (
 	return FindOne(ctx, db.Query(q).Sort([]string{"-" + AlertTimeKey}).Limit(1))
 }
 
+// FindByMonthlyCostBudgetThreshold finds the alert record for a project whose
+// spend crossed the given percentage of its monthly cost budget at or after the
+// start of the month.
+func FindByMonthlyCostBudgetThreshold(ctx context.Context, projectID string, threshold int, monthStart time.Time) (*AlertRecord, error) {
+	q := subscriptionIDQuery(legacyAlertsSubscription)
+	q[TypeKey] = fmt.Sprintf(monthlyCostBudgetTemplate, threshold)
+	q[ProjectIdKey] = projectID
+	q[AlertTimeKey] = bson.M{"$gte": monthStart}
+	return FindOne(ctx, db.Query(q).Sort([]string{"-" + AlertTimeKey}).Limit(1))
+}
+
+// FindByVersionCostBudgetThreshold finds the alert record for a version whose
+// spend crossed the given percentage of its project's version cost budget.
+func FindByVersionCostBudgetThreshold(ctx context.Context, versionID string, threshold int) (*AlertRecord, error) {
+	q := subscriptionIDQuery(legacyAlertsSubscription)
+	q[TypeKey] = fmt.Sprintf(versionCostBudgetTemplate, threshold)
+	q[VersionIdKey] = versionID
+	return FindOne(ctx, db.Query(q).Limit(1))
+}
+
 func InsertNewTaskRegressionByTestRecord(ctx context.Context, subscriptionID, taskID, testName, taskDisplayName, variant, projectID string, revision int) error {
 	record := AlertRecord{
 		Id:                  mgobson.NewObjectId(),
@@ -230,6 +271,25 @@ func InsertNewTaskRegressionByTestRecord(ctx context.Context, subscriptionID, ta
 	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", taskRegressionByTest)
 }
 
+// InsertNewTestFailureRecord records that an alert of the given type was sent
+// for the test in the task.
+func InsertNewTestFailureRecord(ctx context.Context, subscriptionID, alertType, taskID, testName, taskDisplayName, variant, projectID string, revision int) error {
+	record := AlertRecord{
+		Id:                  mgobson.NewObjectId(),
+		SubscriptionID:      subscriptionID,
+		Type:                alertType,
+		TaskId:              taskID,
+		ProjectId:           projectID,
+		TaskName:            taskDisplayName,
+		Variant:             variant,
+		TestName:            testName,
+		RevisionOrderNumber: revision,
+		AlertTime:           time.Now(),
+	}
+
+	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertType)
+}
+
 func InsertNewSpawnHostExpirationRecord(ctx context.Context, hostID string, hours int) error {
 	alertType := fmt.Sprintf(spawnHostWarningTemplate, hours)
 	record := AlertRecord{
@@ -283,3 +343,35 @@ func InsertNewAlertableInstanceTypeRecord(ctx context.Context, hostID string) er
 
 	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertableInstanceTypeWarning)
 }
+
+// InsertNewMonthlyCostBudgetRecord inserts a new alert record for a project
+// whose spend crossed the given percentage of its monthly cost budget.
+func InsertNewMonthlyCostBudgetRecord(ctx context.Context, projectID string, threshold int) error {
+	alertType := fmt.Sprintf(monthlyCostBudgetTemplate, threshold)
+	record := AlertRecord{
+		Id:             mgobson.NewObjectId(),
+		SubscriptionID: legacyAlertsSubscription,
+		Type:           alertType,
+		ProjectId:      projectID,
+		AlertTime:      time.Now(),
+	}
+
+	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertType)
+}
+
+// InsertNewVersionCostBudgetRecord inserts a new alert record for a version
+// whose spend crossed the given percentage of its project's version cost
+// budget.
+func InsertNewVersionCostBudgetRecord(ctx context.Context, projectID, versionID string, threshold int) error {
+	alertType := fmt.Sprintf(versionCostBudgetTemplate, threshold)
+	record := AlertRecord{
+		Id:             mgobson.NewObjectId(),
+		SubscriptionID: legacyAlertsSubscription,
+		Type:           alertType,
+		ProjectId:      projectID,
+		VersionId:      versionID,
+		AlertTime:      time.Now(),
+	}
+
+	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertType)
+}
@@ -7,14 +7,20 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
 const Collection = "artifact_files"
 
+// S3PutCommand is the name of the command whose uploads can be expired by a
+// project's artifact retention policy.
+const S3PutCommand = "s3.put"
+
 const (
 	// strings for setting visibility
 	Public  = "public"
@@ -36,6 +42,17 @@ type Entry struct {
 	Files           []File    `json:"files" bson:"files"`
 	Execution       int       `json:"execution" bson:"execution"`
 	CreateTime      time.Time `json:"create_time" bson:"create_time"`
+	// ProjectID, VersionID and Requester identify which of the project's
+	// artifact retention policies applies to the files.
+	ProjectID string `json:"project,omitempty" bson:"project,omitempty"`
+	VersionID string `json:"version,omitempty" bson:"version,omitempty"`
+	Requester string `json:"requester,omitempty" bson:"requester,omitempty"`
+	// ExpiredAt is when the project's artifact retention policy finished
+	// expiring the entry's files.
+	ExpiredAt time.Time `json:"expired_at,omitzero" bson:"expired_at,omitempty"`
+	// ExpirationFailedAt is when the project's artifact retention policy last
+	// failed to expire some of the entry's files.
+	ExpirationFailedAt time.Time `json:"expiration_failed_at,omitzero" bson:"expiration_failed_at,omitempty"`
 }
 
 // Params stores file entries as key-value pairs, for easy parameter parsing.
@@ -85,6 +102,14 @@ type File struct {
 	AssociatedLinks []AssociatedLink `json:"associated_links,omitempty" bson:"associated_links,omitempty"`
 	// DoNotEncodeLink indicates that the file link should not be escaped.
 	DoNotEncodeLink bool `json:"do_not_encode_link,omitempty" bson:"do_not_encode_link,omitempty"`
+	// Command is the name of the command that uploaded the file, if it was
+	// uploaded by Evergreen.
+	Command string `json:"command,omitempty" bson:"command,omitempty"`
+	// Region is the S3 region of the file's bucket.
+	Region string `json:"region,omitempty" bson:"region,omitempty"`
+	// Expired indicates that the file was expired by the project's artifact
+	// retention policy, so it is no longer shown.
+	Expired bool `json:"expired,omitempty" bson:"expired,omitempty"`
 }
 
 func (f *File) validate() error {
@@ -96,13 +121,25 @@ func (f *File) validate() error {
 	return catcher.Resolve()
 }
 
+// isExpirable returns whether the file can be expired by a project's artifact
+// retention policy. Only s3.put uploads whose credentials were stored with the
+// file can be expired, so that files are never deleted from buckets that the
+// task did not upload them to or with credentials other than the task's own.
+func (f *File) isExpirable() bool {
+	if f.Expired || f.Command != S3PutCommand || f.validate() != nil {
+		return false
+	}
+	return f.AWSRoleARN != "" || (f.AWSKey != "" && f.AWSSecret != "")
+}
+
 // StripHiddenFiles is a helper for only showing users the files they are
-// allowed to see. It also pre-signs file URLs.
+// allowed to see. Files that have expired are never shown. It also pre-signs
+// file URLs.
 func StripHiddenFiles(ctx context.Context, files []File, hasUser bool) ([]File, error) {
 	publicFiles := []File{}
 	for _, file := range files {
 		switch {
-		case file.Visibility == None:
+		case file.Visibility == None || file.Expired:
 			continue
 		case (file.Visibility == Private || file.Visibility == Signed) && !hasUser:
 			continue
@@ -147,6 +184,92 @@ func presignFile(ctx context.Context, file File) (string, error) {
 	return pail.PreSign(ctx, requestParams)
 }
 
+// Expire deletes the entry's expirable files from S3 and marks them expired so
+// that they are no longer shown. An object that another unexpired entry also
+// links to is not deleted, but the file is still marked expired in this entry.
+// Once all of the entry's expirable files are expired, it records when the
+// entry expired. Otherwise, it records the failure so that the entry is retried
+// later. It returns the number of objects deleted.
+func (e *Entry) Expire(ctx context.Context) (int, error) {
+	var expirable []File
+	for _, file := range e.Files {
+		if file.isExpirable() {
+			expirable = append(expirable, file)
+		}
+	}
+	shared, err := e.findSharedFileLocations(ctx, expirable)
+	if err != nil {
+		return 0, errors.Wrap(err, "checking whether files are used by other artifacts")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	files := make([]File, len(e.Files))
+	var removed int
+	for i, file := range e.Files {
+		files[i] = file
+		if !file.isExpirable() {
+			continue
+		}
+		if !shared[fileLocation{bucket: file.Bucket, fileKey: file.FileKey}] {
+			if err := removeFile(ctx, file); err != nil {
+				catcher.Wrapf(err, "removing file '%s' from bucket '%s'", file.FileKey, file.Bucket)
+				continue
+			}
+			removed++
+		}
+		files[i].Expired = true
+	}
+
+	now := time.Now()
+	if catcher.HasErrors() {
+		catcher.Wrap(e.setExpirationFailed(ctx, files, now), "recording failure to expire artifact files")
+		e.Files = files
+		e.ExpirationFailedAt = now
+		return removed, catcher.Resolve()
+	}
+	if err := e.setExpired(ctx, files, now); err != nil {
+		return removed, errors.Wrap(err, "marking artifact files as expired")
+	}
+	e.Files = files
+	e.ExpiredAt = now
+
+	return removed, nil
+}
+
+// removeFile deletes the file's object from S3 using the credentials that it
+// was uploaded with.
+func removeFile(ctx context.Context, file File) error {
+	region := file.Region
+	if region == "" {
+		region = evergreen.DefaultS3Region
+	}
+	opts := pail.S3Options{
+		Name:       file.Bucket,
+		Region:     region,
+		MaxRetries: utility.ToIntPtr(evergreen.DefaultS3MaxRetries),
+	}
+	switch {
+	case file.AWSRoleARN != "":
+		opts.AssumeRoleARN = file.AWSRoleARN
+		if file.ExternalID != "" {
+			externalID := file.ExternalID
+			opts.AssumeRoleOptions = []func(*stscreds.AssumeRoleOptions){
+				func(aro *stscreds.AssumeRoleOptions) {
+					aro.ExternalID = &externalID
+				},
+			}
+		}
+	case file.AWSKey != "" && file.AWSSecret != "":
+		opts.Credentials = pail.CreateAWSStaticCredentials(file.AWSKey, file.AWSSecret, "")
+	}
+
+	bucket, err := pail.NewS3Bucket(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "creating S3 bucket")
+	}
+	return bucket.Remove(ctx, file.FileKey)
+}
+
 func GetAllArtifacts(ctx context.Context, tasks []TaskIDAndExecution) ([]File, error) {
 	artifacts, err := FindAll(ctx, ByTaskIdsAndExecutions(tasks))
 	if err != nil {
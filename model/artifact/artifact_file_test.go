@@ -2,12 +2,15 @@ package artifact
 
 import (
 	"testing"
+	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	_ "github.com/evergreen-ci/evergreen/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type TestArtifactFileSuite struct {
@@ -253,6 +256,151 @@ func (s *TestArtifactFileSuite) TestEscapeFiles() {
 	s.Equal("https://example.com/coverage#report.html", escapedFiles[6].AssociatedLinks[1].Link, "should not escape associated link if DoNotEncodeLink is true")
 }
 
+func (s *TestArtifactFileSuite) TestStripHiddenFilesExcludesExpiredFiles() {
+	files := []File{
+		{Name: "current", Link: "https://example.com/current", Visibility: Public},
+		{Name: "expired", Link: "https://example.com/expired", Visibility: Public, Expired: true},
+	}
+	stripped, err := StripHiddenFiles(s.T().Context(), files, true)
+	s.Require().NoError(err)
+	s.Require().Len(stripped, 1)
+	s.Equal("current", stripped[0].Name)
+}
+
+func (s *TestArtifactFileSuite) TestIsExpirable() {
+	s.True((&File{Bucket: "bucket", FileKey: "key", Command: S3PutCommand, AWSRoleARN: "role"}).isExpirable())
+	s.True((&File{Bucket: "bucket", FileKey: "key", Command: S3PutCommand, AWSKey: "key", AWSSecret: "secret"}).isExpirable())
+	s.False((&File{Bucket: "bucket", FileKey: "key", Command: S3PutCommand, AWSRoleARN: "role", Expired: true}).isExpirable())
+	s.False((&File{Bucket: "bucket", FileKey: "key", Command: S3PutCommand}).isExpirable(), "files without stored credentials should not be expirable")
+	s.False((&File{Bucket: "bucket", FileKey: "key", Command: "s3.copy", AWSRoleARN: "role"}).isExpirable(), "files from other commands should not be expirable")
+	s.False((&File{Bucket: "bucket", FileKey: "key", AWSRoleARN: "role"}).isExpirable())
+	s.False((&File{Link: "https://example.com", Command: S3PutCommand, AWSRoleARN: "role"}).isExpirable())
+}
+
+func (s *TestArtifactFileSuite) TestExpire() {
+	s.Require().NoError(db.EnsureIndex(Collection, mongo.IndexModel{Keys: ExpirationIndex}))
+	s.Require().NoError(db.EnsureIndex(Collection, mongo.IndexModel{Keys: FileLocationIndex}))
+
+	ctx := s.T().Context()
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	entries := []Entry{
+		{
+			TaskId:          "patch_task",
+			TaskDisplayName: "Patch Task",
+			BuildId:         "patch_build",
+			ProjectID:       "project",
+			VersionID:       "patch_version",
+			Requester:       evergreen.PatchVersionRequester,
+			CreateTime:      old,
+			Files: []File{
+				{Name: "external", Link: "https://example.com/external"},
+				{Name: "already_expired", Link: "https://example.com/already_expired", Bucket: "bucket", FileKey: "key", Command: S3PutCommand, AWSRoleARN: "role", Expired: true},
+				{Name: "copied", Link: "https://example.com/copied", Bucket: "release_bucket", FileKey: "copied", Command: "s3.copy", AWSRoleARN: "role"},
+				{Name: "no_credentials", Link: "https://example.com/no_credentials", Bucket: "bucket", FileKey: "no_credentials", Command: S3PutCommand},
+				{Name: "shared", Link: "https://example.com/shared", Bucket: "bucket", FileKey: "latest/shared", Command: S3PutCommand, AWSRoleARN: "role"},
+			},
+		},
+		{
+			TaskId:          "pinned_task",
+			TaskDisplayName: "Pinned Task",
+			BuildId:         "pinned_build",
+			ProjectID:       "project",
+			VersionID:       "pinned_version",
+			Requester:       evergreen.PatchVersionRequester,
+			CreateTime:      old,
+			Files: []File{
+				{Name: "shared", Link: "https://example.com/shared", Bucket: "bucket", FileKey: "latest/shared", Command: S3PutCommand, AWSRoleARN: "role"},
+			},
+		},
+		{
+			TaskId:          "recent_task",
+			TaskDisplayName: "Recent Task",
+			BuildId:         "recent_build",
+			ProjectID:       "project",
+			VersionID:       "recent_version",
+			Requester:       evergreen.PatchVersionRequester,
+			CreateTime:      time.Now(),
+		},
+	}
+	for _, entry := range entries {
+		s.Require().NoError(entry.Upsert(ctx))
+	}
+
+	cutoff := time.Now().Add(-14 * 24 * time.Hour)
+	candidates, err := FindExpirationCandidates(ctx, "project", evergreen.PatchRequesters, cutoff, []string{"pinned_version"}, 10)
+	s.Require().NoError(err)
+	s.Require().Len(candidates, 1)
+	s.Equal("patch_task", candidates[0].TaskId)
+	entry := candidates[0]
+
+	candidates, err = FindExpirationCandidates(ctx, "project", []string{evergreen.RepotrackerVersionRequester}, cutoff, nil, 10)
+	s.Require().NoError(err)
+	s.Empty(candidates)
+
+	removed, err := entry.Expire(ctx)
+	s.Require().NoError(err)
+	s.Zero(removed, "only the shared file is expirable, and its object should be kept for the pinned version")
+
+	dbEntry, err := FindOne(ctx, ByTaskId("patch_task"))
+	s.Require().NoError(err)
+	s.Require().NotNil(dbEntry)
+	s.False(dbEntry.ExpiredAt.IsZero())
+	s.Require().Len(dbEntry.Files, 5)
+	s.False(dbEntry.Files[0].Expired, "file without a bucket should not expire")
+	s.True(dbEntry.Files[1].Expired)
+	s.False(dbEntry.Files[2].Expired, "file from another command should not expire")
+	s.False(dbEntry.Files[3].Expired, "file without credentials should not expire")
+	s.True(dbEntry.Files[4].Expired, "shared file should no longer be shown for the expired entry")
+
+	pinnedEntry, err := FindOne(ctx, ByTaskId("pinned_task"))
+	s.Require().NoError(err)
+	s.Require().NotNil(pinnedEntry)
+	s.Require().Len(pinnedEntry.Files, 1)
+	s.False(pinnedEntry.Files[0].Expired)
+
+	candidates, err = FindExpirationCandidates(ctx, "project", evergreen.PatchRequesters, cutoff, []string{"pinned_version"}, 10)
+	s.Require().NoError(err)
+	s.Empty(candidates)
+}
+
+func (s *TestArtifactFileSuite) TestFindSharedFileLocations() {
+	ctx := s.T().Context()
+	s.Require().NoError(db.EnsureIndex(Collection, mongo.IndexModel{Keys: FileLocationIndex}))
+
+	entry := Entry{
+		TaskId:  "task",
+		BuildId: "build",
+		Files: []File{
+			{Name: "shared", Bucket: "bucket", FileKey: "shared"},
+			{Name: "expired_elsewhere", Bucket: "bucket", FileKey: "expired_elsewhere"},
+			{Name: "other_bucket", Bucket: "bucket", FileKey: "other_bucket"},
+			{Name: "unshared", Bucket: "bucket", FileKey: "unshared"},
+		},
+	}
+	other := Entry{
+		TaskId:  "other_task",
+		BuildId: "other_build",
+		Files: []File{
+			{Name: "shared", Bucket: "bucket", FileKey: "shared"},
+			{Name: "expired_elsewhere", Bucket: "bucket", FileKey: "expired_elsewhere", Expired: true},
+			{Name: "other_bucket", Bucket: "other_bucket", FileKey: "other_bucket"},
+		},
+	}
+	s.Require().NoError(entry.Upsert(ctx))
+	s.Require().NoError(other.Upsert(ctx))
+
+	shared, err := entry.findSharedFileLocations(ctx, entry.Files)
+	s.Require().NoError(err)
+	s.True(shared[fileLocation{bucket: "bucket", fileKey: "shared"}])
+	s.False(shared[fileLocation{bucket: "bucket", fileKey: "expired_elsewhere"}], "file that the other entry expired should not be shared")
+	s.False(shared[fileLocation{bucket: "bucket", fileKey: "other_bucket"}], "file with the same key in another bucket should not be shared")
+	s.False(shared[fileLocation{bucket: "bucket", fileKey: "unshared"}])
+
+	shared, err = entry.findSharedFileLocations(ctx, nil)
+	s.Require().NoError(err)
+	s.Empty(shared)
+}
+
 func TestLooksAlreadyEscaped(t *testing.T) {
 	assert.True(t, looksAlreadyEscaped("file%231.tar.gz"))
 	assert.True(t, looksAlreadyEscaped("file%25231.tar.gz"))
@@ -2,6 +2,7 @@ package artifact
 
 import (
 	"context"
+	"time"
 
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
@@ -22,8 +23,36 @@ var (
 	ContentTypeKey = bsonutil.MustHaveTag(File{}, "ContentType")
 	AWSSecretKey   = bsonutil.MustHaveTag(File{}, "AWSSecret")
 	FileKeyKey     = bsonutil.MustHaveTag(File{}, "FileKey")
+	ProjectIDKey   = bsonutil.MustHaveTag(Entry{}, "ProjectID")
+	VersionIDKey   = bsonutil.MustHaveTag(Entry{}, "VersionID")
+	RequesterKey   = bsonutil.MustHaveTag(Entry{}, "Requester")
+	ExpiredAtKey   = bsonutil.MustHaveTag(Entry{}, "ExpiredAt")
+	BucketKey      = bsonutil.MustHaveTag(File{}, "Bucket")
+	FileExpiredKey = bsonutil.MustHaveTag(File{}, "Expired")
+
+	expirationFailedAtKey = bsonutil.MustHaveTag(Entry{}, "ExpirationFailedAt")
+)
+
+var (
+	// ExpirationIndex is used to find a project's artifacts that have
+	// expired.
+	ExpirationIndex = bson.D{
+		{Key: ProjectIDKey, Value: 1},
+		{Key: RequesterKey, Value: 1},
+		{Key: CreateTimeKey, Value: 1},
+	}
+	// FileLocationIndex is used to find the artifacts that link to an
+	// object before it is expired.
+	FileLocationIndex = bson.D{
+		{Key: bsonutil.GetDottedKeyName(FilesKey, BucketKey), Value: 1},
+		{Key: bsonutil.GetDottedKeyName(FilesKey, FileKeyKey), Value: 1},
+	}
 )
 
+// expirationRetryInterval is how long to wait before retrying to expire an
+// entry whose files could not all be expired.
+const expirationRetryInterval = 24 * time.Hour
+
 type TaskIDAndExecution struct {
 	TaskID    string
 	Execution int
@@ -87,13 +116,114 @@ func (e Entry) Upsert(ctx context.Context) error {
 				},
 			},
 			"$setOnInsert": bson.M{
-				ExecutionKey: e.Execution,
+				ExecutionKey:  e.Execution,
+				CreateTimeKey: e.CreateTime,
+				ProjectIDKey:  e.ProjectID,
+				VersionIDKey:  e.VersionID,
+				RequesterKey:  e.Requester,
 			},
 		},
 	)
 	return err
 }
 
+// FindExpirationCandidates returns up to limit of the project's entries with
+// one of the given requesters that were created before the given time and
+// have not expired yet, from the earliest created. Entries from the excluded
+// versions are not returned, nor are entries that recently failed to expire.
+func FindExpirationCandidates(ctx context.Context, projectID string, requesters []string, createdBefore time.Time, excludeVersions []string, limit int) ([]Entry, error) {
+	filter := bson.M{
+		ProjectIDKey:  projectID,
+		RequesterKey:  bson.M{"$in": requesters},
+		CreateTimeKey: bson.M{"$lt": createdBefore},
+		ExpiredAtKey:  bson.M{"$exists": false},
+		"$or": []bson.M{
+			{expirationFailedAtKey: bson.M{"$exists": false}},
+			{expirationFailedAtKey: bson.M{"$lt": time.Now().Add(-expirationRetryInterval)}},
+		},
+	}
+	if len(excludeVersions) > 0 {
+		filter[VersionIDKey] = bson.M{"$nin": excludeVersions}
+	}
+	return FindAll(ctx, db.Query(filter).Sort([]string{CreateTimeKey}).Limit(limit))
+}
+
+// fileLocation identifies the S3 object that a file links to.
+type fileLocation struct {
+	bucket  string
+	fileKey string
+}
+
+// findSharedFileLocations returns the locations of the given files' objects
+// that any other entry links to without having expired them.
+func (e *Entry) findSharedFileLocations(ctx context.Context, files []File) (map[fileLocation]bool, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	locations := make([]bson.M, 0, len(files))
+	for _, file := range files {
+		locations = append(locations, bson.M{
+			BucketKey:  file.Bucket,
+			FileKeyKey: file.FileKey,
+		})
+	}
+	others, err := FindAll(ctx, db.Query(bson.M{
+		"$nor": []bson.M{e.idFilter()},
+		FilesKey: bson.M{"$elemMatch": bson.M{
+			"$or":          locations,
+			FileExpiredKey: bson.M{"$ne": true},
+		}},
+	}).WithFields(
+		bsonutil.GetDottedKeyName(FilesKey, BucketKey),
+		bsonutil.GetDottedKeyName(FilesKey, FileKeyKey),
+		bsonutil.GetDottedKeyName(FilesKey, FileExpiredKey),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	shared := map[fileLocation]bool{}
+	for _, other := range others {
+		for _, file := range other.Files {
+			if !file.Expired {
+				shared[fileLocation{bucket: file.Bucket, fileKey: file.FileKey}] = true
+			}
+		}
+	}
+	return shared, nil
+}
+
+// setExpired replaces the entry's files and records when the entry expired.
+func (e *Entry) setExpired(ctx context.Context, files []File, expiredAt time.Time) error {
+	return db.Update(ctx, Collection, e.idFilter(), bson.M{
+		"$set": bson.M{
+			FilesKey:     files,
+			ExpiredAtKey: expiredAt,
+		},
+	})
+}
+
+// setExpirationFailed replaces the entry's files and records when the entry
+// failed to expire.
+func (e *Entry) setExpirationFailed(ctx context.Context, files []File, failedAt time.Time) error {
+	return db.Update(ctx, Collection, e.idFilter(), bson.M{
+		"$set": bson.M{
+			FilesKey:              files,
+			expirationFailedAtKey: failedAt,
+		},
+	})
+}
+
+// idFilter returns the filter that identifies the entry.
+func (e *Entry) idFilter() bson.M {
+	return bson.M{
+		TaskIdKey:    e.TaskId,
+		TaskNameKey:  e.TaskDisplayName,
+		BuildIdKey:   e.BuildId,
+		ExecutionKey: e.Execution,
+	}
+}
+
 // FindOne gets one Entry for the given query
 func FindOne(ctx context.Context, query db.Q) (*Entry, error) {
 	entry := &Entry{}
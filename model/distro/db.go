@@ -38,6 +38,7 @@ var (
 	FinderSettingsKey        = bsonutil.MustHaveTag(Distro{}, "FinderSettings")
 	HomeVolumeSettingsKey    = bsonutil.MustHaveTag(Distro{}, "HomeVolumeSettings")
 	HostAllocatorSettingsKey = bsonutil.MustHaveTag(Distro{}, "HostAllocatorSettings")
+	PreemptionSettingsKey    = bsonutil.MustHaveTag(Distro{}, "PreemptionSettings")
 	DisableShallowCloneKey   = bsonutil.MustHaveTag(Distro{}, "DisableShallowClone")
 	ValidProjectsKey         = bsonutil.MustHaveTag(Distro{}, "ValidProjects")
 	IsVirtualWorkstationKey  = bsonutil.MustHaveTag(Distro{}, "IsVirtualWorkstation")
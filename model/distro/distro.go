@@ -50,6 +50,7 @@ type Distro struct {
 	PlannerSettings       PlannerSettings       `bson:"planner_settings" json:"planner_settings" mapstructure:"planner_settings"`
 	DispatcherSettings    DispatcherSettings    `bson:"dispatcher_settings" json:"dispatcher_settings" mapstructure:"dispatcher_settings"`
 	HostAllocatorSettings HostAllocatorSettings `bson:"host_allocator_settings" json:"host_allocator_settings" mapstructure:"host_allocator_settings"`
+	PreemptionSettings    PreemptionSettings    `bson:"preemption_settings,omitempty" json:"preemption_settings,omitempty" mapstructure:"preemption_settings,omitempty"`
 	DisableShallowClone   bool                  `bson:"disable_shallow_clone" json:"disable_shallow_clone" mapstructure:"disable_shallow_clone"`
 	Note                  string                `bson:"note" json:"note" mapstructure:"note"`
 	WarningNote           string                `bson:"warning_note,omitempty" json:"warning_note,omitempty" mapstructure:"warning_note,omitempty"`
@@ -279,6 +280,20 @@ type HostAllocatorSettings struct {
 	FutureHostFraction     float64       `bson:"future_host_fraction" json:"future_host_fraction" mapstructure:"future_host_fraction"`
 }
 
+// PreemptionSettings control when the host allocator may abort and requeue
+// running low priority tasks so that starved high priority tasks can run on
+// their hosts.
+type PreemptionSettings struct {
+	// Enabled determines whether the distro's running tasks can be preempted.
+	Enabled bool `bson:"enabled" json:"enabled" mapstructure:"enabled"`
+	// PriorityThreshold is the minimum priority of a queued task for it to
+	// be able to preempt running tasks.
+	PriorityThreshold int64 `bson:"priority_threshold" json:"priority_threshold" mapstructure:"priority_threshold"`
+	// WaitLimit is how long a queued task at or above the priority threshold
+	// can wait for a host before running tasks are preempted for it.
+	WaitLimit time.Duration `bson:"wait_limit" json:"wait_limit" mapstructure:"wait_limit"`
+}
+
 type FinderSettings struct {
 	Version string `bson:"version" json:"version" mapstructure:"version"`
 }
@@ -295,10 +310,25 @@ type PlannerSettings struct {
 	GenerateTaskFactor        int64         `bson:"generate_task_factor" json:"generate_task_factor" mapstructure:"generate_task_factor"`
 	NumDependentsFactor       float64       `bson:"num_dependents_factor" json:"num_dependents_factor" mapstructure:"num_dependents_factor"`
 	StepbackTaskFactor        int64         `bson:"stepback_task_factor" json:"stepback_task_factor" mapstructure:"stepback_task_factor"`
+	// ProjectShares are the relative shares of the distro's host time that
+	// each project or repo is entitled to. They only apply to the fair-share
+	// planner; projects without a share default to a share of 1.
+	ProjectShares []ProjectShare `bson:"project_shares,omitempty" json:"project_shares,omitempty" mapstructure:"project_shares,omitempty"`
 
 	maxDurationPerHost time.Duration
 }
 
+// ProjectShare is the relative share of a distro's host time that a project or
+// all of the projects attached to a repo are entitled to.
+type ProjectShare struct {
+	// ID is the ID of a project ref or a repo ref.
+	ID    string  `bson:"id" json:"id" mapstructure:"id"`
+	Share float64 `bson:"share" json:"share" mapstructure:"share"`
+}
+
+// DefaultProjectShare is the share of a project that has no explicit share.
+const DefaultProjectShare = 1.0
+
 type DispatcherSettings struct {
 	Version string `bson:"version" json:"version" mapstructure:"version"`
 }
@@ -312,6 +342,7 @@ type Expansion struct {
 
 const (
 	DockerImageBuildTypeImport = "import"
+	DockerImageBuildTypePull   = "pull"
 
 	// Bootstrapping mechanisms
 	// BootstrapMethodNone is for internal use only.
@@ -412,7 +443,7 @@ func (d *Distro) GenerateName() string {
 	switch d.Provider {
 	case evergreen.ProviderNameStatic:
 		return "static"
-	case evergreen.ProviderNameDocker:
+	case evergreen.ProviderNameDocker, evergreen.ProviderNamePodman:
 		return fmt.Sprintf("container-%d", rand.New(rand.NewSource(time.Now().UnixNano())).Int())
 	}
 
@@ -532,9 +563,9 @@ func (d *Distro) GetImageID() (string, error) {
 	switch d.Provider {
 	case evergreen.ProviderNameEc2OnDemand, evergreen.ProviderNameEc2Fleet:
 		key = "ami"
-	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
+	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock, evergreen.ProviderNamePodman:
 		key = "image_url"
-	case evergreen.ProviderNameMock, evergreen.ProviderNameStatic:
+	case evergreen.ProviderNameMock, evergreen.ProviderNameStatic, evergreen.ProviderNameProcess:
 		return "", nil
 	default:
 		return "", errors.New("unknown provider name")
@@ -730,6 +761,7 @@ func (d *Distro) GetResolvedPlannerSettings(s *evergreen.Settings) (PlannerSetti
 		ExpectedRuntimeFactor:     ps.ExpectedRuntimeFactor,
 		GenerateTaskFactor:        ps.GenerateTaskFactor,
 		NumDependentsFactor:       ps.NumDependentsFactor,
+		ProjectShares:             ps.ProjectShares,
 		maxDurationPerHost:        evergreen.MaxDurationPerDistroHost,
 	}
 
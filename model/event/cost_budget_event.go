@@ -0,0 +1,68 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+)
+
+func init() {
+	registry.AddType(ResourceTypeCostBudget, func() any { return &CostBudgetEventData{} })
+
+	registry.AllowSubscription(ResourceTypeCostBudget, EventCostBudgetThresholdReached)
+}
+
+const (
+	ResourceTypeCostBudget = "COST_BUDGET"
+
+	EventCostBudgetThresholdReached = "THRESHOLD_REACHED"
+)
+
+const (
+	// CostBudgetTypeMonthly is a budget for all tasks in a project that
+	// finish in a calendar month.
+	CostBudgetTypeMonthly = "monthly"
+	// CostBudgetTypeVersion is a budget for all tasks in a single version.
+	CostBudgetTypeVersion = "version"
+)
+
+// CostBudgetEventData describes spend that has crossed an alert threshold of
+// one of a project's cost budgets.
+type CostBudgetEventData struct {
+	ProjectID         string `bson:"project_id" json:"project_id"`
+	ProjectIdentifier string `bson:"project_identifier,omitempty" json:"project_identifier,omitempty"`
+	BudgetType        string `bson:"budget_type" json:"budget_type"`
+	// VersionID is the version whose spend crossed the threshold. It is only
+	// set for version budgets.
+	VersionID string `bson:"version_id,omitempty" json:"version_id,omitempty"`
+	// Period is the month, formatted as YYYY-MM, whose spend crossed the
+	// threshold. It is only set for monthly budgets.
+	Period           string  `bson:"period,omitempty" json:"period,omitempty"`
+	Budget           float64 `bson:"budget" json:"budget"`
+	Spend            float64 `bson:"spend" json:"spend"`
+	ThresholdPercent int     `bson:"threshold_percent" json:"threshold_percent"`
+}
+
+// LogCostBudgetThresholdReached logs an event indicating that a project's
+// spend has crossed an alert threshold of one of its cost budgets.
+func LogCostBudgetThresholdReached(ctx context.Context, data CostBudgetEventData) {
+	event := EventLogEntry{
+		Timestamp:    time.Now(),
+		ResourceId:   data.ProjectID,
+		EventType:    EventCostBudgetThresholdReached,
+		Data:         &data,
+		ResourceType: ResourceTypeCostBudget,
+	}
+
+	if err := event.Log(ctx); err != nil {
+		grip.Error(ctx, message.WrapError(err, message.Fields{
+			"resource_type": event.ResourceType,
+			"event_type":    EventCostBudgetThresholdReached,
+			"project_id":    data.ProjectID,
+			"message":       "error logging event",
+			"source":        "event-log-fail",
+		}))
+	}
+}
@@ -0,0 +1,135 @@
+package event
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationTemplateDataVersion is the version of NotificationTemplateData.
+// Fields may be added to the model without changing the version, but the
+// version changes if a field is renamed or removed.
+const NotificationTemplateDataVersion = 1
+
+// templatableSubscriberTypes are the subscriber types whose notification
+// bodies can be rendered from a subscription's template.
+var templatableSubscriberTypes = []string{
+	SlackSubscriberType,
+	EmailSubscriberType,
+	EvergreenWebhookSubscriberType,
+	TeamsSubscriberType,
+	ChatSubscriberType,
+}
+
+// NotificationTemplateData is the data that a subscription's notification
+// template is executed against. The sections for the objects that aren't
+// related to the event are nil.
+type NotificationTemplateData struct {
+	// TemplateVersion is always NotificationTemplateDataVersion.
+	TemplateVersion int
+	// Object is the kind of object the event is for, e.g. task, build,
+	// version, patch or host.
+	Object         string
+	ID             string
+	DisplayName    string
+	Project        string
+	URL            string
+	Status         string
+	Description    string
+	EventID        string
+	SubscriptionID string
+	Trigger        string
+
+	Task    *NotificationTemplateTask
+	Version *NotificationTemplateVersion
+	Build   *NotificationTemplateBuild
+	Host    *NotificationTemplateHost
+	// FailedTests are the task's failed tests, if the event is for a task.
+	FailedTests []NotificationTemplateTest
+}
+
+// NotificationTemplateTask is the task section of NotificationTemplateData.
+type NotificationTemplateTask struct {
+	ID           string
+	DisplayName  string
+	BuildVariant string
+	Status       string
+	Execution    int
+	Requester    string
+	Revision     string
+	Tags         []string
+	// FailureType is the kind of failure, e.g. test, setup or system, if the
+	// task failed.
+	FailureType        string
+	FailureDescription string
+	TimedOut           bool
+	StartTime          time.Time
+	FinishTime         time.Time
+}
+
+// NotificationTemplateVersion is the version section of
+// NotificationTemplateData.
+type NotificationTemplateVersion struct {
+	ID        string
+	Revision  string
+	Author    string
+	Message   string
+	Requester string
+	Status    string
+	Branch    string
+}
+
+// NotificationTemplateBuild is the build section of NotificationTemplateData.
+type NotificationTemplateBuild struct {
+	ID           string
+	BuildVariant string
+	DisplayName  string
+	Status       string
+}
+
+// NotificationTemplateHost is the host section of NotificationTemplateData.
+type NotificationTemplateHost struct {
+	ID           string
+	DisplayName  string
+	Distro       string
+	InstanceType string
+	Status       string
+	StartedBy    string
+}
+
+// NotificationTemplateTest is a test result in NotificationTemplateData.
+type NotificationTemplateTest struct {
+	Name   string
+	Status string
+	LogURL string
+}
+
+// ExecuteNotificationTemplate renders the template against the data.
+func ExecuteNotificationTemplate(tmpl string, data NotificationTemplateData) (string, error) {
+	t, err := template.New("notification").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing notification template")
+	}
+	data.TemplateVersion = NotificationTemplateDataVersion
+
+	buf := &bytes.Buffer{}
+	if err = t.Execute(buf, data); err != nil {
+		return "", errors.Wrap(err, "executing notification template")
+	}
+	return buf.String(), nil
+}
+
+// ValidateNotificationTemplate checks that the template parses and only
+// refers to fields in NotificationTemplateData.
+func ValidateNotificationTemplate(tmpl string) error {
+	_, err := ExecuteNotificationTemplate(tmpl, NotificationTemplateData{
+		Task:        &NotificationTemplateTask{},
+		Version:     &NotificationTemplateVersion{},
+		Build:       &NotificationTemplateBuild{},
+		Host:        &NotificationTemplateHost{},
+		FailedTests: []NotificationTemplateTest{{}},
+	})
+	return err
+}
@@ -0,0 +1,30 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationTemplate(t *testing.T) {
+	t.Run("ValidateAcceptsKnownFields", func(t *testing.T) {
+		assert.NoError(t, ValidateNotificationTemplate("{{ .DisplayName }} {{ with .Task }}{{ .BuildVariant }}{{ end }}{{ range .FailedTests }}{{ .Name }}{{ end }}"))
+		assert.NoError(t, ValidateNotificationTemplate("{{ .Host.Distro }} {{ .Version.Author }} {{ .Build.Status }}"))
+	})
+	t.Run("ValidateRejectsUnknownFields", func(t *testing.T) {
+		assert.Error(t, ValidateNotificationTemplate("{{ .Task.NotAField }}"))
+	})
+	t.Run("ValidateRejectsMalformedTemplate", func(t *testing.T) {
+		assert.Error(t, ValidateNotificationTemplate("{{ .DisplayName "))
+	})
+	t.Run("ExecuteSetsVersion", func(t *testing.T) {
+		out, err := ExecuteNotificationTemplate("v{{ .TemplateVersion }} {{ .Project }}", NotificationTemplateData{Project: "mci"})
+		require.NoError(t, err)
+		assert.Equal(t, "v1 mci", out)
+	})
+	t.Run("ExecuteFailsOnMissingSection", func(t *testing.T) {
+		_, err := ExecuteNotificationTemplate("{{ .Task.ID }}", NotificationTemplateData{})
+		assert.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package event
 
 import (
 	"fmt"
+	"net/url"
 
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/utility"
@@ -18,6 +19,8 @@ const (
 	EvergreenWebhookSubscriberType  = "evergreen-webhook"
 	EmailSubscriberType             = "email"
 	SlackSubscriberType             = "slack"
+	TeamsSubscriberType             = "teams"
+	ChatSubscriberType              = "chat"
 	SubscriberTypeNone              = "none"
 	RunChildPatchSubscriberType     = "run-child-patch"
 
@@ -35,6 +38,8 @@ var SubscriberTypes = []string{
 	EvergreenWebhookSubscriberType,
 	EmailSubscriberType,
 	SlackSubscriberType,
+	TeamsSubscriberType,
+	ChatSubscriberType,
 	RunChildPatchSubscriberType,
 }
 
@@ -73,6 +78,10 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
 		s.Target = &JIRAIssueSubscriber{}
+	case TeamsSubscriberType:
+		s.Target = &TeamsSubscriber{}
+	case ChatSubscriberType:
+		s.Target = &ChatSubscriber{}
 	case JIRACommentSubscriberType, EmailSubscriberType, SlackSubscriberType:
 		str := ""
 		s.Target = &str
@@ -116,6 +125,14 @@ func (s *Subscriber) Validate() error {
 		catcher.Add(v.validate())
 	case *WebhookSubscriber:
 		catcher.Add(v.validate())
+	case TeamsSubscriber:
+		catcher.Add(v.validate())
+	case *TeamsSubscriber:
+		catcher.Add(v.validate())
+	case ChatSubscriber:
+		catcher.Add(v.validate())
+	case *ChatSubscriber:
+		catcher.Add(v.validate())
 	}
 
 	return catcher.Resolve()
@@ -174,6 +191,54 @@ func (s *WebhookSubscriber) GetHeader(key string) string {
 	return ""
 }
 
+// TeamsSubscriber posts notifications as cards to a Microsoft Teams incoming
+// webhook.
+type TeamsSubscriber struct {
+	URL string `bson:"url"`
+}
+
+func (s *TeamsSubscriber) String() string {
+	if len(s.URL) == 0 {
+		return "NIL_URL"
+	}
+	return s.URL
+}
+
+func (s *TeamsSubscriber) validate() error {
+	return errors.Wrap(validateChatURL(s.URL), "invalid Teams subscriber")
+}
+
+// ChatSubscriber posts notifications as Markdown text to a generic chat-ops
+// incoming webhook.
+type ChatSubscriber struct {
+	URL string `bson:"url"`
+}
+
+func (s *ChatSubscriber) String() string {
+	if len(s.URL) == 0 {
+		return "NIL_URL"
+	}
+	return s.URL
+}
+
+func (s *ChatSubscriber) validate() error {
+	return errors.Wrap(validateChatURL(s.URL), "invalid chat subscriber")
+}
+
+func validateChatURL(u string) error {
+	if u == "" {
+		return errors.New("url cannot be empty")
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return errors.Wrapf(err, "parsing url '%s'", u)
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return errors.Errorf("url '%s' must use http or https", u)
+	}
+	return nil
+}
+
 type JIRAIssueSubscriber struct {
 	Project   string `bson:"project"`
 	IssueType string `bson:"issue_type"`
@@ -161,6 +161,34 @@ func TestValidate(t *testing.T) {
 			},
 			errorExpected: false,
 		},
+		"TeamsMissingURL": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: TeamsSubscriber{},
+			},
+			errorExpected: true,
+		},
+		"ValidTeams": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: &TeamsSubscriber{URL: "https://example.webhook.office.com/webhookb2/abc"},
+			},
+			errorExpected: false,
+		},
+		"ChatInvalidURLScheme": {
+			s: Subscriber{
+				Type:   ChatSubscriberType,
+				Target: ChatSubscriber{URL: "ftp://example.com"},
+			},
+			errorExpected: true,
+		},
+		"ValidChat": {
+			s: Subscriber{
+				Type:   ChatSubscriberType,
+				Target: &ChatSubscriber{URL: "https://chat.example.com/hooks/abc"},
+			},
+			errorExpected: false,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			if testCase.errorExpected {
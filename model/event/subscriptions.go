@@ -36,6 +36,7 @@ var (
 	subscriptionOwnerTypeKey      = bsonutil.MustHaveTag(Subscription{}, "OwnerType")
 	subscriptionTriggerDataKey    = bsonutil.MustHaveTag(Subscription{}, "TriggerData")
 	subscriptionLastUpdatedKey    = bsonutil.MustHaveTag(Subscription{}, "LastUpdated")
+	subscriptionTemplateKey       = bsonutil.MustHaveTag(Subscription{}, "Template")
 
 	filterObjectKey       = bsonutil.MustHaveTag(Filter{}, "Object")
 	filterIDKey           = bsonutil.MustHaveTag(Filter{}, "ID")
@@ -62,17 +63,19 @@ const (
 	VersionPercentChangeKey                          = "version-percent-change"
 	TestRegexKey                                     = "test-regex"
 	RenotifyIntervalKey                              = "renotify-interval"
+	TestConsecutiveFailuresKey                       = "test-consecutive-failures"
 	GeneralSubscriptionPatchOutcome                  = "patch-outcome"
 	GeneralSubscriptionPatchFirstFailure             = "patch-first-failure"
 	GeneralSubscriptionBuildBreak                    = "build-break"
 	GeneralSubscriptionSpawnhostExpiration           = "spawnhost-expiration"
 	GeneralSubscriptionSpawnHostOutcome              = "spawnhost-outcome"
 
-	ObjectTask    = "task"
-	ObjectVersion = "version"
-	ObjectBuild   = "build"
-	ObjectHost    = "host"
-	ObjectPatch   = "patch"
+	ObjectTask       = "task"
+	ObjectVersion    = "version"
+	ObjectBuild      = "build"
+	ObjectHost       = "host"
+	ObjectPatch      = "patch"
+	ObjectCostBudget = "cost-budget"
 
 	TriggerOutcome = "outcome"
 	// TriggerFamilyOutcome indicates that a patch or version completed,
@@ -93,8 +96,15 @@ const (
 	TriggerTaskStarted               = "task-started"
 	TriggerSpawnHostIdle             = "spawn-host-idle"
 	TriggerAlertableInstanceType     = "alertable-instance-type"
+	TriggerCostBudgetThreshold       = "cost-budget-threshold"
+	TriggerTestNewFailure            = "test-new-failure"
+	TriggerTestConsecutiveFailures   = "test-consecutive-failures"
 )
 
+// MaxTestConsecutiveFailures is the largest number of consecutive failures a
+// test-consecutive-failures subscription can wait for.
+const MaxTestConsecutiveFailures = 20
+
 type Subscription struct {
 	ID             string            `bson:"_id"`
 	ResourceType   string            `bson:"type"`
@@ -107,6 +117,10 @@ type Subscription struct {
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
 	LastUpdated    time.Time         `bson:"last_updated,omitempty"`
+	// Template is an optional Go text/template that renders the notification
+	// body in place of the default one. It's executed against
+	// NotificationTemplateData.
+	Template string `bson:"template,omitempty"`
 }
 
 type unmarshalSubscription struct {
@@ -120,6 +134,7 @@ type unmarshalSubscription struct {
 	OwnerType      OwnerType         `bson:"owner_type"`
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
+	Template       string            `bson:"template,omitempty"`
 }
 
 func (d *Subscription) UnmarshalBSON(in []byte) error {
@@ -143,6 +158,7 @@ func (s *Subscription) SetBSON(raw mgobson.Raw) error {
 	s.Owner = temp.Owner
 	s.OwnerType = temp.OwnerType
 	s.TriggerData = temp.TriggerData
+	s.Template = temp.Template
 
 	return nil
 }
@@ -498,6 +514,9 @@ func (s *Subscription) Upsert(ctx context.Context) error {
 	if !utility.IsZeroTime(s.LastUpdated) {
 		update[subscriptionLastUpdatedKey] = s.LastUpdated
 	}
+	if s.Template != "" {
+		update[subscriptionTemplateKey] = s.Template
+	}
 
 	// note: this prevents changing the owner of an existing subscription, which is desired
 	c, err := db.Replace(ctx, SubscriptionsCollection, bson.M{
@@ -605,6 +624,11 @@ func (s *Subscription) Validate() error {
 	catcher.Add(s.ValidateSelectors())
 	catcher.Add(s.runCustomValidation())
 	catcher.Add(s.Subscriber.Validate())
+	if s.Template != "" {
+		catcher.ErrorfWhen(!utility.StringSliceContains(templatableSubscriberTypes, s.Subscriber.Type),
+			"subscriber type '%s' does not support notification templates", s.Subscriber.Type)
+		catcher.Wrap(ValidateNotificationTemplate(s.Template), "invalid notification template")
+	}
 	return catcher.Resolve()
 }
 
@@ -635,6 +659,9 @@ func (s *Subscription) runCustomValidation() error {
 	if renotifyInterval, ok := s.TriggerData[RenotifyIntervalKey]; ok {
 		catcher.Wrap(validatePositiveInt(renotifyInterval), "invalid renotify interval")
 	}
+	if s.Trigger == TriggerTestConsecutiveFailures {
+		catcher.Wrap(validateConsecutiveFailures(s.TriggerData[TestConsecutiveFailuresKey]), "invalid number of consecutive test failures")
+	}
 	return catcher.Resolve()
 }
 
@@ -649,6 +676,17 @@ func validatePositiveInt(s string) error {
 	return nil
 }
 
+func validateConsecutiveFailures(s string) error {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return errors.Wrapf(err, "invalid number '%s'", s)
+	}
+	if val < 2 || val > MaxTestConsecutiveFailures {
+		return errors.Errorf("%d must be between 2 and %d", val, MaxTestConsecutiveFailures)
+	}
+	return nil
+}
+
 func validatePositiveFloat(s string) error {
 	val, err := util.TryParseFloat(s)
 	if err != nil {
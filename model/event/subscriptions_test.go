@@ -477,6 +477,49 @@ func (s *subscriptionsSuite) TestValidateSelectors() {
 	s.Error(noFilterParams.ValidateSelectors())
 }
 
+func (s *subscriptionsSuite) TestValidateTemplate() {
+	sub := Subscription{
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerOutcome,
+		OwnerType:    OwnerTypePerson,
+		Owner:        "me",
+		Filter:       Filter{ID: "task"},
+		Selectors:    []Selector{{Type: SelectorID, Data: "task"}},
+		Subscriber:   NewSlackSubscriber("@me"),
+		Template:     "{{ .DisplayName }} {{ .Status }}{{ range .FailedTests }} {{ .Name }}{{ end }}",
+	}
+	s.NoError(sub.Validate())
+
+	sub.Template = "{{ .NotAField }}"
+	s.Error(sub.Validate())
+
+	sub.Template = "{{ .DisplayName }}"
+	sub.Subscriber = Subscriber{Type: ChatSubscriberType, Target: &ChatSubscriber{URL: "https://chat.example.com/hooks/abc"}}
+	s.NoError(sub.Validate())
+
+	sub.Subscriber = Subscriber{Type: JIRACommentSubscriberType, Target: "BF-1234"}
+	s.Error(sub.Validate())
+}
+
+func (s *subscriptionsSuite) TestValidateTestConsecutiveFailures() {
+	sub := Subscription{
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerTestConsecutiveFailures,
+		OwnerType:    OwnerTypeProject,
+		Owner:        "project",
+		Filter:       Filter{Project: "project"},
+		Selectors:    []Selector{{Type: SelectorProject, Data: "project"}},
+		Subscriber:   NewSlackSubscriber("#channel"),
+		TriggerData:  map[string]string{TestConsecutiveFailuresKey: "3"},
+	}
+	s.NoError(sub.Validate())
+
+	for _, n := range []string{"", "1", "21", "three"} {
+		sub.TriggerData[TestConsecutiveFailuresKey] = n
+		s.Error(sub.Validate(), n)
+	}
+}
+
 func (s *subscriptionsSuite) TestFromSelectors() {
 	s.Run("NoType", func() {
 		f := Filter{}
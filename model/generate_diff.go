@@ -0,0 +1,113 @@
+package model
+
+import (
+	"slices"
+)
+
+// GeneratedProjectDiff describes what `generate.tasks` adds to a project.
+type GeneratedProjectDiff struct {
+	// BuildVariants are the names of build variants that did not exist before.
+	BuildVariants []string `json:"build_variants"`
+	// Tasks are the variant-task pairs that did not exist before.
+	Tasks []TVPair `json:"tasks"`
+	// Dependencies are the dependencies of the added variant-task pairs.
+	Dependencies []GeneratedDependency `json:"dependencies"`
+	// DisplayTasks are display tasks that are new or have new execution tasks.
+	DisplayTasks []GeneratedDisplayTask `json:"display_tasks"`
+}
+
+// GeneratedDependency is a dependency of a generated variant-task pair.
+type GeneratedDependency struct {
+	TVPair
+	DependsOn TVPair `json:"depends_on"`
+	Status    string `json:"status,omitempty"`
+}
+
+// GeneratedDisplayTask is a display task that is new or has new execution
+// tasks.
+type GeneratedDisplayTask struct {
+	Variant string `json:"variant"`
+	Name    string `json:"name"`
+	// ExecutionTasks are the execution tasks added to the display task.
+	ExecutionTasks []string `json:"execution_tasks"`
+	// IsNew indicates that the display task did not exist before.
+	IsNew bool `json:"is_new"`
+}
+
+// IsEmpty returns whether the diff adds nothing to the project.
+func (d GeneratedProjectDiff) IsEmpty() bool {
+	return len(d.BuildVariants) == 0 && len(d.Tasks) == 0 && len(d.Dependencies) == 0 && len(d.DisplayTasks) == 0
+}
+
+// GetGeneratedProjectDiff compares the project before and after merging in a
+// generated project and returns what was added.
+func GetGeneratedProjectDiff(oldProject, newProject *Project) GeneratedProjectDiff {
+	diff := GeneratedProjectDiff{}
+
+	oldVariants := map[string]*BuildVariant{}
+	for i, bv := range oldProject.BuildVariants {
+		oldVariants[bv.Name] = &oldProject.BuildVariants[i]
+	}
+	for _, bv := range newProject.BuildVariants {
+		if _, ok := oldVariants[bv.Name]; !ok {
+			diff.BuildVariants = append(diff.BuildVariants, bv.Name)
+		}
+	}
+
+	oldPairs := map[TVPair]bool{}
+	for _, bvtu := range oldProject.FindAllBuildVariantTasks() {
+		oldPairs[TVPair{Variant: bvtu.Variant, TaskName: bvtu.Name}] = true
+	}
+	for _, bvtu := range newProject.FindAllBuildVariantTasks() {
+		pair := TVPair{Variant: bvtu.Variant, TaskName: bvtu.Name}
+		if oldPairs[pair] {
+			continue
+		}
+		oldPairs[pair] = true
+		diff.Tasks = append(diff.Tasks, pair)
+		for _, dep := range bvtu.DependsOn {
+			depVariant := dep.Variant
+			if depVariant == "" {
+				depVariant = bvtu.Variant
+			}
+			diff.Dependencies = append(diff.Dependencies, GeneratedDependency{
+				TVPair:    pair,
+				DependsOn: TVPair{Variant: depVariant, TaskName: dep.Name},
+				Status:    dep.Status,
+			})
+		}
+	}
+
+	for _, bv := range newProject.BuildVariants {
+		for _, dt := range bv.DisplayTasks {
+			var oldExecTasks []string
+			isNew := true
+			if oldBV, ok := oldVariants[bv.Name]; ok {
+				for _, oldDT := range oldBV.DisplayTasks {
+					if oldDT.Name == dt.Name {
+						oldExecTasks = oldDT.ExecTasks
+						isNew = false
+						break
+					}
+				}
+			}
+			var added []string
+			for _, execTask := range dt.ExecTasks {
+				if !slices.Contains(oldExecTasks, execTask) {
+					added = append(added, execTask)
+				}
+			}
+			if !isNew && len(added) == 0 {
+				continue
+			}
+			diff.DisplayTasks = append(diff.DisplayTasks, GeneratedDisplayTask{
+				Variant:        bv.Name,
+				Name:           dt.Name,
+				ExecutionTasks: added,
+				IsNew:          isNew,
+			})
+		}
+	}
+
+	return diff
+}
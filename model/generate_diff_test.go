@@ -0,0 +1,74 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGeneratedProjectDiff(t *testing.T) {
+	const projYml = `
+tasks:
+  - name: generator
+  - name: compile
+buildvariants:
+  - name: ubuntu
+    run_on: ubuntu2204
+    tasks:
+      - name: generator
+      - name: compile
+    display_tasks:
+      - name: existing_display
+        execution_tasks:
+          - compile
+`
+	const generatedJSON = `{
+  "tasks": [
+    {"name": "test", "depends_on": [{"name": "compile"}]},
+    {"name": "lint", "depends_on": [{"name": "compile", "variant": "ubuntu", "status": "*"}]}
+  ],
+  "buildvariants": [
+    {
+      "name": "ubuntu",
+      "tasks": [{"name": "test"}],
+      "display_tasks": [{"name": "existing_display", "execution_tasks": ["compile", "test"]}]
+    },
+    {
+      "name": "windows",
+      "run_on": ["windows"],
+      "tasks": [{"name": "lint"}],
+      "display_tasks": [{"name": "new_display", "execution_tasks": ["lint"]}]
+    }
+  ]
+}`
+
+	oldProject := &Project{}
+	pp, err := LoadProjectInto(t.Context(), []byte(projYml), nil, "", oldProject)
+	require.NoError(t, err)
+	g, err := ParseProjectFromJSONString(generatedJSON)
+	require.NoError(t, err)
+	g.Task = &task.Task{Id: "generator_task"}
+
+	newProject, _, _, err := g.NewVersion(t.Context(), oldProject, pp, &Version{Id: "version"})
+	require.NoError(t, err)
+
+	diff := GetGeneratedProjectDiff(oldProject, newProject)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []string{"windows"}, diff.BuildVariants)
+	assert.ElementsMatch(t, []TVPair{
+		{Variant: "ubuntu", TaskName: "test"},
+		{Variant: "windows", TaskName: "lint"},
+	}, diff.Tasks)
+	assert.ElementsMatch(t, []GeneratedDependency{
+		{TVPair: TVPair{Variant: "ubuntu", TaskName: "test"}, DependsOn: TVPair{Variant: "ubuntu", TaskName: "compile"}},
+		{TVPair: TVPair{Variant: "windows", TaskName: "lint"}, DependsOn: TVPair{Variant: "ubuntu", TaskName: "compile"}, Status: "*"},
+	}, diff.Dependencies)
+	assert.ElementsMatch(t, []GeneratedDisplayTask{
+		{Variant: "ubuntu", Name: "existing_display", ExecutionTasks: []string{"test"}},
+		{Variant: "windows", Name: "new_display", ExecutionTasks: []string{"lint"}, IsNew: true},
+	}, diff.DisplayTasks)
+
+	assert.True(t, GetGeneratedProjectDiff(newProject, newProject).IsEmpty())
+}
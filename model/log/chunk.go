@@ -17,6 +17,9 @@ type chunkInfo struct {
 	end      int64
 	numLines int
 	upload   int64
+	// compacted is whether the chunk is a compacted object that replaces
+	// a set of previously uploaded chunks.
+	compacted bool
 }
 
 // chunkGroup represents a set of chunks belonging to a single log.
@@ -24,3 +27,67 @@ type chunkGroup struct {
 	name   string
 	chunks []chunkInfo
 }
+
+// compactedChunkIndex is the index written as the first line of a compacted
+// object. It describes the original chunks whose lines make up the object, in
+// the order they were written.
+type compactedChunkIndex struct {
+	Chunks []compactedChunkIndexEntry `json:"chunks"`
+}
+
+// compactedChunkIndexEntry describes one of the original chunks of a
+// compacted object.
+type compactedChunkIndexEntry struct {
+	Sequence int   `json:"sequence"`
+	Start    int64 `json:"start"`
+	End      int64 `json:"end"`
+	NumLines int   `json:"num_lines"`
+}
+
+// linesBefore returns the number of lines at the beginning of the compacted
+// object that belong to chunks that end before the given start time.
+func (idx compactedChunkIndex) linesBefore(start *int64) int {
+	if start == nil {
+		return 0
+	}
+
+	var numLines int
+	for _, entry := range idx.Chunks {
+		if entry.End >= *start {
+			break
+		}
+		numLines += entry.NumLines
+	}
+
+	return numLines
+}
+
+// readableChunks returns the chunks that make up the current contents of a
+// log. If the log has been compacted, this is the most recent compacted object
+// followed by any chunks uploaded after it was written; chunks that the
+// compacted object replaced are ignored, even if they have not been deleted
+// yet.
+func readableChunks(chunks []chunkInfo) []chunkInfo {
+	var (
+		latest       chunkInfo
+		hasCompacted bool
+	)
+	for _, chunk := range chunks {
+		if chunk.compacted && (!hasCompacted || chunk.upload > latest.upload) {
+			latest = chunk
+			hasCompacted = true
+		}
+	}
+	if !hasCompacted {
+		return chunks
+	}
+
+	readable := []chunkInfo{latest}
+	for _, chunk := range chunks {
+		if !chunk.compacted && chunk.upload > latest.upload {
+			readable = append(readable, chunk)
+		}
+	}
+
+	return readable
+}
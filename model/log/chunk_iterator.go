@@ -3,6 +3,7 @@ package log
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"io"
 
 	"github.com/evergreen-ci/pail"
@@ -105,6 +106,17 @@ func (it *chunkIterator) Next() bool {
 		}
 		it.chunkLineCount++
 
+		if it.reader.skipLines > 0 {
+			// Lines that the compacted object's index shows are
+			// before the start of the time range are skipped
+			// without parsing them; they also count towards any
+			// tail offset.
+			it.reader.skipLines--
+			if it.lineOffset > 0 {
+				it.lineOffset--
+			}
+			continue
+		}
 		if it.lineOffset > 0 {
 			it.lineOffset--
 			continue
@@ -138,14 +150,17 @@ func (it *chunkIterator) worker(ctx context.Context) {
 	}()
 
 	for _, chunk := range it.opts.chunks {
-		r, err := it.opts.bucket.Get(ctx, chunk.key)
+		r, err := openChunkReader(ctx, it.opts.bucket, chunk)
 		if err != nil {
-			it.catcher.Wrap(err, "getting chunk from bucket")
+			it.catcher.Add(err)
 			return
 		}
+		if chunk.compacted {
+			r.skipLines = r.index.linesBefore(it.opts.start)
+		}
 
 		select {
-		case it.next <- newChunkReader(r, chunk.numLines):
+		case it.next <- r:
 		case <-ctx.Done():
 			it.catcher.Add(ctx.Err())
 			return
@@ -205,11 +220,47 @@ func filterChunksByLimit(chunks []chunkInfo, limit int) []chunkInfo {
 
 type chunkReader struct {
 	numLines int
+	// index is the index of a compacted object.
+	index compactedChunkIndex
+	// skipLines is the number of lines at the beginning of the chunk to
+	// skip without parsing.
+	skipLines int
 
 	*bufio.Reader
 	io.ReadCloser
 }
 
+// openChunkReader returns a reader for the lines of the given chunk. For
+// compacted objects, the index is read from the beginning of the object and
+// the reader is positioned at the first line.
+func openChunkReader(ctx context.Context, bucket pail.Bucket, chunk chunkInfo) (*chunkReader, error) {
+	r, err := bucket.Get(ctx, chunk.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting chunk from bucket")
+	}
+
+	cr := newChunkReader(r, chunk.numLines)
+	if !chunk.compacted {
+		return cr, nil
+	}
+
+	header, err := cr.ReadBytes('\n')
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "reading compacted chunk index")
+		catcher.Add(cr.Close())
+		return nil, catcher.Resolve()
+	}
+	if err = json.Unmarshal(header, &cr.index); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "unmarshalling compacted chunk index")
+		catcher.Add(cr.Close())
+		return nil, catcher.Resolve()
+	}
+
+	return cr, nil
+}
+
 func newChunkReader(r io.ReadCloser, numLines int) *chunkReader {
 	return &chunkReader{
 		numLines:   numLines,
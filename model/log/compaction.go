@@ -0,0 +1,220 @@
+package log
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// compactedChunkKeyPrefix is the prefix of the keys of compacted objects. The
+// rest of the key has the same format as a chunk key without a sequence.
+const compactedChunkKeyPrefix = "compacted_"
+
+// CompactionStats describes the result of compacting a set of logs.
+type CompactionStats struct {
+	// Logs is the number of logs that were compacted.
+	Logs int
+	// ChunksRemoved is the number of chunk objects that were removed from
+	// the bucket, including any left behind by earlier compactions.
+	ChunksRemoved int
+}
+
+// Compact merges the chunks of each of the given logs into a single object
+// that starts with an index of the original chunks, followed by their lines.
+// The chunks are removed once the compacted object is written. Logs that are
+// still being appended to should not be compacted; lines appended after a log
+// is compacted are still read, but the log needs to be compacted again to
+// merge them.
+//
+// The compacted object is written with the bucket's own compression, so the
+// bucket should be configured to compress objects.
+func (s *logServiceV0) Compact(ctx context.Context, logNames []string) (CompactionStats, error) {
+	var stats CompactionStats
+
+	chunkGroups, _, _, err := s.getLogChunks(ctx, logNames)
+	if err != nil {
+		return stats, errors.Wrap(err, "getting log chunks")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, group := range chunkGroups {
+		readable := readableChunks(group.chunks)
+		if len(readable) == 1 && (readable[0].compacted || len(group.chunks) == 1) {
+			// The log is already compacted or only has a single
+			// chunk, so there is nothing to merge. Only remove
+			// chunks left behind by an earlier compaction that did
+			// not finish.
+			staleKeys := make([]string, 0, len(group.chunks)-1)
+			for _, chunk := range group.chunks {
+				if chunk.key != readable[0].key {
+					staleKeys = append(staleKeys, chunk.key)
+				}
+			}
+			if len(staleKeys) == 0 {
+				continue
+			}
+			if err = s.bucket.RemoveMany(ctx, staleKeys...); err != nil {
+				catcher.Wrapf(err, "removing stale chunks of log '%s'", group.name)
+				continue
+			}
+			stats.ChunksRemoved += len(staleKeys)
+			continue
+		}
+
+		compactedKey, err := s.writeCompactedChunk(ctx, group.name, readable)
+		if err != nil {
+			catcher.Wrapf(err, "compacting log '%s'", group.name)
+			continue
+		}
+		stats.Logs++
+
+		keys := make([]string, 0, len(group.chunks))
+		for _, chunk := range group.chunks {
+			if chunk.key != compactedKey {
+				keys = append(keys, chunk.key)
+			}
+		}
+		if err = s.bucket.RemoveMany(ctx, keys...); err != nil {
+			catcher.Wrapf(err, "removing compacted chunks of log '%s'", group.name)
+			continue
+		}
+		stats.ChunksRemoved += len(keys)
+	}
+
+	return stats, catcher.Resolve()
+}
+
+// writeCompactedChunk writes a compacted object containing the lines of the
+// given chunks, in order, and returns its key.
+func (s *logServiceV0) writeCompactedChunk(ctx context.Context, logName string, chunks []chunkInfo) (string, error) {
+	// The index must be written before any lines, so open the readers for
+	// any compacted objects up front to read their indexes.
+	readers := make([]*chunkReader, len(chunks))
+	closeReaders := func() {
+		for _, r := range readers {
+			if r != nil {
+				grip.Warning(ctx, errors.Wrap(r.Close(), "closing chunk reader"))
+			}
+		}
+	}
+
+	var index compactedChunkIndex
+	start, end := chunks[0].start, chunks[0].end
+	var numLines int
+	var latestUpload int64
+	for i, chunk := range chunks {
+		if chunk.compacted {
+			r, err := openChunkReader(ctx, s.bucket, chunk)
+			if err != nil {
+				closeReaders()
+				return "", err
+			}
+			readers[i] = r
+			index.Chunks = append(index.Chunks, r.index.Chunks...)
+		} else {
+			index.Chunks = append(index.Chunks, compactedChunkIndexEntry{
+				Sequence: chunk.sequence,
+				Start:    chunk.start,
+				End:      chunk.end,
+				NumLines: chunk.numLines,
+			})
+		}
+
+		numLines += chunk.numLines
+		start = min(start, chunk.start)
+		end = max(end, chunk.end)
+		// Use the latest upload time of the merged chunks so that
+		// chunks uploaded after them are still read along with the
+		// compacted object.
+		latestUpload = max(latestUpload, chunk.upload)
+	}
+
+	header, err := json.Marshal(index)
+	if err != nil {
+		closeReaders()
+		return "", errors.Wrap(err, "marshalling compacted chunk index")
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer closeReaders()
+		_ = pw.CloseWithError(s.copyChunks(ctx, pw, header, chunks, readers))
+	}()
+
+	key := fmt.Sprintf("%s/%s%d_%d_%d_%d", logName, compactedChunkKeyPrefix, start, end, numLines, latestUpload)
+	err = s.bucket.Put(ctx, key, pr)
+	_ = pr.CloseWithError(err)
+	<-done
+	if err != nil {
+		// Don't leave behind a partially written object that would
+		// be read in place of the original chunks.
+		grip.Warning(ctx, errors.Wrap(s.bucket.Remove(ctx, key), "removing partially written compacted chunk"))
+		return "", errors.Wrap(err, "writing compacted chunk to bucket")
+	}
+
+	return key, nil
+}
+
+// copyChunks writes the index header followed by the lines of each chunk,
+// verifying that each chunk has the expected number of lines.
+func (s *logServiceV0) copyChunks(ctx context.Context, w io.Writer, header []byte, chunks []chunkInfo, readers []*chunkReader) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(append(header, '\n')); err != nil {
+		return errors.Wrap(err, "writing compacted chunk index")
+	}
+
+	for i, chunk := range chunks {
+		r := readers[i]
+		if r == nil {
+			var err error
+			if r, err = openChunkReader(ctx, s.bucket, chunk); err != nil {
+				return err
+			}
+			readers[i] = r
+		}
+
+		var lineCount int
+		for {
+			line, err := r.ReadString('\n')
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return errors.Wrapf(err, "reading chunk '%s'", chunk.key)
+			}
+			if _, err = bw.WriteString(line); err != nil {
+				return errors.Wrap(err, "writing line")
+			}
+			lineCount++
+		}
+		if lineCount != chunk.numLines {
+			return errors.Errorf("chunk '%s' has %d lines but expected %d", chunk.key, lineCount, chunk.numLines)
+		}
+	}
+
+	return errors.Wrap(bw.Flush(), "flushing compacted chunk")
+}
+
+// Delete removes all of the chunks and compacted objects of the given logs
+// from the bucket and returns the number of objects removed.
+func (s *logServiceV0) Delete(ctx context.Context, logNames []string) (int, error) {
+	keys, err := s.GetChunkKeys(ctx, logNames)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting chunk keys")
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	if err = s.bucket.RemoveMany(ctx, keys...); err != nil {
+		return 0, errors.Wrap(err, "removing log chunks")
+	}
+
+	return len(keys), nil
+}
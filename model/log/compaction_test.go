@@ -0,0 +1,138 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	setup := func(t *testing.T) (*logServiceV0, pail.Bucket, []LogLine) {
+		bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir(), UseSlash: true})
+		require.NoError(t, err)
+		svc := NewLogServiceV0(bucket)
+
+		ts := time.Now().UnixNano()
+		var lines []LogLine
+		for i := 0; i < 9; i++ {
+			lines = append(lines, LogLine{
+				LogName:   "project/task/0/task",
+				Priority:  level.Info,
+				Timestamp: ts + int64(i)*int64(time.Second),
+				Data:      fmt.Sprintf("line %d", i),
+			})
+		}
+		for i := 0; i < 3; i++ {
+			require.NoError(t, ignoreBytes(svc.Append(ctx, "project/task/0/task", i, lines[3*i:3*i+3])))
+		}
+		require.NoError(t, ignoreBytes(svc.Append(ctx, "project/task/0/agent", 0, lines[:1])))
+
+		return svc, bucket, lines
+	}
+	countObjects := func(t *testing.T, bucket pail.Bucket, prefix string) int {
+		it, err := bucket.List(ctx, prefix)
+		require.NoError(t, err)
+		var count int
+		for it.Next(ctx) {
+			count++
+		}
+		require.NoError(t, it.Err())
+		return count
+	}
+	checkLines := func(t *testing.T, svc *logServiceV0, opts GetOptions, expected []LogLine) {
+		found := readLogLines(t, svc, ctx, opts)
+		require.Len(t, found, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i].Timestamp, found[i].Timestamp)
+			assert.Equal(t, expected[i].Data, found[i].Data)
+		}
+	}
+
+	t.Run("MergesChunks", func(t *testing.T) {
+		svc, bucket, lines := setup(t)
+
+		stats, err := svc.Compact(ctx, []string{"project/task/0/task", "project/task/0/agent"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Logs)
+		assert.Equal(t, 3, stats.ChunksRemoved)
+		assert.Equal(t, 1, countObjects(t, bucket, "project/task/0/task"))
+		assert.Equal(t, 1, countObjects(t, bucket, "project/task/0/agent"))
+
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}}, lines)
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/agent"}}, lines[:1])
+	})
+	t.Run("GetWithFilters", func(t *testing.T) {
+		svc, _, lines := setup(t)
+		_, err := svc.Compact(ctx, []string{"project/task/0/task"})
+		require.NoError(t, err)
+
+		logNames := []string{"project/task/0/task"}
+		checkLines(t, svc, GetOptions{LogNames: logNames, Start: utility.ToInt64Ptr(lines[4].Timestamp)}, lines[4:])
+		checkLines(t, svc, GetOptions{LogNames: logNames, Start: utility.ToInt64Ptr(lines[2].Timestamp), End: utility.ToInt64Ptr(lines[5].Timestamp)}, lines[2:6])
+		checkLines(t, svc, GetOptions{LogNames: logNames, TailN: 4}, lines[5:])
+		checkLines(t, svc, GetOptions{LogNames: logNames, LineLimit: 2}, lines[:2])
+	})
+	t.Run("ReadsChunksAppendedAfterCompaction", func(t *testing.T) {
+		svc, bucket, lines := setup(t)
+		_, err := svc.Compact(ctx, []string{"project/task/0/task"})
+		require.NoError(t, err)
+
+		newLine := LogLine{Priority: level.Info, Timestamp: lines[8].Timestamp + 1, Data: "appended"}
+		require.NoError(t, ignoreBytes(svc.Append(ctx, "project/task/0/task", 3, []LogLine{newLine})))
+		expected := append(append([]LogLine{}, lines...), newLine)
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}}, expected)
+
+		stats, err := svc.Compact(ctx, []string{"project/task/0/task"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Logs)
+		assert.Equal(t, 2, stats.ChunksRemoved)
+		assert.Equal(t, 1, countObjects(t, bucket, "project/task/0/task"))
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}}, expected)
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}, Start: utility.ToInt64Ptr(newLine.Timestamp)}, expected[9:])
+	})
+	t.Run("IgnoresReplacedChunks", func(t *testing.T) {
+		svc, bucket, lines := setup(t)
+		keys, err := svc.GetChunkKeys(ctx, []string{"project/task/0/task"})
+		require.NoError(t, err)
+		chunks := make([]chunkInfo, 0, len(keys))
+		for _, key := range keys {
+			idx := strings.LastIndex(key, "/")
+			chunk, err := svc.parseChunkKey(key[:idx], key[idx+1:])
+			require.NoError(t, err)
+			chunks = append(chunks, chunk)
+		}
+		// Simulate a compaction that stopped before removing the
+		// original chunks.
+		_, err = svc.writeCompactedChunk(ctx, "project/task/0/task", readableChunks(chunks))
+		require.NoError(t, err)
+		assert.Equal(t, 4, countObjects(t, bucket, "project/task/0/task"))
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}}, lines)
+
+		stats, err := svc.Compact(ctx, []string{"project/task/0/task"})
+		require.NoError(t, err)
+		assert.Zero(t, stats.Logs)
+		assert.Equal(t, 3, stats.ChunksRemoved)
+		checkLines(t, svc, GetOptions{LogNames: []string{"project/task/0/task"}}, lines)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		svc, bucket, _ := setup(t)
+		_, err := svc.Compact(ctx, []string{"project/task/0/agent"})
+		require.NoError(t, err)
+
+		removed, err := svc.Delete(ctx, []string{"project/task/0"})
+		require.NoError(t, err)
+		assert.Equal(t, 4, removed)
+		assert.Zero(t, countObjects(t, bucket, "project/task/0"))
+	})
+}
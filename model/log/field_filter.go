@@ -0,0 +1,273 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mongodb/grip/level"
+	"github.com/pkg/errors"
+)
+
+// FieldFilterOperator is the comparison a field filter makes between a field
+// of a structured log line and the filter's value.
+type FieldFilterOperator string
+
+const (
+	FieldFilterEqual              FieldFilterOperator = "="
+	FieldFilterNotEqual           FieldFilterOperator = "!="
+	FieldFilterGreaterThan        FieldFilterOperator = ">"
+	FieldFilterGreaterThanOrEqual FieldFilterOperator = ">="
+	FieldFilterLessThan           FieldFilterOperator = "<"
+	FieldFilterLessThanOrEqual    FieldFilterOperator = "<="
+)
+
+// fieldFilterOperators are the recognized operators, ordered so that
+// operators that are prefixes of other operators come last when parsing.
+var fieldFilterOperators = []FieldFilterOperator{
+	FieldFilterNotEqual,
+	FieldFilterGreaterThanOrEqual,
+	FieldFilterLessThanOrEqual,
+	FieldFilterEqual,
+	FieldFilterGreaterThan,
+	FieldFilterLessThan,
+}
+
+// FieldFilter matches structured log lines, i.e. lines whose data is a JSON
+// object, by comparing one of their fields to a value.
+//
+// Numeric fields are compared numerically. String fields whose value and the
+// filter's value are both log levels (e.g. "warn" and "error") are compared by
+// priority, so "level>=warn" matches warning, error and more severe lines.
+// Other fields are compared as strings. Lines that are not JSON objects never
+// match, and lines without the field only match the != operator.
+type FieldFilter struct {
+	// Field is the name of the field to compare. Fields of nested objects
+	// are separated by dots, e.g. "attr.ns".
+	Field    string
+	Operator FieldFilterOperator
+	Value    string
+}
+
+// ParseFieldFilter parses a field filter in the form <field><operator><value>,
+// e.g. "component=replset" or "level>=warn".
+func ParseFieldFilter(filter string) (FieldFilter, error) {
+	idx, op := -1, FieldFilterOperator("")
+	for _, candidate := range fieldFilterOperators {
+		i := strings.Index(filter, string(candidate))
+		if i < 0 {
+			continue
+		}
+		// Use the earliest operator in the filter, preferring the
+		// longest operator at the same position.
+		if idx < 0 || i < idx {
+			idx, op = i, candidate
+		}
+	}
+	if idx <= 0 {
+		return FieldFilter{}, errors.Errorf("field filter '%s' must be in the form <field><operator><value> where the operator is one of =, !=, >, >=, < or <=", filter)
+	}
+
+	return FieldFilter{
+		Field:    strings.TrimSpace(filter[:idx]),
+		Operator: op,
+		Value:    strings.TrimSpace(filter[idx+len(op):]),
+	}, nil
+}
+
+// String returns the filter in the form that ParseFieldFilter accepts.
+func (f FieldFilter) String() string {
+	return fmt.Sprintf("%s%s%s", f.Field, f.Operator, f.Value)
+}
+
+// Matches returns whether the structured log line's fields match the filter.
+func (f FieldFilter) Matches(fields map[string]any) bool {
+	val, ok := lookupField(fields, f.Field)
+	if !ok {
+		return f.Operator == FieldFilterNotEqual
+	}
+
+	cmp, ok := compareFieldValue(val, f.Value)
+	if !ok {
+		// Values that can't be ordered can still be compared for
+		// equality.
+		switch f.Operator {
+		case FieldFilterEqual:
+			return formatFieldValue(val) == f.Value
+		case FieldFilterNotEqual:
+			return formatFieldValue(val) != f.Value
+		default:
+			return false
+		}
+	}
+
+	switch f.Operator {
+	case FieldFilterEqual:
+		return cmp == 0
+	case FieldFilterNotEqual:
+		return cmp != 0
+	case FieldFilterGreaterThan:
+		return cmp > 0
+	case FieldFilterGreaterThanOrEqual:
+		return cmp >= 0
+	case FieldFilterLessThan:
+		return cmp < 0
+	case FieldFilterLessThanOrEqual:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// ParseFields returns the fields of a structured log line and whether the line
+// is structured, i.e. its data is a JSON object.
+func ParseFields(data string) (map[string]any, bool) {
+	data = strings.TrimSpace(data)
+	if !strings.HasPrefix(data, "{") {
+		return nil, false
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(data), &fields); err != nil {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// ParseStructuredLine parses a raw structured (JSON) log line, compacting the
+// JSON object so that its fields are stored as-is. The line's priority is taken
+// from its "level" field, if it has a recognized log level. Lines that are not
+// JSON objects are kept as plain text.
+func ParseStructuredLine(line string) (LogLine, error) {
+	line = strings.TrimRight(line, "\r\n")
+	fields, ok := ParseFields(line)
+	if !ok {
+		return LogLine{Data: line}, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(strings.TrimSpace(line))); err != nil {
+		return LogLine{}, errors.Wrap(err, "compacting structured log line")
+	}
+	logLine := LogLine{Data: buf.String()}
+	if lvl, ok := fields["level"].(string); ok {
+		if priority := parseLevel(lvl); priority != level.Invalid {
+			logLine.Priority = priority
+		}
+	}
+
+	return logLine, nil
+}
+
+// MatchesFieldFilters returns whether the log line is structured and matches
+// all of the filters.
+func MatchesFieldFilters(line LogLine, filters []FieldFilter) bool {
+	fields, ok := ParseFields(line.Data)
+	if !ok {
+		return false
+	}
+	for _, filter := range filters {
+		if !filter.Matches(fields) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupField returns the value of the field, following dots into nested
+// objects. A field whose name contains dots is matched before nested objects
+// are searched.
+func lookupField(fields map[string]any, name string) (any, bool) {
+	if val, ok := fields[name]; ok {
+		return val, true
+	}
+
+	head, rest, found := strings.Cut(name, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := fields[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	return lookupField(nested, rest)
+}
+
+// compareFieldValue compares the field value to the filter value, returning a
+// negative number, 0 or a positive number if the field value is less than,
+// equal to or greater than the filter value. It returns false if the values
+// cannot be ordered.
+func compareFieldValue(val any, filterVal string) (int, bool) {
+	switch v := val.(type) {
+	case float64:
+		f, err := strconv.ParseFloat(filterVal, 64)
+		if err != nil {
+			return 0, false
+		}
+		return compareFloats(v, f), true
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			if filterF, err := strconv.ParseFloat(filterVal, 64); err == nil {
+				return compareFloats(f, filterF), true
+			}
+		}
+		if p, filterP := parseLevel(v), parseLevel(filterVal); p != level.Invalid && filterP != level.Invalid {
+			return int(p) - int(filterP), true
+		}
+		return strings.Compare(v, filterVal), true
+	default:
+		return 0, false
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseLevel returns the priority of a log level name, accepting the common
+// abbreviations used by structured loggers.
+func parseLevel(name string) level.Priority {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "warn":
+		return level.Warning
+	case "err":
+		return level.Error
+	case "fatal", "crit":
+		return level.Critical
+	case "panic", "emerg":
+		return level.Emergency
+	default:
+		return level.FromString(name)
+	}
+}
+
+// formatFieldValue returns the string form of a field value for equality
+// comparisons.
+func formatFieldValue(val any) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(out)
+	}
+}
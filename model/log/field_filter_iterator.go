@@ -0,0 +1,51 @@
+package log
+
+type fieldFilterIterator struct {
+	it        LogIterator
+	filters   []FieldFilter
+	lineLimit int
+	lineCount int
+	item      LogLine
+	exhausted bool
+}
+
+// newFieldFilterIterator returns a LogIterator that only reads the structured
+// log lines of the given iterator that match all of the field filters, up to
+// the line limit if it is greater than 0.
+func newFieldFilterIterator(it LogIterator, filters []FieldFilter, lineLimit int) *fieldFilterIterator {
+	return &fieldFilterIterator{
+		it:        it,
+		filters:   filters,
+		lineLimit: lineLimit,
+	}
+}
+
+func (it *fieldFilterIterator) Next() bool {
+	if it.exhausted {
+		return false
+	}
+	if it.lineLimit > 0 && it.lineCount == it.lineLimit {
+		it.exhausted = true
+		return false
+	}
+
+	for it.it.Next() {
+		item := it.it.Item()
+		if MatchesFieldFilters(item, it.filters) {
+			it.item = item
+			it.lineCount++
+			return true
+		}
+	}
+	it.exhausted = it.it.Exhausted()
+
+	return false
+}
+
+func (it *fieldFilterIterator) Item() LogLine { return it.item }
+
+func (it *fieldFilterIterator) Exhausted() bool { return it.exhausted }
+
+func (it *fieldFilterIterator) Err() error { return it.it.Err() }
+
+func (it *fieldFilterIterator) Close() error { return it.it.Close() }
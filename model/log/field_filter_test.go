@@ -0,0 +1,150 @@
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldFilter(t *testing.T) {
+	for _, test := range []struct {
+		filter   string
+		expected FieldFilter
+	}{
+		{filter: "component=replset", expected: FieldFilter{Field: "component", Operator: FieldFilterEqual, Value: "replset"}},
+		{filter: "level>=warn", expected: FieldFilter{Field: "level", Operator: FieldFilterGreaterThanOrEqual, Value: "warn"}},
+		{filter: "attr.durationMillis > 100", expected: FieldFilter{Field: "attr.durationMillis", Operator: FieldFilterGreaterThan, Value: "100"}},
+		{filter: "ctx!=conn1", expected: FieldFilter{Field: "ctx", Operator: FieldFilterNotEqual, Value: "conn1"}},
+		{filter: "n<=5", expected: FieldFilter{Field: "n", Operator: FieldFilterLessThanOrEqual, Value: "5"}},
+		{filter: "n<5", expected: FieldFilter{Field: "n", Operator: FieldFilterLessThan, Value: "5"}},
+		{filter: "msg=a=b", expected: FieldFilter{Field: "msg", Operator: FieldFilterEqual, Value: "a=b"}},
+		{filter: "msg=", expected: FieldFilter{Field: "msg", Operator: FieldFilterEqual, Value: ""}},
+	} {
+		t.Run(test.filter, func(t *testing.T) {
+			filter, err := ParseFieldFilter(test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, filter)
+		})
+	}
+
+	for _, invalid := range []string{"", "component", "=replset", ">=warn"} {
+		_, err := ParseFieldFilter(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestFieldFilterMatches(t *testing.T) {
+	fields, ok := ParseFields(`{"level":"WARN","component":"replset","attr":{"durationMillis":150,"ns":"test.coll"},"code":"200","ok":true,"tags":["a"]}`)
+	require.True(t, ok)
+
+	for filter, expected := range map[string]bool{
+		"component=replset":        true,
+		"component!=replset":       false,
+		"component=sharding":       false,
+		"level>=warn":              true,
+		"level>=warning":           true,
+		"level>error":              false,
+		"level<=info":              false,
+		"level=warning":            true,
+		"attr.durationMillis>100":  true,
+		"attr.durationMillis<=100": false,
+		"attr.durationMillis=150":  true,
+		"attr.ns=test.coll":        true,
+		"code>=200":                true,
+		"code<30":                  false,
+		"ok=true":                  true,
+		"ok>false":                 false,
+		`tags=["a"]`:               true,
+		"missing=value":            false,
+		"missing!=value":           true,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			f, err := ParseFieldFilter(filter)
+			require.NoError(t, err)
+			assert.Equal(t, expected, f.Matches(fields))
+		})
+	}
+
+	t.Run("UnstructuredLines", func(t *testing.T) {
+		filters := []FieldFilter{{Field: "level", Operator: FieldFilterNotEqual, Value: "info"}}
+		assert.False(t, MatchesFieldFilters(LogLine{Data: "plain text"}, filters))
+		assert.False(t, MatchesFieldFilters(LogLine{Data: "[1, 2]"}, filters))
+		assert.False(t, MatchesFieldFilters(LogLine{Data: "{not json"}, filters))
+		assert.True(t, MatchesFieldFilters(LogLine{Data: `{"level":"error"}`}, filters))
+	})
+}
+
+func TestGetWithFieldFilters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir(), UseSlash: true})
+	require.NoError(t, err)
+	svc := NewLogServiceV0(bucket)
+
+	ts := time.Now().UnixNano()
+	data := []string{
+		`{"level":"info","component":"replset","msg":"one"}`,
+		"not structured",
+		`{"level":"warn","component":"replset","msg":"two"}`,
+		`{"level":"error","component":"network","msg":"three"}`,
+		`{"level":"error","component":"replset","msg":"four"}`,
+		`{"level":"debug","component":"replset","msg":"five"}`,
+	}
+	lines := make([]LogLine, len(data))
+	for i, d := range data {
+		lines[i] = LogLine{Priority: level.Info, Timestamp: ts + int64(i), Data: d}
+	}
+	require.NoError(t, ignoreBytes(svc.Append(ctx, "task/0/task_logs/task", 0, lines[:3])))
+	require.NoError(t, ignoreBytes(svc.Append(ctx, "task/0/task_logs/task", 1, lines[3:])))
+	require.NoError(t, ignoreBytes(svc.Append(ctx, "task/0/task_logs/agent", 0, []LogLine{{Priority: level.Info, Timestamp: ts, Data: `{"level":"error","component":"replset","msg":"agent"}`}})))
+
+	filters := []FieldFilter{
+		{Field: "component", Operator: FieldFilterEqual, Value: "replset"},
+		{Field: "level", Operator: FieldFilterGreaterThanOrEqual, Value: "warn"},
+	}
+	readMessages := func(t *testing.T, opts GetOptions) []string {
+		var msgs []string
+		for _, line := range readLogLines(t, svc, ctx, opts) {
+			fields, ok := ParseFields(line.Data)
+			require.True(t, ok)
+			msgs = append(msgs, fields["msg"].(string))
+		}
+		return msgs
+	}
+
+	t.Run("SingleLog", func(t *testing.T) {
+		assert.Equal(t, []string{"two", "four"}, readMessages(t, GetOptions{LogNames: []string{"task/0/task_logs/task"}, FieldFilters: filters}))
+	})
+	t.Run("LineLimitAppliesToMatchingLines", func(t *testing.T) {
+		assert.Equal(t, []string{"two"}, readMessages(t, GetOptions{LogNames: []string{"task/0/task_logs/task"}, FieldFilters: filters, LineLimit: 1}))
+	})
+	t.Run("TailAppliesToMatchingLines", func(t *testing.T) {
+		assert.Equal(t, []string{"four"}, readMessages(t, GetOptions{LogNames: []string{"task/0/task_logs/task"}, FieldFilters: filters, TailN: 1}))
+	})
+	t.Run("MergedLogs", func(t *testing.T) {
+		assert.Equal(t, []string{"agent", "two", "four"}, readMessages(t, GetOptions{LogNames: []string{"task/0/task_logs"}, FieldFilters: filters}))
+	})
+}
+
+func TestParseStructuredLine(t *testing.T) {
+	line, err := ParseStructuredLine("  {\"level\": \"ERROR\", \"attr\": {\"ns\": \"test.coll\"}}\n")
+	require.NoError(t, err)
+	assert.Equal(t, level.Error, line.Priority)
+	assert.Equal(t, `{"level":"ERROR","attr":{"ns":"test.coll"}}`, line.Data)
+
+	line, err = ParseStructuredLine(`{"level": "verbose"}`)
+	require.NoError(t, err)
+	assert.Zero(t, line.Priority)
+	assert.Equal(t, `{"level":"verbose"}`, line.Data)
+
+	line, err = ParseStructuredLine("plain text\r\n")
+	require.NoError(t, err)
+	assert.Zero(t, line.Priority)
+	assert.Equal(t, "plain text", line.Data)
+}
@@ -0,0 +1,108 @@
+package log
+
+import (
+	"regexp"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// SearchOptions represents the arguments for searching Evergreen logs.
+type SearchOptions struct {
+	// Pattern is the regular expression that matching lines contain. Must
+	// be set.
+	Pattern *regexp.Regexp
+	// ContextLines is the number of lines from the same log to return
+	// before and after each matching line. Ignored if less than or equal
+	// to 0.
+	ContextLines int
+	// MaxMatches limits the number of matching lines returned. Ignored if
+	// less than or equal to 0.
+	MaxMatches int
+}
+
+// SearchMatch is a log line that matched a search.
+type SearchMatch struct {
+	// LogName is the name of the log that the line belongs to.
+	LogName string
+	// LineNumber is the 1-based number of the line in its log.
+	LineNumber int
+	Line       LogLine
+	// Before and After are the lines from the same log immediately before
+	// and after the matching line, up to the number of context lines.
+	Before []LogLine
+	After  []LogLine
+}
+
+// Search reads the log lines from the iterator and returns the ones that match
+// the pattern, in iteration order. Line numbers and context lines are counted
+// separately for each log, so merged logs can be searched with a single
+// iterator. Search closes the iterator.
+func Search(it LogIterator, opts SearchOptions) ([]SearchMatch, error) {
+	if opts.Pattern == nil {
+		return nil, errors.New("must specify a search pattern")
+	}
+
+	type logState struct {
+		lineNumber int
+		before     []LogLine
+	}
+	logs := map[string]*logState{}
+	var (
+		matches []SearchMatch
+		// pending are the indexes of the matches that still need lines
+		// after them.
+		pending []int
+	)
+	for it.Next() {
+		line := it.Item()
+		state, ok := logs[line.LogName]
+		if !ok {
+			state = &logState{}
+			logs[line.LogName] = state
+		}
+		state.lineNumber++
+
+		remaining := pending[:0]
+		for _, idx := range pending {
+			if matches[idx].LogName != line.LogName {
+				remaining = append(remaining, idx)
+				continue
+			}
+			matches[idx].After = append(matches[idx].After, line)
+			if len(matches[idx].After) < opts.ContextLines {
+				remaining = append(remaining, idx)
+			}
+		}
+		pending = remaining
+
+		full := opts.MaxMatches > 0 && len(matches) >= opts.MaxMatches
+		if full && len(pending) == 0 {
+			break
+		}
+		if !full && opts.Pattern.MatchString(line.Data) {
+			matches = append(matches, SearchMatch{
+				LogName:    line.LogName,
+				LineNumber: state.lineNumber,
+				Line:       line,
+				Before:     append([]LogLine{}, state.before...),
+			})
+			if opts.ContextLines > 0 {
+				pending = append(pending, len(matches)-1)
+			}
+		}
+
+		if opts.ContextLines > 0 {
+			state.before = append(state.before, line)
+			if len(state.before) > opts.ContextLines {
+				state.before = state.before[1:]
+			}
+		}
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(it.Err())
+	catcher.Add(it.Close())
+
+	return matches, errors.Wrap(catcher.Resolve(), "searching logs")
+}
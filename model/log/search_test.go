@@ -0,0 +1,69 @@
+package log
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	lines := []LogLine{
+		{LogName: "task", Timestamp: 1, Data: "starting"},
+		{LogName: "agent", Timestamp: 2, Data: "agent starting"},
+		{LogName: "task", Timestamp: 3, Data: "ERROR: connection refused"},
+		{LogName: "agent", Timestamp: 4, Data: "heartbeat"},
+		{LogName: "task", Timestamp: 5, Data: "retrying"},
+		{LogName: "task", Timestamp: 6, Data: "error: connection refused"},
+		{LogName: "task", Timestamp: 7, Data: "exiting"},
+	}
+
+	t.Run("MatchesWithLineNumbersPerLog", func(t *testing.T) {
+		matches, err := Search(newBasicIterator(lines), SearchOptions{Pattern: regexp.MustCompile("starting")})
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+		assert.Equal(t, "task", matches[0].LogName)
+		assert.Equal(t, 1, matches[0].LineNumber)
+		assert.Equal(t, "agent", matches[1].LogName)
+		assert.Equal(t, 1, matches[1].LineNumber)
+		assert.Empty(t, matches[0].Before)
+		assert.Empty(t, matches[0].After)
+	})
+	t.Run("ContextLinesAreFromTheSameLog", func(t *testing.T) {
+		matches, err := Search(newBasicIterator(lines), SearchOptions{
+			Pattern:      regexp.MustCompile("(?i)connection refused"),
+			ContextLines: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+
+		assert.Equal(t, 2, matches[0].LineNumber)
+		assert.Equal(t, []LogLine{lines[0]}, matches[0].Before)
+		assert.Equal(t, []LogLine{lines[4]}, matches[0].After)
+
+		assert.Equal(t, 4, matches[1].LineNumber)
+		assert.Equal(t, []LogLine{lines[4]}, matches[1].Before)
+		assert.Equal(t, []LogLine{lines[6]}, matches[1].After)
+	})
+	t.Run("MaxMatchesStillReadsContext", func(t *testing.T) {
+		matches, err := Search(newBasicIterator(lines), SearchOptions{
+			Pattern:      regexp.MustCompile("connection refused"),
+			ContextLines: 2,
+			MaxMatches:   1,
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		assert.Equal(t, lines[2], matches[0].Line)
+		assert.Equal(t, []LogLine{lines[4], lines[5]}, matches[0].After)
+	})
+	t.Run("NoMatches", func(t *testing.T) {
+		matches, err := Search(newBasicIterator(lines), SearchOptions{Pattern: regexp.MustCompile("panic")})
+		require.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+	t.Run("RequiresPattern", func(t *testing.T) {
+		_, err := Search(newBasicIterator(lines), SearchOptions{})
+		assert.Error(t, err)
+	})
+}
@@ -44,6 +44,10 @@ type GetOptions struct {
 	// TailN is the number of lines to read from the tail of the log.
 	// Ignored if less than or equal to 0.
 	TailN int
+	// FieldFilters, if set, only reads structured log lines, i.e. lines
+	// whose data is a JSON object, that match all of the filters. The
+	// line limit and tail apply to the matching lines.
+	FieldFilters []FieldFilter
 }
 
 // LineParser functions parse a raw log line into the service representation of
@@ -41,27 +41,43 @@ func (s *logServiceV0) Get(ctx context.Context, getOpts GetOptions) (LogIterator
 		}
 	}
 
+	// The line limit and tail apply to the lines that match the field
+	// filters, so they can't be applied when reading the chunks.
+	lineLimit, tailN := getOpts.LineLimit, getOpts.TailN
+	if len(getOpts.FieldFilters) > 0 {
+		lineLimit, tailN = 0, 0
+	}
+
 	var its []LogIterator
 	for _, chunks := range allLogChunks {
 		its = append(its, newChunkIterator(ctx, chunkIteratorOptions{
 			bucket:    s.bucket,
-			chunks:    chunks.chunks,
+			chunks:    readableChunks(chunks.chunks),
 			parser:    s.getParser(chunks.name),
 			start:     start,
 			end:       end,
-			lineLimit: getOpts.LineLimit,
-			tailN:     getOpts.TailN,
+			lineLimit: lineLimit,
+			tailN:     tailN,
 		}))
 	}
 
+	var it LogIterator
 	if len(its) == 1 {
-		return its[0], nil
+		it = its[0]
+	} else {
+		it = newMergingIterator(lineLimit, its...)
+		if tailN > 0 {
+			return newTailIterator(it, tailN)
+		}
 	}
 
-	it := newMergingIterator(getOpts.LineLimit, its...)
-	if getOpts.TailN > 0 {
-		return newTailIterator(it, getOpts.TailN)
+	if len(getOpts.FieldFilters) > 0 {
+		it = newFieldFilterIterator(it, getOpts.FieldFilters, getOpts.LineLimit)
+		if getOpts.TailN > 0 {
+			return newTailIterator(it, getOpts.TailN)
+		}
 	}
+
 	return it, nil
 }
 
@@ -184,8 +200,9 @@ func (s *logServiceV0) createChunkKey(sequence int, start, end int64, numLines i
 
 // parseChunkKey returns the chunk info encoded in the given key.
 func (s *logServiceV0) parseChunkKey(prefix, key string) (chunkInfo, error) {
-	parsedKey := strings.Split(key, "_")
-	if len(parsedKey) < 3 || len(parsedKey) > 5 {
+	compacted := strings.HasPrefix(key, compactedChunkKeyPrefix)
+	parsedKey := strings.Split(strings.TrimPrefix(key, compactedChunkKeyPrefix), "_")
+	if len(parsedKey) < 3 || len(parsedKey) > 5 || (compacted && len(parsedKey) != 4) {
 		return chunkInfo{}, errors.New("invalid key format")
 	}
 
@@ -213,7 +230,7 @@ func (s *logServiceV0) parseChunkKey(prefix, key string) (chunkInfo, error) {
 		return chunkInfo{}, errors.Wrap(err, "parsing num lines")
 	}
 	var upload int64
-	if len(parsedKey) == 4 {
+	if len(parsedKey)-idxOffset == 4 {
 		upload, err = strconv.ParseInt(parsedKey[idxOffset+3], 10, 64)
 		if err != nil {
 			return chunkInfo{}, errors.Wrap(err, "parsing upload time")
@@ -221,12 +238,13 @@ func (s *logServiceV0) parseChunkKey(prefix, key string) (chunkInfo, error) {
 	}
 
 	return chunkInfo{
-		key:      prefix + "/" + key,
-		sequence: sequence,
-		start:    start,
-		end:      end,
-		numLines: numLines,
-		upload:   upload,
+		key:       prefix + "/" + key,
+		sequence:  sequence,
+		start:     start,
+		end:       end,
+		numLines:  numLines,
+		upload:    upload,
+		compacted: compacted,
 	}, nil
 }
 
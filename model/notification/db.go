@@ -65,6 +65,9 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	case event.SlackSubscriberType:
 		n.Payload = &SlackPayload{}
 
+	case event.TeamsSubscriberType, event.ChatSubscriberType:
+		n.Payload = &util.ChatMessage{}
+
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		n.Payload = &message.GithubStatus{}
 
@@ -59,6 +59,8 @@ type Notification struct {
 type NotificationMetadata struct {
 	TaskID        string `bson:"task_id,omitempty"`
 	TaskExecution int    `bson:"task_execution,omitempty"`
+	// SubscriptionID is the subscription that created the notification.
+	SubscriptionID string `bson:"subscription_id,omitempty"`
 }
 
 // SenderKey returns an evergreen.SenderKey to get a grip sender for this
@@ -80,6 +82,9 @@ func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	case event.SlackSubscriberType:
 		return evergreen.SenderSlack, nil
 
+	case event.TeamsSubscriberType, event.ChatSubscriberType:
+		return evergreen.SenderChat, nil
+
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		return evergreen.SenderGithubStatus, nil
 
@@ -113,6 +118,16 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 		for _, header := range sub.Headers {
 			payload.Headers.Add(header.Key, header.Value)
 		}
+		payload.DeliveryCallback = func(attempts []util.WebhookAttempt, err error) {
+			grip.Error(ctx, message.WrapError(RecordWebhookDelivery(ctx, n, sub.URL, attempts, err), message.Fields{
+				"message":         "could not record webhook delivery",
+				"notification_id": n.ID,
+			}))
+			grip.Error(ctx, message.WrapError(n.MarkError(ctx, err), message.Fields{
+				"message":         "could not set error for webhook notification",
+				"notification_id": n.ID,
+			}))
+		}
 
 		return util.NewWebhookMessage(*payload), nil
 
@@ -179,6 +194,36 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 
 		return message.NewSlackMessage(level.Notice, formattedTarget, payload.Body, payload.Attachments), nil
 
+	case event.TeamsSubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.TeamsSubscriber)
+		if !ok {
+			return nil, errors.New("teams subscriber is invalid")
+		}
+
+		payload, ok := n.Payload.(*util.ChatMessage)
+		if !ok || payload == nil {
+			return nil, errors.New("teams payload is invalid")
+		}
+
+		payload.URL = sub.URL
+		payload.NotificationID = n.ID
+		return util.NewChatMessage(*payload), nil
+
+	case event.ChatSubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.ChatSubscriber)
+		if !ok {
+			return nil, errors.New("chat subscriber is invalid")
+		}
+
+		payload, ok := n.Payload.(*util.ChatMessage)
+		if !ok || payload == nil {
+			return nil, errors.New("chat payload is invalid")
+		}
+
+		payload.URL = sub.URL
+		payload.NotificationID = n.ID
+		return util.NewChatMessage(*payload), nil
+
 	case event.GithubPullRequestSubscriberType:
 		sub := n.Subscriber.Target.(*event.GithubPullRequestSubscriber)
 		payload, ok := n.Payload.(*message.GithubStatus)
@@ -266,6 +311,29 @@ func (n *Notification) MarkError(ctx context.Context, sendErr error) error {
 	return nil
 }
 
+// MarkUnsent clears the notification's sent time and error so that it can be
+// sent again.
+func (n *Notification) MarkUnsent(ctx context.Context) error {
+	if len(n.ID) == 0 {
+		return errors.New("notification has no ID")
+	}
+
+	update := bson.M{
+		"$unset": bson.M{
+			sentAtKey: 1,
+			errorKey:  1,
+		},
+	}
+	if err := db.UpdateId(ctx, Collection, n.ID, update); err != nil {
+		return errors.Wrap(err, "marking notification as unsent")
+	}
+
+	n.SentAt = time.Time{}
+	n.Error = ""
+
+	return nil
+}
+
 func (n *Notification) SetTaskMetadata(ID string, execution int) {
 	n.Metadata.TaskID = ID
 	n.Metadata.TaskExecution = execution
@@ -297,6 +365,8 @@ type NotificationStats struct {
 	EvergreenWebhook  int `json:"evergreen_webhook" bson:"evergreen_webhook" yaml:"evergreen_webhook"`
 	Email             int `json:"email" bson:"email" yaml:"email"`
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
+	Teams             int `json:"teams" bson:"teams" yaml:"teams"`
+	Chat              int `json:"chat" bson:"chat" yaml:"chat"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	GithubMerge       int `json:"github_merge" bson:"github_merge" yaml:"github_merge"`
 }
@@ -358,6 +428,12 @@ func CollectUnsentNotificationStats(ctx context.Context) (*NotificationStats, er
 		case event.SlackSubscriberType:
 			nStats.Slack = data.Count
 
+		case event.TeamsSubscriberType:
+			nStats.Teams = data.Count
+
+		case event.ChatSubscriberType:
+			nStats.Chat = data.Count
+
 		default:
 			grip.Error(ctx, message.Fields{
 				"message": fmt.Sprintf("unknown subscriber '%s'", data.Key),
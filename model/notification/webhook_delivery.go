@@ -0,0 +1,145 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	WebhookDeliveriesCollection = "webhook_deliveries"
+
+	// webhookDeliveryListLimit is the maximum number of deliveries returned
+	// when listing a subscription's deliveries.
+	webhookDeliveryListLimit = 100
+)
+
+// WebhookDeliveryStatus is the state of a webhook notification's delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliverySucceeded means the webhook receiver accepted the
+	// notification.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryDeadLetter means every attempt to deliver the
+	// notification failed and it won't be retried unless it's replayed.
+	WebhookDeliveryDeadLetter WebhookDeliveryStatus = "dead-letter"
+	// WebhookDeliveryReplaying means a user asked for the notification to be
+	// delivered again and it's waiting to be sent.
+	WebhookDeliveryReplaying WebhookDeliveryStatus = "replaying"
+)
+
+// IsValid returns whether the status is a known webhook delivery status.
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliverySucceeded, WebhookDeliveryDeadLetter, WebhookDeliveryReplaying:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookDelivery is the delivery log for a single webhook notification. It
+// records every attempt made to deliver the notification, including attempts
+// made when it's replayed.
+type WebhookDelivery struct {
+	// ID is the ID of the notification that was delivered.
+	ID             string                `bson:"_id"`
+	SubscriptionID string                `bson:"subscription_id,omitempty"`
+	URL            string                `bson:"url"`
+	Status         WebhookDeliveryStatus `bson:"status"`
+	Attempts       []util.WebhookAttempt `bson:"attempts"`
+	// Error is the error from the most recent delivery, if it failed.
+	Error         string    `bson:"error,omitempty"`
+	LastAttemptAt time.Time `bson:"last_attempt_at"`
+}
+
+var (
+	webhookDeliveryIDKey             = bsonutil.MustHaveTag(WebhookDelivery{}, "ID")
+	webhookDeliverySubscriptionIDKey = bsonutil.MustHaveTag(WebhookDelivery{}, "SubscriptionID")
+	webhookDeliveryURLKey            = bsonutil.MustHaveTag(WebhookDelivery{}, "URL")
+	webhookDeliveryStatusKey         = bsonutil.MustHaveTag(WebhookDelivery{}, "Status")
+	webhookDeliveryAttemptsKey       = bsonutil.MustHaveTag(WebhookDelivery{}, "Attempts")
+	webhookDeliveryErrorKey          = bsonutil.MustHaveTag(WebhookDelivery{}, "Error")
+	webhookDeliveryLastAttemptAtKey  = bsonutil.MustHaveTag(WebhookDelivery{}, "LastAttemptAt")
+)
+
+// RecordWebhookDelivery adds the attempts to deliver the webhook notification
+// to its delivery log. If the delivery failed, the delivery is dead-lettered.
+func RecordWebhookDelivery(ctx context.Context, n *Notification, url string, attempts []util.WebhookAttempt, sendErr error) error {
+	if len(n.ID) == 0 {
+		return errors.New("notification has no ID")
+	}
+
+	set := bson.M{
+		webhookDeliverySubscriptionIDKey: n.Metadata.SubscriptionID,
+		webhookDeliveryURLKey:            url,
+		webhookDeliveryStatusKey:         WebhookDeliverySucceeded,
+		webhookDeliveryLastAttemptAtKey:  time.Now(),
+	}
+	update := bson.M{
+		"$set": set,
+		"$push": bson.M{
+			webhookDeliveryAttemptsKey: bson.M{"$each": attempts},
+		},
+	}
+	if sendErr != nil {
+		set[webhookDeliveryStatusKey] = WebhookDeliveryDeadLetter
+		set[webhookDeliveryErrorKey] = sendErr.Error()
+	} else {
+		update["$unset"] = bson.M{webhookDeliveryErrorKey: 1}
+	}
+	if len(attempts) > 0 {
+		set[webhookDeliveryLastAttemptAtKey] = attempts[len(attempts)-1].Time
+	}
+
+	_, err := db.Upsert(ctx, WebhookDeliveriesCollection, bson.M{webhookDeliveryIDKey: n.ID}, update)
+	return errors.Wrapf(err, "recording webhook delivery for notification '%s'", n.ID)
+}
+
+// FindWebhookDelivery finds the delivery log for the webhook notification.
+func FindWebhookDelivery(ctx context.Context, id string) (*WebhookDelivery, error) {
+	delivery := WebhookDelivery{}
+	err := db.FindOneQ(ctx, WebhookDeliveriesCollection, db.Query(bson.M{webhookDeliveryIDKey: id}), &delivery)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding webhook delivery '%s'", id)
+	}
+	return &delivery, nil
+}
+
+// FindWebhookDeliveriesForSubscription finds the most recent webhook
+// deliveries for the subscription. If status is not empty, only deliveries
+// with that status are returned.
+func FindWebhookDeliveriesForSubscription(ctx context.Context, subscriptionID string, status WebhookDeliveryStatus) ([]WebhookDelivery, error) {
+	filter := bson.M{webhookDeliverySubscriptionIDKey: subscriptionID}
+	if status != "" {
+		filter[webhookDeliveryStatusKey] = status
+	}
+	q := db.Query(filter).Sort([]string{"-" + webhookDeliveryLastAttemptAtKey}).Limit(webhookDeliveryListLimit)
+
+	deliveries := []WebhookDelivery{}
+	if err := db.FindAllQ(ctx, WebhookDeliveriesCollection, q, &deliveries); err != nil {
+		return nil, errors.Wrapf(err, "finding webhook deliveries for subscription '%s'", subscriptionID)
+	}
+	return deliveries, nil
+}
+
+// MarkReplaying marks the delivery as waiting to be sent again.
+func (d *WebhookDelivery) MarkReplaying(ctx context.Context) error {
+	if err := db.UpdateId(ctx, WebhookDeliveriesCollection, d.ID, bson.M{
+		"$set": bson.M{webhookDeliveryStatusKey: WebhookDeliveryReplaying},
+	}); err != nil {
+		return errors.Wrapf(err, "marking webhook delivery '%s' as replaying", d.ID)
+	}
+	d.Status = WebhookDeliveryReplaying
+	return nil
+}
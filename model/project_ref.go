@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -143,6 +144,18 @@ type ProjectRef struct {
 	// Test selection settings
 	TestSelection TestSelectionSettings `bson:"test_selection,omitempty" json:"test_selection,omitzero" yaml:"test_selection,omitempty"`
 
+	// Test quarantine settings
+	TestQuarantine TestQuarantineSettings `bson:"test_quarantine,omitempty" json:"test_quarantine,omitzero" yaml:"test_quarantine,omitempty"`
+
+	// Cost budget settings
+	CostBudget CostBudgetSettings `bson:"cost_budget,omitempty" json:"cost_budget,omitzero" yaml:"cost_budget,omitempty"`
+
+	// Log compaction and retention settings
+	LogStorage LogStorageSettings `bson:"log_storage,omitempty" json:"log_storage,omitzero" yaml:"log_storage,omitempty"`
+
+	// Artifact retention settings
+	ArtifactRetention ArtifactRetentionSettings `bson:"artifact_retention,omitempty" json:"artifact_retention,omitzero" yaml:"artifact_retention,omitempty"`
+
 	// RunEveryMainlineCommit indicates that the project should activate the versions for all mainline commits.
 	// This goes against Evergreen's optimization of only activating the latest commit in a series of mainline commits.
 	// This is used for projects that use tasks on mainline commits to trigger downstream processes, like deployments.
@@ -491,6 +504,190 @@ type TestSelectionSettings struct {
 	DefaultEnabled *bool `bson:"default_enabled,omitempty" json:"default_enabled,omitzero" yaml:"default_enabled,omitempty"`
 }
 
+type TestQuarantineSettings struct {
+	// AutoNominate indicates whether tests should be automatically
+	// quarantined when their results on mainline commits are flaky.
+	AutoNominate *bool `bson:"auto_nominate,omitempty" json:"auto_nominate,omitzero" yaml:"auto_nominate,omitempty"`
+	// FlipRateThreshold is the fraction of recent mainline executions in
+	// which a test must flip between passing and failing for it to be
+	// nominated for quarantine.
+	FlipRateThreshold float64 `bson:"flip_rate_threshold,omitempty" json:"flip_rate_threshold,omitempty" yaml:"flip_rate_threshold,omitempty"`
+	// LookbackExecutions is the number of recent mainline executions of each
+	// task considered when computing a test's flip rate.
+	LookbackExecutions int `bson:"lookback_executions,omitempty" json:"lookback_executions,omitempty" yaml:"lookback_executions,omitempty"`
+}
+
+const (
+	defaultTestQuarantineFlipRateThreshold  = 0.3
+	defaultTestQuarantineLookbackExecutions = 20
+	maxTestQuarantineLookbackExecutions     = 100
+)
+
+// GetFlipRateThreshold returns the flip rate threshold above which tests are
+// nominated for quarantine, or the default if it is not set.
+func (s TestQuarantineSettings) GetFlipRateThreshold() float64 {
+	if s.FlipRateThreshold <= 0 {
+		return defaultTestQuarantineFlipRateThreshold
+	}
+	return s.FlipRateThreshold
+}
+
+// GetLookbackExecutions returns the number of recent mainline executions used
+// to compute flip rates, or the default if it is not set.
+func (s TestQuarantineSettings) GetLookbackExecutions() int {
+	if s.LookbackExecutions <= 0 {
+		return defaultTestQuarantineLookbackExecutions
+	}
+	return s.LookbackExecutions
+}
+
+// Validate checks that the test quarantine settings are valid.
+func (s TestQuarantineSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(s.FlipRateThreshold < 0 || s.FlipRateThreshold > 1, "flip rate threshold must be between 0 and 1")
+	catcher.ErrorfWhen(s.LookbackExecutions < 0, "lookback executions cannot be negative")
+	catcher.ErrorfWhen(s.LookbackExecutions > maxTestQuarantineLookbackExecutions, "lookback executions cannot exceed %d", maxTestQuarantineLookbackExecutions)
+	return catcher.Resolve()
+}
+
+// CostBudgetSettings configure the spending limits for a project. Alerts are
+// sent when a project's spend crosses a percentage of one of its budgets.
+type CostBudgetSettings struct {
+	// MonthlyBudget is the maximum adjusted cost, in dollars, of all tasks in
+	// the project that finish in a calendar month (UTC).
+	MonthlyBudget float64 `bson:"monthly_budget,omitempty" json:"monthly_budget,omitempty" yaml:"monthly_budget,omitempty"`
+	// VersionBudget is the maximum adjusted cost, in dollars, of all tasks in a
+	// single version.
+	VersionBudget float64 `bson:"version_budget,omitempty" json:"version_budget,omitempty" yaml:"version_budget,omitempty"`
+	// AlertThresholds are the percentages of a budget at which alerts are
+	// sent.
+	AlertThresholds []int `bson:"alert_thresholds,omitempty" json:"alert_thresholds,omitempty" yaml:"alert_thresholds,omitempty"`
+}
+
+const maxCostBudgetAlertThreshold = 1000
+
+var defaultCostBudgetAlertThresholds = []int{50, 80, 100}
+
+// IsEnabled returns whether the project has any budget configured.
+func (s CostBudgetSettings) IsEnabled() bool {
+	return s.MonthlyBudget > 0 || s.VersionBudget > 0
+}
+
+// GetAlertThresholds returns the percentages of a budget at which alerts are
+// sent in ascending order, or the defaults if they are not set.
+func (s CostBudgetSettings) GetAlertThresholds() []int {
+	if len(s.AlertThresholds) == 0 {
+		return defaultCostBudgetAlertThresholds
+	}
+	thresholds := append([]int{}, s.AlertThresholds...)
+	sort.Ints(thresholds)
+	return thresholds
+}
+
+// HighestThresholdReached returns the highest alert threshold, as a percentage
+// of the budget, that the spend has reached. It returns 0 if the budget is not
+// set or the spend has not reached any threshold.
+func (s CostBudgetSettings) HighestThresholdReached(budget, spend float64) int {
+	if budget <= 0 {
+		return 0
+	}
+	highest := 0
+	for _, threshold := range s.GetAlertThresholds() {
+		if spend >= budget*float64(threshold)/100 {
+			highest = threshold
+		}
+	}
+	return highest
+}
+
+// Validate checks that the cost budget settings are valid.
+func (s CostBudgetSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(s.MonthlyBudget < 0, "monthly budget cannot be negative")
+	catcher.ErrorfWhen(s.VersionBudget < 0, "version budget cannot be negative")
+	for _, threshold := range s.AlertThresholds {
+		catcher.ErrorfWhen(threshold <= 0 || threshold > maxCostBudgetAlertThreshold, "alert threshold %d%% must be between 1 and %d", threshold, maxCostBudgetAlertThreshold)
+	}
+	return catcher.Resolve()
+}
+
+// LogStorageSettings configure how long the logs of a project's tasks are
+// kept and whether they are compacted once the tasks finish.
+type LogStorageSettings struct {
+	// CompactLogs is whether the chunks of each finished task's logs are
+	// merged into a single object per log.
+	CompactLogs *bool `bson:"compact_logs,omitempty" json:"compact_logs,omitempty" yaml:"compact_logs,omitempty"`
+	// PatchRetentionDays is the number of days after a patch task finishes
+	// that its logs are deleted. If 0, the logs are kept for the lifetime
+	// of the bucket.
+	PatchRetentionDays int `bson:"patch_retention_days,omitempty" json:"patch_retention_days,omitempty" yaml:"patch_retention_days,omitempty"`
+	// MainlineRetentionDays is the number of days after a mainline task
+	// (i.e. any task not from a patch) finishes that its logs are deleted.
+	// If 0, the logs are kept for the lifetime of the bucket.
+	MainlineRetentionDays int `bson:"mainline_retention_days,omitempty" json:"mainline_retention_days,omitempty" yaml:"mainline_retention_days,omitempty"`
+}
+
+const maxLogRetentionDays = 3650
+
+// IsEnabled returns whether the project compacts or expires any of its logs.
+func (s LogStorageSettings) IsEnabled() bool {
+	return utility.FromBoolPtr(s.CompactLogs) || s.PatchRetentionDays > 0 || s.MainlineRetentionDays > 0
+}
+
+// GetRetentionDays returns the number of days that the logs of tasks with the
+// given requester are kept, or 0 if they are not expired.
+func (s LogStorageSettings) GetRetentionDays(requester string) int {
+	if evergreen.IsPatchRequester(requester) {
+		return s.PatchRetentionDays
+	}
+	return s.MainlineRetentionDays
+}
+
+// Validate checks that the log storage settings are valid.
+func (s LogStorageSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(s.PatchRetentionDays < 0 || s.PatchRetentionDays > maxLogRetentionDays, "patch log retention must be between 0 and %d days", maxLogRetentionDays)
+	catcher.ErrorfWhen(s.MainlineRetentionDays < 0 || s.MainlineRetentionDays > maxLogRetentionDays, "mainline log retention must be between 0 and %d days", maxLogRetentionDays)
+	return catcher.Resolve()
+}
+
+// ArtifactRetentionSettings configure how long the files that a project's
+// tasks upload with s3.put are kept.
+type ArtifactRetentionSettings struct {
+	// PatchRetentionDays is the number of days after a patch task uploads
+	// its files that they are deleted. If 0, the files are kept for the
+	// lifetime of the bucket.
+	PatchRetentionDays int `bson:"patch_retention_days,omitempty" json:"patch_retention_days,omitempty" yaml:"patch_retention_days,omitempty"`
+	// MainlineRetentionDays is the number of days after a mainline task
+	// (i.e. any task not from a patch) uploads its files that they are
+	// deleted. If 0, the files are kept for the lifetime of the bucket.
+	MainlineRetentionDays int `bson:"mainline_retention_days,omitempty" json:"mainline_retention_days,omitempty" yaml:"mainline_retention_days,omitempty"`
+}
+
+const maxArtifactRetentionDays = 3650
+
+// IsEnabled returns whether the project expires any of its artifacts.
+func (s ArtifactRetentionSettings) IsEnabled() bool {
+	return s.PatchRetentionDays > 0 || s.MainlineRetentionDays > 0
+}
+
+// GetRetentionDays returns the number of days that the artifacts of tasks with
+// the given requester are kept, or 0 if they are not expired.
+func (s ArtifactRetentionSettings) GetRetentionDays(requester string) int {
+	if evergreen.IsPatchRequester(requester) {
+		return s.PatchRetentionDays
+	}
+	return s.MainlineRetentionDays
+}
+
+// Validate checks that the artifact retention settings are valid.
+func (s ArtifactRetentionSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(s.PatchRetentionDays < 0 || s.PatchRetentionDays > maxArtifactRetentionDays, "patch artifact retention must be between 0 and %d days", maxArtifactRetentionDays)
+	catcher.ErrorfWhen(s.MainlineRetentionDays < 0 || s.MainlineRetentionDays > maxArtifactRetentionDays, "mainline artifact retention must be between 0 and %d days", maxArtifactRetentionDays)
+	return catcher.Resolve()
+}
+
 var (
 	// bson fields for the ProjectRef struct
 	ProjectRefIdKey                                 = bsonutil.MustHaveTag(ProjectRef{}, "Id")
@@ -546,6 +743,10 @@ var (
 	projectRefLastAutoRestartedTaskAtKey            = bsonutil.MustHaveTag(ProjectRef{}, "LastAutoRestartedTaskAt")
 	projectRefNumAutoRestartedTasksKey              = bsonutil.MustHaveTag(ProjectRef{}, "NumAutoRestartedTasks")
 	projectRefTestSelectionKey                      = bsonutil.MustHaveTag(ProjectRef{}, "TestSelection")
+	projectRefTestQuarantineKey                     = bsonutil.MustHaveTag(ProjectRef{}, "TestQuarantine")
+	projectRefCostBudgetKey                         = bsonutil.MustHaveTag(ProjectRef{}, "CostBudget")
+	projectRefLogStorageKey                         = bsonutil.MustHaveTag(ProjectRef{}, "LogStorage")
+	projectRefArtifactRetentionKey                  = bsonutil.MustHaveTag(ProjectRef{}, "ArtifactRetention")
 
 	commitQueueEnabledKey       = bsonutil.MustHaveTag(CommitQueueParams{}, "Enabled")
 	triggerDefinitionProjectKey = bsonutil.MustHaveTag(TriggerDefinition{}, "Project")
@@ -667,6 +868,10 @@ func (p *ProjectRef) IsTestSelectionDefaultEnabled() bool {
 	return utility.FromBoolPtr(p.TestSelection.DefaultEnabled)
 }
 
+func (p *ProjectRef) IsTestQuarantineAutoNominateEnabled() bool {
+	return utility.FromBoolPtr(p.TestQuarantine.AutoNominate)
+}
+
 const (
 	ProjectRefCollection     = "project_ref"
 	ProjectTriggerLevelTask  = "task"
@@ -2283,6 +2488,9 @@ func SaveProjectPageForSection(ctx context.Context, projectId string, p *Project
 			ProjectRefDisabledStatsCacheKey:      p.DisabledStatsCache,
 			projectRefDebugSpawnHostsDisabledKey: p.DebugSpawnHostsDisabled,
 			projectRefRunEveryMainlineCommitKey:  p.RunEveryMainlineCommit,
+			projectRefCostBudgetKey:              p.CostBudget,
+			projectRefLogStorageKey:              p.LogStorage,
+			projectRefArtifactRetentionKey:       p.ArtifactRetention,
 		}
 		// Unlike other fields, this will only be set if we're actually modifying it since it's used by the backend.
 		if p.TracksPushEvents != nil {
@@ -2393,7 +2601,8 @@ func SaveProjectPageForSection(ctx context.Context, projectId string, p *Project
 			bson.M{ProjectRefIdKey: projectId},
 			bson.M{
 				"$set": bson.M{
-					projectRefTestSelectionKey: p.TestSelection,
+					projectRefTestSelectionKey:  p.TestSelection,
+					projectRefTestQuarantineKey: p.TestQuarantine,
 				},
 			})
 	case ProjectPageGithubAppSettingsSection:
@@ -3943,3 +3943,79 @@ func TestUserHasRepoViewPermission(t *testing.T) {
 		})
 	}
 }
+
+func TestCostBudgetSettings(t *testing.T) {
+	t.Run("IsEnabled", func(t *testing.T) {
+		assert.False(t, CostBudgetSettings{}.IsEnabled())
+		assert.True(t, CostBudgetSettings{MonthlyBudget: 100}.IsEnabled())
+		assert.True(t, CostBudgetSettings{VersionBudget: 10}.IsEnabled())
+	})
+	t.Run("DefaultAlertThresholds", func(t *testing.T) {
+		assert.Equal(t, []int{50, 80, 100}, CostBudgetSettings{}.GetAlertThresholds())
+	})
+	t.Run("AlertThresholdsAreSorted", func(t *testing.T) {
+		s := CostBudgetSettings{AlertThresholds: []int{120, 75, 90}}
+		assert.Equal(t, []int{75, 90, 120}, s.GetAlertThresholds())
+		assert.Equal(t, []int{120, 75, 90}, s.AlertThresholds, "configured thresholds should not be modified")
+	})
+	t.Run("HighestThresholdReached", func(t *testing.T) {
+		s := CostBudgetSettings{}
+		assert.Zero(t, s.HighestThresholdReached(100, 49.99))
+		assert.Equal(t, 50, s.HighestThresholdReached(100, 50))
+		assert.Equal(t, 80, s.HighestThresholdReached(100, 99))
+		assert.Equal(t, 100, s.HighestThresholdReached(100, 250))
+		assert.Zero(t, s.HighestThresholdReached(0, 250), "unset budget should never reach a threshold")
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, CostBudgetSettings{}.Validate())
+		assert.NoError(t, CostBudgetSettings{MonthlyBudget: 1000, VersionBudget: 50, AlertThresholds: []int{25, 100, 150}}.Validate())
+		assert.Error(t, CostBudgetSettings{MonthlyBudget: -1}.Validate())
+		assert.Error(t, CostBudgetSettings{VersionBudget: -1}.Validate())
+		assert.Error(t, CostBudgetSettings{AlertThresholds: []int{0}}.Validate())
+		assert.Error(t, CostBudgetSettings{AlertThresholds: []int{1001}}.Validate())
+	})
+}
+
+func TestLogStorageSettings(t *testing.T) {
+	t.Run("IsEnabled", func(t *testing.T) {
+		assert.False(t, LogStorageSettings{}.IsEnabled())
+		assert.False(t, LogStorageSettings{CompactLogs: utility.FalsePtr()}.IsEnabled())
+		assert.True(t, LogStorageSettings{CompactLogs: utility.TruePtr()}.IsEnabled())
+		assert.True(t, LogStorageSettings{PatchRetentionDays: 7}.IsEnabled())
+		assert.True(t, LogStorageSettings{MainlineRetentionDays: 90}.IsEnabled())
+	})
+	t.Run("GetRetentionDays", func(t *testing.T) {
+		s := LogStorageSettings{PatchRetentionDays: 7, MainlineRetentionDays: 90}
+		assert.Equal(t, 7, s.GetRetentionDays(evergreen.PatchVersionRequester))
+		assert.Equal(t, 7, s.GetRetentionDays(evergreen.GithubPRRequester))
+		assert.Equal(t, 90, s.GetRetentionDays(evergreen.RepotrackerVersionRequester))
+		assert.Equal(t, 90, s.GetRetentionDays(evergreen.AdHocRequester))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, LogStorageSettings{}.Validate())
+		assert.NoError(t, LogStorageSettings{CompactLogs: utility.TruePtr(), PatchRetentionDays: 7, MainlineRetentionDays: 3650}.Validate())
+		assert.Error(t, LogStorageSettings{PatchRetentionDays: -1}.Validate())
+		assert.Error(t, LogStorageSettings{MainlineRetentionDays: 3651}.Validate())
+	})
+}
+
+func TestArtifactRetentionSettings(t *testing.T) {
+	t.Run("IsEnabled", func(t *testing.T) {
+		assert.False(t, ArtifactRetentionSettings{}.IsEnabled())
+		assert.True(t, ArtifactRetentionSettings{PatchRetentionDays: 14}.IsEnabled())
+		assert.True(t, ArtifactRetentionSettings{MainlineRetentionDays: 365}.IsEnabled())
+	})
+	t.Run("GetRetentionDays", func(t *testing.T) {
+		s := ArtifactRetentionSettings{PatchRetentionDays: 14, MainlineRetentionDays: 365}
+		assert.Equal(t, 14, s.GetRetentionDays(evergreen.PatchVersionRequester))
+		assert.Equal(t, 14, s.GetRetentionDays(evergreen.GithubMergeRequester))
+		assert.Equal(t, 365, s.GetRetentionDays(evergreen.RepotrackerVersionRequester))
+		assert.Equal(t, 365, s.GetRetentionDays(evergreen.GitTagRequester))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, ArtifactRetentionSettings{}.Validate())
+		assert.NoError(t, ArtifactRetentionSettings{PatchRetentionDays: 14, MainlineRetentionDays: 3650}.Validate())
+		assert.Error(t, ArtifactRetentionSettings{PatchRetentionDays: -1}.Validate())
+		assert.Error(t, ArtifactRetentionSettings{MainlineRetentionDays: 3651}.Validate())
+	})
+}
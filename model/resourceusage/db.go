@@ -0,0 +1,139 @@
+package resourceusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// Collection is the name of the collection of task resource usage.
+	Collection = "task_resource_usage"
+	// RecommendationsCollection is the name of the collection of resource
+	// recommendations.
+	RecommendationsCollection = "resource_recommendations"
+)
+
+var (
+	IDKey                    = bsonutil.MustHaveTag(TaskResourceUsage{}, "ID")
+	TaskIDKey                = bsonutil.MustHaveTag(TaskResourceUsage{}, "TaskID")
+	ExecutionKey             = bsonutil.MustHaveTag(TaskResourceUsage{}, "Execution")
+	ProjectIDKey             = bsonutil.MustHaveTag(TaskResourceUsage{}, "ProjectID")
+	BuildVariantKey          = bsonutil.MustHaveTag(TaskResourceUsage{}, "BuildVariant")
+	TaskNameKey              = bsonutil.MustHaveTag(TaskResourceUsage{}, "TaskName")
+	DistroIDKey              = bsonutil.MustHaveTag(TaskResourceUsage{}, "DistroID")
+	NumCPUsKey               = bsonutil.MustHaveTag(TaskResourceUsage{}, "NumCPUs")
+	TotalMemoryBytesKey      = bsonutil.MustHaveTag(TaskResourceUsage{}, "TotalMemoryBytes")
+	PointsKey                = bsonutil.MustHaveTag(TaskResourceUsage{}, "Points")
+	FinishTimeKey            = bsonutil.MustHaveTag(TaskResourceUsage{}, "FinishTime")
+	RecommendationIDKey      = bsonutil.MustHaveTag(Recommendation{}, "ID")
+	RecommendationProjectKey = bsonutil.MustHaveTag(Recommendation{}, "ProjectID")
+	RecommendationVariantKey = bsonutil.MustHaveTag(Recommendation{}, "BuildVariant")
+	RecommendationTaskKey    = bsonutil.MustHaveTag(Recommendation{}, "TaskName")
+)
+
+var (
+	// ProjectFinishTimeIndex is the index on the resource usage collection
+	// that the right-sizing job uses to find and remove a project's resource
+	// usage by when the tasks finished.
+	ProjectFinishTimeIndex = bson.D{
+		{Key: ProjectIDKey, Value: 1},
+		{Key: FinishTimeKey, Value: 1},
+	}
+)
+
+func byID(id string) bson.M {
+	return bson.M{IDKey: id}
+}
+
+// FindOneByTaskExecution finds the resource usage of the task execution. It
+// returns nil if the execution has no resource usage.
+func FindOneByTaskExecution(ctx context.Context, taskID string, execution int) (*TaskResourceUsage, error) {
+	u := &TaskResourceUsage{}
+	err := db.FindOneQ(ctx, Collection, db.Query(byID(usageID(taskID, execution))), u)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding resource usage for task '%s' execution %d", taskID, execution)
+	}
+	return u, nil
+}
+
+// FindSummariesByProjectSince finds the resource usage of the project's task
+// executions that finished since the given time, sorted from newest to oldest.
+// The timeseries points are not included.
+func FindSummariesByProjectSince(ctx context.Context, projectID string, since time.Time) ([]TaskResourceUsage, error) {
+	q := db.Query(bson.M{
+		ProjectIDKey:  projectID,
+		FinishTimeKey: bson.M{"$gte": since},
+	}).WithoutFields(PointsKey).Sort([]string{"-" + FinishTimeKey})
+	usages := []TaskResourceUsage{}
+	if err := db.FindAllQ(ctx, Collection, q, &usages); err != nil {
+		return nil, errors.Wrapf(err, "finding resource usage for project '%s'", projectID)
+	}
+	return usages, nil
+}
+
+// DistroCapacity is the size of a distro's hosts as reported by the agent.
+type DistroCapacity struct {
+	DistroID    string `bson:"_id"`
+	NumCPUs     int    `bson:"num_cpus"`
+	MemoryBytes uint64 `bson:"memory_bytes"`
+}
+
+// FindDistroCapacities returns the size of the hosts of every distro that ran
+// a task since the given time. It aggregates across all projects, so callers
+// should reuse the result rather than call it for each project.
+func FindDistroCapacities(ctx context.Context, since time.Time) ([]DistroCapacity, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{FinishTimeKey: bson.M{"$gte": since}}},
+		{"$group": bson.M{
+			"_id":          "$" + DistroIDKey,
+			"num_cpus":     bson.M{"$max": "$" + NumCPUsKey},
+			"memory_bytes": bson.M{"$max": "$" + TotalMemoryBytesKey},
+		}},
+	}
+	capacities := []DistroCapacity{}
+	if err := db.Aggregate(ctx, Collection, pipeline, &capacities); err != nil {
+		return nil, errors.Wrap(err, "aggregating distro capacities")
+	}
+	return capacities, nil
+}
+
+// RemoveByProjectBefore removes the resource usage of the project's task
+// executions that finished before the given time.
+func RemoveByProjectBefore(ctx context.Context, projectID string, before time.Time) error {
+	return errors.Wrapf(db.RemoveAll(ctx, Collection, bson.M{
+		ProjectIDKey:  projectID,
+		FinishTimeKey: bson.M{"$lt": before},
+	}), "removing old resource usage for project '%s'", projectID)
+}
+
+// FindRecommendationsByProject finds the project's resource recommendations,
+// sorted by build variant and task name.
+func FindRecommendationsByProject(ctx context.Context, projectID string) ([]Recommendation, error) {
+	q := db.Query(bson.M{RecommendationProjectKey: projectID}).Sort([]string{RecommendationVariantKey, RecommendationTaskKey})
+	recs := []Recommendation{}
+	if err := db.FindAllQ(ctx, RecommendationsCollection, q, &recs); err != nil {
+		return nil, errors.Wrapf(err, "finding resource recommendations for project '%s'", projectID)
+	}
+	return recs, nil
+}
+
+// RemoveRecommendationsByProjectExcept removes the project's resource
+// recommendations other than the ones with the given IDs.
+func RemoveRecommendationsByProjectExcept(ctx context.Context, projectID string, keepIDs []string) error {
+	if keepIDs == nil {
+		keepIDs = []string{}
+	}
+	return errors.Wrapf(db.RemoveAll(ctx, RecommendationsCollection, bson.M{
+		RecommendationProjectKey: projectID,
+		RecommendationIDKey:      bson.M{"$nin": keepIDs},
+	}), "removing stale resource recommendations for project '%s'", projectID)
+}
@@ -0,0 +1,5 @@
+// Package resourceusage stores the timeseries of host resource usage that the
+// agent samples while each task execution runs, and recommends cheaper or
+// larger distros for tasks whose sustained usage doesn't fit the hosts they run
+// on.
+package resourceusage
@@ -0,0 +1,158 @@
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DirectionDownsize means the task could run on a cheaper distro with
+	// smaller hosts.
+	DirectionDownsize = "downsize"
+	// DirectionUpsize means the task's hosts are too small for it.
+	DirectionUpsize = "upsize"
+
+	// MinRecommendationExecutions is the minimum number of task executions
+	// on a distro needed to recommend a different distro.
+	MinRecommendationExecutions = 5
+	// targetUtilization is the fraction of a host's CPU and memory that a
+	// task should use at most, leaving headroom for variance between runs.
+	targetUtilization = 0.8
+	// constrainedPercent is the sustained CPU or peak memory usage at which
+	// a task is considered too big for its hosts. It matches the threshold
+	// at which the agent reports a task as resource constrained.
+	constrainedPercent = 90.0
+	// executionPercentile is the percentile of the executions' sustained CPU
+	// usage that a task needs to fit, so that a single unusually heavy run
+	// doesn't prevent downsizing.
+	executionPercentile = 0.9
+)
+
+// DistroOption is a distro that a task could run on.
+type DistroOption struct {
+	DistroID    string `bson:"distro_id" json:"distro_id"`
+	Arch        string `bson:"arch" json:"arch"`
+	NumCPUs     int    `bson:"num_cpus" json:"num_cpus"`
+	MemoryBytes uint64 `bson:"memory_bytes" json:"memory_bytes"`
+	// HourlyRate is the on-demand cost of one of the distro's hosts per
+	// hour.
+	HourlyRate float64 `bson:"hourly_rate" json:"hourly_rate"`
+}
+
+// Recommendation recommends a different distro for a task on a build variant
+// based on the task's sustained resource usage.
+type Recommendation struct {
+	ID           string `bson:"_id" json:"id"`
+	ProjectID    string `bson:"project_id" json:"project_id"`
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	TaskName     string `bson:"task_name" json:"task_name"`
+	// Direction is whether the recommended distro is smaller or larger.
+	Direction string `bson:"direction" json:"direction"`
+	// NumExecutions is the number of task executions the recommendation is
+	// based on.
+	NumExecutions       int          `bson:"num_executions" json:"num_executions"`
+	SustainedCPUPercent float64      `bson:"sustained_cpu_percent" json:"sustained_cpu_percent"`
+	PeakMemoryPercent   float64      `bson:"peak_memory_percent" json:"peak_memory_percent"`
+	CurrentDistro       DistroOption `bson:"current_distro" json:"current_distro"`
+	RecommendedDistro   DistroOption `bson:"recommended_distro" json:"recommended_distro"`
+	CreatedAt           time.Time    `bson:"created_at" json:"created_at"`
+}
+
+func recommendationID(projectID, buildVariant, taskName string) string {
+	return fmt.Sprintf("%s/%s/%s", projectID, buildVariant, taskName)
+}
+
+// Upsert stores the recommendation, replacing any existing recommendation for
+// the task on the build variant.
+func (r *Recommendation) Upsert(ctx context.Context) error {
+	r.ID = recommendationID(r.ProjectID, r.BuildVariant, r.TaskName)
+	_, err := db.Replace(ctx, RecommendationsCollection, byID(r.ID), r)
+	return errors.Wrapf(err, "upserting resource recommendation for task '%s' on variant '%s'", r.TaskName, r.BuildVariant)
+}
+
+// Recommend returns a recommendation for the task based on the usage of its
+// executions, which must all be for the same task on the same build variant
+// and be sorted from newest to oldest. Only the executions on the distro the
+// task most recently ran on are considered. It returns nil if the task's
+// current distro fits it or there isn't enough data to recommend another one.
+func Recommend(usages []TaskResourceUsage, options map[string]DistroOption) *Recommendation {
+	if len(usages) == 0 {
+		return nil
+	}
+	latest := usages[0]
+	current, ok := options[latest.DistroID]
+	if !ok || current.HourlyRate <= 0 || current.NumCPUs <= 0 || current.MemoryBytes == 0 {
+		return nil
+	}
+
+	var cpuPercents []float64
+	var peakMemoryPercent float64
+	for _, u := range usages {
+		if u.DistroID != latest.DistroID {
+			continue
+		}
+		cpuPercents = append(cpuPercents, u.SustainedCPUPercent)
+		peakMemoryPercent = math.Max(peakMemoryPercent, u.PeakMemoryPercent)
+	}
+	if len(cpuPercents) < MinRecommendationExecutions {
+		return nil
+	}
+	sustainedCPUPercent := percentile(cpuPercents, executionPercentile)
+
+	requiredCPUs := sustainedCPUPercent / 100 * float64(current.NumCPUs) / targetUtilization
+	requiredMemory := peakMemoryPercent / 100 * float64(current.MemoryBytes) / targetUtilization
+	upsize := sustainedCPUPercent >= constrainedPercent || peakMemoryPercent >= constrainedPercent
+
+	var best *DistroOption
+	for _, o := range sortedOptions(options) {
+		if o.DistroID == current.DistroID || o.Arch != current.Arch || o.HourlyRate <= 0 {
+			continue
+		}
+		if float64(o.NumCPUs) < requiredCPUs || float64(o.MemoryBytes) < requiredMemory {
+			continue
+		}
+		if !upsize && o.HourlyRate >= current.HourlyRate {
+			continue
+		}
+		if best == nil || o.HourlyRate < best.HourlyRate {
+			best = &o
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	direction := DirectionDownsize
+	if upsize {
+		direction = DirectionUpsize
+	}
+	return &Recommendation{
+		ID:                  recommendationID(latest.ProjectID, latest.BuildVariant, latest.TaskName),
+		ProjectID:           latest.ProjectID,
+		BuildVariant:        latest.BuildVariant,
+		TaskName:            latest.TaskName,
+		Direction:           direction,
+		NumExecutions:       len(cpuPercents),
+		SustainedCPUPercent: sustainedCPUPercent,
+		PeakMemoryPercent:   peakMemoryPercent,
+		CurrentDistro:       current,
+		RecommendedDistro:   *best,
+	}
+}
+
+// sortedOptions returns the options sorted by distro ID so that ties between
+// equally priced distros are broken consistently.
+func sortedOptions(options map[string]DistroOption) []DistroOption {
+	sorted := make([]DistroOption, 0, len(options))
+	for _, o := range options {
+		sorted = append(sorted, o)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].DistroID < sorted[j].DistroID })
+	return sorted
+}
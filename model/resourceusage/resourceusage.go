@@ -0,0 +1,117 @@
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/pkg/errors"
+)
+
+// sustainedPercentile is the percentile of a task execution's CPU usage
+// samples that is considered its sustained usage, so that short spikes don't
+// count.
+const sustainedPercentile = 0.95
+
+// TaskResourceUsage is the resource usage of the host a task execution ran on.
+type TaskResourceUsage struct {
+	ID           string `bson:"_id" json:"id"`
+	TaskID       string `bson:"task_id" json:"task_id"`
+	Execution    int    `bson:"execution" json:"execution"`
+	ProjectID    string `bson:"project_id" json:"project_id"`
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	TaskName     string `bson:"task_name" json:"task_name"`
+	Requester    string `bson:"requester" json:"requester"`
+	DistroID     string `bson:"distro_id" json:"distro_id"`
+	InstanceType string `bson:"instance_type,omitempty" json:"instance_type,omitempty"`
+
+	NumCPUs          int                            `bson:"num_cpus" json:"num_cpus"`
+	TotalMemoryBytes uint64                         `bson:"total_memory_bytes" json:"total_memory_bytes"`
+	Points           []apimodels.ResourceUsagePoint `bson:"points" json:"points"`
+
+	// SustainedCPUPercent is the 95th percentile of the CPU usage samples.
+	SustainedCPUPercent float64 `bson:"sustained_cpu_percent" json:"sustained_cpu_percent"`
+	// PeakMemoryPercent is the highest memory usage sample.
+	PeakMemoryPercent float64   `bson:"peak_memory_percent" json:"peak_memory_percent"`
+	FinishTime        time.Time `bson:"finish_time" json:"finish_time"`
+}
+
+// TaskInfo identifies the task execution and host that resource usage was
+// sampled on.
+type TaskInfo struct {
+	TaskID       string
+	Execution    int
+	ProjectID    string
+	BuildVariant string
+	TaskName     string
+	Requester    string
+	DistroID     string
+	InstanceType string
+	FinishTime   time.Time
+}
+
+// NewTaskResourceUsage returns the resource usage of a task execution with its
+// summary statistics computed.
+func NewTaskResourceUsage(info TaskInfo, usage apimodels.ResourceUsage) *TaskResourceUsage {
+	u := &TaskResourceUsage{
+		ID:               usageID(info.TaskID, info.Execution),
+		TaskID:           info.TaskID,
+		Execution:        info.Execution,
+		ProjectID:        info.ProjectID,
+		BuildVariant:     info.BuildVariant,
+		TaskName:         info.TaskName,
+		Requester:        info.Requester,
+		DistroID:         info.DistroID,
+		InstanceType:     info.InstanceType,
+		NumCPUs:          usage.NumCPUs,
+		TotalMemoryBytes: usage.TotalMemoryBytes,
+		Points:           usage.Points,
+		FinishTime:       info.FinishTime,
+	}
+
+	cpuPercents := make([]float64, 0, len(usage.Points))
+	for _, p := range usage.Points {
+		cpuPercents = append(cpuPercents, p.CPUPercent)
+		if p.MemoryPercent > u.PeakMemoryPercent {
+			u.PeakMemoryPercent = p.MemoryPercent
+		}
+	}
+	u.SustainedCPUPercent = percentile(cpuPercents, sustainedPercentile)
+
+	return u
+}
+
+func usageID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
+
+// Upsert stores the resource usage, replacing any usage already stored for
+// the task execution.
+func (u *TaskResourceUsage) Upsert(ctx context.Context) error {
+	if u.TaskID == "" {
+		return errors.New("resource usage must have a task ID")
+	}
+	_, err := db.Replace(ctx, Collection, byID(u.ID), u)
+	return errors.Wrapf(err, "upserting resource usage for task '%s' execution %d", u.TaskID, u.Execution)
+}
+
+// percentile returns the value below which the given fraction of the values
+// fall, or zero if there are no values.
+func percentile(values []float64, fraction float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	i := int(fraction*float64(len(sorted))+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
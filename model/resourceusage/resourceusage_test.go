@@ -0,0 +1,126 @@
+package resourceusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTaskResourceUsage(t *testing.T) {
+	var points []apimodels.ResourceUsagePoint
+	for i := 1; i <= 20; i++ {
+		points = append(points, apimodels.ResourceUsagePoint{
+			CPUPercent:    30,
+			MemoryPercent: float64(50 + i),
+		})
+	}
+	// A single spike shouldn't count as sustained usage.
+	points[3].CPUPercent = 100
+
+	u := NewTaskResourceUsage(TaskInfo{TaskID: "t1", Execution: 2, DistroID: "d1"}, apimodels.ResourceUsage{
+		NumCPUs:          4,
+		TotalMemoryBytes: 1024,
+		Points:           points,
+	})
+	assert.Equal(t, "t1_2", u.ID)
+	assert.Equal(t, 4, u.NumCPUs)
+	assert.InDelta(t, 30, u.SustainedCPUPercent, 0.01)
+	assert.InDelta(t, 70, u.PeakMemoryPercent, 0.01)
+
+	empty := NewTaskResourceUsage(TaskInfo{TaskID: "t1"}, apimodels.ResourceUsage{})
+	assert.Zero(t, empty.SustainedCPUPercent)
+	assert.Zero(t, empty.PeakMemoryPercent)
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Zero(t, percentile(nil, 0.9))
+	assert.Equal(t, 7.0, percentile([]float64{7}, 0.9))
+	assert.Equal(t, 9.0, percentile([]float64{10, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 0.9))
+	assert.Equal(t, 1.0, percentile([]float64{3, 1, 2}, 0))
+}
+
+func TestRecommend(t *testing.T) {
+	const gb = 1 << 30
+	options := map[string]DistroOption{
+		"small":  {DistroID: "small", Arch: "linux_amd64", NumCPUs: 2, MemoryBytes: 4 * gb, HourlyRate: 0.1},
+		"medium": {DistroID: "medium", Arch: "linux_amd64", NumCPUs: 4, MemoryBytes: 8 * gb, HourlyRate: 0.2},
+		"large":  {DistroID: "large", Arch: "linux_amd64", NumCPUs: 8, MemoryBytes: 16 * gb, HourlyRate: 0.4},
+		"xlarge": {DistroID: "xlarge", Arch: "linux_amd64", NumCPUs: 16, MemoryBytes: 32 * gb, HourlyRate: 0.8},
+		"arm":    {DistroID: "arm", Arch: "linux_arm64", NumCPUs: 2, MemoryBytes: 4 * gb, HourlyRate: 0.05},
+	}
+	usagesOn := func(distroID string, n int, cpuPercent, memoryPercent float64) []TaskResourceUsage {
+		var usages []TaskResourceUsage
+		for i := 0; i < n; i++ {
+			usages = append(usages, TaskResourceUsage{
+				ProjectID:           "project",
+				BuildVariant:        "variant",
+				TaskName:            "task",
+				DistroID:            distroID,
+				SustainedCPUPercent: cpuPercent,
+				PeakMemoryPercent:   memoryPercent,
+				FinishTime:          time.Now().Add(-time.Duration(i) * time.Hour),
+			})
+		}
+		return usages
+	}
+
+	t.Run("DownsizesToCheapestDistroThatFits", func(t *testing.T) {
+		// 20% of 8 CPUs is 1.6 CPUs, which needs 2 CPUs with headroom.
+		rec := Recommend(usagesOn("large", 10, 20, 20), options)
+		require.NotNil(t, rec)
+		assert.Equal(t, DirectionDownsize, rec.Direction)
+		assert.Equal(t, "large", rec.CurrentDistro.DistroID)
+		assert.Equal(t, "small", rec.RecommendedDistro.DistroID, "should not recommend a different architecture")
+		assert.Equal(t, 10, rec.NumExecutions)
+		assert.Equal(t, "project/variant/task", rec.ID)
+	})
+	t.Run("MemoryLimitsDownsizing", func(t *testing.T) {
+		// 40% of 16 GB is 6.4 GB, which needs 8 GB with headroom.
+		rec := Recommend(usagesOn("large", 10, 20, 40), options)
+		require.NotNil(t, rec)
+		assert.Equal(t, "medium", rec.RecommendedDistro.DistroID)
+	})
+	t.Run("NoRecommendationWhenDistroFits", func(t *testing.T) {
+		assert.Nil(t, Recommend(usagesOn("medium", 10, 60, 60), options))
+	})
+	t.Run("UpsizesConstrainedTask", func(t *testing.T) {
+		rec := Recommend(usagesOn("medium", 10, 95, 50), options)
+		require.NotNil(t, rec)
+		assert.Equal(t, DirectionUpsize, rec.Direction)
+		assert.Equal(t, "large", rec.RecommendedDistro.DistroID)
+	})
+	t.Run("UpsizesTaskRunningOutOfMemory", func(t *testing.T) {
+		rec := Recommend(usagesOn("small", 10, 30, 95), options)
+		require.NotNil(t, rec)
+		assert.Equal(t, DirectionUpsize, rec.Direction)
+		assert.Equal(t, "medium", rec.RecommendedDistro.DistroID)
+	})
+	t.Run("IgnoresOccasionalHeavyRun", func(t *testing.T) {
+		usages := usagesOn("large", 20, 20, 20)
+		usages[5].SustainedCPUPercent = 90
+		rec := Recommend(usages, options)
+		require.NotNil(t, rec)
+		assert.Equal(t, "small", rec.RecommendedDistro.DistroID)
+	})
+	t.Run("NotEnoughExecutions", func(t *testing.T) {
+		assert.Nil(t, Recommend(usagesOn("large", MinRecommendationExecutions-1, 20, 20), options))
+	})
+	t.Run("OnlyConsidersLatestDistro", func(t *testing.T) {
+		usages := append(usagesOn("large", 2, 20, 20), usagesOn("xlarge", 10, 10, 10)...)
+		assert.Nil(t, Recommend(usages, options))
+	})
+	t.Run("UnknownOrUnpricedDistro", func(t *testing.T) {
+		assert.Nil(t, Recommend(usagesOn("unknown", 10, 20, 20), options))
+		unpriced := map[string]DistroOption{
+			"large": {DistroID: "large", Arch: "linux_amd64", NumCPUs: 8, MemoryBytes: 16 * gb},
+			"small": options["small"],
+		}
+		assert.Nil(t, Recommend(usagesOn("large", 10, 20, 20), unpriced))
+	})
+	t.Run("NoUsage", func(t *testing.T) {
+		assert.Nil(t, Recommend(nil, options))
+	})
+}
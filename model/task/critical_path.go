@@ -0,0 +1,228 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CriticalPath is the longest chain of dependent tasks in a version, which
+// determines how long the version takes to finish.
+type CriticalPath struct {
+	// Tasks are the tasks on the critical path, ordered from the first task to
+	// run to the last.
+	Tasks []CriticalPathTask
+	// StartTime is when the earliest task in the version became available to
+	// run.
+	StartTime time.Time
+	// FinishTime is when the last task on the critical path finished or, if it
+	// has not finished yet, when it is expected to finish.
+	FinishTime time.Time
+	// Makespan is the total wall-clock time from StartTime to FinishTime.
+	Makespan time.Duration
+	// Estimated indicates that some tasks on the critical path have not
+	// finished, so the makespan is partially based on expected durations.
+	Estimated bool
+}
+
+// CriticalPathTask describes a task on the critical path and how much
+// wall-clock time it added to the version's makespan.
+type CriticalPathTask struct {
+	TaskNode
+	Status string
+	// QueueWait is how long the task waited to start after it became ready
+	// to run, meaning that it was activated and all its dependencies
+	// finished.
+	QueueWait time.Duration
+	// ExecutionTime is how long the task ran or, if it has not finished,
+	// how long it is expected to run.
+	ExecutionTime time.Duration
+	// Contribution is how much wall-clock time the task added to the
+	// makespan. It includes the queue wait, the execution time, and any time
+	// the task waited to be activated after its dependencies finished.
+	Contribution time.Duration
+	// Estimated indicates that the task has not finished, so its times are
+	// based on its expected duration.
+	Estimated bool
+}
+
+// criticalPathTiming is the actual or projected timing of a task used to
+// compute the critical path.
+type criticalPathTiming struct {
+	ready       time.Time
+	start       time.Time
+	finish      time.Time
+	estimated   bool
+	predecessor *TaskNode
+}
+
+// VersionCriticalPath computes the critical path for the activated tasks in
+// the version. Tasks that have finished are weighted by their actual timing,
+// while tasks that have not finished are weighted by their stored expected
+// duration. Display tasks are excluded since their execution tasks are
+// already included.
+func VersionCriticalPath(ctx context.Context, versionID string) (*CriticalPath, error) {
+	tasks, err := FindWithFields(ctx, bson.M{
+		VersionKey:     versionID,
+		ActivatedKey:   true,
+		DisplayOnlyKey: bson.M{"$ne": true},
+	},
+		IdKey,
+		ExecutionKey,
+		ProjectKey,
+		DisplayNameKey,
+		BuildVariantKey,
+		DependsOnKey,
+		StatusKey,
+		ActivatedTimeKey,
+		ScheduledTimeKey,
+		StartTimeKey,
+		FinishTimeKey,
+		ExpectedDurationKey,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding tasks for version '%s'", versionID)
+	}
+
+	return getCriticalPath(tasks, time.Now())
+}
+
+// getCriticalPath computes the critical path through the tasks as of now.
+func getCriticalPath(tasks []Task, now time.Time) (*CriticalPath, error) {
+	if len(tasks) == 0 {
+		return &CriticalPath{}, nil
+	}
+
+	g := taskDependencyGraph(tasks, true)
+	if cycles := g.Cycles(); len(cycles) > 0 {
+		return nil, errors.Errorf("cannot compute critical path for tasks with dependency cycles: %s", cycles.String())
+	}
+	// Since the graph is transposed, tasks are sorted so that every task
+	// comes after all the tasks it depends on.
+	sortedNodes, err := g.TopologicalStableSort()
+	if err != nil {
+		return nil, errors.Wrap(err, "sorting tasks by dependencies")
+	}
+
+	tasksByNode := make(map[TaskNode]*Task, len(tasks))
+	startTime := utility.MaxTime
+	for i := range tasks {
+		tasksByNode[tasks[i].ToTaskNode()] = &tasks[i]
+		if available := getTaskAvailableTime(&tasks[i]); !utility.IsZeroTime(available) && available.Before(startTime) {
+			startTime = available
+		}
+	}
+	if startTime == utility.MaxTime {
+		startTime = now
+	}
+
+	timings := make(map[TaskNode]criticalPathTiming, len(tasks))
+	var last *TaskNode
+	for _, node := range sortedNodes {
+		t, ok := tasksByNode[node]
+		if !ok {
+			continue
+		}
+
+		timing := criticalPathTiming{ready: getTaskAvailableTime(t)}
+		if utility.IsZeroTime(timing.ready) {
+			timing.ready = startTime
+		}
+		for _, edge := range g.EdgesIntoTask(node) {
+			depTiming, ok := timings[edge.From]
+			if !ok {
+				continue
+			}
+			if timing.predecessor == nil || depTiming.finish.After(timings[*timing.predecessor].finish) {
+				from := edge.From
+				timing.predecessor = &from
+			}
+		}
+		if timing.predecessor != nil {
+			if predFinish := timings[*timing.predecessor].finish; predFinish.After(timing.ready) {
+				timing.ready = predFinish
+			}
+		}
+
+		expected := t.ExpectedDuration
+		if expected <= 0 {
+			expected = defaultTaskDuration
+		}
+		switch {
+		case evergreen.IsFinishedTaskStatus(t.Status) && !utility.IsZeroTime(t.StartTime) && !utility.IsZeroTime(t.FinishTime):
+			timing.start = t.StartTime
+			timing.finish = t.FinishTime
+		case !utility.IsZeroTime(t.StartTime):
+			timing.start = t.StartTime
+			timing.finish = t.StartTime.Add(expected)
+			if timing.finish.Before(now) {
+				timing.finish = now
+			}
+			timing.estimated = true
+		default:
+			timing.start = timing.ready
+			if timing.start.Before(now) {
+				timing.start = now
+			}
+			timing.finish = timing.start.Add(expected)
+			timing.estimated = true
+		}
+		// The recorded start time can precede the ready time if a
+		// dependency was restarted after this task ran.
+		if timing.start.Before(timing.ready) {
+			timing.ready = timing.start
+		}
+
+		timings[node] = timing
+		if last == nil || timing.finish.After(timings[*last].finish) {
+			n := node
+			last = &n
+		}
+	}
+
+	if last == nil {
+		return &CriticalPath{}, nil
+	}
+
+	cp := &CriticalPath{
+		StartTime:  startTime,
+		FinishTime: timings[*last].finish,
+		Makespan:   timings[*last].finish.Sub(startTime),
+	}
+	for node := last; node != nil; node = timings[*node].predecessor {
+		timing := timings[*node]
+		prevFinish := startTime
+		if timing.predecessor != nil {
+			prevFinish = timings[*timing.predecessor].finish
+		}
+		contribution := timing.finish.Sub(prevFinish)
+		if contribution < 0 {
+			contribution = 0
+		}
+
+		cp.Tasks = append([]CriticalPathTask{{
+			TaskNode:      *node,
+			Status:        tasksByNode[*node].Status,
+			QueueWait:     timing.start.Sub(timing.ready),
+			ExecutionTime: timing.finish.Sub(timing.start),
+			Contribution:  contribution,
+			Estimated:     timing.estimated,
+		}}, cp.Tasks...)
+		cp.Estimated = cp.Estimated || timing.estimated
+	}
+
+	return cp, nil
+}
+
+// getTaskAvailableTime returns the time at which the task was made available
+// to run, not accounting for its dependencies.
+func getTaskAvailableTime(t *Task) time.Time {
+	if !utility.IsZeroTime(t.ActivatedTime) {
+		return t.ActivatedTime
+	}
+	return t.ScheduledTime
+}
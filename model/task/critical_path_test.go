@@ -0,0 +1,121 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCriticalPath(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	t.Run("NoTasks", func(t *testing.T) {
+		cp, err := getCriticalPath(nil, start)
+		require.NoError(t, err)
+		assert.Empty(t, cp.Tasks)
+		assert.Zero(t, cp.Makespan)
+	})
+	t.Run("FinishedTasksUseActualTimes", func(t *testing.T) {
+		// compile (0-30m) -> test (waits 20m, runs 40m) -> push (waits 5m, runs 10m)
+		// lint (0-60m) runs in parallel and is not on the critical path.
+		tasks := []Task{
+			{Id: "compile", Status: evergreen.TaskSucceeded, ActivatedTime: at(0), StartTime: at(0), FinishTime: at(30)},
+			{Id: "lint", Status: evergreen.TaskSucceeded, ActivatedTime: at(0), StartTime: at(0), FinishTime: at(60)},
+			{Id: "test", Status: evergreen.TaskFailed, ActivatedTime: at(0), StartTime: at(50), FinishTime: at(90),
+				DependsOn: []Dependency{{TaskId: "compile"}}},
+			{Id: "push", Status: evergreen.TaskSucceeded, ActivatedTime: at(0), StartTime: at(95), FinishTime: at(105),
+				DependsOn: []Dependency{{TaskId: "test"}, {TaskId: "lint"}}},
+		}
+
+		cp, err := getCriticalPath(tasks, at(200))
+		require.NoError(t, err)
+		assert.False(t, cp.Estimated)
+		assert.Equal(t, start, cp.StartTime)
+		assert.Equal(t, at(105), cp.FinishTime)
+		assert.Equal(t, 105*time.Minute, cp.Makespan)
+
+		require.Len(t, cp.Tasks, 3)
+		assert.Equal(t, "compile", cp.Tasks[0].ID)
+		assert.Equal(t, time.Duration(0), cp.Tasks[0].QueueWait)
+		assert.Equal(t, 30*time.Minute, cp.Tasks[0].ExecutionTime)
+		assert.Equal(t, 30*time.Minute, cp.Tasks[0].Contribution)
+
+		assert.Equal(t, "test", cp.Tasks[1].ID)
+		assert.Equal(t, evergreen.TaskFailed, cp.Tasks[1].Status)
+		assert.Equal(t, 20*time.Minute, cp.Tasks[1].QueueWait)
+		assert.Equal(t, 40*time.Minute, cp.Tasks[1].ExecutionTime)
+		assert.Equal(t, 60*time.Minute, cp.Tasks[1].Contribution)
+
+		assert.Equal(t, "push", cp.Tasks[2].ID)
+		assert.Equal(t, 5*time.Minute, cp.Tasks[2].QueueWait)
+		assert.Equal(t, 10*time.Minute, cp.Tasks[2].ExecutionTime)
+		assert.Equal(t, 15*time.Minute, cp.Tasks[2].Contribution)
+
+		var total time.Duration
+		for _, cpTask := range cp.Tasks {
+			total += cpTask.Contribution
+		}
+		assert.Equal(t, cp.Makespan, total)
+	})
+	t.Run("UnfinishedTasksUseExpectedDurations", func(t *testing.T) {
+		tasks := []Task{
+			{Id: "compile", Status: evergreen.TaskSucceeded, ActivatedTime: at(0), StartTime: at(0), FinishTime: at(10)},
+			{Id: "test", Status: evergreen.TaskStarted, ActivatedTime: at(0), StartTime: at(10), ExpectedDuration: time.Hour,
+				DependsOn: []Dependency{{TaskId: "compile"}}},
+			{Id: "push", Status: evergreen.TaskUndispatched, ActivatedTime: at(0), ExpectedDuration: 5 * time.Minute,
+				DependsOn: []Dependency{{TaskId: "test"}}},
+		}
+
+		cp, err := getCriticalPath(tasks, at(20))
+		require.NoError(t, err)
+		assert.True(t, cp.Estimated)
+		require.Len(t, cp.Tasks, 3)
+		assert.False(t, cp.Tasks[0].Estimated)
+		assert.True(t, cp.Tasks[1].Estimated)
+		assert.Equal(t, time.Hour, cp.Tasks[1].ExecutionTime)
+		assert.True(t, cp.Tasks[2].Estimated)
+		assert.Equal(t, time.Duration(0), cp.Tasks[2].QueueWait)
+		assert.Equal(t, 5*time.Minute, cp.Tasks[2].ExecutionTime)
+		assert.Equal(t, 75*time.Minute, cp.Makespan)
+	})
+	t.Run("RunningTaskPastExpectedDurationIsExtendedToNow", func(t *testing.T) {
+		tasks := []Task{
+			{Id: "test", Status: evergreen.TaskStarted, ActivatedTime: at(0), StartTime: at(0), ExpectedDuration: 10 * time.Minute},
+		}
+
+		cp, err := getCriticalPath(tasks, at(30))
+		require.NoError(t, err)
+		require.Len(t, cp.Tasks, 1)
+		assert.Equal(t, 30*time.Minute, cp.Tasks[0].ExecutionTime)
+		assert.Equal(t, 30*time.Minute, cp.Makespan)
+	})
+	t.Run("LateActivationCountsTowardsContribution", func(t *testing.T) {
+		tasks := []Task{
+			{Id: "compile", Status: evergreen.TaskSucceeded, ActivatedTime: at(0), StartTime: at(0), FinishTime: at(10)},
+			{Id: "test", Status: evergreen.TaskSucceeded, ActivatedTime: at(40), StartTime: at(45), FinishTime: at(55),
+				DependsOn: []Dependency{{TaskId: "compile"}}},
+		}
+
+		cp, err := getCriticalPath(tasks, at(60))
+		require.NoError(t, err)
+		require.Len(t, cp.Tasks, 2)
+		assert.Equal(t, 5*time.Minute, cp.Tasks[1].QueueWait)
+		assert.Equal(t, 10*time.Minute, cp.Tasks[1].ExecutionTime)
+		assert.Equal(t, 45*time.Minute, cp.Tasks[1].Contribution)
+	})
+	t.Run("FailsWithCycle", func(t *testing.T) {
+		tasks := []Task{
+			{Id: "t0", DependsOn: []Dependency{{TaskId: "t1"}}},
+			{Id: "t1", DependsOn: []Dependency{{TaskId: "t0"}}},
+		}
+
+		_, err := getCriticalPath(tasks, start)
+		assert.Error(t, err)
+	})
+}
@@ -814,6 +814,38 @@ func GetRecentTaskStats(ctx context.Context, period time.Duration, nameKey strin
 	return result, nil
 }
 
+// GetProjectHostUsageForDistro returns the total time that tasks in each
+// project spent running on the distro's hosts, for tasks that finished after
+// the given time.
+func GetProjectHostUsageForDistro(ctx context.Context, distroID string, since time.Time) (map[string]time.Duration, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			DistroIdKey:   distroID,
+			FinishTimeKey: bson.M{"$gt": since},
+			StatusKey:     bson.M{"$in": evergreen.TaskCompletedStatuses},
+		}},
+		{"$group": bson.M{
+			"_id":        "$" + ProjectKey,
+			"time_taken": bson.M{"$sum": "$" + TimeTakenKey},
+		}},
+	}
+
+	result := []struct {
+		Project   string        `bson:"_id"`
+		TimeTaken time.Duration `bson:"time_taken"`
+	}{}
+	if err := Aggregate(ctx, pipeline, &result); err != nil {
+		return nil, errors.Wrapf(err, "aggregating project host usage for distro '%s'", distroID)
+	}
+
+	usage := make(map[string]time.Duration, len(result))
+	for _, r := range result {
+		usage[r.Project] = r.TimeTaken
+	}
+
+	return usage, nil
+}
+
 // FindByExecutionTasksAndMaxExecution returns the tasks corresponding to the
 // passed in taskIds and execution, or the most recent executions of those
 // tasks if they do not have a matching execution.
@@ -1516,6 +1548,33 @@ func FindWithFields(ctx context.Context, filter bson.M, fields ...string) ([]Tas
 	return tasks, err
 }
 
+// FindRecentMainlineTasksWithTestResults returns the most recent finished
+// mainline tasks in the project that have test results and finished after the
+// given time, sorted from newest to oldest revision. At most limit tasks are
+// returned.
+func FindRecentMainlineTasksWithTestResults(ctx context.Context, projectID string, since time.Time, limit int) ([]Task, error) {
+	q := db.Query(bson.M{
+		ProjectKey:        projectID,
+		RequesterKey:      evergreen.RepotrackerVersionRequester,
+		StatusKey:         bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:     bson.M{"$gte": since},
+		HasTestResultsKey: true,
+		DisplayOnlyKey:    bson.M{"$ne": true},
+	}).WithFields(
+		IdKey,
+		ExecutionKey,
+		ProjectKey,
+		BuildVariantKey,
+		DisplayNameKey,
+		RevisionOrderNumberKey,
+		HasTestResultsKey,
+		ResultsServiceKey,
+		TaskOutputInfoKey,
+	).Sort([]string{"-" + RevisionOrderNumberKey}).Limit(limit)
+
+	return FindAll(ctx, q)
+}
+
 func FindWithSort(ctx context.Context, filter bson.M, sort []string) ([]Task, error) {
 	tasks := []Task{}
 	_, exists := filter[DisplayOnlyKey]
@@ -3319,3 +3378,83 @@ func GetFirstTaskStartTimeForVersion(ctx context.Context, versionID string) (tim
 	}
 	return task.StartTime, nil
 }
+
+// adjustedTaskCostFieldKeys are the adjusted components of a task's cost,
+// whose sum is the task's total adjusted cost.
+var adjustedTaskCostFieldKeys = []string{
+	cost.AdjustedEC2CostKey,
+	cost.AdjustedEBSThroughputCostKey,
+	cost.AdjustedEBSStorageCostKey,
+	cost.AdjustedS3ArtifactPutCostKey,
+	cost.AdjustedS3LogPutCostKey,
+	cost.AdjustedS3ArtifactStorageCostKey,
+	cost.AdjustedS3LogStorageCostKey,
+}
+
+// VersionCost is the total adjusted cost of a version's tasks.
+type VersionCost struct {
+	VersionID string  `bson:"_id"`
+	Total     float64 `bson:"total"`
+}
+
+// GetProjectAdjustedCostsByVersion returns the total adjusted cost of the
+// project's execution tasks, including previous executions, that finished at
+// or after the given time, grouped by version. The query matches on the
+// project, status and finish time so that it's served by
+// TaskHistoricalDataIndex in both the tasks and old tasks collections.
+func GetProjectAdjustedCostsByVersion(ctx context.Context, projectID string, since time.Time) ([]VersionCost, error) {
+	return getAdjustedCostsByVersion(ctx, bson.M{
+		ProjectKey:     projectID,
+		StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:  bson.M{"$gte": since},
+		DisplayOnlyKey: bson.M{"$ne": true},
+	}, TaskHistoricalDataIndex)
+}
+
+// GetAdjustedCostsForVersions returns the total adjusted cost of all execution
+// tasks, including previous executions, in each of the given versions.
+func GetAdjustedCostsForVersions(ctx context.Context, versionIDs []string) ([]VersionCost, error) {
+	if len(versionIDs) == 0 {
+		return nil, nil
+	}
+	return getAdjustedCostsByVersion(ctx, bson.M{
+		VersionKey:     bson.M{"$in": versionIDs},
+		DisplayOnlyKey: bson.M{"$ne": true},
+	}, nil)
+}
+
+// getAdjustedCostsByVersion sums the adjusted cost of the matching tasks and
+// old tasks by version. If the hint is not nil, the query on the tasks
+// collection uses it as its index.
+func getAdjustedCostsByVersion(ctx context.Context, match bson.M, hint any) ([]VersionCost, error) {
+	components := make([]any, 0, len(adjustedTaskCostFieldKeys))
+	for _, key := range adjustedTaskCostFieldKeys {
+		components = append(components, bson.M{"$ifNull": []any{"$" + bsonutil.GetDottedKeyName(TaskCostKey, key), 0}})
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$unionWith": bson.M{
+			"coll":     OldCollection,
+			"pipeline": []bson.M{{"$match": match}},
+		}},
+		{"$group": bson.M{
+			"_id":   "$" + VersionKey,
+			"total": bson.M{"$sum": bson.M{"$add": components}},
+		}},
+	}
+
+	opts := options.Aggregate()
+	if hint != nil {
+		opts.SetHint(hint)
+	}
+	cursor, err := evergreen.GetEnvironment().DB().Collection(Collection).Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "aggregating task costs by version")
+	}
+	results := []VersionCost{}
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, errors.Wrap(err, "decoding task costs by version")
+	}
+	return results, nil
+}
@@ -2286,6 +2286,34 @@ func TestGetNoPendingGenerateTasks(t *testing.T) {
 	assert.Equal(t, 0, numPending)
 }
 
+func TestGetProjectHostUsageForDistro(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+
+	now := time.Now()
+	tasks := []Task{
+		{Id: "t1", DistroId: "d1", Project: "p1", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-time.Hour), TimeTaken: time.Minute},
+		{Id: "t2", DistroId: "d1", Project: "p1", Status: evergreen.TaskFailed, FinishTime: now.Add(-2 * time.Hour), TimeTaken: 2 * time.Minute},
+		{Id: "t3", DistroId: "d1", Project: "p2", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-time.Hour), TimeTaken: 5 * time.Minute},
+		{Id: "finished_too_long_ago", DistroId: "d1", Project: "p1", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-48 * time.Hour), TimeTaken: time.Hour},
+		{Id: "running", DistroId: "d1", Project: "p1", Status: evergreen.TaskStarted, TimeTaken: time.Hour},
+		{Id: "other_distro", DistroId: "d2", Project: "p1", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-time.Hour), TimeTaken: time.Hour},
+	}
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert(t.Context()))
+	}
+
+	usage, err := GetProjectHostUsageForDistro(t.Context(), "d1", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{
+		"p1": 3 * time.Minute,
+		"p2": 5 * time.Minute,
+	}, usage)
+
+	usage, err = GetProjectHostUsageForDistro(t.Context(), "nonexistent", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, usage)
+}
+
 func TestGetLatestTaskFromImage(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,253 @@
+package task
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// LogSearchTypeTest is the log type of matches in test logs.
+	LogSearchTypeTest = "test_log"
+
+	// DefaultProjectLogSearchTaskLimit and MaxProjectLogSearchTaskLimit are
+	// the default and maximum number of tasks searched by a project log
+	// search. The search runs while the request waits, so these are kept
+	// small.
+	DefaultProjectLogSearchTaskLimit = 20
+	MaxProjectLogSearchTaskLimit     = 50
+
+	// ProjectLogSearchTimeout is the longest a project log search can run
+	// before it gives up.
+	ProjectLogSearchTimeout = 30 * time.Second
+)
+
+// ErrProjectLogSearchTimedOut indicates that a project log search did not
+// finish within ProjectLogSearchTimeout.
+var ErrProjectLogSearchTimedOut = errors.New("project log search timed out")
+
+// searchableTaskLogTypes are the task log types searched by a log search, in
+// the order that they're searched.
+var searchableTaskLogTypes = []TaskLogType{TaskLogTypeTask, TaskLogTypeAgent, TaskLogTypeSystem}
+
+// LogSearchOptions represents the arguments for searching a task run's logs.
+type LogSearchOptions struct {
+	// Pattern is the regular expression that matching lines contain. Must
+	// be set.
+	Pattern *regexp.Regexp
+	// ContextLines is the number of lines to return before and after each
+	// matching line.
+	ContextLines int
+	// MaxMatches limits the number of matching lines returned across all
+	// of the logs. Ignored if less than or equal to 0.
+	MaxMatches int
+	// ExcludeTestLogs skips searching the task's test logs.
+	ExcludeTestLogs bool
+}
+
+// LogSearchMatch is a line in a task run's logs that matched a search.
+type LogSearchMatch struct {
+	// LogType is the type of log the line is in: one of the task log types
+	// or test_log.
+	LogType string
+	// TestLogPath is the path of the test log the line is in, relative to
+	// the task's test logs directory. Only set for test logs.
+	TestLogPath string
+
+	log.SearchMatch
+}
+
+// SearchLogs searches the task's task, agent, system and test logs for lines
+// matching the pattern. Matches are grouped by log type, in the order task,
+// agent, system and test logs.
+func (t *Task) SearchLogs(ctx context.Context, opts LogSearchOptions) ([]LogSearchMatch, error) {
+	if t.DisplayOnly {
+		return nil, errors.New("cannot search logs for a display task")
+	}
+	if opts.Pattern == nil {
+		return nil, errors.New("must specify a search pattern")
+	}
+
+	var matches []LogSearchMatch
+	searchOpts := log.SearchOptions{
+		Pattern:      opts.Pattern,
+		ContextLines: opts.ContextLines,
+		MaxMatches:   opts.MaxMatches,
+	}
+	remaining := func() bool {
+		if opts.MaxMatches <= 0 {
+			return true
+		}
+		searchOpts.MaxMatches = opts.MaxMatches - len(matches)
+		return searchOpts.MaxMatches > 0
+	}
+
+	for _, logType := range searchableTaskLogTypes {
+		if !remaining() {
+			return matches, nil
+		}
+		it, err := t.GetTaskLogs(ctx, TaskLogGetOptions{LogType: logType})
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting task log type '%s'", logType)
+		}
+		logMatches, err := log.Search(it, searchOpts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching task log type '%s'", logType)
+		}
+		for _, match := range logMatches {
+			matches = append(matches, LogSearchMatch{LogType: string(logType), SearchMatch: match})
+		}
+	}
+
+	if opts.ExcludeTestLogs || !remaining() {
+		return matches, nil
+	}
+	// An empty path is a prefix of every test log.
+	it, err := t.GetTestLogs(ctx, TestLogGetOptions{LogPaths: []string{""}})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting test logs")
+	}
+	logMatches, err := log.Search(it, searchOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "searching test logs")
+	}
+	for _, match := range logMatches {
+		matches = append(matches, LogSearchMatch{
+			LogType:     LogSearchTypeTest,
+			TestLogPath: testLogPath(match.LogName),
+			SearchMatch: match,
+		})
+	}
+
+	return matches, nil
+}
+
+// testLogPath returns the path of the test log relative to the task's test
+// logs directory.
+func testLogPath(logName string) string {
+	idx := strings.Index(logName, "/"+TestLogOutput{}.ID()+"/")
+	if idx < 0 {
+		return logName
+	}
+	return logName[idx+len(TestLogOutput{}.ID())+2:]
+}
+
+// ProjectLogSearchOptions represents the arguments for searching the logs of a
+// project's recent tasks.
+type ProjectLogSearchOptions struct {
+	ProjectID string
+	// Pattern is the regular expression that matching lines contain. Must
+	// be set.
+	Pattern *regexp.Regexp
+	// Since is the earliest finish time of the tasks to search.
+	Since time.Time
+	// Requesters, BuildVariants and TaskNames filter the tasks to search.
+	// Each defaults to all values if empty.
+	Requesters    []string
+	BuildVariants []string
+	TaskNames     []string
+	// TaskLimit is the number of most recently finished tasks to search.
+	// Defaults to DefaultProjectLogSearchTaskLimit.
+	TaskLimit int
+	// ExcludeTestLogs skips searching the tasks' test logs.
+	ExcludeTestLogs bool
+}
+
+// Validate checks that the options are valid and sets defaults.
+func (o *ProjectLogSearchOptions) Validate() error {
+	if o.ProjectID == "" {
+		return errors.New("must specify a project")
+	}
+	if o.Pattern == nil {
+		return errors.New("must specify a search pattern")
+	}
+	if o.TaskLimit <= 0 {
+		o.TaskLimit = DefaultProjectLogSearchTaskLimit
+	}
+	if o.TaskLimit > MaxProjectLogSearchTaskLimit {
+		return errors.Errorf("cannot search more than %d tasks", MaxProjectLogSearchTaskLimit)
+	}
+	return nil
+}
+
+// ProjectLogSearchResult is a task whose logs matched a project log search.
+type ProjectLogSearchResult struct {
+	Task Task
+	// FirstMatch is the first line in the task's logs that matched.
+	FirstMatch LogSearchMatch
+}
+
+// SearchProjectLogs searches the logs of the project's most recently finished
+// tasks and returns the tasks that printed a line matching the pattern, along
+// with the first matching line. Results are sorted by finish time from oldest
+// to newest, so the first result is the earliest of the searched tasks that
+// printed the line. If the search takes longer than ProjectLogSearchTimeout,
+// it returns ErrProjectLogSearchTimedOut.
+func SearchProjectLogs(ctx context.Context, opts ProjectLogSearchOptions) ([]ProjectLogSearchResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid project log search options")
+	}
+
+	searchCtx, cancel := context.WithTimeout(ctx, ProjectLogSearchTimeout)
+	defer cancel()
+	results, err := searchProjectLogs(searchCtx, opts)
+	if err != nil && ctx.Err() == nil && errors.Is(searchCtx.Err(), context.DeadlineExceeded) {
+		return nil, errors.Wrapf(ErrProjectLogSearchTimedOut, "searching logs for project '%s' took longer than %s", opts.ProjectID, ProjectLogSearchTimeout)
+	}
+	return results, err
+}
+
+func searchProjectLogs(ctx context.Context, opts ProjectLogSearchOptions) ([]ProjectLogSearchResult, error) {
+	filter := bson.M{
+		ProjectKey:     opts.ProjectID,
+		StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:  bson.M{"$gte": opts.Since},
+		DisplayOnlyKey: bson.M{"$ne": true},
+	}
+	if len(opts.Requesters) > 0 {
+		filter[RequesterKey] = bson.M{"$in": opts.Requesters}
+	}
+	if len(opts.BuildVariants) > 0 {
+		filter[BuildVariantKey] = bson.M{"$in": opts.BuildVariants}
+	}
+	if len(opts.TaskNames) > 0 {
+		filter[DisplayNameKey] = bson.M{"$in": opts.TaskNames}
+	}
+	tasks, err := FindAll(ctx, db.Query(filter).Sort([]string{"-" + FinishTimeKey}).Limit(opts.TaskLimit))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding recent tasks for project '%s'", opts.ProjectID)
+	}
+
+	var results []ProjectLogSearchResult
+	for _, t := range tasks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		matches, err := t.SearchLogs(ctx, LogSearchOptions{
+			Pattern:         opts.Pattern,
+			MaxMatches:      1,
+			ExcludeTestLogs: opts.ExcludeTestLogs,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching logs for task '%s'", t.Id)
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		results = append(results, ProjectLogSearchResult{Task: t, FirstMatch: matches[0]})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Task.FinishTime.Before(results[j].Task.FinishTime)
+	})
+
+	return results, nil
+}
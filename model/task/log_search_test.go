@@ -0,0 +1,99 @@
+package task
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+	tsk := &Task{
+		Id:      "task",
+		Project: "project",
+		TaskOutputInfo: &TaskOutput{
+			TaskLogs: TaskLogOutput{BucketConfig: bucketConfig},
+			TestLogs: TestLogOutput{BucketConfig: bucketConfig},
+		},
+	}
+	now := time.Now().UnixNano()
+	makeLines := func(data ...string) []log.LogLine {
+		lines := make([]log.LogLine, len(data))
+		for i, d := range data {
+			lines[i] = log.LogLine{Priority: level.Info, Timestamp: now + int64(i), Data: d}
+		}
+		return lines
+	}
+	require.NoError(t, AppendTaskLogs(ctx, tsk, TaskLogTypeTask, makeLines("running tests", "connection refused", "done")))
+	require.NoError(t, AppendTaskLogs(ctx, tsk, TaskLogTypeAgent, makeLines("connection refused by app server")))
+	testSvc, err := getTestLogService(ctx, tsk.TaskOutputInfo.TestLogs)
+	require.NoError(t, err)
+	_, err = testSvc.Append(ctx, getLogNames(*tsk, []string{"suite/test.log"}, TestLogOutput{}.ID())[0], 0, makeLines("setup", "connection refused"))
+	require.NoError(t, err)
+
+	t.Run("SearchesAllLogs", func(t *testing.T) {
+		matches, err := tsk.SearchLogs(ctx, LogSearchOptions{Pattern: regexp.MustCompile("connection refused"), ContextLines: 1})
+		require.NoError(t, err)
+		require.Len(t, matches, 3)
+
+		assert.Equal(t, string(TaskLogTypeTask), matches[0].LogType)
+		assert.Equal(t, 2, matches[0].LineNumber)
+		require.Len(t, matches[0].Before, 1)
+		assert.Equal(t, "running tests", matches[0].Before[0].Data)
+		require.Len(t, matches[0].After, 1)
+		assert.Equal(t, "done", matches[0].After[0].Data)
+
+		assert.Equal(t, string(TaskLogTypeAgent), matches[1].LogType)
+		assert.Equal(t, 1, matches[1].LineNumber)
+
+		assert.Equal(t, LogSearchTypeTest, matches[2].LogType)
+		assert.Equal(t, "suite/test.log", matches[2].TestLogPath)
+		assert.Equal(t, 2, matches[2].LineNumber)
+	})
+	t.Run("MaxMatchesAcrossLogs", func(t *testing.T) {
+		matches, err := tsk.SearchLogs(ctx, LogSearchOptions{Pattern: regexp.MustCompile("connection refused"), MaxMatches: 2})
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+		assert.Equal(t, string(TaskLogTypeAgent), matches[1].LogType)
+	})
+	t.Run("ExcludeTestLogs", func(t *testing.T) {
+		matches, err := tsk.SearchLogs(ctx, LogSearchOptions{Pattern: regexp.MustCompile("setup"), ExcludeTestLogs: true})
+		require.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+	t.Run("DisplayTask", func(t *testing.T) {
+		displayTask := &Task{Id: "display", DisplayOnly: true}
+		_, err := displayTask.SearchLogs(ctx, LogSearchOptions{Pattern: regexp.MustCompile("setup")})
+		assert.Error(t, err)
+	})
+}
+
+func TestProjectLogSearchOptionsValidate(t *testing.T) {
+	pattern := regexp.MustCompile("error")
+
+	opts := ProjectLogSearchOptions{ProjectID: "project", Pattern: pattern}
+	require.NoError(t, opts.Validate())
+	assert.Equal(t, DefaultProjectLogSearchTaskLimit, opts.TaskLimit)
+
+	opts = ProjectLogSearchOptions{ProjectID: "project", Pattern: pattern, TaskLimit: MaxProjectLogSearchTaskLimit}
+	assert.NoError(t, opts.Validate())
+
+	opts = ProjectLogSearchOptions{ProjectID: "project", Pattern: pattern, TaskLimit: MaxProjectLogSearchTaskLimit + 1}
+	assert.Error(t, opts.Validate())
+
+	opts = ProjectLogSearchOptions{Pattern: pattern}
+	assert.Error(t, opts.Validate())
+
+	opts = ProjectLogSearchOptions{ProjectID: "project"}
+	assert.Error(t, opts.Validate())
+}
@@ -0,0 +1,189 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CompactLogs merges the chunks of each of the task run's task and test logs
+// into a single object per log and records when the logs were compacted. The
+// task must be finished. Logs of failed tasks that have not been moved to the
+// failed task bucket yet are not compacted.
+func (t *Task) CompactLogs(ctx context.Context) (log.CompactionStats, error) {
+	var stats log.CompactionStats
+	if !t.IsFinished() {
+		return stats, errors.Errorf("task '%s' is not finished", t.Id)
+	}
+	output, ok := t.GetTaskOutputSafe()
+	if !ok || t.TaskOutputInfo == nil {
+		return stats, nil
+	}
+	if t.logsPendingFailedBucketMove(evergreen.GetEnvironment().Settings(), output) {
+		return stats, nil
+	}
+
+	for _, logs := range t.getLogStorageGroups(output) {
+		b, err := newBucket(ctx, logs.bucketConfig, logs.awsCredentials)
+		if err != nil {
+			return stats, errors.Wrap(err, "getting log bucket")
+		}
+		logStats, err := log.NewLogServiceV0(b).Compact(ctx, logs.logNames)
+		stats.Logs += logStats.Logs
+		stats.ChunksRemoved += logStats.ChunksRemoved
+		if err != nil {
+			return stats, errors.Wrap(err, "compacting logs")
+		}
+	}
+
+	now := time.Now()
+	if err := t.setTaskOutputTime(ctx, taskOutputLogsCompactedAtKey, now); err != nil {
+		return stats, errors.Wrap(err, "marking logs as compacted")
+	}
+	t.TaskOutputInfo.LogsCompactedAt = now
+
+	return stats, nil
+}
+
+// ExpireLogs deletes the task run's task and test logs and records when they
+// were deleted. It returns the number of objects removed from the log
+// buckets.
+func (t *Task) ExpireLogs(ctx context.Context) (int, error) {
+	if !t.IsFinished() {
+		return 0, errors.Errorf("task '%s' is not finished", t.Id)
+	}
+	output, ok := t.GetTaskOutputSafe()
+	if !ok || t.TaskOutputInfo == nil {
+		return 0, nil
+	}
+
+	var removed int
+	for _, logs := range t.getLogStorageGroups(output) {
+		b, err := newBucket(ctx, logs.bucketConfig, logs.awsCredentials)
+		if err != nil {
+			return removed, errors.Wrap(err, "getting log bucket")
+		}
+		n, err := log.NewLogServiceV0(b).Delete(ctx, logs.logNames)
+		removed += n
+		if err != nil {
+			return removed, errors.Wrap(err, "deleting logs")
+		}
+	}
+
+	now := time.Now()
+	if err := t.setTaskOutputTime(ctx, taskOutputLogsExpiredAtKey, now); err != nil {
+		return removed, errors.Wrap(err, "marking logs as expired")
+	}
+	t.TaskOutputInfo.LogsExpiredAt = now
+
+	return removed, nil
+}
+
+// setTaskOutputTime sets the given time field of the task run's task output.
+func (t *Task) setTaskOutputTime(ctx context.Context, key string, ts time.Time) error {
+	update := bson.M{"$set": bson.M{bsonutil.GetDottedKeyName(TaskOutputInfoKey, key): ts}}
+	if t.Archived {
+		return updateOneOld(ctx, bson.M{IdKey: t.Id}, update)
+	}
+	return UpdateOne(ctx, ByIdAndExecution(t.Id, t.Execution), update)
+}
+
+// logStorageGroup is a set of a task run's logs stored in the same bucket.
+type logStorageGroup struct {
+	bucketConfig   evergreen.BucketConfig
+	awsCredentials aws.CredentialsProvider
+	logNames       []string
+}
+
+// getLogStorageGroups returns the task run's task logs and test logs along
+// with the buckets they are stored in.
+func (t *Task) getLogStorageGroups(output *TaskOutput) []logStorageGroup {
+	// Logs are stored under the ID of the task rather than the ID of its
+	// archived execution.
+	tsk := *t
+	if tsk.Archived {
+		tsk.Id = tsk.OldTaskId
+	}
+
+	taskLogNames := make([]string, 0, 3)
+	for _, logType := range []TaskLogType{TaskLogTypeAgent, TaskLogTypeSystem, TaskLogTypeTask} {
+		taskLogNames = append(taskLogNames, getLogName(tsk, logType, output.TaskLogs.ID()))
+	}
+
+	return []logStorageGroup{
+		{
+			bucketConfig:   getBucketConfigForProject(tsk.Project, output.TaskLogs.BucketConfig),
+			awsCredentials: output.TaskLogs.AWSCredentials,
+			logNames:       taskLogNames,
+		},
+		{
+			bucketConfig:   getBucketConfigForProject(tsk.Project, output.TestLogs.BucketConfig),
+			awsCredentials: output.TestLogs.AWSCredentials,
+			logNames:       getLogNames(tsk, []string{""}, output.TestLogs.ID()),
+		},
+	}
+}
+
+// logsPendingFailedBucketMove returns whether the task failed and its logs are
+// still waiting to be moved to the failed task bucket.
+func (t *Task) logsPendingFailedBucketMove(settings *evergreen.Settings, output *TaskOutput) bool {
+	if t.Status != evergreen.TaskFailed || settings == nil || t.UsesLongRetentionBucket(settings) {
+		return false
+	}
+	failedBucket := settings.Buckets.LogBucketFailedTasks.Name
+	return failedBucket != "" && output.TaskLogs.BucketConfig.Name != failedBucket
+}
+
+// FindLogCompactionCandidates returns up to limit of the project's finished
+// tasks that finished within the given time range and whose logs have not
+// been compacted or expired, from the earliest to finish.
+func FindLogCompactionCandidates(ctx context.Context, projectID string, finishedAfter, finishedBefore time.Time, limit int) ([]Task, error) {
+	filter := bson.M{
+		ProjectKey:     projectID,
+		StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:  bson.M{"$gte": finishedAfter, "$lte": finishedBefore},
+		DisplayOnlyKey: bson.M{"$ne": true},
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, taskOutputLogsCompactedAtKey):         bson.M{"$exists": false},
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, taskOutputLogsExpiredAtKey):           bson.M{"$exists": false},
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, "task_logs", "bucket_config", "name"): bson.M{"$exists": true},
+	}
+	return FindAll(ctx, db.Query(filter).Sort([]string{FinishTimeKey}).Limit(limit))
+}
+
+// FindLogExpirationCandidates returns up to limit of the project's task runs,
+// including previous executions, with one of the given requesters that
+// finished before the given time and whose logs have not been expired yet,
+// from the earliest to finish.
+func FindLogExpirationCandidates(ctx context.Context, projectID string, requesters []string, finishedBefore time.Time, limit int) ([]Task, error) {
+	filter := bson.M{
+		ProjectKey:     projectID,
+		RequesterKey:   bson.M{"$in": requesters},
+		StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:  bson.M{"$lt": finishedBefore},
+		DisplayOnlyKey: bson.M{"$ne": true},
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, taskOutputLogsExpiredAtKey):           bson.M{"$exists": false},
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, "task_logs", "bucket_config", "name"): bson.M{"$exists": true},
+	}
+	query := db.Query(filter).Sort([]string{FinishTimeKey}).Limit(limit)
+
+	tasks, err := FindAll(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding tasks")
+	}
+	if len(tasks) >= limit {
+		return tasks, nil
+	}
+	oldTasks, err := FindAllOld(ctx, query.Limit(limit-len(tasks)))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding previous executions of tasks")
+	}
+
+	return append(tasks, oldTasks...), nil
+}
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactAndExpireLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(Collection, OldCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection, OldCollection))
+	}()
+
+	bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+	tsk := &Task{
+		Id:         "task",
+		Project:    "project",
+		Requester:  evergreen.PatchVersionRequester,
+		Status:     evergreen.TaskSucceeded,
+		FinishTime: time.Now().Add(-2 * time.Hour),
+		TaskOutputInfo: &TaskOutput{
+			TaskLogs: TaskLogOutput{BucketConfig: bucketConfig},
+			TestLogs: TestLogOutput{BucketConfig: bucketConfig},
+		},
+	}
+	require.NoError(t, tsk.Insert(ctx))
+
+	now := time.Now().UnixNano()
+	var lines []log.LogLine
+	for i, data := range []string{"one", "two", "three", "four"} {
+		line := log.LogLine{Priority: level.Info, Timestamp: now + int64(i), Data: data}
+		lines = append(lines, line)
+		require.NoError(t, AppendTaskLogs(ctx, tsk, TaskLogTypeTask, []log.LogLine{line}))
+	}
+	testSvc, err := getTestLogService(ctx, tsk.TaskOutputInfo.TestLogs)
+	require.NoError(t, err)
+	testLogName := getLogNames(*tsk, []string{"suite/test.log"}, TestLogOutput{}.ID())[0]
+	for i := range lines {
+		_, err = testSvc.Append(ctx, testLogName, i, lines[i:i+1])
+		require.NoError(t, err)
+	}
+
+	readLines := func(t *testing.T, it log.LogIterator) []string {
+		var data []string
+		for it.Next() {
+			data = append(data, it.Item().Data)
+		}
+		require.NoError(t, it.Err())
+		require.NoError(t, it.Close())
+		return data
+	}
+
+	candidates, err := FindLogCompactionCandidates(ctx, "project", time.Now().Add(-24*time.Hour), time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, tsk.Id, candidates[0].Id)
+
+	stats, err := tsk.CompactLogs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Logs)
+	assert.Equal(t, 8, stats.ChunksRemoved)
+
+	it, err := tsk.GetTaskLogs(ctx, TaskLogGetOptions{LogType: TaskLogTypeTask})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three", "four"}, readLines(t, it))
+	it, err = tsk.GetTestLogs(ctx, TestLogGetOptions{LogPaths: []string{"suite/test.log"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three", "four"}, readLines(t, it))
+
+	dbTask, err := FindOneId(ctx, tsk.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.False(t, dbTask.TaskOutputInfo.LogsCompactedAt.IsZero())
+	assert.Equal(t, bucketConfig, dbTask.TaskOutputInfo.TaskLogs.BucketConfig)
+
+	candidates, err = FindLogCompactionCandidates(ctx, "project", time.Now().Add(-24*time.Hour), time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+
+	candidates, err = FindLogExpirationCandidates(ctx, "project", evergreen.PatchRequesters, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	candidates, err = FindLogExpirationCandidates(ctx, "project", []string{evergreen.RepotrackerVersionRequester}, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+
+	removed, err := tsk.ExpireLogs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	it, err = tsk.GetTaskLogs(ctx, TaskLogGetOptions{LogType: TaskLogTypeTask})
+	require.NoError(t, err)
+	assert.Empty(t, readLines(t, it))
+
+	dbTask, err = FindOneId(ctx, tsk.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.False(t, dbTask.TaskOutputInfo.LogsExpiredAt.IsZero())
+
+	candidates, err = FindLogExpirationCandidates(ctx, "project", evergreen.PatchRequesters, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+}
+
+func TestCompactLogsUnfinishedTask(t *testing.T) {
+	tsk := &Task{Id: "task", Status: evergreen.TaskStarted}
+	_, err := tsk.CompactLogs(context.Background())
+	assert.Error(t, err)
+	_, err = tsk.ExpireLogs(context.Background())
+	assert.Error(t, err)
+}
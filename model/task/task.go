@@ -485,6 +485,9 @@ type AbortInfo struct {
 	TaskID     string `bson:"task_id,omitempty" json:"task_id,omitempty"`
 	NewVersion string `bson:"new_version,omitempty" json:"new_version,omitempty"`
 	PRClosed   bool   `bson:"pr_closed,omitempty" json:"pr_closed,omitempty"`
+	// Reason explains why the task was aborted, if it was aborted by
+	// Evergreen rather than by a user.
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
 }
 
 var (
@@ -1449,6 +1452,33 @@ func (t *Task) SetAborted(ctx context.Context, reason AbortInfo) error {
 	)
 }
 
+// SetPreempted aborts the task and marks it to reset when it finishes, so
+// that it runs again later. It only applies if the task is still in progress.
+func (t *Task) SetPreempted(ctx context.Context, reason AbortInfo) error {
+	update := taskAbortUpdate(reason)
+	update[ResetWhenFinishedKey] = true
+	if err := UpdateOne(
+		ctx,
+		bson.M{
+			IdKey:     t.Id,
+			StatusKey: bson.M{"$in": evergreen.TaskInProgressStatuses},
+		},
+		[]bson.M{
+			{"$set": update},
+			addDisplayStatusCache,
+		},
+	); err != nil {
+		return err
+	}
+	t.Aborted = true
+	t.AbortInfo = reason
+	t.ResetWhenFinished = true
+	t.ResetFailedWhenFinished = false
+	t.IsAutomaticRestart = false
+	t.DisplayStatus = t.DetermineDisplayStatus()
+	return nil
+}
+
 func taskAbortUpdate(reason AbortInfo) bson.M {
 	return bson.M{
 		AbortedKey:                 true,
@@ -4035,6 +4065,10 @@ type RankValueBreakdown struct {
 	// PatchWaitTimeImpact represents how much of the total rank value can be attributed to
 	// how long a patch task has been waiting for dispatch.
 	PatchWaitTimeImpact int64
+	// FairShareImpact represents how much of the total rank value can be attributed to
+	// the task's project using more or less than its fair share of the distro. It is
+	// negative when the project has used more than its share.
+	FairShareImpact int64
 }
 
 const (
@@ -4065,6 +4099,7 @@ func (t *Task) SetSortingValueBreakdownAttributes(ctx context.Context, breakdown
 		attribute.Int64(fmt.Sprintf("%s.stepback", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.StepbackImpact),
 		attribute.Int64(fmt.Sprintf("%s.num_dependents", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.NumDependentsImpact),
 		attribute.Int64(fmt.Sprintf("%s.estimated_runtime", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.EstimatedRuntimeImpact),
+		attribute.Int64(fmt.Sprintf("%s.fair_share", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.FairShareImpact),
 		// Priority percentage values
 		attribute.Float64(fmt.Sprintf("%s.base_priority_pct", priorityBreakdownAttributePrefix), float64(breakdown.PriorityBreakdown.InitialPriorityImpact/breakdown.TotalValue*100)),
 		attribute.Float64(fmt.Sprintf("%s.task_group_pct", priorityBreakdownAttributePrefix), float64(breakdown.PriorityBreakdown.TaskGroupImpact/breakdown.TotalValue*100)),
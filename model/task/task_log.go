@@ -69,6 +69,9 @@ type TaskLogGetOptions struct {
 	// TailN is the number of lines to read from the tail of the log.
 	// Ignored if less than or equal to 0.
 	TailN int
+	// FieldFilters limits the lines read to structured (JSON) lines whose
+	// fields match all of the filters. Ignored if empty.
+	FieldFilters []log.FieldFilter
 }
 
 // NewTaskLogSender returns a new task log sender for the given task run.
@@ -166,11 +169,12 @@ func getTaskLogs(ctx context.Context, task Task, getOpts TaskLogGetOptions) (log
 	}
 
 	return svc.Get(ctx, log.GetOptions{
-		LogNames:  []string{getLogName(task, getOpts.LogType, output.TaskLogs.ID())},
-		Start:     getOpts.Start,
-		End:       getOpts.End,
-		LineLimit: getOpts.LineLimit,
-		TailN:     getOpts.TailN,
+		LogNames:     []string{getLogName(task, getOpts.LogType, output.TaskLogs.ID())},
+		Start:        getOpts.Start,
+		End:          getOpts.End,
+		LineLimit:    getOpts.LineLimit,
+		TailN:        getOpts.TailN,
+		FieldFilters: getOpts.FieldFilters,
 	})
 }
 
@@ -1,7 +1,10 @@
 package task
 
 import (
+	"time"
+
 	"github.com/evergreen-ci/evergreen"
+	"github.com/mongodb/anser/bsonutil"
 )
 
 // TaskOutput is the versioned entry point for coordinating persistent storage
@@ -10,8 +13,19 @@ type TaskOutput struct {
 	TaskLogs    TaskLogOutput    `bson:"task_logs,omitempty" json:"task_logs"`
 	TestLogs    TestLogOutput    `bson:"test_logs,omitempty" json:"test_logs"`
 	TestResults TestResultOutput `bson:"test_results,omitempty" json:"test_results"`
+
+	// LogsCompactedAt is when the task run's logs were compacted.
+	LogsCompactedAt time.Time `bson:"logs_compacted_at,omitempty" json:"logs_compacted_at,omitzero"`
+	// LogsExpiredAt is when the task run's logs were deleted by the
+	// project's log retention policy.
+	LogsExpiredAt time.Time `bson:"logs_expired_at,omitempty" json:"logs_expired_at,omitzero"`
 }
 
+var (
+	taskOutputLogsCompactedAtKey = bsonutil.MustHaveTag(TaskOutput{}, "LogsCompactedAt")
+	taskOutputLogsExpiredAtKey   = bsonutil.MustHaveTag(TaskOutput{}, "LogsExpiredAt")
+)
+
 // InitializeTaskOutput initializes the task output for a new task run.
 func InitializeTaskOutput(env evergreen.Environment, projectID string) *TaskOutput {
 	settings := env.Settings()
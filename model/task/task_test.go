@@ -3369,6 +3369,48 @@ func TestAddExecTasksToDisplayTask(t *testing.T) {
 	assert.False(t, utility.IsZeroTime(dtFromDB.ActivatedTime))
 }
 
+func TestSetPreempted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(Collection))
+	running := &Task{
+		Id:                      "running",
+		Status:                  evergreen.TaskStarted,
+		Activated:               true,
+		ResetFailedWhenFinished: true,
+	}
+	finished := &Task{
+		Id:        "finished",
+		Status:    evergreen.TaskSucceeded,
+		Activated: true,
+	}
+	require.NoError(t, running.Insert(ctx))
+	require.NoError(t, finished.Insert(ctx))
+
+	reason := AbortInfo{User: evergreen.PreemptionTaskActivator, Reason: "preempted"}
+	require.NoError(t, running.SetPreempted(ctx, reason))
+	assert.True(t, running.Aborted)
+	assert.True(t, running.ResetWhenFinished)
+	assert.Equal(t, reason, running.AbortInfo)
+
+	dbTask, err := FindOneId(ctx, running.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.True(t, dbTask.Aborted)
+	assert.True(t, dbTask.ResetWhenFinished)
+	assert.False(t, dbTask.ResetFailedWhenFinished)
+	assert.Equal(t, reason, dbTask.AbortInfo)
+
+	assert.Error(t, finished.SetPreempted(ctx, reason), "finished task should not be preempted")
+	dbTask, err = FindOneId(ctx, finished.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.False(t, dbTask.Aborted)
+	assert.False(t, dbTask.ResetWhenFinished)
+	assert.Zero(t, dbTask.AbortInfo)
+}
+
 func TestAbortVersionTasks(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -43,6 +43,9 @@ type TestLogGetOptions struct {
 	// TailN is the number of lines to read from the tail of the log.
 	// Ignored if less than or equal to 0.
 	TailN int
+	// FieldFilters limits the lines read to structured (JSON) lines whose
+	// fields match all of the filters. Ignored if empty.
+	FieldFilters []log.FieldFilter
 }
 
 // NewTestLogSender returns a new test log sender for the given task run.
@@ -96,6 +99,7 @@ func getTestLogs(ctx context.Context, task Task, getOpts TestLogGetOptions) (log
 		DefaultTimeRangeOfFirstLog: true,
 		LineLimit:                  getOpts.LineLimit,
 		TailN:                      getOpts.TailN,
+		FieldFilters:               getOpts.FieldFilters,
 	})
 }
 
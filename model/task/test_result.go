@@ -295,7 +295,7 @@ func testStatusSortRank(status string) int {
 	switch status {
 	case evergreen.TestFailedStatus:
 		return 1
-	case evergreen.TestSilentlyFailedStatus:
+	case evergreen.TestSilentlyFailedStatus, evergreen.TestQuarantinedFailedStatus:
 		return 2
 	case evergreen.TestTimedOutStatus:
 		return 3
@@ -252,6 +252,23 @@ func GetTaskOrderByDate(ctx context.Context, date time.Time, opts FindTaskHistor
 	return found.RevisionOrderNumber, nil
 }
 
+// FindMainlineTasksInRange finds all tasks, activated or unactivated, with the given task name, build variant, and
+// project ID whose order numbers are strictly between the lower and upper bounds. Both bounds must be defined.
+// The result is sorted by order numbers, ascending (e.g. 97, 98, 99, 100, ...).
+func FindMainlineTasksInRange(ctx context.Context, opts FindTaskHistoryOptions) ([]task.Task, error) {
+	if opts.LowerBound == nil || opts.UpperBound == nil {
+		return nil, errors.New("both bounds must be defined")
+	}
+
+	filter := getBaseTaskHistoryFilter(opts)
+	filter[task.RevisionOrderNumberKey] = bson.M{
+		"$gt": utility.FromIntPtr(opts.LowerBound),
+		"$lt": utility.FromIntPtr(opts.UpperBound),
+	}
+	q := db.Query(filter).Sort([]string{task.RevisionOrderNumberKey})
+	return task.FindAll(ctx, q)
+}
+
 // All of the code below is for testing purposes only (DEVPROD-31587). It allows fetching task history sorted by CreateTime rather than by RevisionOrderNumber.
 
 // FindTaskHistoryByCreateTimeOptions defines options for task history queries sorted by create_time.
@@ -743,3 +743,112 @@ func TestGetTaskOrderByDate(t *testing.T) {
 		})
 	}
 }
+
+func TestFindMainlineTasksInRange(t *testing.T) {
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection))
+	}()
+
+	projectId := "evergreen"
+	taskName := "test-graphql"
+	buildVariant := "ubuntu2204"
+
+	for tName, tCase := range map[string]func(t *testing.T, ctx context.Context){
+		"returns activated and unactivated mainline tasks strictly between the bounds": func(t *testing.T, ctx context.Context) {
+			tasks, err := FindMainlineTasksInRange(t.Context(), FindTaskHistoryOptions{
+				TaskName:     taskName,
+				BuildVariant: buildVariant,
+				ProjectId:    projectId,
+				LowerBound:   utility.ToIntPtr(98),
+				UpperBound:   utility.ToIntPtr(102),
+			})
+			require.NoError(t, err)
+			require.Len(t, tasks, 2)
+			assert.Equal(t, "t_3", tasks[0].Id)
+			assert.Equal(t, 100, tasks[0].RevisionOrderNumber)
+			assert.Equal(t, "t_4", tasks[1].Id)
+			assert.Equal(t, 101, tasks[1].RevisionOrderNumber)
+		},
+		"returns no tasks for adjacent bounds": func(t *testing.T, ctx context.Context) {
+			tasks, err := FindMainlineTasksInRange(t.Context(), FindTaskHistoryOptions{
+				TaskName:     taskName,
+				BuildVariant: buildVariant,
+				ProjectId:    projectId,
+				LowerBound:   utility.ToIntPtr(100),
+				UpperBound:   utility.ToIntPtr(101),
+			})
+			require.NoError(t, err)
+			assert.Empty(t, tasks)
+		},
+		"errors without both bounds": func(t *testing.T, ctx context.Context) {
+			_, err := FindMainlineTasksInRange(t.Context(), FindTaskHistoryOptions{
+				TaskName:     taskName,
+				BuildVariant: buildVariant,
+				ProjectId:    projectId,
+				LowerBound:   utility.ToIntPtr(98),
+			})
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			assert.NoError(t, db.ClearCollections(task.Collection))
+
+			t1 := task.Task{
+				Id:                  "t_1",
+				Requester:           evergreen.RepotrackerVersionRequester,
+				RevisionOrderNumber: 98,
+				Activated:           true,
+				Project:             projectId,
+				DisplayName:         taskName,
+				BuildVariant:        buildVariant,
+			}
+			assert.NoError(t, t1.Insert(t.Context()))
+
+			t2 := task.Task{
+				Id:                  "t_2",
+				Requester:           evergreen.GithubPRRequester,
+				RevisionOrderNumber: 99,
+				Activated:           true,
+				Project:             projectId,
+				DisplayName:         taskName,
+				BuildVariant:        buildVariant,
+			}
+			assert.NoError(t, t2.Insert(t.Context()))
+
+			t3 := task.Task{
+				Id:                  "t_3",
+				Requester:           evergreen.RepotrackerVersionRequester,
+				RevisionOrderNumber: 100,
+				Activated:           true,
+				Project:             projectId,
+				DisplayName:         taskName,
+				BuildVariant:        buildVariant,
+			}
+			assert.NoError(t, t3.Insert(t.Context()))
+
+			t4 := task.Task{
+				Id:                  "t_4",
+				Requester:           evergreen.RepotrackerVersionRequester,
+				RevisionOrderNumber: 101,
+				Activated:           false,
+				Project:             projectId,
+				DisplayName:         taskName,
+				BuildVariant:        buildVariant,
+			}
+			assert.NoError(t, t4.Insert(t.Context()))
+
+			t5 := task.Task{
+				Id:                  "t_5",
+				Requester:           evergreen.RepotrackerVersionRequester,
+				RevisionOrderNumber: 102,
+				Activated:           true,
+				Project:             projectId,
+				DisplayName:         taskName,
+				BuildVariant:        buildVariant,
+			}
+			assert.NoError(t, t5.Insert(t.Context()))
+
+			tCase(t, t.Context())
+		})
+	}
+}
@@ -370,6 +370,31 @@ func AbortTask(ctx context.Context, taskId, caller string) error {
 	return t.SetAborted(ctx, task.AbortInfo{User: caller})
 }
 
+// PreemptTask aborts a running task so that its host can be used by
+// higher-priority work. Unlike AbortTask, the task stays active and is reset
+// once it finishes so that it runs again later. The reason is recorded in the
+// task's abort info.
+func PreemptTask(ctx context.Context, taskId, reason string) error {
+	t, err := task.FindOneId(ctx, taskId)
+	if err != nil {
+		return errors.Wrapf(err, "finding task '%s'", taskId)
+	}
+	if t == nil {
+		return errors.Errorf("task '%s' not found", taskId)
+	}
+	if !t.IsAbortable() {
+		return errors.Errorf("task '%s' currently has status '%s' - cannot preempt task"+
+			" in this status", t.Id, t.Status)
+	}
+	if t.IsPartOfDisplay(ctx) || t.IsPartOfSingleHostTaskGroup() {
+		return errors.Errorf("task '%s' cannot be reset on its own, so it cannot be preempted", t.Id)
+	}
+
+	caller := evergreen.PreemptionTaskActivator
+	event.LogTaskAbortRequest(ctx, t.Id, t.Execution, caller)
+	return errors.Wrapf(t.SetPreempted(ctx, task.AbortInfo{User: caller, Reason: reason}), "marking task '%s' preempted", t.Id)
+}
+
 // DeactivatePreviousTasks deactivates any previously activated but undispatched
 // tasks for the same build variant + display name + project combination
 // as the task, provided nothing is waiting on it.
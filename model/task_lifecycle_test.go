@@ -13,6 +13,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/db/mgo/bson"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
@@ -2853,6 +2854,94 @@ func TestAbortTask(t *testing.T) {
 
 }
 
+func TestPreemptTask(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for tName, tCase := range map[string]func(t *testing.T, running *task.Task){
+		"AbortsAndResetsRunningTask": func(t *testing.T, running *task.Task) {
+			require.NoError(t, PreemptTask(ctx, running.Id, "preempted for higher priority task 't2'"))
+
+			dbTask, err := task.FindOneId(ctx, running.Id)
+			require.NoError(t, err)
+			require.NotNil(t, dbTask)
+			assert.True(t, dbTask.Aborted)
+			assert.True(t, dbTask.ResetWhenFinished)
+			assert.True(t, dbTask.Activated, "preempted task should stay scheduled")
+			assert.Equal(t, evergreen.PreemptionTaskActivator, dbTask.AbortInfo.User)
+			assert.Equal(t, "preempted for higher priority task 't2'", dbTask.AbortInfo.Reason)
+
+			events, err := event.FindAllByResourceID(ctx, running.Id)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.TaskAbortRequest, events[0].EventType)
+		},
+		"DoesNotModifyAnnotation": func(t *testing.T, running *task.Task) {
+			annotation := annotations.TaskAnnotation{
+				Id:            "annotation",
+				TaskId:        running.Id,
+				TaskExecution: running.Execution,
+				Note:          &annotations.Note{Message: "user note"},
+			}
+			require.NoError(t, annotation.Upsert(ctx))
+
+			require.NoError(t, PreemptTask(ctx, running.Id, "preempted"))
+
+			dbAnnotation, err := annotations.FindOneByTaskIdAndExecution(ctx, running.Id, running.Execution)
+			require.NoError(t, err)
+			require.NotNil(t, dbAnnotation)
+			require.NotNil(t, dbAnnotation.Note)
+			assert.Equal(t, "user note", dbAnnotation.Note.Message)
+		},
+		"FailsForFinishedTask": func(t *testing.T, running *task.Task) {
+			finished := task.Task{
+				Id:            "finished",
+				Status:        evergreen.TaskFailed,
+				Activated:     true,
+				DisplayTaskId: utility.ToStringPtr(""),
+			}
+			require.NoError(t, finished.Insert(ctx))
+
+			assert.Error(t, PreemptTask(ctx, finished.Id, "preempted"))
+
+			dbTask, err := task.FindOneId(ctx, finished.Id)
+			require.NoError(t, err)
+			require.NotNil(t, dbTask)
+			assert.False(t, dbTask.Aborted)
+			assert.False(t, dbTask.ResetWhenFinished)
+		},
+		"FailsForSingleHostTaskGroupTask": func(t *testing.T, running *task.Task) {
+			require.NoError(t, task.UpdateOne(ctx, bson.M{task.IdKey: running.Id}, bson.M{"$set": bson.M{
+				task.TaskGroupKey:         "tg",
+				task.TaskGroupMaxHostsKey: 1,
+			}}))
+
+			assert.Error(t, PreemptTask(ctx, running.Id, "preempted"))
+
+			dbTask, err := task.FindOneId(ctx, running.Id)
+			require.NoError(t, err)
+			require.NotNil(t, dbTask)
+			assert.False(t, dbTask.Aborted)
+		},
+		"FailsForNonexistentTask": func(t *testing.T, running *task.Task) {
+			assert.Error(t, PreemptTask(ctx, "nonexistent", "preempted"))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, event.EventCollection, annotations.Collection))
+			running := &task.Task{
+				Id:            "running",
+				Status:        evergreen.TaskStarted,
+				Activated:     true,
+				Priority:      -1,
+				DisplayTaskId: utility.ToStringPtr(""),
+			}
+			require.NoError(t, running.Insert(ctx))
+			tCase(t, running)
+		})
+	}
+}
+
 func TestMarkStart(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
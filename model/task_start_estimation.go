@@ -95,6 +95,21 @@ func (s *estimatedTimeSimulator) dispatchNextTask() {
 	}
 }
 
+// EstimateHostStartDelay returns the average observed time for a host with
+// the given status to start running, or zero if the host is not starting up.
+func EstimateHostStartDelay(status string) time.Duration {
+	switch status {
+	case evergreen.HostUninitialized:
+		return hostInitializingDelay
+	case evergreen.HostStarting:
+		return hostStartingDelay
+	case evergreen.HostProvisioning:
+		return hostProvisiongingDelay
+	default:
+		return 0
+	}
+}
+
 // GetEstimatedStartTime returns the estimated start time for a task
 func GetEstimatedStartTime(ctx context.Context, t task.Task) (time.Duration, error) {
 	queue, err := LoadTaskQueue(ctx, t.DistroId)
@@ -128,12 +143,8 @@ func createSimulatorModel(ctx context.Context, taskQueue TaskQueue, hosts []host
 	}
 	for _, h := range hosts {
 		switch h.Status {
-		case evergreen.HostUninitialized:
-			estimator.hosts = append(estimator.hosts, estimatedHost{timeToCompletion: hostInitializingDelay})
-		case evergreen.HostStarting:
-			estimator.hosts = append(estimator.hosts, estimatedHost{timeToCompletion: hostStartingDelay})
-		case evergreen.HostProvisioning:
-			estimator.hosts = append(estimator.hosts, estimatedHost{timeToCompletion: hostProvisiongingDelay})
+		case evergreen.HostUninitialized, evergreen.HostStarting, evergreen.HostProvisioning:
+			estimator.hosts = append(estimator.hosts, estimatedHost{timeToCompletion: EstimateHostStartDelay(h.Status)})
 		case evergreen.HostRunning:
 			if h.RunningTask == "" {
 				estimator.hosts = append(estimator.hosts, estimatedHost{timeToCompletion: 0})
@@ -0,0 +1,258 @@
+package taskstats
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CostGroupBy is a dimension by which task costs can be grouped in a cost
+// report.
+type CostGroupBy string
+
+const (
+	CostGroupByVariant   CostGroupBy = "variant"
+	CostGroupByTask      CostGroupBy = "task"
+	CostGroupByRequester CostGroupBy = "requester"
+	CostGroupByAuthor    CostGroupBy = "author"
+
+	// CostReportMaxDays is the longest date range that a single cost report
+	// can cover.
+	CostReportMaxDays = 92
+)
+
+func (gb CostGroupBy) validate() error {
+	switch gb {
+	case CostGroupByVariant, CostGroupByTask, CostGroupByRequester, CostGroupByAuthor:
+		return nil
+	default:
+		return errors.Errorf("invalid cost group by '%s'", gb)
+	}
+}
+
+// costReportFieldKeys are the components of a task's cost that are summed in
+// a cost report.
+var costReportFieldKeys = []string{
+	cost.OnDemandEC2CostKey,
+	cost.AdjustedEC2CostKey,
+	cost.OnDemandEBSThroughputCostKey,
+	cost.AdjustedEBSThroughputCostKey,
+	cost.OnDemandEBSStorageCostKey,
+	cost.AdjustedEBSStorageCostKey,
+	cost.OnDemandS3ArtifactPutCostKey,
+	cost.AdjustedS3ArtifactPutCostKey,
+	cost.OnDemandS3LogPutCostKey,
+	cost.AdjustedS3LogPutCostKey,
+	cost.OnDemandS3ArtifactStorageCostKey,
+	cost.AdjustedS3ArtifactStorageCostKey,
+	cost.OnDemandS3LogStorageCostKey,
+	cost.AdjustedS3LogStorageCostKey,
+}
+
+// costReportAdjustedFieldKeys are the components of a task's cost that make
+// up its total adjusted cost.
+var costReportAdjustedFieldKeys = []string{
+	cost.AdjustedEC2CostKey,
+	cost.AdjustedEBSThroughputCostKey,
+	cost.AdjustedEBSStorageCostKey,
+	cost.AdjustedS3ArtifactPutCostKey,
+	cost.AdjustedS3LogPutCostKey,
+	cost.AdjustedS3ArtifactStorageCostKey,
+	cost.AdjustedS3LogStorageCostKey,
+}
+
+// CostReportFilter represents the parameters of a cost report, which sums the
+// cost of a project's tasks that finished in a date range.
+type CostReportFilter struct {
+	Project    string
+	AfterDate  time.Time
+	BeforeDate time.Time
+
+	// Requesters and BuildVariants restrict the report to tasks with one of
+	// the given requesters or build variants. If empty, tasks are not
+	// filtered by them.
+	Requesters    []string
+	BuildVariants []string
+
+	GroupBy []CostGroupBy
+}
+
+// Validate checks that the cost report filter is valid.
+func (f *CostReportFilter) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(f.Project == "", "missing project")
+	catcher.NewWhen(!f.AfterDate.Equal(utility.GetUTCDay(f.AfterDate)), "'after' date is not in UTC")
+	catcher.NewWhen(!f.BeforeDate.Equal(utility.GetUTCDay(f.BeforeDate)), "'before' date is not in UTC")
+	catcher.NewWhen(!f.BeforeDate.After(f.AfterDate), "'after' date restriction must be earlier than 'before' date restriction")
+	catcher.ErrorfWhen(f.BeforeDate.Sub(f.AfterDate) > CostReportMaxDays*utility.Day, "date range cannot exceed %d days", CostReportMaxDays)
+	catcher.NewWhen(len(f.GroupBy) == 0, "missing group by")
+
+	seen := map[CostGroupBy]bool{}
+	for _, gb := range f.GroupBy {
+		catcher.Add(gb.validate())
+		catcher.ErrorfWhen(seen[gb], "duplicate group by '%s'", gb)
+		seen[gb] = true
+	}
+
+	return catcher.Resolve()
+}
+
+func (f *CostReportFilter) groupsBy(gb CostGroupBy) bool {
+	for _, groupBy := range f.GroupBy {
+		if groupBy == gb {
+			return true
+		}
+	}
+	return false
+}
+
+// CostReport is the total cost of the tasks in one group of a cost report.
+// Only the fields of the dimensions that the report is grouped by are set.
+type CostReport struct {
+	BuildVariant string `bson:"variant"`
+	TaskName     string `bson:"task_name"`
+	Requester    string `bson:"requester"`
+	Author       string `bson:"author"`
+
+	NumTasks int `bson:"num_tasks"`
+	// TimeTaken is the total time that the tasks spent running.
+	TimeTaken time.Duration `bson:"time_taken"`
+	Cost      cost.Cost     `bson:"cost"`
+}
+
+const (
+	costReportVersionDocField = "version_doc"
+	costReportAuthorField     = "author"
+	costReportTotalField      = "total_adjusted"
+)
+
+// CostReportPipeline returns the aggregation pipeline over the tasks
+// collection that produces the cost report.
+func (f *CostReportFilter) CostReportPipeline() []bson.M {
+	match := bson.M{
+		task.ProjectKey: f.Project,
+		task.FinishTimeKey: bson.M{
+			"$gte": f.AfterDate,
+			"$lt":  f.BeforeDate,
+		},
+		task.DisplayOnlyKey: bson.M{"$ne": true},
+	}
+	if len(f.Requesters) > 0 {
+		match[task.RequesterKey] = bson.M{"$in": f.Requesters}
+	}
+	if len(f.BuildVariants) > 0 {
+		match[task.BuildVariantKey] = bson.M{"$in": f.BuildVariants}
+	}
+
+	// Previous executions of restarted tasks also cost money, so they are
+	// included alongside the latest executions.
+	taskStages := []bson.M{
+		{"$match": match},
+		{"$project": bson.M{
+			task.BuildVariantKey: 1,
+			task.DisplayNameKey:  1,
+			task.RequesterKey:    1,
+			task.VersionKey:      1,
+			task.TimeTakenKey:    1,
+			task.TaskCostKey:     1,
+		}},
+	}
+	pipeline := append([]bson.M{}, taskStages...)
+	pipeline = append(pipeline, bson.M{"$unionWith": bson.M{
+		"coll":     task.OldCollection,
+		"pipeline": taskStages,
+	}})
+
+	groupID := bson.M{}
+	if f.groupsBy(CostGroupByVariant) {
+		groupID["variant"] = taskBuildVariantKeyRef
+	}
+	if f.groupsBy(CostGroupByTask) {
+		groupID["task_name"] = taskDisplayNameKeyRef
+	}
+	if f.groupsBy(CostGroupByRequester) {
+		groupID["requester"] = taskRequesterKeyRef
+	}
+	if f.groupsBy(CostGroupByAuthor) {
+		// Tasks don't store who created them, so the author comes from the
+		// task's version, which is the patch author for patches.
+		pipeline = append(pipeline,
+			bson.M{"$lookup": bson.M{
+				"from":         model.VersionCollection,
+				"localField":   task.VersionKey,
+				"foreignField": model.VersionIdKey,
+				"as":           costReportVersionDocField,
+			}},
+			bson.M{"$addFields": bson.M{
+				costReportAuthorField: bson.M{"$arrayElemAt": Array{"$" + bsonutil.GetDottedKeyName(costReportVersionDocField, model.VersionAuthorKey), 0}},
+			}},
+		)
+		groupID["author"] = "$" + costReportAuthorField
+	}
+
+	adjusted := make(Array, 0, len(costReportAdjustedFieldKeys))
+	for _, key := range costReportAdjustedFieldKeys {
+		adjusted = append(adjusted, bson.M{"$ifNull": Array{"$" + bsonutil.GetDottedKeyName(task.TaskCostKey, key), 0}})
+	}
+	groupStage := bson.M{
+		"_id":                groupID,
+		"num_tasks":          bson.M{"$sum": 1},
+		"time_taken":         bson.M{"$sum": taskTimeTakenKeyRef},
+		costReportTotalField: bson.M{"$sum": bson.M{"$add": adjusted}},
+	}
+	costFields := bson.M{}
+	for _, key := range costReportFieldKeys {
+		groupStage[key] = bson.M{"$sum": "$" + bsonutil.GetDottedKeyName(task.TaskCostKey, key)}
+		costFields[key] = "$" + key
+	}
+
+	return append(pipeline,
+		bson.M{"$group": groupStage},
+		bson.M{"$project": bson.M{
+			"_id":                0,
+			"variant":            "$_id.variant",
+			"task_name":          "$_id.task_name",
+			"requester":          "$_id.requester",
+			"author":             "$_id.author",
+			"num_tasks":          1,
+			"time_taken":         1,
+			"cost":               costFields,
+			costReportTotalField: 1,
+		}},
+		bson.M{"$sort": bson.D{
+			{Key: costReportTotalField, Value: -1},
+			{Key: "variant", Value: 1},
+			{Key: "task_name", Value: 1},
+			{Key: "requester", Value: 1},
+			{Key: "author", Value: 1},
+		}},
+	)
+}
+
+// GetCostReport sums the cost of the project's tasks that finished in the
+// filter's date range, grouped by the filter's dimensions. The groups are
+// sorted from most to least expensive.
+func GetCostReport(ctx context.Context, filter CostReportFilter) ([]CostReport, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid cost report filter")
+	}
+
+	reports := []CostReport{}
+	if err := db.Aggregate(ctx, task.Collection, filter.CostReportPipeline(), &reports); err != nil {
+		return nil, errors.Wrap(err, "aggregating task costs")
+	}
+	for i := range reports {
+		reports[i].Cost.Total = cost.RoundCost(reports[i].Cost.TotalAdjusted())
+	}
+
+	return reports, nil
+}
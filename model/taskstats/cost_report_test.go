@@ -0,0 +1,185 @@
+package taskstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostReportFilterValidate(t *testing.T) {
+	validFilter := func() CostReportFilter {
+		return CostReportFilter{
+			Project:    "p1",
+			AfterDate:  baseDay,
+			BeforeDate: baseDay.Add(7 * 24 * time.Hour),
+			GroupBy:    []CostGroupBy{CostGroupByVariant, CostGroupByTask},
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		f := validFilter()
+		assert.NoError(t, f.Validate())
+	})
+	t.Run("MissingProject", func(t *testing.T) {
+		f := validFilter()
+		f.Project = ""
+		assert.Error(t, f.Validate())
+	})
+	t.Run("DateNotAtStartOfDay", func(t *testing.T) {
+		f := validFilter()
+		f.AfterDate = baseTime
+		assert.Error(t, f.Validate())
+	})
+	t.Run("BeforeDateNotAfterAfterDate", func(t *testing.T) {
+		f := validFilter()
+		f.BeforeDate = f.AfterDate
+		assert.Error(t, f.Validate())
+	})
+	t.Run("DateRangeTooLong", func(t *testing.T) {
+		f := validFilter()
+		f.BeforeDate = f.AfterDate.Add((CostReportMaxDays + 1) * 24 * time.Hour)
+		assert.Error(t, f.Validate())
+	})
+	t.Run("MissingGroupBy", func(t *testing.T) {
+		f := validFilter()
+		f.GroupBy = nil
+		assert.Error(t, f.Validate())
+	})
+	t.Run("InvalidGroupBy", func(t *testing.T) {
+		f := validFilter()
+		f.GroupBy = []CostGroupBy{"distro"}
+		assert.Error(t, f.Validate())
+	})
+	t.Run("DuplicateGroupBy", func(t *testing.T) {
+		f := validFilter()
+		f.GroupBy = []CostGroupBy{CostGroupByVariant, CostGroupByVariant}
+		assert.Error(t, f.Validate())
+	})
+}
+
+func TestCostReportPipeline(t *testing.T) {
+	t.Run("OnlyLooksUpVersionsWhenGroupingByAuthor", func(t *testing.T) {
+		hasLookup := func(f CostReportFilter) bool {
+			for _, stage := range f.CostReportPipeline() {
+				if _, ok := stage["$lookup"]; ok {
+					return true
+				}
+			}
+			return false
+		}
+		f := CostReportFilter{Project: "p1", GroupBy: []CostGroupBy{CostGroupByVariant, CostGroupByRequester}}
+		assert.False(t, hasLookup(f))
+		f.GroupBy = append(f.GroupBy, CostGroupByAuthor)
+		assert.True(t, hasLookup(f))
+	})
+}
+
+func TestGetCostReport(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, model.VersionCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, model.VersionCollection))
+	}()
+
+	finish := baseDay.Add(time.Hour)
+	makeTask := func(id, variant, name, requester, version string, ec2Cost float64) task.Task {
+		return task.Task{
+			Id:           id,
+			Project:      "p1",
+			BuildVariant: variant,
+			DisplayName:  name,
+			Requester:    requester,
+			Version:      version,
+			FinishTime:   finish,
+			TimeTaken:    time.Minute,
+			TaskCost:     cost.Cost{AdjustedEC2Cost: ec2Cost, OnDemandEC2Cost: ec2Cost},
+		}
+	}
+	tasks := []task.Task{
+		makeTask("t1", "v1", "compile", evergreen.RepotrackerVersionRequester, "version1", 3),
+		makeTask("t2", "v1", "test", evergreen.PatchVersionRequester, "version2", 1),
+		makeTask("t3", "v2", "compile", evergreen.PatchVersionRequester, "version2", 2),
+		makeTask("t4", "v1", "compile", evergreen.RepotrackerVersionRequester, "version1", 5),
+	}
+	// Tasks outside the date range or in other projects are not included.
+	outOfRange := makeTask("t5", "v1", "compile", evergreen.RepotrackerVersionRequester, "version1", 100)
+	outOfRange.FinishTime = baseDay.Add(-time.Hour)
+	tasks = append(tasks, outOfRange)
+	otherProject := makeTask("t6", "v1", "compile", evergreen.RepotrackerVersionRequester, "version1", 100)
+	otherProject.Project = "p2"
+	tasks = append(tasks, otherProject)
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert(ctx))
+	}
+
+	// A previous execution of a restarted task is included.
+	oldExecution := makeTask("t1_0", "v1", "compile", evergreen.RepotrackerVersionRequester, "version1", 4)
+	oldExecution.OldTaskId = "t1"
+	require.NoError(t, db.Insert(ctx, task.OldCollection, oldExecution))
+
+	require.NoError(t, (&model.Version{Id: "version1", Author: "mainline-author"}).Insert(ctx))
+	require.NoError(t, (&model.Version{Id: "version2", Author: "patch-author"}).Insert(ctx))
+
+	filter := CostReportFilter{
+		Project:    "p1",
+		AfterDate:  baseDay,
+		BeforeDate: baseDay.Add(24 * time.Hour),
+		GroupBy:    []CostGroupBy{CostGroupByVariant, CostGroupByTask},
+	}
+
+	t.Run("GroupsByVariantAndTask", func(t *testing.T) {
+		reports, err := GetCostReport(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+
+		assert.Equal(t, "v1", reports[0].BuildVariant)
+		assert.Equal(t, "compile", reports[0].TaskName)
+		assert.Equal(t, 3, reports[0].NumTasks)
+		assert.Equal(t, 3*time.Minute, reports[0].TimeTaken)
+		assert.Equal(t, 12.0, reports[0].Cost.Total)
+		assert.Empty(t, reports[0].Requester)
+
+		assert.Equal(t, "v2", reports[1].BuildVariant)
+		assert.Equal(t, 2.0, reports[1].Cost.Total)
+		assert.Equal(t, "v1", reports[2].BuildVariant)
+		assert.Equal(t, "test", reports[2].TaskName)
+		assert.Equal(t, 1.0, reports[2].Cost.Total)
+	})
+	t.Run("GroupsByRequesterAndAuthor", func(t *testing.T) {
+		f := filter
+		f.GroupBy = []CostGroupBy{CostGroupByRequester, CostGroupByAuthor}
+		reports, err := GetCostReport(ctx, f)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+
+		assert.Equal(t, evergreen.RepotrackerVersionRequester, reports[0].Requester)
+		assert.Equal(t, "mainline-author", reports[0].Author)
+		assert.Equal(t, 12.0, reports[0].Cost.Total)
+		assert.Equal(t, evergreen.PatchVersionRequester, reports[1].Requester)
+		assert.Equal(t, "patch-author", reports[1].Author)
+		assert.Equal(t, 3.0, reports[1].Cost.Total)
+	})
+	t.Run("FiltersByRequesterAndVariant", func(t *testing.T) {
+		f := filter
+		f.Requesters = []string{evergreen.PatchVersionRequester}
+		f.BuildVariants = []string{"v1"}
+		reports, err := GetCostReport(ctx, f)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, "test", reports[0].TaskName)
+		assert.Equal(t, 1.0, reports[0].Cost.Total)
+	})
+	t.Run("InvalidFilterErrors", func(t *testing.T) {
+		f := filter
+		f.GroupBy = nil
+		_, err := GetCostReport(ctx, f)
+		assert.Error(t, err)
+	})
+}
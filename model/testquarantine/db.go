@@ -0,0 +1,77 @@
+package testquarantine
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the name of the collection of quarantined tests.
+const Collection = "quarantined_tests"
+
+var (
+	IDKey        = bsonutil.MustHaveTag(QuarantinedTest{}, "ID")
+	ProjectIDKey = bsonutil.MustHaveTag(QuarantinedTest{}, "ProjectID")
+	TestNameKey  = bsonutil.MustHaveTag(QuarantinedTest{}, "TestName")
+	SourceKey    = bsonutil.MustHaveTag(QuarantinedTest{}, "Source")
+	ReasonKey    = bsonutil.MustHaveTag(QuarantinedTest{}, "Reason")
+	FlipRateKey  = bsonutil.MustHaveTag(QuarantinedTest{}, "FlipRate")
+	CreatedByKey = bsonutil.MustHaveTag(QuarantinedTest{}, "CreatedBy")
+	CreatedAtKey = bsonutil.MustHaveTag(QuarantinedTest{}, "CreatedAt")
+)
+
+func byProjectAndTestName(projectID, testName string) bson.M {
+	return bson.M{
+		ProjectIDKey: projectID,
+		TestNameKey:  testName,
+	}
+}
+
+// FindOne finds the quarantined test matching the query.
+func FindOne(ctx context.Context, q db.Q) (*QuarantinedTest, error) {
+	qt := &QuarantinedTest{}
+	err := db.FindOneQ(ctx, Collection, q, qt)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return qt, errors.Wrap(err, "finding quarantined test")
+}
+
+// Find finds all quarantined tests matching the query.
+func Find(ctx context.Context, q db.Q) ([]QuarantinedTest, error) {
+	qts := []QuarantinedTest{}
+	if err := db.FindAllQ(ctx, Collection, q, &qts); err != nil {
+		return nil, errors.Wrap(err, "finding quarantined tests")
+	}
+	return qts, nil
+}
+
+// FindOneByProjectAndTestName finds the quarantined test with the given name
+// in the project.
+func FindOneByProjectAndTestName(ctx context.Context, projectID, testName string) (*QuarantinedTest, error) {
+	return FindOne(ctx, db.Query(byProjectAndTestName(projectID, testName)))
+}
+
+// FindByProject finds all quarantined tests in the project, sorted by test
+// name.
+func FindByProject(ctx context.Context, projectID string) ([]QuarantinedTest, error) {
+	return Find(ctx, db.Query(bson.M{ProjectIDKey: projectID}).Sort([]string{TestNameKey}))
+}
+
+// FindTestNamesByProject returns the set of names of all quarantined tests in
+// the project.
+func FindTestNamesByProject(ctx context.Context, projectID string) (map[string]bool, error) {
+	qts, err := Find(ctx, db.Query(bson.M{ProjectIDKey: projectID}).WithFields(TestNameKey))
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(qts))
+	for _, qt := range qts {
+		names[qt.TestName] = true
+	}
+	return names, nil
+}
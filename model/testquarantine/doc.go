@@ -0,0 +1,4 @@
+// Package testquarantine models per-project lists of quarantined tests.
+// Failures of quarantined tests are still recorded, but they do not cause the
+// task that ran them to fail.
+package testquarantine
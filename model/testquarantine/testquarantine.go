@@ -0,0 +1,126 @@
+package testquarantine
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// SourceManual indicates that a user explicitly quarantined the test.
+	SourceManual = "manual"
+	// SourceNominated indicates that the test was automatically quarantined
+	// because its historical results were flaky.
+	SourceNominated = "nominated"
+)
+
+// QuarantinedTest is a test that is quarantined within a project. Failures of
+// a quarantined test are recorded with the
+// evergreen.TestQuarantinedFailedStatus status and do not fail the task.
+type QuarantinedTest struct {
+	ID        string `bson:"_id" json:"id"`
+	ProjectID string `bson:"project_id" json:"project_id"`
+	TestName  string `bson:"test_name" json:"test_name"`
+	// Source is how the test came to be quarantined.
+	Source string `bson:"source" json:"source"`
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
+	// FlipRate is the fraction of recent mainline executions in which the
+	// test's status differed from its previous execution. It is only set
+	// for nominated tests.
+	FlipRate  float64   `bson:"flip_rate,omitempty" json:"flip_rate,omitempty"`
+	CreatedBy string    `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// Upsert quarantines the test in its project. If the test is already
+// quarantined, its flip rate is updated but its original creation information
+// is preserved. Its reason is only updated by a manual quarantine, so that a
+// nomination does not overwrite the reason a user gave.
+func (q *QuarantinedTest) Upsert(ctx context.Context) error {
+	if q.ProjectID == "" {
+		return errors.New("quarantined test must have a project")
+	}
+	if q.TestName == "" {
+		return errors.New("quarantined test must have a test name")
+	}
+	if q.Source == "" {
+		q.Source = SourceManual
+	}
+	if q.CreatedAt.IsZero() {
+		q.CreatedAt = time.Now()
+	}
+	if q.ID == "" {
+		q.ID = primitive.NewObjectID().Hex()
+	}
+
+	set := bson.M{FlipRateKey: q.FlipRate}
+	setOnInsert := bson.M{
+		IDKey:        q.ID,
+		SourceKey:    q.Source,
+		CreatedByKey: q.CreatedBy,
+		CreatedAtKey: q.CreatedAt,
+	}
+	if q.Source == SourceNominated {
+		setOnInsert[ReasonKey] = q.Reason
+	} else {
+		set[ReasonKey] = q.Reason
+	}
+
+	_, err := db.Upsert(ctx, Collection, byProjectAndTestName(q.ProjectID, q.TestName), bson.M{
+		"$set":         set,
+		"$setOnInsert": setOnInsert,
+	})
+	return errors.Wrapf(err, "upserting quarantined test '%s' in project '%s'", q.TestName, q.ProjectID)
+}
+
+// Remove removes the test from its project's quarantine.
+func Remove(ctx context.Context, projectID, testName string) error {
+	return errors.Wrapf(db.Remove(ctx, Collection, byProjectAndTestName(projectID, testName)), "removing quarantined test '%s' from project '%s'", testName, projectID)
+}
+
+// ApplyToStatus returns the status a test result should be recorded with if
+// the test is quarantined. Only failing statuses are changed; all other
+// statuses are returned unmodified.
+func ApplyToStatus(status string) string {
+	if status == evergreen.TestFailedStatus {
+		return evergreen.TestQuarantinedFailedStatus
+	}
+	return status
+}
+
+// FlipRate returns the fraction of transitions between consecutive statuses
+// in which a test went from passing to failing or vice versa. Statuses must
+// be ordered from oldest to newest. Statuses other than pass and fail, such as
+// skips, are ignored. If there are fewer than two relevant statuses, the flip
+// rate is zero.
+func FlipRate(statuses []string) float64 {
+	var prev string
+	var transitions, flips int
+	for _, status := range statuses {
+		switch status {
+		case evergreen.TestQuarantinedFailedStatus:
+			status = evergreen.TestFailedStatus
+		case evergreen.TestFailedStatus, evergreen.TestSucceededStatus:
+		default:
+			continue
+		}
+
+		if prev != "" {
+			transitions++
+			if prev != status {
+				flips++
+			}
+		}
+		prev = status
+	}
+	if transitions == 0 {
+		return 0
+	}
+
+	return float64(flips) / float64(transitions)
+}
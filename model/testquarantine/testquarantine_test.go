@@ -0,0 +1,124 @@
+package testquarantine
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.Setup()
+}
+
+func TestFlipRate(t *testing.T) {
+	pass := evergreen.TestSucceededStatus
+	fail := evergreen.TestFailedStatus
+
+	for tName, tCase := range map[string]struct {
+		statuses []string
+		expected float64
+	}{
+		"NoStatuses":              {statuses: nil, expected: 0},
+		"SingleStatus":            {statuses: []string{fail}, expected: 0},
+		"AlwaysPasses":            {statuses: []string{pass, pass, pass}, expected: 0},
+		"AlwaysFails":             {statuses: []string{fail, fail, fail}, expected: 0},
+		"AlternatesEveryTime":     {statuses: []string{pass, fail, pass, fail, pass}, expected: 1},
+		"BreaksOnce":              {statuses: []string{pass, pass, fail, fail, fail}, expected: 0.25},
+		"IgnoresSkips":            {statuses: []string{pass, evergreen.TestSkippedStatus, fail}, expected: 1},
+		"CountsQuarantinedAsFail": {statuses: []string{fail, evergreen.TestQuarantinedFailedStatus, pass}, expected: 0.5},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			assert.Equal(t, tCase.expected, FlipRate(tCase.statuses))
+		})
+	}
+}
+
+func TestApplyToStatus(t *testing.T) {
+	assert.Equal(t, evergreen.TestQuarantinedFailedStatus, ApplyToStatus(evergreen.TestFailedStatus))
+	assert.Equal(t, evergreen.TestSucceededStatus, ApplyToStatus(evergreen.TestSucceededStatus))
+	assert.Equal(t, evergreen.TestSilentlyFailedStatus, ApplyToStatus(evergreen.TestSilentlyFailedStatus))
+}
+
+func TestQuarantinedTests(t *testing.T) {
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"UpsertInsertsNewTest": func(t *testing.T) {
+			qt := QuarantinedTest{ProjectID: "project", TestName: "test", Reason: "flaky", CreatedBy: "me"}
+			require.NoError(t, qt.Upsert(t.Context()))
+
+			dbQT, err := FindOneByProjectAndTestName(t.Context(), "project", "test")
+			require.NoError(t, err)
+			require.NotNil(t, dbQT)
+			assert.NotZero(t, dbQT.ID)
+			assert.Equal(t, SourceManual, dbQT.Source)
+			assert.Equal(t, "flaky", dbQT.Reason)
+			assert.Equal(t, "me", dbQT.CreatedBy)
+			assert.False(t, dbQT.CreatedAt.IsZero())
+		},
+		"UpsertPreservesCreationInfo": func(t *testing.T) {
+			original := QuarantinedTest{ProjectID: "project", TestName: "test", Reason: "flaky", CreatedBy: "me"}
+			require.NoError(t, original.Upsert(t.Context()))
+			updated := QuarantinedTest{ProjectID: "project", TestName: "test", Reason: "very flaky", Source: SourceNominated, FlipRate: 0.5}
+			require.NoError(t, updated.Upsert(t.Context()))
+
+			qts, err := FindByProject(t.Context(), "project")
+			require.NoError(t, err)
+			require.Len(t, qts, 1)
+			assert.Equal(t, original.ID, qts[0].ID)
+			assert.Equal(t, SourceManual, qts[0].Source)
+			assert.Equal(t, "me", qts[0].CreatedBy)
+			assert.Equal(t, "flaky", qts[0].Reason, "nomination should not overwrite a manual reason")
+			assert.Equal(t, 0.5, qts[0].FlipRate)
+		},
+		"ManualUpsertUpdatesReason": func(t *testing.T) {
+			original := QuarantinedTest{ProjectID: "project", TestName: "test", Reason: "flip rate too high", Source: SourceNominated, FlipRate: 0.5}
+			require.NoError(t, original.Upsert(t.Context()))
+			updated := QuarantinedTest{ProjectID: "project", TestName: "test", Reason: "known race in setup", CreatedBy: "me"}
+			require.NoError(t, updated.Upsert(t.Context()))
+
+			dbQT, err := FindOneByProjectAndTestName(t.Context(), "project", "test")
+			require.NoError(t, err)
+			require.NotNil(t, dbQT)
+			assert.Equal(t, SourceNominated, dbQT.Source)
+			assert.Equal(t, "known race in setup", dbQT.Reason)
+		},
+		"UpsertFailsWithoutTestName": func(t *testing.T) {
+			qt := QuarantinedTest{ProjectID: "project"}
+			assert.Error(t, qt.Upsert(t.Context()))
+		},
+		"FindTestNamesByProjectOnlyReturnsProjectTests": func(t *testing.T) {
+			for _, qt := range []QuarantinedTest{
+				{ProjectID: "project", TestName: "test1"},
+				{ProjectID: "project", TestName: "test2"},
+				{ProjectID: "other", TestName: "test3"},
+			} {
+				require.NoError(t, qt.Upsert(t.Context()))
+			}
+
+			names, err := FindTestNamesByProject(t.Context(), "project")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]bool{"test1": true, "test2": true}, names)
+		},
+		"RemoveDeletesTest": func(t *testing.T) {
+			qt := QuarantinedTest{ProjectID: "project", TestName: "test"}
+			require.NoError(t, qt.Upsert(t.Context()))
+			require.NoError(t, Remove(t.Context(), "project", "test"))
+
+			dbQT, err := FindOneByProjectAndTestName(t.Context(), "project", "test")
+			require.NoError(t, err)
+			assert.Nil(t, dbQT)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(Collection))
+			tCase(t)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package testselection
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the name of the collection of file test maps.
+const Collection = "test_selection_file_maps"
+
+var (
+	IDKey        = bsonutil.MustHaveTag(FileTestMap{}, "ID")
+	ProjectIDKey = bsonutil.MustHaveTag(FileTestMap{}, "ProjectID")
+	TaskNameKey  = bsonutil.MustHaveTag(FileTestMap{}, "TaskName")
+	FilesKey     = bsonutil.MustHaveTag(FileTestMap{}, "Files")
+	UpdatedAtKey = bsonutil.MustHaveTag(FileTestMap{}, "UpdatedAt")
+)
+
+// FindFileTestMap finds the file test map for the task in the project. It
+// returns nil if the task has no map.
+func FindFileTestMap(ctx context.Context, projectID, taskName string) (*FileTestMap, error) {
+	m := &FileTestMap{}
+	err := db.FindOneQ(ctx, Collection, db.Query(bson.M{IDKey: fileTestMapID(projectID, taskName)}), m)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding file test map for task '%s' in project '%s'", taskName, projectID)
+	}
+	return m, nil
+}
@@ -0,0 +1,5 @@
+// Package testselection selects a subset of a task's tests to run using
+// strategies computed from the test results of the task's recent mainline
+// runs. It is used instead of the external test selection service when no
+// test selection service is configured.
+package testselection
@@ -0,0 +1,99 @@
+package testselection
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// FileTestMap associates the files in a project's repository with the tests in
+// one of its tasks that exercise them. It is used by the changed files
+// strategy.
+type FileTestMap struct {
+	ID        string      `bson:"_id" json:"id"`
+	ProjectID string      `bson:"project_id" json:"project_id"`
+	TaskName  string      `bson:"task_name" json:"task_name"`
+	Files     []FileTests `bson:"files" json:"files"`
+	UpdatedAt time.Time   `bson:"updated_at" json:"updated_at"`
+}
+
+// FileTests are the tests that exercise a file.
+type FileTests struct {
+	// File is the file's path relative to the repository root. It may be a
+	// pattern in the format accepted by path.Match, such as "src/db/*.go".
+	File  string   `bson:"file" json:"file"`
+	Tests []string `bson:"tests" json:"tests"`
+}
+
+// Validate checks that the map is well-formed.
+func (m *FileTestMap) Validate() error {
+	if m.ProjectID == "" {
+		return errors.New("file test map must have a project")
+	}
+	if m.TaskName == "" {
+		return errors.New("file test map must have a task name")
+	}
+	for _, entry := range m.Files {
+		if entry.File == "" {
+			return errors.New("file test map entries must have a file")
+		}
+		if _, err := path.Match(entry.File, ""); err != nil {
+			return errors.Wrapf(err, "invalid file pattern '%s'", entry.File)
+		}
+	}
+	return nil
+}
+
+// Upsert replaces the map for the project's task.
+func (m *FileTestMap) Upsert(ctx context.Context) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	m.ID = fileTestMapID(m.ProjectID, m.TaskName)
+	m.UpdatedAt = time.Now()
+
+	_, err := db.Upsert(ctx, Collection, bson.M{IDKey: m.ID}, bson.M{
+		"$set": bson.M{
+			ProjectIDKey: m.ProjectID,
+			TaskNameKey:  m.TaskName,
+			FilesKey:     m.Files,
+			UpdatedAtKey: m.UpdatedAt,
+		},
+	})
+	return errors.Wrapf(err, "upserting file test map for task '%s' in project '%s'", m.TaskName, m.ProjectID)
+}
+
+func fileTestMapID(projectID, taskName string) string {
+	return projectID + "/" + taskName
+}
+
+// testsForFiles returns the tests associated with any of the files.
+func (m *FileTestMap) testsForFiles(files []string) map[string]bool {
+	tests := map[string]bool{}
+	for _, entry := range m.Files {
+		for _, file := range files {
+			if !entry.matches(file) {
+				continue
+			}
+			for _, test := range entry.Tests {
+				tests[test] = true
+			}
+			break
+		}
+	}
+	return tests
+}
+
+// matches returns whether the file is the entry's file or matches the entry's
+// pattern.
+func (e FileTests) matches(file string) bool {
+	if e.File == file {
+		return true
+	}
+	match, err := path.Match(e.File, file)
+	return err == nil && match
+}
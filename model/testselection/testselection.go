@@ -0,0 +1,261 @@
+package testselection
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// StrategyRecentlyFailed selects tests that failed in any of the task's
+	// recent mainline runs on the same build variant.
+	StrategyRecentlyFailed = "RecentlyFailed"
+	// StrategyFlaky selects tests that both passed and failed in the task's
+	// recent mainline runs on the same build variant.
+	StrategyFlaky = "Flaky"
+	// StrategyChangedFiles selects tests that the project's file-to-test
+	// map associates with the files changed in a patch.
+	StrategyChangedFiles = "ChangedFiles"
+	// StrategyRandomSample splits the tests into a number of groups and
+	// selects a different group in each run, so that every test runs at
+	// least once in any window of that many consecutive runs. Tests that
+	// have never run before are always selected.
+	StrategyRandomSample = "RandomSample"
+
+	// historyLookback is the number of the task's most recent mainline
+	// runs whose test results are considered.
+	historyLookback = 20
+	// DefaultCoverageRuns is the default number of consecutive runs over
+	// which the random sample strategy runs every test.
+	DefaultCoverageRuns = 5
+	// MaxCoverageRuns is the maximum number of consecutive runs over which
+	// the random sample strategy can run every test.
+	MaxCoverageRuns = 100
+)
+
+// Strategies are the built-in test selection strategies.
+var Strategies = []string{
+	StrategyRecentlyFailed,
+	StrategyFlaky,
+	StrategyChangedFiles,
+	StrategyRandomSample,
+}
+
+// ValidateStrategies returns an error if any of the strategies are not
+// built-in strategies.
+func ValidateStrategies(strategies []string) error {
+	for _, s := range strategies {
+		if !utility.StringSliceContains(Strategies, s) {
+			return errors.Errorf("unrecognized test selection strategy '%s', must be one of: %v", s, Strategies)
+		}
+	}
+	return nil
+}
+
+// SelectOptions are the options for selecting a task's tests.
+type SelectOptions struct {
+	Task *task.Task
+	// Tests are the tests to select from. If empty, tests are selected from
+	// all the tests in the task's recent mainline runs.
+	Tests []string
+	// Strategies are the strategies to select tests with. A test is
+	// selected if any of the strategies selects it. If empty, all the tests
+	// are selected.
+	Strategies []string
+	// CoverageRuns is the number of consecutive runs over which the random
+	// sample strategy runs every test. Defaults to DefaultCoverageRuns.
+	CoverageRuns int
+}
+
+// Select returns the tests to run in the task, in the same order as the input
+// tests, or sorted by name if there are no input tests.
+func Select(ctx context.Context, env evergreen.Environment, opts SelectOptions) ([]string, error) {
+	if opts.Task == nil {
+		return nil, errors.New("task must be specified")
+	}
+	if err := ValidateStrategies(opts.Strategies); err != nil {
+		return nil, err
+	}
+	if opts.CoverageRuns < 0 || opts.CoverageRuns > MaxCoverageRuns {
+		return nil, errors.Errorf("coverage runs must be between 0 and %d", MaxCoverageRuns)
+	}
+
+	history, err := findTestHistory(ctx, env, opts.Task)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding test history")
+	}
+
+	var changedFileTests map[string]bool
+	if utility.StringSliceContains(opts.Strategies, StrategyChangedFiles) {
+		if changedFileTests, err = findChangedFileTests(ctx, opts.Task); err != nil {
+			return nil, errors.Wrap(err, "finding tests for changed files")
+		}
+	}
+
+	coverageRuns := opts.CoverageRuns
+	if coverageRuns == 0 {
+		coverageRuns = DefaultCoverageRuns
+	}
+
+	return selectTests(selectionInput{
+		tests:            opts.Tests,
+		strategies:       opts.Strategies,
+		history:          history,
+		changedFileTests: changedFileTests,
+		runIndex:         opts.Task.RevisionOrderNumber,
+		coverageRuns:     coverageRuns,
+	}), nil
+}
+
+// selectionInput is the data that tests are selected from.
+type selectionInput struct {
+	tests      []string
+	strategies []string
+	// history maps each test in the task's recent runs to its statuses,
+	// ordered from oldest to newest.
+	history map[string][]string
+	// changedFileTests are the tests associated with the changed files.
+	changedFileTests map[string]bool
+	// runIndex identifies the run for the random sample strategy.
+	// Consecutive runs must have consecutive indexes.
+	runIndex     int
+	coverageRuns int
+}
+
+func selectTests(in selectionInput) []string {
+	tests := in.tests
+	if len(tests) == 0 {
+		for name := range in.history {
+			tests = append(tests, name)
+		}
+		sort.Strings(tests)
+	}
+	if len(in.strategies) == 0 {
+		return tests
+	}
+
+	selected := []string{}
+	for _, name := range tests {
+		for _, strategy := range in.strategies {
+			if in.selectedBy(strategy, name) {
+				selected = append(selected, name)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+func (in selectionInput) selectedBy(strategy, testName string) bool {
+	statuses, ranBefore := in.history[testName]
+	switch strategy {
+	case StrategyRecentlyFailed:
+		for _, status := range statuses {
+			if status == evergreen.TestFailedStatus || status == evergreen.TestQuarantinedFailedStatus {
+				return true
+			}
+		}
+		return false
+	case StrategyFlaky:
+		return testquarantine.FlipRate(statuses) > 0
+	case StrategyChangedFiles:
+		return in.changedFileTests[testName]
+	case StrategyRandomSample:
+		return !ranBefore || sampleGroup(testName, in.coverageRuns) == in.runIndex%in.coverageRuns
+	default:
+		return false
+	}
+}
+
+// sampleGroup returns which of the groups the test belongs to. A test always
+// belongs to the same group.
+func sampleGroup(testName string, numGroups int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(testName))
+	return int(h.Sum32() % uint32(numGroups))
+}
+
+// findTestHistory returns the statuses of the tests in the task's most recent
+// completed mainline runs on the same build variant, ordered from oldest to
+// newest. Only runs before the task are considered if the task is itself a
+// mainline task.
+func findTestHistory(ctx context.Context, env evergreen.Environment, t *task.Task) (map[string][]string, error) {
+	filter := bson.M{
+		task.ProjectKey:        t.Project,
+		task.BuildVariantKey:   t.BuildVariant,
+		task.DisplayNameKey:    t.DisplayName,
+		task.RequesterKey:      bson.M{"$in": evergreen.SystemVersionRequesterTypes},
+		task.StatusKey:         bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.HasTestResultsKey: true,
+	}
+	if utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, t.Requester) {
+		filter[task.RevisionOrderNumberKey] = bson.M{"$lt": t.RevisionOrderNumber}
+	}
+	q := db.Query(filter).Sort([]string{"-" + task.RevisionOrderNumberKey}).Limit(historyLookback)
+	tasks, err := task.FindAll(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding recent mainline tasks")
+	}
+
+	history := map[string][]string{}
+	// Tasks are sorted newest first, but statuses must be ordered the way
+	// the tasks ran.
+	for i := len(tasks) - 1; i >= 0; i-- {
+		results, err := tasks[i].GetTestResults(ctx, env, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting test results for task '%s'", tasks[i].Id)
+		}
+		for _, r := range results.Results {
+			name := r.GetDisplayTestName()
+			history[name] = append(history[name], r.Status)
+		}
+	}
+
+	return history, nil
+}
+
+// findChangedFileTests returns the tests that the project's file-to-test map
+// for the task associates with the files changed in the task's patch. Mainline
+// tasks have no changed files.
+func findChangedFileTests(ctx context.Context, t *task.Task) (map[string]bool, error) {
+	if !evergreen.IsPatchRequester(t.Requester) {
+		return nil, nil
+	}
+
+	fileMap, err := FindFileTestMap(ctx, t.Project, t.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+	if fileMap == nil {
+		return nil, nil
+	}
+
+	p, err := patch.FindOneId(ctx, t.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding patch '%s'", t.Version)
+	}
+	if p == nil {
+		return nil, errors.Errorf("patch '%s' not found", t.Version)
+	}
+	var changedFiles []string
+	for _, modulePatch := range p.Patches {
+		// The file map only covers the project's own repository.
+		if modulePatch.ModuleName != "" {
+			continue
+		}
+		for _, summary := range modulePatch.PatchSet.Summary {
+			changedFiles = append(changedFiles, summary.Name)
+		}
+	}
+
+	return fileMap.testsForFiles(changedFiles), nil
+}
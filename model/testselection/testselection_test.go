@@ -0,0 +1,141 @@
+package testselection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStrategies(t *testing.T) {
+	assert.NoError(t, ValidateStrategies(nil))
+	assert.NoError(t, ValidateStrategies(Strategies))
+	assert.Error(t, ValidateStrategies([]string{StrategyFlaky, "NotFailing"}))
+}
+
+func TestSelectTests(t *testing.T) {
+	history := map[string][]string{
+		"passing":  {evergreen.TestSucceededStatus, evergreen.TestSucceededStatus},
+		"failed":   {evergreen.TestFailedStatus, evergreen.TestFailedStatus},
+		"flaky":    {evergreen.TestSucceededStatus, evergreen.TestQuarantinedFailedStatus, evergreen.TestSucceededStatus},
+		"skipping": {evergreen.TestSkippedStatus},
+	}
+
+	t.Run("NoStrategiesReturnsInputTests", func(t *testing.T) {
+		tests := []string{"passing", "new"}
+		assert.Equal(t, tests, selectTests(selectionInput{tests: tests, history: history}))
+	})
+	t.Run("NoInputTestsUsesHistory", func(t *testing.T) {
+		assert.Equal(t, []string{"failed", "flaky", "passing", "skipping"}, selectTests(selectionInput{history: history}))
+	})
+	t.Run("RecentlyFailed", func(t *testing.T) {
+		assert.Equal(t, []string{"failed", "flaky"}, selectTests(selectionInput{
+			history:    history,
+			strategies: []string{StrategyRecentlyFailed},
+		}))
+	})
+	t.Run("Flaky", func(t *testing.T) {
+		assert.Equal(t, []string{"flaky"}, selectTests(selectionInput{
+			history:    history,
+			strategies: []string{StrategyFlaky},
+		}))
+	})
+	t.Run("ChangedFiles", func(t *testing.T) {
+		assert.Equal(t, []string{"passing"}, selectTests(selectionInput{
+			history:          history,
+			strategies:       []string{StrategyChangedFiles},
+			changedFileTests: map[string]bool{"passing": true, "unknown": true},
+		}))
+	})
+	t.Run("StrategiesAreUnionedInInputOrder", func(t *testing.T) {
+		assert.Equal(t, []string{"passing", "flaky", "failed"}, selectTests(selectionInput{
+			tests:            []string{"passing", "skipping", "flaky", "failed"},
+			history:          history,
+			strategies:       []string{StrategyFlaky, StrategyChangedFiles, StrategyRecentlyFailed},
+			changedFileTests: map[string]bool{"passing": true},
+		}))
+	})
+	t.Run("NoMatchesReturnsEmptySlice", func(t *testing.T) {
+		selected := selectTests(selectionInput{
+			tests:      []string{"passing"},
+			history:    history,
+			strategies: []string{StrategyFlaky},
+		})
+		assert.NotNil(t, selected)
+		assert.Empty(t, selected)
+	})
+	t.Run("RandomSampleCoversEveryTest", func(t *testing.T) {
+		const coverageRuns = 4
+		var tests []string
+		sampleHistory := map[string][]string{}
+		for i := 0; i < 100; i++ {
+			name := fmt.Sprintf("test%d", i)
+			tests = append(tests, name)
+			sampleHistory[name] = []string{evergreen.TestSucceededStatus}
+		}
+
+		// Any window of consecutive runs runs every test exactly once.
+		counts := map[string]int{}
+		for run := 17; run < 17+coverageRuns; run++ {
+			selected := selectTests(selectionInput{
+				tests:        tests,
+				history:      sampleHistory,
+				strategies:   []string{StrategyRandomSample},
+				runIndex:     run,
+				coverageRuns: coverageRuns,
+			})
+			assert.Less(t, len(selected), len(tests))
+			for _, name := range selected {
+				counts[name]++
+			}
+		}
+		for _, name := range tests {
+			assert.Equal(t, 1, counts[name], name)
+		}
+	})
+	t.Run("RandomSampleAlwaysSelectsNewTests", func(t *testing.T) {
+		for run := 0; run < DefaultCoverageRuns; run++ {
+			assert.Contains(t, selectTests(selectionInput{
+				tests:        []string{"new"},
+				history:      history,
+				strategies:   []string{StrategyRandomSample},
+				runIndex:     run,
+				coverageRuns: DefaultCoverageRuns,
+			}), "new")
+		}
+	})
+}
+
+func TestFileTestMap(t *testing.T) {
+	m := &FileTestMap{
+		ProjectID: "project",
+		TaskName:  "task",
+		Files: []FileTests{
+			{File: "src/db/query.go", Tests: []string{"TestQuery"}},
+			{File: "src/model/*.go", Tests: []string{"TestModel", "TestQuery"}},
+			{File: "docs/*", Tests: nil},
+		},
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, m.Validate())
+		assert.Error(t, (&FileTestMap{TaskName: "task"}).Validate())
+		assert.Error(t, (&FileTestMap{ProjectID: "project"}).Validate())
+		assert.Error(t, (&FileTestMap{ProjectID: "project", TaskName: "task", Files: []FileTests{{Tests: []string{"TestQuery"}}}}).Validate())
+		assert.Error(t, (&FileTestMap{ProjectID: "project", TaskName: "task", Files: []FileTests{{File: "src/[", Tests: []string{"TestQuery"}}}}).Validate())
+	})
+	t.Run("ExactPath", func(t *testing.T) {
+		assert.Equal(t, map[string]bool{"TestQuery": true}, m.testsForFiles([]string{"src/db/query.go"}))
+	})
+	t.Run("Pattern", func(t *testing.T) {
+		assert.Equal(t, map[string]bool{"TestModel": true, "TestQuery": true}, m.testsForFiles([]string{"README.md", "src/model/task.go"}))
+	})
+	t.Run("PatternDoesNotMatchSubdirectories", func(t *testing.T) {
+		assert.Empty(t, m.testsForFiles([]string{"src/model/sub/task.go"}))
+	})
+	t.Run("NoChangedFiles", func(t *testing.T) {
+		assert.Empty(t, m.testsForFiles(nil))
+	})
+}
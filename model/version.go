@@ -76,6 +76,9 @@ type Version struct {
 	BuildVariants   []VersionBuildStatus `bson:"build_variants_status,omitempty" json:"build_variants_status,omitempty"`
 	PeriodicBuildID string               `bson:"periodic_build_id,omitempty" json:"periodic_build_id,omitempty"`
 	Aborted         bool                 `bson:"aborted,omitempty" json:"aborted,omitempty"`
+	// ArtifactsPinned indicates that the version's artifacts are kept
+	// regardless of the project's artifact retention policy.
+	ArtifactsPinned bool `bson:"artifacts_pinned,omitempty" json:"artifacts_pinned,omitempty"`
 
 	// This stores whether or not a version has tasks which were activated.
 	// We use a bool ptr in order to to distinguish the unset value from the default value
@@ -222,6 +225,20 @@ func (v *Version) SetAborted(ctx context.Context, aborted bool) error {
 	)
 }
 
+// SetArtifactsPinned sets whether the version's artifacts are kept regardless
+// of the project's artifact retention policy.
+func SetArtifactsPinned(ctx context.Context, versionID string, pinned bool) error {
+	return VersionUpdateOne(
+		ctx,
+		bson.M{VersionIdKey: versionID},
+		bson.M{
+			"$set": bson.M{
+				VersionArtifactsPinnedKey: pinned,
+			},
+		},
+	)
+}
+
 func (v *Version) Insert(ctx context.Context) error {
 	// Production paths set IngestTime explicitly (e.g. from patch ingest or repotracker).
 	// Stub and test callers that omit it get a wall-clock insert time here.
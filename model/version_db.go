@@ -54,6 +54,7 @@ var (
 	VersionPeriodicBuildIDKey                   = bsonutil.MustHaveTag(Version{}, "PeriodicBuildID")
 	VersionActivatedKey                         = bsonutil.MustHaveTag(Version{}, "Activated")
 	VersionAbortedKey                           = bsonutil.MustHaveTag(Version{}, "Aborted")
+	VersionArtifactsPinnedKey                   = bsonutil.MustHaveTag(Version{}, "ArtifactsPinned")
 	VersionAuthorIDKey                          = bsonutil.MustHaveTag(Version{}, "AuthorID")
 	VersionProjectStorageMethodKey              = bsonutil.MustHaveTag(Version{}, "ProjectStorageMethod")
 	VersionPreGenerationProjectStorageMethodKey = bsonutil.MustHaveTag(Version{}, "PreGenerationProjectStorageMethod")
@@ -101,6 +102,23 @@ func byLatestProjectVersion(projectId string) bson.M {
 	}
 }
 
+// FindArtifactsPinnedVersionIDs returns the IDs of the project's versions
+// whose artifacts are pinned.
+func FindArtifactsPinnedVersionIDs(ctx context.Context, projectID string) ([]string, error) {
+	versions, err := VersionFind(ctx, db.Query(bson.M{
+		VersionIdentifierKey:      projectID,
+		VersionArtifactsPinnedKey: true,
+	}).WithFields(VersionIdKey))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding versions with pinned artifacts for project '%s'", projectID)
+	}
+	ids := make([]string, 0, len(versions))
+	for _, v := range versions {
+		ids = append(ids, v.Id)
+	}
+	return ids, nil
+}
+
 // FindLatestRevisionAndAuthorForProject returns the latest revision and author ID for the project, and returns an error if it's not found.
 func FindLatestRevisionAndAuthorForProject(ctx context.Context, projectId string) (string, string, error) {
 	v, err := VersionFindOne(ctx, db.Query(byLatestProjectVersion(projectId)).
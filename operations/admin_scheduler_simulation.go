@@ -0,0 +1,230 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cheynewallace/tabby"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func simulateScheduler() cli.Command {
+	const (
+		distroFlagName       = "distro"
+		snapshotFlagName     = "snapshot"
+		saveSnapshotFlagName = "save-snapshot"
+
+		targetTimeFlagName                = "target-time"
+		groupVersionsFlagName             = "group-versions"
+		patchFactorFlagName               = "patch-factor"
+		patchTimeInQueueFactorFlagName    = "patch-time-in-queue-factor"
+		commitQueueFactorFlagName         = "commit-queue-factor"
+		mainlineTimeInQueueFactorFlagName = "mainline-time-in-queue-factor"
+		expectedRuntimeFactorFlagName     = "expected-runtime-factor"
+		generateTaskFactorFlagName        = "generate-task-factor"
+		numDependentsFactorFlagName       = "num-dependents-factor"
+
+		minHostsFlagName           = "min-hosts"
+		maxHostsFlagName           = "max-hosts"
+		roundingRuleFlagName       = "rounding-rule"
+		feedbackRuleFlagName       = "feedback-rule"
+		futureHostFractionFlagName = "future-host-fraction"
+	)
+
+	return cli.Command{
+		Name:  "simulate-scheduler",
+		Usage: "project a distro's queue wait times, host count and cost under alternative planner and host allocator settings",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     joinFlagNames(distroFlagName, "d"),
+				Usage:    "the distro to simulate",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:  snapshotFlagName,
+				Usage: "replay a snapshot previously saved with --save-snapshot instead of the distro's current state",
+			},
+			cli.StringFlag{
+				Name:  saveSnapshotFlagName,
+				Usage: "save a snapshot of the distro's current state to this file so it can be replayed later",
+			},
+			cli.DurationFlag{
+				Name:  targetTimeFlagName,
+				Usage: "planner target time to simulate (e.g. 30m)",
+			},
+			cli.StringFlag{
+				Name:  groupVersionsFlagName,
+				Usage: "whether the planner should group tasks by version (true or false)",
+			},
+			cli.Int64Flag{
+				Name:  patchFactorFlagName,
+				Usage: "planner patch factor to simulate",
+			},
+			cli.Int64Flag{
+				Name:  patchTimeInQueueFactorFlagName,
+				Usage: "planner patch time in queue factor to simulate",
+			},
+			cli.Int64Flag{
+				Name:  commitQueueFactorFlagName,
+				Usage: "planner commit queue factor to simulate",
+			},
+			cli.Int64Flag{
+				Name:  mainlineTimeInQueueFactorFlagName,
+				Usage: "planner mainline time in queue factor to simulate",
+			},
+			cli.Int64Flag{
+				Name:  expectedRuntimeFactorFlagName,
+				Usage: "planner expected runtime factor to simulate",
+			},
+			cli.Int64Flag{
+				Name:  generateTaskFactorFlagName,
+				Usage: "planner generate task factor to simulate",
+			},
+			cli.Float64Flag{
+				Name:  numDependentsFactorFlagName,
+				Usage: "planner number of dependents factor to simulate",
+			},
+			cli.IntFlag{
+				Name:  minHostsFlagName,
+				Usage: "host allocator minimum hosts to simulate",
+			},
+			cli.IntFlag{
+				Name:  maxHostsFlagName,
+				Usage: "host allocator maximum hosts to simulate",
+			},
+			cli.StringFlag{
+				Name:  roundingRuleFlagName,
+				Usage: "host allocator rounding rule to simulate (round-down or round-up)",
+			},
+			cli.StringFlag{
+				Name:  feedbackRuleFlagName,
+				Usage: "host allocator feedback rule to simulate (no-feedback or waits-over-thresh)",
+			},
+			cli.Float64Flag{
+				Name:  futureHostFractionFlagName,
+				Usage: "host allocator future host fraction to simulate, between 0 and 1",
+			},
+			cli.BoolFlag{
+				Name:  jsonFlagName,
+				Usage: "output the full simulation results, including each task's projected wait, as JSON",
+			},
+		},
+		Before: mergeBeforeFuncs(
+			autoUpdateCLI,
+			mutuallyExclusiveArgs(false, snapshotFlagName, saveSnapshotFlagName),
+			func(c *cli.Context) error {
+				if groupVersions := c.String(groupVersionsFlagName); groupVersions != "" {
+					if _, err := strconv.ParseBool(groupVersions); err != nil {
+						return errors.Errorf("invalid value '%s' for group versions", groupVersions)
+					}
+				}
+				return nil
+			},
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().Parent().String(ConfFlagName)
+			opts := client.SimulateDistroSchedulerOptions{
+				DistroID: c.String(distroFlagName),
+			}
+			opts.PlannerSettings.TargetTime = c.Duration(targetTimeFlagName)
+			if groupVersions := c.String(groupVersionsFlagName); groupVersions != "" {
+				parsed, _ := strconv.ParseBool(groupVersions)
+				opts.PlannerSettings.GroupVersions = utility.ToBoolPtr(parsed)
+			}
+			opts.PlannerSettings.PatchFactor = c.Int64(patchFactorFlagName)
+			opts.PlannerSettings.PatchTimeInQueueFactor = c.Int64(patchTimeInQueueFactorFlagName)
+			opts.PlannerSettings.CommitQueueFactor = c.Int64(commitQueueFactorFlagName)
+			opts.PlannerSettings.MainlineTimeInQueueFactor = c.Int64(mainlineTimeInQueueFactorFlagName)
+			opts.PlannerSettings.ExpectedRuntimeFactor = c.Int64(expectedRuntimeFactorFlagName)
+			opts.PlannerSettings.GenerateTaskFactor = c.Int64(generateTaskFactorFlagName)
+			opts.PlannerSettings.NumDependentsFactor = c.Float64(numDependentsFactorFlagName)
+			opts.HostAllocatorSettings.MinimumHosts = c.Int(minHostsFlagName)
+			opts.HostAllocatorSettings.MaximumHosts = c.Int(maxHostsFlagName)
+			opts.HostAllocatorSettings.RoundingRule = c.String(roundingRuleFlagName)
+			opts.HostAllocatorSettings.FeedbackRule = c.String(feedbackRuleFlagName)
+			opts.HostAllocatorSettings.FutureHostFraction = c.Float64(futureHostFractionFlagName)
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			if snapshotPath := c.String(snapshotFlagName); snapshotPath != "" {
+				opts.Snapshot = &scheduler.SimulationSnapshot{}
+				if err = utility.ReadJSONFile(snapshotPath, opts.Snapshot); err != nil {
+					return errors.Wrapf(err, "reading snapshot from file '%s'", snapshotPath)
+				}
+			}
+			if savePath := c.String(saveSnapshotFlagName); savePath != "" {
+				opts.Snapshot, err = comm.GetDistroSchedulerSnapshot(ctx, opts.DistroID)
+				if err != nil {
+					return errors.Wrapf(err, "getting scheduler snapshot for distro '%s'", opts.DistroID)
+				}
+				if err = utility.WriteJSONFile(savePath, opts.Snapshot); err != nil {
+					return errors.Wrapf(err, "writing snapshot to file '%s'", savePath)
+				}
+			}
+
+			comparison, err := comm.SimulateDistroScheduler(ctx, opts)
+			if err != nil {
+				return errors.Wrapf(err, "simulating scheduler for distro '%s'", opts.DistroID)
+			}
+
+			if c.Bool(jsonFlagName) {
+				out, err := json.MarshalIndent(comparison, "", "  ")
+				if err != nil {
+					return errors.Wrap(err, "marshalling simulation results to JSON")
+				}
+				fmt.Println(string(out))
+				return nil
+			}
+
+			printSchedulerSimulation(opts.DistroID, comparison)
+			return nil
+		},
+	}
+}
+
+func printSchedulerSimulation(distroID string, comparison *scheduler.SimulationComparison) {
+	fmt.Printf("Scheduler simulation for distro '%s' (snapshot taken at %s)\n\n", distroID, comparison.SnapshotTakenAt.Format(time.RFC3339))
+
+	baseline := comparison.Baseline
+	simulated := comparison.Simulated
+	formatDuration := func(d time.Duration) string {
+		return d.Round(time.Second).String()
+	}
+
+	t := tabby.New()
+	t.AddHeader("", "Current Settings", "Simulated Settings")
+	t.AddLine("Target Time", formatDuration(baseline.PlannerSettings.TargetTime), formatDuration(simulated.PlannerSettings.TargetTime))
+	t.AddLine("Group Versions", utility.FromBoolPtr(baseline.PlannerSettings.GroupVersions), utility.FromBoolPtr(simulated.PlannerSettings.GroupVersions))
+	t.AddLine("Minimum Hosts", baseline.HostAllocatorSettings.MinimumHosts, simulated.HostAllocatorSettings.MinimumHosts)
+	t.AddLine("Maximum Hosts", baseline.HostAllocatorSettings.MaximumHosts, simulated.HostAllocatorSettings.MaximumHosts)
+	t.AddLine("Queue Length", baseline.QueueLength, simulated.QueueLength)
+	t.AddLine("Queue Length (Dependencies Met)", baseline.QueueLengthDepsMet, simulated.QueueLengthDepsMet)
+	t.AddLine("Existing Hosts", baseline.ExistingHosts, simulated.ExistingHosts)
+	t.AddLine("New Hosts", baseline.NewHosts, simulated.NewHosts)
+	t.AddLine("Total Hosts", baseline.TotalHosts, simulated.TotalHosts)
+	t.AddLine("Average Wait", formatDuration(baseline.AverageWait), formatDuration(simulated.AverageWait))
+	t.AddLine("90th Percentile Wait", formatDuration(baseline.P90Wait), formatDuration(simulated.P90Wait))
+	t.AddLine("Max Wait", formatDuration(baseline.MaxWait), formatDuration(simulated.MaxWait))
+	t.AddLine("Makespan", formatDuration(baseline.Makespan), formatDuration(simulated.Makespan))
+	t.AddLine("Host Time", formatDuration(baseline.HostTime), formatDuration(simulated.HostTime))
+	t.AddLine("Estimated Cost", fmt.Sprintf("$%.2f", baseline.EstimatedCost), fmt.Sprintf("$%.2f", simulated.EstimatedCost))
+	t.Print()
+}
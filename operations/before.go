@@ -184,6 +184,22 @@ func requireOnlyOneBool(flags ...string) cli.BeforeFunc {
 	}
 }
 
+func requireOnlyOneFlag(flags ...string) cli.BeforeFunc {
+	return func(c *cli.Context) error {
+		count := 0
+		for idx := range flags {
+			if c.IsSet(flags[idx]) {
+				count++
+			}
+		}
+
+		if count != 1 {
+			return errors.Errorf("must specify one and only one of: --%s", strings.Join(flags, ", --"))
+		}
+		return nil
+	}
+}
+
 func requireAtLeastOneBool(flags ...string) cli.BeforeFunc {
 	return func(c *cli.Context) error {
 		for idx := range flags {
@@ -0,0 +1,321 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+// bisectPollInterval is how often the status of a running bisect task is
+// checked.
+const bisectPollInterval = 30 * time.Second
+
+// bisectResult is the outcome of a task run on one of the bisected versions.
+type bisectResult int
+
+const (
+	bisectGood bisectResult = iota
+	bisectBad
+	// bisectSkip means the run doesn't say whether the version is good or
+	// bad, e.g. because the task system failed.
+	bisectSkip
+)
+
+func Bisect() cli.Command {
+	const (
+		taskFlagName    = "task"
+		goodFlagName    = "good"
+		badFlagName     = "bad"
+		manualFlagName  = "manual"
+		variantFlagName = "variant"
+	)
+
+	return cli.Command{
+		Name:  "bisect",
+		Usage: "find the first mainline commit where a task started failing by running it on intermediate versions in binary-search order",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     joinFlagNames(projectFlagName, "p"),
+				Usage:    "the project ID or identifier",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     joinFlagNames(variantFlagName, "v"),
+				Usage:    "the build variant of the task",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     joinFlagNames(taskFlagName, "t"),
+				Usage:    "the display name of the task",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     goodFlagName,
+				Usage:    "a revision (or unique prefix) of a mainline version where the task succeeds",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     badFlagName,
+				Usage:    "a revision (or unique prefix) of a later mainline version where the task fails",
+				Required: true,
+			},
+			cli.BoolFlag{
+				Name:  manualFlagName,
+				Usage: "decide whether each run is good or bad yourself rather than by its status, e.g. to find a performance regression in a task that still succeeds",
+			},
+		},
+		Before: autoUpdateCLI,
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(c.Parent().String(ConfFlagName))
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			bisectRange, err := comm.GetBisectRange(ctx, client.GetBisectRangeOptions{
+				ProjectID:    c.String(projectFlagName),
+				BuildVariant: c.String(variantFlagName),
+				TaskName:     c.String(taskFlagName),
+				GoodRevision: c.String(goodFlagName),
+				BadRevision:  c.String(badFlagName),
+			})
+			if err != nil {
+				return errors.Wrap(err, "getting versions to bisect")
+			}
+
+			b := &bisector{
+				comm:   comm,
+				uiHost: conf.UIServerHost,
+				manual: c.Bool(manualFlagName),
+				state:  newBisectState(len(bisectRange.Tasks)),
+			}
+			if err = b.run(ctx, bisectRange.Tasks); err != nil {
+				return err
+			}
+
+			printBisectResult(bisectRange, b.state)
+			return nil
+		},
+	}
+}
+
+// bisectState tracks a binary search over versions ordered from oldest to
+// newest, where the versions before the first one are known to be good and the
+// versions after the last one are known to be bad.
+type bisectState struct {
+	// good is the index of the newest version known to be good, or -1.
+	good int
+	// bad is the index of the oldest version known to be bad, or the number
+	// of versions.
+	bad     int
+	skipped map[int]bool
+}
+
+func newBisectState(numVersions int) *bisectState {
+	return &bisectState{
+		good:    -1,
+		bad:     numVersions,
+		skipped: map[int]bool{},
+	}
+}
+
+// next returns the index of the next version to test. It returns false once
+// every version between the newest good and the oldest bad version has been
+// tested or skipped.
+func (s *bisectState) next() (int, bool) {
+	untested := func(i int) bool {
+		return i > s.good && i < s.bad && !s.skipped[i]
+	}
+
+	mid := s.good + (s.bad-s.good)/2
+	// Prefer the version closest to the middle that hasn't been skipped.
+	for offset := 0; mid-offset > s.good || mid+offset+1 < s.bad; offset++ {
+		if i := mid - offset; untested(i) {
+			return i, true
+		}
+		if i := mid + offset + 1; untested(i) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// mark records the result of testing the version at index i.
+func (s *bisectState) mark(i int, result bisectResult) {
+	switch result {
+	case bisectGood:
+		s.good = i
+	case bisectBad:
+		s.bad = i
+	case bisectSkip:
+		s.skipped[i] = true
+	}
+}
+
+// remaining returns the number of versions that may still be the first bad
+// one, not counting the oldest known-bad version.
+func (s *bisectState) remaining() int {
+	return s.bad - s.good - 1
+}
+
+type bisector struct {
+	comm   client.Communicator
+	uiHost string
+	manual bool
+	state  *bisectState
+}
+
+func (b *bisector) run(ctx context.Context, tasks []restmodel.APITask) error {
+	for {
+		i, ok := b.state.next()
+		if !ok {
+			return nil
+		}
+		t := tasks[i]
+		grip.Infof(ctx, "Testing revision '%s' (%d versions left to test, roughly %d steps).", utility.FromStringPtr(t.Revision), b.state.remaining(), bisectSteps(b.state.remaining()))
+
+		finished, err := b.waitForTask(ctx, t)
+		if err != nil {
+			return err
+		}
+		result := classifyBisectTask(finished)
+		if b.manual && result != bisectSkip {
+			result = b.promptResult(finished)
+		}
+		b.state.mark(i, result)
+	}
+}
+
+// waitForTask activates the task if it hasn't run yet and waits for it to
+// finish.
+func (b *bisector) waitForTask(ctx context.Context, t restmodel.APITask) (*restmodel.APITask, error) {
+	taskID := utility.FromStringPtr(t.Id)
+	if !t.Activated {
+		grip.Infof(ctx, "Activating task '%s'.", taskID)
+		if _, err := b.comm.ActivateTask(ctx, taskID); err != nil {
+			return nil, errors.Wrapf(err, "activating task '%s'", taskID)
+		}
+	}
+
+	ticker := time.NewTicker(bisectPollInterval)
+	defer ticker.Stop()
+	var lastStatus string
+	for {
+		latest, err := b.comm.GetTask(ctx, taskID, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting task '%s'", taskID)
+		}
+		status := utility.FromStringPtr(latest.DisplayStatus)
+		if evergreen.IsFinishedTaskStatus(utility.FromStringPtr(latest.Status)) || status == evergreen.TaskStatusBlocked {
+			grip.Infof(ctx, "Task '%s' finished with status '%s': %s", taskID, status, b.taskLink(taskID))
+			return latest, nil
+		}
+		if status != lastStatus {
+			grip.Infof(ctx, "Waiting for task '%s' (status '%s').", taskID, status)
+			lastStatus = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *bisector) promptResult(t *restmodel.APITask) bisectResult {
+	for {
+		switch strings.ToLower(prompt(fmt.Sprintf("Is revision '%s' good or bad? (good/bad/skip)", utility.FromStringPtr(t.Revision)))) {
+		case "good", "g":
+			return bisectGood
+		case "bad", "b":
+			return bisectBad
+		case "skip", "s":
+			return bisectSkip
+		}
+	}
+}
+
+func (b *bisector) taskLink(taskID string) string {
+	return fmt.Sprintf("%s/task/%s", b.uiHost, taskID)
+}
+
+// classifyBisectTask returns whether a finished task run shows its version is
+// good or bad. Runs that failed for reasons other than the task's own
+// commands, such as system failures, setup failures and aborts, are skipped.
+func classifyBisectTask(t *restmodel.APITask) bisectResult {
+	switch utility.FromStringPtr(t.DisplayStatus) {
+	case evergreen.TaskSucceeded:
+		return bisectGood
+	case evergreen.TaskFailed, evergreen.TaskTestTimedOut, evergreen.TaskKnownIssue:
+		return bisectBad
+	default:
+		return bisectSkip
+	}
+}
+
+// bisectSteps returns the number of tests it takes to bisect the given number
+// of versions.
+func bisectSteps(numVersions int) int {
+	steps := 0
+	for n := numVersions; n > 0; n /= 2 {
+		steps++
+	}
+	return steps
+}
+
+func printBisectResult(bisectRange *restmodel.APIBisectRange, s *bisectState) {
+	revisionAt := func(i int) string {
+		switch {
+		case i < 0:
+			return utility.FromStringPtr(bisectRange.GoodRevision)
+		case i >= len(bisectRange.Tasks):
+			return utility.FromStringPtr(bisectRange.BadRevision)
+		default:
+			return utility.FromStringPtr(bisectRange.Tasks[i].Revision)
+		}
+	}
+
+	if len(s.skipped) > 0 && s.remaining() > 0 {
+		fmt.Printf("Could not narrow down the first bad revision because some runs were skipped. It is one of:\n")
+		for i := s.good + 1; i <= s.bad; i++ {
+			fmt.Printf("  %s\n", revisionAt(i))
+		}
+		return
+	}
+
+	fmt.Printf("First bad revision: %s\n", revisionAt(s.bad))
+	fmt.Printf("Last good revision: %s\n", revisionAt(s.good))
+	if orderAt(bisectRange, s.bad)-orderAt(bisectRange, s.good) > 1 {
+		fmt.Println("Some versions between them don't contain the task and weren't tested, so the breaking change may be in any of their commits.")
+	}
+}
+
+// orderAt returns the revision order number of the bisected version at index
+// i, where -1 is the good version and the number of tasks is the bad version.
+func orderAt(bisectRange *restmodel.APIBisectRange, i int) int {
+	switch {
+	case i < 0:
+		return bisectRange.GoodOrder
+	case i >= len(bisectRange.Tasks):
+		return bisectRange.BadOrder
+	default:
+		return bisectRange.Tasks[i].Order
+	}
+}
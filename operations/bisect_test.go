@@ -0,0 +1,94 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBisectState(t *testing.T) {
+	// bisect runs the search against versions that turn bad at firstBad,
+	// skipping the versions in skip, and returns the tested indexes.
+	bisect := func(s *bisectState, firstBad int, skip map[int]bool) []int {
+		var tested []int
+		for {
+			i, ok := s.next()
+			if !ok {
+				return tested
+			}
+			tested = append(tested, i)
+			switch {
+			case skip[i]:
+				s.mark(i, bisectSkip)
+			case i >= firstBad:
+				s.mark(i, bisectBad)
+			default:
+				s.mark(i, bisectGood)
+			}
+		}
+	}
+
+	t.Run("FindsFirstBadVersion", func(t *testing.T) {
+		for firstBad := 0; firstBad <= 10; firstBad++ {
+			s := newBisectState(10)
+			tested := bisect(s, firstBad, nil)
+			assert.Equal(t, firstBad, s.bad)
+			assert.Equal(t, firstBad-1, s.good)
+			assert.Zero(t, s.remaining())
+			assert.LessOrEqual(t, len(tested), bisectSteps(10))
+		}
+	})
+	t.Run("TestsMiddleVersionFirst", func(t *testing.T) {
+		i, ok := newBisectState(7).next()
+		require.True(t, ok)
+		assert.Equal(t, 3, i)
+	})
+	t.Run("NoVersions", func(t *testing.T) {
+		s := newBisectState(0)
+		_, ok := s.next()
+		assert.False(t, ok)
+		assert.Equal(t, 0, s.bad)
+	})
+	t.Run("TestsVersionsNextToSkippedOnes", func(t *testing.T) {
+		s := newBisectState(10)
+		bisect(s, 6, map[int]bool{4: true})
+		assert.Equal(t, 6, s.bad)
+		assert.Equal(t, 5, s.good)
+	})
+	t.Run("SkippedVersionsLeaveRangeAmbiguous", func(t *testing.T) {
+		s := newBisectState(10)
+		tested := bisect(s, 6, map[int]bool{5: true})
+		assert.Equal(t, 6, s.bad)
+		assert.Equal(t, 4, s.good)
+		assert.Equal(t, 1, s.remaining())
+		assert.Contains(t, tested, 5)
+	})
+}
+
+func TestClassifyBisectTask(t *testing.T) {
+	for status, expected := range map[string]bisectResult{
+		evergreen.TaskSucceeded:     bisectGood,
+		evergreen.TaskFailed:        bisectBad,
+		evergreen.TaskTestTimedOut:  bisectBad,
+		evergreen.TaskKnownIssue:    bisectBad,
+		evergreen.TaskSystemFailed:  bisectSkip,
+		evergreen.TaskSetupFailed:   bisectSkip,
+		evergreen.TaskAborted:       bisectSkip,
+		evergreen.TaskStatusBlocked: bisectSkip,
+	} {
+		t.Run(status, func(t *testing.T) {
+			assert.Equal(t, expected, classifyBisectTask(&restmodel.APITask{DisplayStatus: utility.ToStringPtr(status)}))
+		})
+	}
+}
+
+func TestBisectSteps(t *testing.T) {
+	assert.Equal(t, 0, bisectSteps(0))
+	assert.Equal(t, 1, bisectSteps(1))
+	assert.Equal(t, 2, bisectSteps(3))
+	assert.Equal(t, 4, bisectSteps(10))
+}
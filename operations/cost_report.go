@@ -0,0 +1,166 @@
+package operations
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	costReportFormatJSON = "json"
+	costReportFormatCSV  = "csv"
+)
+
+func CostReport() cli.Command {
+	const (
+		afterFlagName     = "after"
+		beforeFlagName    = "before"
+		groupByFlagName   = "group-by"
+		requesterFlagName = "requester"
+		formatFlagName    = "format"
+	)
+
+	return cli.Command{
+		Name:  "cost-report",
+		Usage: "show the cost of a project's tasks over a date range, grouped by variant, task, requester or author",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     joinFlagNames(projectFlagName, "p"),
+				Usage:    "the project ID or identifier",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     afterFlagName,
+				Usage:    "start date (inclusive) of the report, in the format YYYY-MM-DD",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     beforeFlagName,
+				Usage:    "end date (exclusive) of the report, in the format YYYY-MM-DD",
+				Required: true,
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(groupByFlagName, "g"),
+				Usage: "dimension to group costs by: variant, task, requester or author (can be specified multiple times, defaults to variant and task)",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(requesterFlagName, "r"),
+				Usage: "only include tasks with this requester, e.g. patch, commit, github_merge_queue or ad_hoc (can be specified multiple times)",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(variantsFlagName, "v"),
+				Usage: "only include tasks in this build variant (can be specified multiple times)",
+			},
+			cli.StringFlag{
+				Name:  formatFlagName,
+				Usage: "output format: json or csv",
+				Value: costReportFormatJSON,
+			},
+		},
+		Before: mergeBeforeFuncs(autoUpdateCLI, func(c *cli.Context) error {
+			format := c.String(formatFlagName)
+			if format != costReportFormatJSON && format != costReportFormatCSV {
+				return errors.Errorf("invalid format '%s'", format)
+			}
+			return nil
+		}),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().String(ConfFlagName)
+			opts := client.GetProjectCostReportOptions{
+				ProjectID:  c.String(projectFlagName),
+				AfterDate:  c.String(afterFlagName),
+				BeforeDate: c.String(beforeFlagName),
+				GroupBy:    c.StringSlice(groupByFlagName),
+				Requesters: c.StringSlice(requesterFlagName),
+				Variants:   c.StringSlice(variantsFlagName),
+			}
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			reports, err := comm.GetProjectCostReport(ctx, opts)
+			if err != nil {
+				return errors.Wrapf(err, "getting cost report for project '%s'", opts.ProjectID)
+			}
+
+			if c.String(formatFlagName) == costReportFormatCSV {
+				return writeCostReportCSV(os.Stdout, reports)
+			}
+			out, err := json.MarshalIndent(reports, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "marshalling cost report to JSON")
+			}
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+}
+
+// writeCostReportCSV writes the cost report as CSV with a header row. Columns
+// for dimensions that the report is not grouped by are left empty.
+func writeCostReportCSV(w io.Writer, reports []restmodel.APICostReport) error {
+	formatCost := func(c float64) string {
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	}
+
+	csvWriter := csv.NewWriter(w)
+	records := [][]string{{
+		"variant",
+		"task_name",
+		"requester",
+		"author",
+		"num_tasks",
+		"time_taken_secs",
+		"total_cost",
+		"ec2_cost",
+		"ebs_throughput_cost",
+		"ebs_storage_cost",
+		"s3_artifact_put_cost",
+		"s3_log_put_cost",
+		"s3_artifact_storage_cost",
+		"s3_log_storage_cost",
+	}}
+	for _, r := range reports {
+		records = append(records, []string{
+			utility.FromStringPtr(r.BuildVariant),
+			utility.FromStringPtr(r.TaskName),
+			utility.FromStringPtr(r.Requester),
+			utility.FromStringPtr(r.Author),
+			strconv.Itoa(r.NumTasks),
+			strconv.FormatFloat(r.TimeTakenSecs, 'f', 0, 64),
+			formatCost(r.Cost.Total),
+			formatCost(r.Cost.AdjustedEC2Cost),
+			formatCost(r.Cost.AdjustedEBSThroughputCost),
+			formatCost(r.Cost.AdjustedEBSStorageCost),
+			formatCost(r.Cost.AdjustedS3ArtifactPutCost),
+			formatCost(r.Cost.AdjustedS3LogPutCost),
+			formatCost(r.Cost.AdjustedS3ArtifactStorageCost),
+			formatCost(r.Cost.AdjustedS3LogStorageCost),
+		})
+	}
+
+	if err := csvWriter.WriteAll(records); err != nil {
+		return errors.Wrap(err, "writing cost report as CSV")
+	}
+	return nil
+}
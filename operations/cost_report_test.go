@@ -0,0 +1,47 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/cost"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCostReportCSV(t *testing.T) {
+	reports := []restmodel.APICostReport{
+		{
+			BuildVariant:  utility.ToStringPtr("ubuntu"),
+			Requester:     utility.ToStringPtr("patch"),
+			NumTasks:      3,
+			TimeTakenSecs: 90,
+			Cost:          cost.Cost{Total: 1.25, AdjustedEC2Cost: 1, AdjustedS3LogPutCost: 0.25},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCostReportCSV(&buf, reports))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Len(t, records[1], len(records[0]))
+
+	row := map[string]string{}
+	for i, header := range records[0] {
+		row[header] = records[1][i]
+	}
+	assert.Equal(t, "ubuntu", row["variant"])
+	assert.Empty(t, row["task_name"])
+	assert.Equal(t, "patch", row["requester"])
+	assert.Empty(t, row["author"])
+	assert.Equal(t, "3", row["num_tasks"])
+	assert.Equal(t, "90", row["time_taken_secs"])
+	assert.Equal(t, "1.25", row["total_cost"])
+	assert.Equal(t, "1", row["ec2_cost"])
+	assert.Equal(t, "0.25", row["s3_log_put_cost"])
+}
@@ -0,0 +1,147 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func GenerateDryRun() cli.Command {
+	const (
+		versionFlagName = "version"
+		fileFlagName    = "file"
+	)
+
+	return cli.Command{
+		Name:  "generate-dry-run",
+		Usage: "show what generate.tasks would add to a version without generating anything",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     versionFlagName,
+				Usage:    "ID of the version to generate tasks in",
+				Required: true,
+			},
+			cli.StringSliceFlag{
+				Name:     joinFlagNames(fileFlagName, "f"),
+				Usage:    "path to a JSON file passed to generate.tasks (can be specified multiple times)",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     joinFlagNames(taskIDFlagName, "t"),
+				Usage:    "ID of the task that runs generate.tasks",
+				Required: true,
+			},
+			cli.BoolFlag{
+				Name:  jsonFlagName,
+				Usage: "output the result as JSON",
+			},
+		},
+		Before: autoUpdateCLI,
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			confPath := c.Parent().String(ConfFlagName)
+			versionID := c.String(versionFlagName)
+			opts := restmodel.APIGenerateTasksDryRunRequest{TaskID: c.String(taskIDFlagName)}
+			for _, path := range c.StringSlice(fileFlagName) {
+				contents, err := os.ReadFile(path)
+				if err != nil {
+					return errors.Wrapf(err, "reading file '%s'", path)
+				}
+				if !json.Valid(contents) {
+					return errors.Errorf("file '%s' does not contain valid JSON", path)
+				}
+				opts.Files = append(opts.Files, contents)
+			}
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			dryRun, err := client.GenerateTasksDryRun(ctx, versionID, opts)
+			if err != nil {
+				return errors.Wrapf(err, "dry running generate tasks for version '%s'", versionID)
+			}
+
+			if c.Bool(jsonFlagName) {
+				out, err := json.MarshalIndent(dryRun, "", "  ")
+				if err != nil {
+					return errors.Wrap(err, "marshalling dry run result to JSON")
+				}
+				fmt.Println(string(out))
+			} else {
+				fmt.Print(formatGenerateDryRun(dryRun))
+			}
+
+			if len(dryRun.Errors) > 0 {
+				return errors.Errorf("generate.tasks would fail with %d error(s)", len(dryRun.Errors))
+			}
+			return nil
+		},
+	}
+}
+
+// formatGenerateDryRun returns a human-readable summary of a generate.tasks
+// dry run.
+func formatGenerateDryRun(dryRun *restmodel.APIGenerateTasksDryRun) string {
+	var sb strings.Builder
+	writeSection := func(title string, lines []string) {
+		if len(lines) == 0 {
+			return
+		}
+		fmt.Fprintf(&sb, "%s:\n", title)
+		for _, line := range lines {
+			fmt.Fprintf(&sb, "  %s\n", line)
+		}
+	}
+
+	writeSection("New build variants", dryRun.BuildVariants)
+
+	var tasks []string
+	for _, pair := range dryRun.Tasks {
+		tasks = append(tasks, fmt.Sprintf("%s (%s)", pair.TaskName, pair.Variant))
+	}
+	writeSection("New tasks", tasks)
+
+	var deps []string
+	for _, dep := range dryRun.Dependencies {
+		line := fmt.Sprintf("%s (%s) -> %s (%s)", dep.TaskName, dep.Variant, dep.DependsOn.TaskName, dep.DependsOn.Variant)
+		if dep.Status != "" {
+			line += fmt.Sprintf(" [status: %s]", dep.Status)
+		}
+		deps = append(deps, line)
+	}
+	writeSection("New dependencies", deps)
+
+	var displayTasks []string
+	for _, dt := range dryRun.DisplayTasks {
+		action := "add to"
+		if dt.IsNew {
+			action = "new"
+		}
+		displayTasks = append(displayTasks, fmt.Sprintf("%s (%s) [%s]: %s", dt.Name, dt.Variant, action, strings.Join(dt.ExecutionTasks, ", ")))
+	}
+	writeSection("Display tasks", displayTasks)
+
+	if len(tasks) == 0 && len(dryRun.BuildVariants) == 0 && len(displayTasks) == 0 {
+		sb.WriteString("generate.tasks would not add anything to the version\n")
+	}
+
+	writeSection("Warnings", dryRun.Warnings)
+	writeSection("Errors", dryRun.Errors)
+
+	return sb.String()
+}
@@ -11,6 +11,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -34,6 +35,13 @@ const (
 	setupFlagName       = "setup"
 	tailFlagName        = "tail"
 	debugTaskIDFlagName = "task-id"
+	debugBundleFlagName = "bundle"
+	includeDepsFlagName = "include-deps"
+
+	breakFunctionFlagName    = "function"
+	breakCommandFlagName     = "command"
+	breakDisplayNameFlagName = "display-name"
+	breakConditionFlagName   = "if"
 )
 
 // getRootContext walks up the cli.Context chain to find the root context,
@@ -125,25 +133,33 @@ func Debug() cli.Command {
 			},
 			{
 				Name:      "load",
-				Usage:     "Load a configuration file or specific task ID",
+				Usage:     "Load a configuration file, specific task ID or task bundle",
 				ArgsUsage: "<config.yml>",
 				Flags: []cli.Flag{
 					cli.StringFlag{
 						Name:  debugTaskIDFlagName,
 						Usage: "Task ID to load configuration from (required when not on a spawn host)",
 					},
+					cli.StringFlag{
+						Name:  debugBundleFlagName,
+						Usage: "Task bundle created by 'evergreen task bundle' to load the task from without contacting Evergreen",
+					},
 				},
 				Action: loadConfigCmd,
 			},
 			{
 				Name:      "select",
-				Usage:     "Select a task for debugging",
-				ArgsUsage: "<task_name>",
+				Usage:     "Select a task or task group for debugging",
+				ArgsUsage: "<task_name|task_group_name>",
 				Flags: []cli.Flag{
 					cli.StringFlag{
 						Name:  "variant, v",
 						Usage: "Build variant to apply variant-specific expansions",
 					},
+					cli.BoolFlag{
+						Name:  includeDepsFlagName,
+						Usage: "Run the task's dependencies on the same build variant before the task (requires --variant)",
+					},
 				},
 				Action: selectTaskCmd,
 			},
@@ -180,6 +196,57 @@ func Debug() cli.Command {
 				ArgsUsage: "<step_number>",
 				Action:    jumpToCmd,
 			},
+			{
+				Name:  "break",
+				Usage: "Manage breakpoints for run-all and run-until",
+				Subcommands: []cli.Command{
+					{
+						Name:  "add",
+						Usage: "Pause before steps that match all of the given criteria",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  breakFunctionFlagName,
+								Usage: "Match steps in the named function",
+							},
+							cli.StringFlag{
+								Name:  breakCommandFlagName,
+								Usage: "Match steps that run the command type (e.g. 'shell.exec')",
+							},
+							cli.StringFlag{
+								Name:  breakDisplayNameFlagName,
+								Usage: "Match steps whose display name contains the text",
+							},
+							cli.StringFlag{
+								Name:  breakConditionFlagName,
+								Usage: "Only match when the expansion condition holds (e.g. 'key==value' or 'key!=value')",
+							},
+						},
+						Action: addBreakpointCmd,
+					},
+					{
+						Name:   "list",
+						Usage:  "List breakpoints",
+						Action: listBreakpointsCmd,
+					},
+					{
+						Name:      "remove",
+						Usage:     "Remove a breakpoint",
+						ArgsUsage: "<breakpoint_id>",
+						Action:    removeBreakpointCmd,
+					},
+					{
+						Name:   "clear",
+						Usage:  "Remove all breakpoints",
+						Action: clearBreakpointsCmd,
+					},
+					{
+						Name:      "on-failure",
+						Usage:     "Pause before a task's teardown when one of its steps fails",
+						ArgsUsage: "<on|off>",
+						Action:    setBreakOnFailureCmd,
+					},
+				},
+			},
 			{
 				Name:  "logs",
 				Usage: "View debug session logs",
@@ -475,6 +542,10 @@ func daemonStatusCmd(c *cli.Context) error {
 // loadConfigCmd loads a configuration file. It handles OAuth authentication
 // and spawn host validation before sending the config to the daemon.
 func loadConfigCmd(c *cli.Context) error {
+	if bundlePath := c.String(debugBundleFlagName); bundlePath != "" {
+		return loadBundleCmd(bundlePath)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
@@ -542,6 +613,49 @@ func loadConfigCmd(c *cli.Context) error {
 	return nil
 }
 
+// loadBundleCmd extracts a task bundle next to the bundle file and loads its
+// task into the daemon.
+func loadBundleCmd(bundlePath string) error {
+	bundlePath, err := filepath.Abs(bundlePath)
+	if err != nil {
+		return errors.Wrap(err, "resolving bundle path")
+	}
+	bundleDir := strings.TrimSuffix(strings.TrimSuffix(bundlePath, ".tar.gz"), ".tgz")
+	if bundleDir == bundlePath {
+		bundleDir += "_extracted"
+	}
+
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return errors.Wrapf(err, "opening bundle '%s'", bundlePath)
+	}
+	defer f.Close()
+
+	manifest, err := taskexec.ExtractBundle(f, bundleDir)
+	if err != nil {
+		return errors.Wrapf(err, "extracting bundle '%s'", bundlePath)
+	}
+	grip.Infof(context.Background(), "Extracted bundle for task '%s' execution %d to '%s'.", manifest.TaskID, manifest.Execution, bundleDir)
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return errors.Wrap(err, "connecting to daemon")
+	}
+
+	resp, err := postJSON(url+"/config/load", map[string]string{"bundle_dir": bundleDir})
+	if err != nil {
+		return errors.Wrap(err, "loading bundle")
+	}
+
+	grip.Infof(context.Background(), "Loaded and auto-selected task: %v (variant: %v)", resp["selected_task"], resp["selected_variant"])
+	grip.Infof(context.Background(), "Total steps: %v", resp["step_count"])
+	if len(manifest.RedactedExpansions) > 0 {
+		grip.Infof(context.Background(), "Redacted expansions must be set with 'evergreen debug set-var' before the steps that use them: %s", strings.Join(manifest.RedactedExpansions, ", "))
+	}
+
+	return nil
+}
+
 // selectTaskCmd selects a task for debugging
 func selectTaskCmd(c *cli.Context) error {
 	if c.NArg() < 1 {
@@ -550,6 +664,10 @@ func selectTaskCmd(c *cli.Context) error {
 
 	taskName := c.Args().Get(0)
 	variantName := c.String("variant")
+	includeDeps := c.Bool(includeDepsFlagName)
+	if includeDeps && variantName == "" {
+		return errors.New("a build variant is required to include dependencies")
+	}
 
 	// Clear previous session logs when selecting a new task.
 	if err := taskexec.ClearSessionLogs(); err != nil {
@@ -561,9 +679,10 @@ func selectTaskCmd(c *cli.Context) error {
 		return err
 	}
 
-	reqBody := map[string]string{
-		"task_name":    taskName,
-		"variant_name": variantName,
+	reqBody := map[string]interface{}{
+		"task_name":            taskName,
+		"variant_name":         variantName,
+		"include_dependencies": includeDeps,
 	}
 
 	resp, err := postJSON(url+"/task/select", reqBody)
@@ -690,6 +809,134 @@ func setVariableCmd(c *cli.Context) error {
 	return nil
 }
 
+// addBreakpointCmd adds a breakpoint.
+func addBreakpointCmd(c *cli.Context) error {
+	reqBody := map[string]string{
+		"function_name": c.String(breakFunctionFlagName),
+		"command_name":  c.String(breakCommandFlagName),
+		"display_name":  c.String(breakDisplayNameFlagName),
+		"condition":     c.String(breakConditionFlagName),
+	}
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := postJSON(url+"/breakpoints/add", reqBody)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Added breakpoint %v: %v\n", resp["id"], resp["description"])
+	return nil
+}
+
+// listBreakpointsCmd lists all breakpoints.
+func listBreakpointsCmd(c *cli.Context) error {
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Get(url + "/breakpoints")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyData, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyData))
+	}
+
+	var result struct {
+		Breakpoints    []taskexec.Breakpoint `json:"breakpoints"`
+		BreakOnFailure bool                  `json:"break_on_failure"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if len(result.Breakpoints) == 0 {
+		fmt.Println("No breakpoints.")
+	} else {
+		fmt.Println("Breakpoints:")
+		for _, bp := range result.Breakpoints {
+			fmt.Printf("  %d: %s\n", bp.ID, bp.String())
+		}
+	}
+	fmt.Printf("Break on failure: %t\n", result.BreakOnFailure)
+
+	return nil
+}
+
+// removeBreakpointCmd removes a breakpoint by ID.
+func removeBreakpointCmd(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return errors.New("breakpoint ID required")
+	}
+
+	id := c.Args().Get(0)
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	if _, err := postJSON(fmt.Sprintf("%s/breakpoints/remove/%s", url, id), nil); err != nil {
+		return err
+	}
+
+	fmt.Printf("Removed breakpoint %s\n", id)
+	return nil
+}
+
+// clearBreakpointsCmd removes all breakpoints.
+func clearBreakpointsCmd(c *cli.Context) error {
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	if _, err := postJSON(url+"/breakpoints/clear", nil); err != nil {
+		return err
+	}
+
+	fmt.Println("Cleared all breakpoints")
+	return nil
+}
+
+// setBreakOnFailureCmd sets whether run-all and run-until pause before a
+// task's teardown when one of its steps fails.
+func setBreakOnFailureCmd(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return errors.New("'on' or 'off' required")
+	}
+
+	var enabled bool
+	switch c.Args().Get(0) {
+	case "on":
+		enabled = true
+	case "off":
+		enabled = false
+	default:
+		return errors.Errorf("invalid value '%s', use 'on' or 'off'", c.Args().Get(0))
+	}
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	if _, err := postJSON(url+"/breakpoints/break-on-failure", map[string]bool{"enabled": enabled}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Break on failure: %t\n", enabled)
+	return nil
+}
+
 // listStepsCmd lists all steps
 func listStepsCmd(c *cli.Context) error {
 	url, err := getDaemonURL()
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 
 	"github.com/evergreen-ci/evergreen/agent/taskexec"
@@ -44,9 +45,15 @@ func (d *localDaemonREST) Start() error {
 	router.HandleFunc("/task/list-steps", d.handleListSteps).Methods("GET")
 	router.HandleFunc("/step/next", d.handleStepNext).Methods("POST")
 	router.HandleFunc("/step/run-all", d.handleRunAll).Methods("POST")
-	router.HandleFunc("/step/run-until/{step}", d.handleRunUntil).Methods("POST")
-	router.HandleFunc("/step/jump/{step}", d.handleJumpTo).Methods("POST")
+	// Step numbers may contain a slash when they're qualified by task name.
+	router.HandleFunc("/step/run-until/{step:.+}", d.handleRunUntil).Methods("POST")
+	router.HandleFunc("/step/jump/{step:.+}", d.handleJumpTo).Methods("POST")
 	router.HandleFunc("/variable/set", d.handleSetVariable).Methods("POST")
+	router.HandleFunc("/breakpoints", d.handleListBreakpoints).Methods("GET")
+	router.HandleFunc("/breakpoints/add", d.handleAddBreakpoint).Methods("POST")
+	router.HandleFunc("/breakpoints/remove/{id}", d.handleRemoveBreakpoint).Methods("POST")
+	router.HandleFunc("/breakpoints/clear", d.handleClearBreakpoints).Methods("POST")
+	router.HandleFunc("/breakpoints/break-on-failure", d.handleSetBreakOnFailure).Methods("POST")
 	router.HandleFunc("/status", d.handleStatus).Methods("GET")
 
 	if err := d.writeDaemonInfo(); err != nil {
@@ -68,6 +75,10 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 		ConfigPath string `json:"config_path"`
 		OAuthToken string `json:"oauth_token"`
 		TaskID     string `json:"task_id"`
+		// BundleDir is the directory of an extracted task bundle. Bundles
+		// contain everything needed to run the task, so no token is
+		// required to load one.
+		BundleDir string `json:"bundle_dir"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -75,7 +86,7 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if req.OAuthToken == "" {
+	if req.OAuthToken == "" && req.BundleDir == "" {
 		http.Error(w, "OAuth token is required", http.StatusUnauthorized)
 		return
 	}
@@ -83,7 +94,9 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	d.conf.OAuth.AccessToken = req.OAuthToken
+	if req.OAuthToken != "" {
+		d.conf.OAuth.AccessToken = req.OAuthToken
+	}
 
 	// If an executor already exists with a selected task, hot reload the
 	// project so the debug session state is preserved.
@@ -110,7 +123,9 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 	}
 
 	workDir := req.ConfigPath
-	if workDir != "" {
+	if req.BundleDir != "" {
+		workDir = req.BundleDir
+	} else if workDir != "" {
 		workDir = filepath.Dir(workDir)
 	} else {
 		// Fallback to OS working directory if config path is not provided (i.e. on a local machine)
@@ -128,6 +143,7 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 		TaskID:      taskID,
 		OAuthToken:  req.OAuthToken,
 		SpawnHostID: d.conf.SpawnHostID,
+		BundleDir:   req.BundleDir,
 	}
 
 	executor, err := taskexec.NewLocalExecutor(r.Context(), opts)
@@ -155,7 +171,8 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 
 	// Users must supply a specific task ID when using the feature locally. When it is provided,
 	// auto-select the task and automatically infer the task name and variant.
-	if req.TaskID != "" {
+	// Task bundles always contain a single task, so it's selected the same way.
+	if req.TaskID != "" || req.BundleDir != "" {
 		d.localMode = true
 		taskName := executor.GetFetchedTaskName()
 		variant := executor.GetFetchedBuildVariant()
@@ -188,8 +205,9 @@ func (d *localDaemonREST) handleLoadConfig(w http.ResponseWriter, r *http.Reques
 // handleSelectTask selects a task for debugging
 func (d *localDaemonREST) handleSelectTask(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		TaskName    string `json:"task_name"`
-		VariantName string `json:"variant_name"`
+		TaskName            string `json:"task_name"`
+		VariantName         string `json:"variant_name"`
+		IncludeDependencies bool   `json:"include_dependencies"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -210,7 +228,11 @@ func (d *localDaemonREST) handleSelectTask(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if err := d.executor.PrepareTask(r.Context(), req.TaskName, req.VariantName); err != nil {
+	if err := d.executor.PrepareTaskWithOptions(r.Context(), taskexec.PrepareTaskOptions{
+		TaskName:            req.TaskName,
+		VariantName:         req.VariantName,
+		IncludeDependencies: req.IncludeDependencies,
+	}); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -296,6 +318,7 @@ func (d *localDaemonREST) handleListSteps(w http.ResponseWriter, r *http.Request
 		steps = append(steps, map[string]interface{}{
 			"index":         i,
 			"step_number":   cmd.FullStepNumber(),
+			"task_name":     cmd.TaskName,
 			"command_type":  cmd.Command.Command,
 			"display_name":  cmd.DisplayName,
 			"is_function":   cmd.IsFunction,
@@ -427,6 +450,134 @@ func (d *localDaemonREST) handleSetVariable(w http.ResponseWriter, r *http.Reque
 	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]bool{"success": true}))
 }
 
+// handleListBreakpoints lists the breakpoints in the debug session.
+func (d *localDaemonREST) handleListBreakpoints(w http.ResponseWriter, r *http.Request) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	state := d.executor.GetDebugState()
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]interface{}{
+		"breakpoints":      state.Breakpoints,
+		"break_on_failure": state.BreakOnFailure,
+	}))
+}
+
+// handleAddBreakpoint adds a breakpoint to the debug session.
+func (d *localDaemonREST) handleAddBreakpoint(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		FunctionName string `json:"function_name"`
+		CommandName  string `json:"command_name"`
+		DisplayName  string `json:"display_name"`
+		Condition    string `json:"condition"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, errors.Wrap(err, "adding breakpoint").Error(), http.StatusBadRequest)
+		return
+	}
+
+	bp := taskexec.Breakpoint{
+		FunctionName: req.FunctionName,
+		CommandName:  req.CommandName,
+		DisplayName:  req.DisplayName,
+	}
+	if req.Condition != "" {
+		condition, err := taskexec.ParseBreakpointCondition(req.Condition)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		bp.Condition = condition
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	added, err := d.executor.AddBreakpoint(r.Context(), bp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":     true,
+		"id":          added.ID,
+		"description": added.String(),
+	}))
+}
+
+// handleRemoveBreakpoint removes a breakpoint by ID.
+func (d *localDaemonREST) handleRemoveBreakpoint(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, errors.Wrap(err, "parsing breakpoint ID").Error(), http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	if err := d.executor.GetDebugState().RemoveBreakpoint(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]bool{"success": true}))
+}
+
+// handleClearBreakpoints removes all breakpoints.
+func (d *localDaemonREST) handleClearBreakpoints(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	d.executor.GetDebugState().ClearBreakpoints()
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]bool{"success": true}))
+}
+
+// handleSetBreakOnFailure sets whether continuous runs pause before the
+// teardown of a failed task.
+func (d *localDaemonREST) handleSetBreakOnFailure(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	d.executor.SetBreakOnFailure(r.Context(), req.Enabled)
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]bool{"success": true}))
+}
+
 // handleStepNext executes the next step with streaming output.
 func (d *localDaemonREST) handleStepNext(w http.ResponseWriter, r *http.Request) {
 	d.mu.Lock()
@@ -0,0 +1,215 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func SearchLogs() cli.Command {
+	const (
+		patternFlagName         = "pattern"
+		taskFlagName            = "task"
+		literalFlagName         = "literal"
+		contextFlagName         = "context"
+		limitFlagName           = "limit"
+		daysFlagName            = "days"
+		taskLimitFlagName       = "task-limit"
+		requesterFlagName       = "requester"
+		excludeTestLogsFlagName = "exclude-test-logs"
+	)
+
+	return cli.Command{
+		Name:  "search-logs",
+		Usage: "search a task's logs, or find which of a project's recent tasks printed a line",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     joinFlagNames(patternFlagName, "e"),
+				Usage:    "the regular expression (RE2 syntax) to search for",
+				Required: true,
+			},
+			cli.BoolFlag{
+				Name:  joinFlagNames(literalFlagName, "F"),
+				Usage: "match the pattern as a plain string rather than a regular expression",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "the ID of the task to search",
+			},
+			cli.IntFlag{
+				Name:  executionFlagName,
+				Usage: "the 0-based execution of the task to search (defaults to the latest execution)",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(projectFlagName, "p"),
+				Usage: "the project ID or identifier whose recent tasks to search",
+			},
+			cli.IntFlag{
+				Name:  joinFlagNames(contextFlagName, "C"),
+				Usage: "the number of lines to show before and after each match when searching a task (at most 10)",
+			},
+			cli.IntFlag{
+				Name:  limitFlagName,
+				Usage: "the maximum number of matches to return when searching a task (defaults to 100)",
+			},
+			cli.IntFlag{
+				Name:  daysFlagName,
+				Usage: "only search project tasks that finished in this many past days (defaults to 7, at most 30)",
+			},
+			cli.IntFlag{
+				Name:  taskLimitFlagName,
+				Usage: "the number of most recently finished project tasks to search (defaults to 20, at most 50)",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(requesterFlagName, "r"),
+				Usage: "only search project tasks with this requester, e.g. patch, commit, github_merge_queue or ad_hoc (can be specified multiple times)",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(variantsFlagName, "v"),
+				Usage: "only search project tasks in this build variant (can be specified multiple times)",
+			},
+			cli.StringSliceFlag{
+				Name:  tasksFlagName,
+				Usage: "only search project tasks with this display name (can be specified multiple times)",
+			},
+			cli.BoolFlag{
+				Name:  excludeTestLogsFlagName,
+				Usage: "don't search test logs",
+			},
+			cli.BoolFlag{
+				Name:  jsonFlagName,
+				Usage: "output the results as JSON",
+			},
+		},
+		Before: mergeBeforeFuncs(
+			autoUpdateCLI,
+			requireOnlyOneFlag(taskFlagName, projectFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(c.Parent().String(ConfFlagName))
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			if taskID := c.String(taskFlagName); taskID != "" {
+				opts := client.SearchTaskLogsOptions{
+					TaskID:          taskID,
+					Pattern:         c.String(patternFlagName),
+					Literal:         c.Bool(literalFlagName),
+					ContextLines:    c.Int(contextFlagName),
+					Limit:           c.Int(limitFlagName),
+					ExcludeTestLogs: c.Bool(excludeTestLogsFlagName),
+				}
+				if c.IsSet(executionFlagName) {
+					opts.Execution = utility.ToIntPtr(c.Int(executionFlagName))
+				}
+				matches, err := comm.SearchTaskLogs(ctx, opts)
+				if err != nil {
+					return errors.Wrapf(err, "searching logs for task '%s'", taskID)
+				}
+				if c.Bool(jsonFlagName) {
+					return printLogSearchJSON(matches)
+				}
+				writeLogSearchMatches(os.Stdout, matches)
+				return nil
+			}
+
+			opts := client.SearchProjectLogsOptions{
+				ProjectID:       c.String(projectFlagName),
+				Pattern:         c.String(patternFlagName),
+				Literal:         c.Bool(literalFlagName),
+				Days:            c.Int(daysFlagName),
+				TaskLimit:       c.Int(taskLimitFlagName),
+				Requesters:      c.StringSlice(requesterFlagName),
+				Variants:        c.StringSlice(variantsFlagName),
+				Tasks:           c.StringSlice(tasksFlagName),
+				ExcludeTestLogs: c.Bool(excludeTestLogsFlagName),
+			}
+			results, err := comm.SearchProjectLogs(ctx, opts)
+			if err != nil {
+				return errors.Wrapf(err, "searching logs for project '%s'", opts.ProjectID)
+			}
+			if c.Bool(jsonFlagName) {
+				return printLogSearchJSON(results)
+			}
+			writeProjectLogSearchResults(os.Stdout, results)
+			return nil
+		},
+	}
+}
+
+func printLogSearchJSON(data any) error {
+	out, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshalling results to JSON")
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// writeLogSearchMatches writes the matches in a grep-like format: matching
+// lines are written as "log:line number: data" and context lines as
+// "log-line number- data", with groups of context separated by "--".
+func writeLogSearchMatches(w io.Writer, matches []restmodel.APILogSearchMatch) {
+	for i, match := range matches {
+		name := logSearchMatchName(match)
+		if i > 0 && (len(match.Before) > 0 || len(matches[i-1].After) > 0) {
+			fmt.Fprintln(w, "--")
+		}
+		for _, line := range match.Before {
+			fmt.Fprintf(w, "%s-%d- %s\n", name, line.LineNumber, line.Data)
+		}
+		fmt.Fprintf(w, "%s:%d: %s\n", name, match.Line.LineNumber, match.Line.Data)
+		for _, line := range match.After {
+			fmt.Fprintf(w, "%s-%d- %s\n", name, line.LineNumber, line.Data)
+		}
+	}
+}
+
+// writeProjectLogSearchResults writes one line for each task that matched,
+// from the earliest to the latest.
+func writeProjectLogSearchResults(w io.Writer, results []restmodel.APIProjectLogSearchResult) {
+	for _, result := range results {
+		finishTime := ""
+		if result.FinishTime != nil {
+			finishTime = result.FinishTime.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s %s (%s %s, %s revision %s) %s:%d: %s\n",
+			finishTime,
+			utility.FromStringPtr(result.TaskID),
+			utility.FromStringPtr(result.BuildVariant),
+			utility.FromStringPtr(result.DisplayName),
+			utility.FromStringPtr(result.Requester),
+			utility.FromStringPtr(result.Revision),
+			logSearchMatchName(result.FirstMatch),
+			result.FirstMatch.Line.LineNumber,
+			result.FirstMatch.Line.Data,
+		)
+	}
+}
+
+// logSearchMatchName returns the test log path for matches in test logs and
+// the log type otherwise.
+func logSearchMatchName(match restmodel.APILogSearchMatch) string {
+	if path := utility.FromStringPtr(match.TestLogPath); path != "" {
+		return path
+	}
+	return utility.FromStringPtr(match.LogType)
+}
@@ -0,0 +1,35 @@
+package operations
+
+import (
+	"bytes"
+	"testing"
+
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteLogSearchMatches(t *testing.T) {
+	matches := []restmodel.APILogSearchMatch{
+		{
+			LogType: utility.ToStringPtr("task_log"),
+			Line:    restmodel.APILogSearchLine{LineNumber: 2, Data: "connection refused"},
+			Before:  []restmodel.APILogSearchLine{{LineNumber: 1, Data: "connecting"}},
+			After:   []restmodel.APILogSearchLine{{LineNumber: 3, Data: "retrying"}},
+		},
+		{
+			LogType:     utility.ToStringPtr("test_log"),
+			TestLogPath: utility.ToStringPtr("suite/test.log"),
+			Line:        restmodel.APILogSearchLine{LineNumber: 7, Data: "connection refused"},
+		},
+	}
+
+	var buf bytes.Buffer
+	writeLogSearchMatches(&buf, matches)
+	assert.Equal(t, `task_log-1- connecting
+task_log:2: connection refused
+task_log-3- retrying
+--
+suite/test.log:7: connection refused
+`, buf.String())
+}
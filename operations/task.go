@@ -20,6 +20,7 @@ const (
 	logEndFlagName           = "end"
 	logLineLimitFlagName     = "line_limit"
 	logTailLimitFlagName     = "tail_limit"
+	logFieldFilterFlagName   = "field_filter"
 	logPrintTimeFlagName     = "print_time"
 	logPrintPriorityFlagName = "print_priority"
 	logPaginateFlagName      = "paginate"
@@ -44,6 +45,7 @@ func Task() cli.Command {
 		Usage: "operations for Evergreen tasks",
 		Subcommands: []cli.Command{
 			taskBuild(flags),
+			taskBundle(flags),
 		},
 	}
 }
@@ -105,6 +107,7 @@ func taskLogs(flags []cli.Flag) cli.Command {
 				End:           c.String(logEndFlagName),
 				LineLimit:     c.Int(logLineLimitFlagName),
 				TailLimit:     c.Int(logTailLimitFlagName),
+				FieldFilters:  c.StringSlice(logFieldFilterFlagName),
 				PrintTime:     c.Bool(logPrintTimeFlagName),
 				PrintPriority: c.Bool(logPrintPriorityFlagName),
 				Paginate:      c.Bool(logPaginateFlagName),
@@ -184,6 +187,7 @@ func testLogs(flags []cli.Flag) cli.Command {
 				End:           c.String(logEndFlagName),
 				LineLimit:     c.Int(logLineLimitFlagName),
 				TailLimit:     c.Int(logTailLimitFlagName),
+				FieldFilters:  c.StringSlice(logFieldFilterFlagName),
 				PrintTime:     c.Bool(logPrintTimeFlagName),
 				PrintPriority: c.Bool(logPrintPriorityFlagName),
 				Paginate:      c.Bool(logPaginateFlagName),
@@ -226,6 +230,10 @@ func taskOutputLogFlags() []cli.Flag {
 			Name:  fmt.Sprintf("%s,n", logTailLimitFlagName),
 			Usage: "If set greater than 0, returns the last N log lines.",
 		},
+		cli.StringSliceFlag{
+			Name:  logFieldFilterFlagName,
+			Usage: "Filter for structured (JSON) log lines in the form <field><operator><value>, where the operator is one of =, !=, >, >=, < or <=, e.g. 'component=replset' or 'level>=warn'. Only structured lines matching every filter are returned. Repeat the option flag if more than one value.",
+		},
 		cli.BoolFlag{
 			Name:  logPrintTimeFlagName,
 			Usage: "If set, returns log lines prefixed with their timestamp.",
@@ -0,0 +1,219 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/taskexec"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func taskBundle(flags []cli.Flag) cli.Command {
+	const maxTestLogsFlagName = "max_test_logs"
+
+	return cli.Command{
+		Name:  "bundle",
+		Usage: "download a task execution's logs, test results, artifacts, expansions, host and project config into one tarball",
+		Flags: mergeFlagSlices(
+			flags,
+			[]cli.Flag{
+				cli.StringFlag{
+					Name:  fmt.Sprintf("%s,o", logOutputFileFlagName),
+					Usage: "Output file. Defaults to '<task_id>_<execution>.tar.gz'.",
+				},
+				cli.IntFlag{
+					Name:  maxTestLogsFlagName,
+					Usage: "The maximum number of failed tests whose logs are included.",
+					Value: 50,
+				},
+			},
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(c.Parent().String(ConfFlagName))
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			restClient, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer restClient.Close()
+
+			var execution *int
+			if c.IsSet(executionFlagName) {
+				execution = utility.ToIntPtr(c.Int(executionFlagName))
+			}
+			apiTask, err := restClient.GetTask(ctx, c.String(taskIDFlagName), execution)
+			if err != nil {
+				return errors.Wrap(err, "getting task")
+			}
+			if apiTask.DisplayOnly {
+				return errors.New("cannot bundle a display task, bundle one of its execution tasks instead")
+			}
+
+			// The task's project config and expansions are fetched the same
+			// way the local debugger fetches them.
+			if err = conf.SetOAuthToken(ctx); err != nil {
+				return errors.Wrap(err, "obtaining OAuth token")
+			}
+			executor, err := taskexec.NewLocalExecutor(ctx, taskexec.LocalExecutorOptions{
+				ServerURL:  conf.getApiServerHost(true),
+				TaskID:     utility.FromStringPtr(apiTask.Id),
+				OAuthToken: conf.OAuth.AccessToken,
+			})
+			if err != nil {
+				return errors.Wrap(err, "fetching task config")
+			}
+
+			fn := c.String(logOutputFileFlagName)
+			if fn == "" {
+				fn = fmt.Sprintf("%s_%d.tar.gz", utility.FromStringPtr(apiTask.Id), apiTask.Execution)
+			}
+			out, err := os.Create(fn)
+			if err != nil {
+				return errors.Wrapf(err, "creating output file '%s'", fn)
+			}
+			defer out.Close()
+
+			b := taskexec.NewBundleWriter(out, taskexec.BundleManifest{
+				TaskID:       utility.FromStringPtr(apiTask.Id),
+				Execution:    apiTask.Execution,
+				TaskName:     utility.FromStringPtr(apiTask.DisplayName),
+				BuildVariant: utility.FromStringPtr(apiTask.BuildVariant),
+				Project:      utility.FromStringPtr(apiTask.ProjectIdentifier),
+				Revision:     utility.FromStringPtr(apiTask.Revision),
+			})
+			if err = writeTaskBundle(ctx, restClient, executor, b, apiTask, c.Int(maxTestLogsFlagName)); err != nil {
+				return errors.Wrap(err, "writing bundle")
+			}
+			if err = b.Close(); err != nil {
+				return errors.Wrap(err, "writing bundle")
+			}
+
+			grip.Infof(ctx, "Wrote bundle for task '%s' execution %d to '%s'.", utility.FromStringPtr(apiTask.Id), apiTask.Execution, fn)
+			return errors.Wrapf(out.Close(), "closing output file '%s'", fn)
+		},
+	}
+}
+
+func writeTaskBundle(ctx context.Context, comm client.Communicator, executor *taskexec.LocalExecutor, b *taskexec.BundleWriter, apiTask *restmodel.APITask, maxTestLogs int) error {
+	taskID := utility.FromStringPtr(apiTask.Id)
+
+	if err := b.AddJSON(taskexec.BundleTaskFile, apiTask); err != nil {
+		return err
+	}
+	if err := b.AddJSON(taskexec.BundleArtifactsFile, apiTask.Artifacts); err != nil {
+		return err
+	}
+	if err := executor.WriteBundleConfig(b); err != nil {
+		return errors.Wrap(err, "adding project config and expansions")
+	}
+
+	// The host and distro may have been removed since the task ran, so
+	// the bundle is still written without them.
+	if hostID := utility.FromStringPtr(apiTask.HostId); hostID != "" {
+		h, err := comm.GetSpawnHost(ctx, hostID)
+		if err != nil {
+			grip.Warning(ctx, errors.Wrapf(err, "getting host '%s', omitting it from the bundle", hostID))
+		} else if err = b.AddJSON(taskexec.BundleHostFile, h); err != nil {
+			return err
+		}
+	}
+	if distroID := utility.FromStringPtr(apiTask.DistroId); distroID != "" {
+		d, err := comm.GetDistroByName(ctx, distroID)
+		if err != nil {
+			grip.Warning(ctx, errors.Wrapf(err, "getting distro '%s', omitting it from the bundle", distroID))
+		} else if err = b.AddJSON(taskexec.BundleDistroFile, d); err != nil {
+			return err
+		}
+	}
+
+	for _, logType := range []task.TaskLogType{task.TaskLogTypeAgent, task.TaskLogTypeSystem, task.TaskLogTypeTask} {
+		r, err := comm.GetTaskLogs(ctx, client.GetTaskLogsOptions{
+			TaskID:    taskID,
+			Execution: utility.ToIntPtr(apiTask.Execution),
+			Type:      string(logType),
+			PrintTime: true,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "getting %s logs", logType)
+		}
+		if err = addBundleLog(b, path.Join(taskexec.BundleLogsDir, string(logType)), r); err != nil {
+			return errors.Wrapf(err, "adding %s logs", logType)
+		}
+	}
+
+	results, err := comm.GetTestResults(ctx, taskID, apiTask.Execution)
+	if err != nil {
+		return errors.Wrap(err, "getting test results")
+	}
+	if err = b.AddJSON(taskexec.BundleTestResultsFile, results); err != nil {
+		return err
+	}
+
+	var numTestLogs int
+	for _, result := range results {
+		logPath := utility.FromStringPtr(result.Logs.TestName)
+		if utility.FromStringPtr(result.Status) != evergreen.TestFailedStatus || logPath == "" {
+			continue
+		}
+		if numTestLogs >= maxTestLogs {
+			grip.Warningf(ctx, "Only including the logs of the first %d failed tests.", maxTestLogs)
+			break
+		}
+
+		r, err := comm.GetTestLogs(ctx, client.GetTestLogsOptions{
+			TaskID:      taskID,
+			Path:        logPath,
+			Execution:   utility.ToIntPtr(apiTask.Execution),
+			LogsToMerge: result.Logs.LogsToMerge,
+			PrintTime:   true,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "getting test log '%s'", logPath)
+		}
+		if err = addBundleLog(b, path.Join(taskexec.BundleTestLogsDir, logPath), r); err != nil {
+			return errors.Wrapf(err, "adding test log '%s'", logPath)
+		}
+		numTestLogs++
+	}
+
+	return nil
+}
+
+// addBundleLog adds the log to the bundle. The log is downloaded to a
+// temporary file first since the size of each file in the bundle must be
+// known before it's written.
+func addBundleLog(b *taskexec.BundleWriter, name string, r io.ReadCloser) error {
+	defer r.Close()
+
+	tmp, err := os.CreateTemp("", "evergreen-bundle-log-")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary file")
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		return errors.Wrap(err, "downloading log")
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrap(err, "closing temporary file")
+	}
+
+	return b.AddFile(name, tmp.Name())
+}
@@ -0,0 +1,13 @@
+//go:build processprovider
+
+package evergreen
+
+// ProcessProviderEnabled is whether distros can use the process provider. The
+// process provider runs agents as child processes of the app server, so it is
+// only built into binaries with the processprovider build tag, which are meant
+// for integration testing.
+const ProcessProviderEnabled = true
+
+func init() {
+	ProviderSpawnable = append(ProviderSpawnable, ProviderNameProcess)
+}
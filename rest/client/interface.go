@@ -7,10 +7,12 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/scheduler"
 	"github.com/evergreen-ci/evergreen/validator"
 )
 
@@ -89,6 +91,12 @@ type Communicator interface {
 	ListAliases(context.Context, string, bool) ([]model.ProjectAlias, error)
 	ListPatchTriggerAliases(context.Context, string) ([]string, error)
 	GetDistroByName(context.Context, string) (*restmodel.APIDistro, error)
+	// GetDistroSchedulerSnapshot returns a snapshot of the distro's runnable
+	// tasks and hosts that can be replayed by SimulateDistroScheduler.
+	GetDistroSchedulerSnapshot(ctx context.Context, distroID string) (*scheduler.SimulationSnapshot, error)
+	// SimulateDistroScheduler replays the task planner and host allocator for
+	// a distro with its current settings and with alternative settings.
+	SimulateDistroScheduler(ctx context.Context, opts SimulateDistroSchedulerOptions) (*scheduler.SimulationComparison, error)
 
 	// Get project settings by project ID
 	GetProject(context.Context, string) (*restmodel.APIProjectRef, error)
@@ -116,6 +124,10 @@ type Communicator interface {
 	// project.
 	GetRecentVersionsForProject(ctx context.Context, projectID, requester string, startAtOrderNum, limit int) ([]restmodel.APIVersion, error)
 
+	// GetProjectCostReport returns the cost of a project's tasks that
+	// finished in a date range, grouped by the given dimensions.
+	GetProjectCostReport(ctx context.Context, opts GetProjectCostReportOptions) ([]restmodel.APICostReport, error)
+
 	// GetBuildsForVersion gets all builds for a version.
 	GetBuildsForVersion(ctx context.Context, versionID string) ([]restmodel.APIBuild, error)
 	// GetTasksForBuild gets all tasks in a build.
@@ -138,9 +150,29 @@ type Communicator interface {
 	GetTaskLogs(context.Context, GetTaskLogsOptions) (io.ReadCloser, error)
 	// GetTaskLogs returns test logs for the given task.
 	GetTestLogs(context.Context, GetTestLogsOptions) (io.ReadCloser, error)
+	// SearchTaskLogs returns the lines in the given task's logs that match
+	// a pattern.
+	SearchTaskLogs(context.Context, SearchTaskLogsOptions) ([]restmodel.APILogSearchMatch, error)
+	// SearchProjectLogs returns the project's recent tasks whose logs
+	// contain a line that matches a pattern.
+	SearchProjectLogs(context.Context, SearchProjectLogsOptions) ([]restmodel.APIProjectLogSearchResult, error)
+	// GetTask returns the given execution of the task. If the execution is
+	// nil, it returns the latest execution.
+	GetTask(ctx context.Context, taskID string, execution *int) (*restmodel.APITask, error)
+	// GetTestResults returns all of the test results of the given task
+	// execution.
+	GetTestResults(ctx context.Context, taskID string, execution int) ([]restmodel.APITest, error)
+	// ActivateTask activates the task so that it's scheduled to run.
+	ActivateTask(ctx context.Context, taskID string) (*restmodel.APITask, error)
+	// GetBisectRange returns a task's runs on the project's mainline
+	// versions between a known-good and a known-bad revision.
+	GetBisectRange(context.Context, GetBisectRangeOptions) (*restmodel.APIBisectRange, error)
 
 	// GetEstimatedGeneratedTasks returns the estimated number of generated tasks to be created by an unfinalized patch.
 	GetEstimatedGeneratedTasks(context.Context, string, []model.TVPair) (int, error)
+	// GenerateTasksDryRun returns what the generate.tasks JSON files would add
+	// to the version's project without generating anything.
+	GenerateTasksDryRun(ctx context.Context, versionID string, opts restmodel.APIGenerateTasksDryRunRequest) (*restmodel.APIGenerateTasksDryRun, error)
 
 	// RevokeGitHubDynamicAccessToken revokes the given GitHub dynamic access tokens.
 	RevokeGitHubDynamicAccessTokens(ctx context.Context, taskID string, tokens []string) error
@@ -152,6 +184,32 @@ type Communicator interface {
 	SendPanicReport(ctx context.Context, details *restmodel.PanicReport) error
 }
 
+// GetProjectCostReportOptions are the options for fetching a project's cost
+// report.
+type GetProjectCostReportOptions struct {
+	ProjectID string
+	// AfterDate and BeforeDate are the start (inclusive) and end (exclusive)
+	// dates of the report, in the format YYYY-MM-DD.
+	AfterDate  string
+	BeforeDate string
+	GroupBy    []string
+	Requesters []string
+	Variants   []string
+}
+
+// SimulateDistroSchedulerOptions are the options for simulating a distro's
+// scheduler.
+type SimulateDistroSchedulerOptions struct {
+	DistroID string `json:"-"`
+	// Snapshot is the snapshot to replay. If it's not set, the server takes a
+	// snapshot of the distro.
+	Snapshot *scheduler.SimulationSnapshot `json:"snapshot,omitempty"`
+	// PlannerSettings and HostAllocatorSettings are the settings to simulate.
+	// Only the fields that are set override the distro's settings.
+	PlannerSettings       distro.PlannerSettings       `json:"planner_settings"`
+	HostAllocatorSettings distro.HostAllocatorSettings `json:"host_allocator_settings"`
+}
+
 // GetTaskLogsOptions are the options for fetching task logs for a given task.
 type GetTaskLogsOptions struct {
 	TaskID        string
@@ -161,11 +219,51 @@ type GetTaskLogsOptions struct {
 	End           string
 	LineLimit     int
 	TailLimit     int
+	FieldFilters  []string
 	PrintTime     bool
 	PrintPriority bool
 	Paginate      bool
 }
 
+// SearchTaskLogsOptions are the options for searching a task's logs.
+type SearchTaskLogsOptions struct {
+	TaskID    string
+	Execution *int
+	Pattern   string
+	// Literal matches the pattern as a plain string rather than a regular
+	// expression.
+	Literal         bool
+	ContextLines    int
+	Limit           int
+	ExcludeTestLogs bool
+}
+
+// SearchProjectLogsOptions are the options for searching the logs of a
+// project's recent tasks.
+type SearchProjectLogsOptions struct {
+	ProjectID string
+	Pattern   string
+	// Literal matches the pattern as a plain string rather than a regular
+	// expression.
+	Literal         bool
+	Days            int
+	TaskLimit       int
+	Requesters      []string
+	Variants        []string
+	Tasks           []string
+	ExcludeTestLogs bool
+}
+
+// GetBisectRangeOptions are the options for finding the versions to bisect a
+// task over.
+type GetBisectRangeOptions struct {
+	ProjectID    string
+	BuildVariant string
+	TaskName     string
+	GoodRevision string
+	BadRevision  string
+}
+
 // GetTestLogsOptions are the options for fetching test logs for a given task.
 type GetTestLogsOptions struct {
 	TaskID        string
@@ -176,6 +274,7 @@ type GetTestLogsOptions struct {
 	End           string
 	LineLimit     int
 	TailLimit     int
+	FieldFilters  []string
 	PrintTime     bool
 	PrintPriority bool
 	Paginate      bool
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/scheduler"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/gimlet"
@@ -675,6 +677,36 @@ func (c *communicatorImpl) GetEstimatedGeneratedTasks(ctx context.Context, patch
 	return utility.FromIntPtr(numTasksToFinalize.NumTasksToFinalize), nil
 }
 
+// GenerateTasksDryRun returns what the generate.tasks JSON files would add to
+// the version's project without generating anything.
+func (c *communicatorImpl) GenerateTasksDryRun(ctx context.Context, versionID string, opts restmodel.APIGenerateTasksDryRunRequest) (*restmodel.APIGenerateTasksDryRun, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("versions/%s/generate_dry_run", versionID),
+	}
+	resp, err := c.request(ctx, info, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to dry run generate tasks")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespError(resp, "dry running generate tasks")
+	}
+
+	dryRun := restmodel.APIGenerateTasksDryRun{}
+	if err = utility.ReadJSON(resp.Body, &dryRun); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return &dryRun, nil
+}
+
 func (c *communicatorImpl) RevokeGitHubDynamicAccessTokens(ctx context.Context, taskId string, tokens []string) error {
 	info := requestInfo{
 		method: http.MethodDelete,
@@ -1419,6 +1451,50 @@ func (c *communicatorImpl) GetHostProcessOutput(ctx context.Context, hostProcess
 	return result, nil
 }
 
+// GetProjectCostReport returns the cost of a project's tasks that finished in
+// a date range, grouped by the given dimensions.
+func (c *communicatorImpl) GetProjectCostReport(ctx context.Context, opts GetProjectCostReportOptions) ([]model.APICostReport, error) {
+	params := url.Values{}
+	params.Set("after_date", opts.AfterDate)
+	params.Set("before_date", opts.BeforeDate)
+	if len(opts.GroupBy) > 0 {
+		params.Set("group_by", strings.Join(opts.GroupBy, ","))
+	}
+	if len(opts.Requesters) > 0 {
+		params.Set("requesters", strings.Join(opts.Requesters, ","))
+	}
+	if len(opts.Variants) > 0 {
+		params.Set("variants", strings.Join(opts.Variants, ","))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("projects/%s/cost_report?%s", opts.ProjectID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get cost report for project '%s'", opts.ProjectID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting cost report for project '%s'", opts.ProjectID)
+	}
+
+	reports := []model.APICostReport{}
+	if err = utility.ReadJSON(resp.Body, &reports); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return reports, nil
+}
+
 func (c *communicatorImpl) GetRecentVersionsForProject(ctx context.Context, projectID, requester string, startAtOrderNum, limit int) ([]model.APIVersion, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -1553,6 +1629,66 @@ func (c *communicatorImpl) GetDistroByName(ctx context.Context, id string) (*res
 
 }
 
+func (c *communicatorImpl) GetDistroSchedulerSnapshot(ctx context.Context, distroID string) (*scheduler.SimulationSnapshot, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("distros/%s/scheduler_snapshot", distroID),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get scheduler snapshot for distro '%s'", distroID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting scheduler snapshot for distro '%s'", distroID)
+	}
+
+	snapshot := &scheduler.SimulationSnapshot{}
+	if err = utility.ReadJSON(resp.Body, snapshot); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return snapshot, nil
+}
+
+func (c *communicatorImpl) SimulateDistroScheduler(ctx context.Context, opts SimulateDistroSchedulerOptions) (*scheduler.SimulationComparison, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("distros/%s/scheduler_simulation", opts.DistroID),
+	}
+
+	resp, err := c.request(ctx, info, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to simulate scheduler for distro '%s'", opts.DistroID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "simulating scheduler for distro '%s'", opts.DistroID)
+	}
+
+	comparison := &scheduler.SimulationComparison{}
+	if err = utility.ReadJSON(resp.Body, comparison); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return comparison, nil
+}
+
 func (c *communicatorImpl) GetClientURLs(ctx context.Context, distroID string) ([]string, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -1759,6 +1895,9 @@ func (c *communicatorImpl) GetTaskLogs(ctx context.Context, opts GetTaskLogsOpti
 	if opts.TailLimit > 0 {
 		params = append(params, fmt.Sprintf("tail_limit=%d", opts.TailLimit))
 	}
+	for _, filter := range opts.FieldFilters {
+		params = append(params, fmt.Sprintf("field_filter=%s", url.QueryEscape(filter)))
+	}
 	if opts.PrintTime {
 		params = append(params, fmt.Sprintf("print_time=%v", opts.PrintTime))
 	}
@@ -1819,6 +1958,9 @@ func (c *communicatorImpl) GetTestLogs(ctx context.Context, opts GetTestLogsOpti
 	if opts.TailLimit > 0 {
 		params = append(params, fmt.Sprintf("tail_limit=%d", opts.TailLimit))
 	}
+	for _, filter := range opts.FieldFilters {
+		params = append(params, fmt.Sprintf("field_filter=%s", url.QueryEscape(filter)))
+	}
 	if opts.PrintTime {
 		params = append(params, fmt.Sprintf("print_time=%v", opts.PrintTime))
 	}
@@ -1860,6 +2002,254 @@ func (c *communicatorImpl) GetTestLogs(ctx context.Context, opts GetTestLogsOpti
 	return utility.NewPaginatedReadCloser(ctx, c.httpClient, resp, header), nil
 }
 
+// SearchTaskLogs returns the lines in the task's logs that match the pattern.
+func (c *communicatorImpl) SearchTaskLogs(ctx context.Context, opts SearchTaskLogsOptions) ([]model.APILogSearchMatch, error) {
+	params := url.Values{}
+	params.Set("pattern", opts.Pattern)
+	if opts.Literal {
+		params.Set("literal", "true")
+	}
+	if opts.Execution != nil {
+		params.Set("execution", strconv.Itoa(utility.FromIntPtr(opts.Execution)))
+	}
+	if opts.ContextLines > 0 {
+		params.Set("context", strconv.Itoa(opts.ContextLines))
+	}
+	if opts.Limit > 0 {
+		params.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.ExcludeTestLogs {
+		params.Set("exclude_test_logs", "true")
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("tasks/%s/logs/search?%s", opts.TaskID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to search logs for task '%s'", opts.TaskID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "searching logs for task '%s'", opts.TaskID)
+	}
+
+	matches := []model.APILogSearchMatch{}
+	if err = utility.ReadJSON(resp.Body, &matches); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return matches, nil
+}
+
+// SearchProjectLogs returns the project's recent tasks whose logs contain a
+// line that matches the pattern.
+func (c *communicatorImpl) SearchProjectLogs(ctx context.Context, opts SearchProjectLogsOptions) ([]model.APIProjectLogSearchResult, error) {
+	params := url.Values{}
+	params.Set("pattern", opts.Pattern)
+	if opts.Literal {
+		params.Set("literal", "true")
+	}
+	if opts.Days > 0 {
+		params.Set("days", strconv.Itoa(opts.Days))
+	}
+	if opts.TaskLimit > 0 {
+		params.Set("task_limit", strconv.Itoa(opts.TaskLimit))
+	}
+	if len(opts.Requesters) > 0 {
+		params.Set("requesters", strings.Join(opts.Requesters, ","))
+	}
+	if len(opts.Variants) > 0 {
+		params.Set("variants", strings.Join(opts.Variants, ","))
+	}
+	if len(opts.Tasks) > 0 {
+		params.Set("tasks", strings.Join(opts.Tasks, ","))
+	}
+	if opts.ExcludeTestLogs {
+		params.Set("exclude_test_logs", "true")
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("projects/%s/logs/search?%s", opts.ProjectID, params.Encode()),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to search logs for project '%s'", opts.ProjectID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "searching logs for project '%s'", opts.ProjectID)
+	}
+
+	results := []model.APIProjectLogSearchResult{}
+	if err = utility.ReadJSON(resp.Body, &results); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return results, nil
+}
+
+// GetTask returns the given execution of the task, or its latest execution if
+// the execution is nil.
+func (c *communicatorImpl) GetTask(ctx context.Context, taskID string, execution *int) (*model.APITask, error) {
+	path := fmt.Sprintf("tasks/%s", taskID)
+	if execution != nil {
+		path = fmt.Sprintf("%s?execution=%d", path, utility.FromIntPtr(execution))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   path,
+	}
+
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get task '%s'", taskID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting task '%s'", taskID)
+	}
+
+	apiTask := &model.APITask{}
+	if err = utility.ReadJSON(resp.Body, apiTask); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return apiTask, nil
+}
+
+// testResultsPageSize is the number of test results requested per page.
+const testResultsPageSize = 100
+
+// GetTestResults returns all of the test results of the task execution,
+// requesting them a page at a time.
+func (c *communicatorImpl) GetTestResults(ctx context.Context, taskID string, execution int) ([]model.APITest, error) {
+	var results []model.APITest
+	for page := 0; ; page++ {
+		info := requestInfo{
+			method: http.MethodGet,
+			path:   fmt.Sprintf("tasks/%s/tests?execution=%d&start_at=%d&limit=%d", taskID, execution, page, testResultsPageSize),
+		}
+
+		resp, err := c.retryRequest(ctx, info, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "sending request to get test results for task '%s'", taskID)
+		}
+
+		var pageResults []model.APITest
+		switch resp.StatusCode {
+		case http.StatusOK:
+			err = utility.ReadJSON(resp.Body, &pageResults)
+		case http.StatusUnauthorized:
+			err = util.RespError(resp, AuthError)
+		case http.StatusForbidden:
+			err = util.RespError(resp, VPNError)
+		default:
+			err = util.RespErrorf(resp, "getting test results for task '%s'", taskID)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "reading test results")
+		}
+
+		results = append(results, pageResults...)
+		if len(pageResults) < testResultsPageSize {
+			return results, nil
+		}
+	}
+}
+
+// ActivateTask activates the task so that it's scheduled to run.
+func (c *communicatorImpl) ActivateTask(ctx context.Context, taskID string) (*model.APITask, error) {
+	info := requestInfo{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("tasks/%s", taskID),
+	}
+
+	resp, err := c.request(ctx, info, map[string]bool{"activated": true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to activate task '%s'", taskID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "activating task '%s'", taskID)
+	}
+
+	apiTask := &model.APITask{}
+	if err = utility.ReadJSON(resp.Body, apiTask); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return apiTask, nil
+}
+
+// GetBisectRange returns a task's runs on the project's mainline versions
+// between a known-good and a known-bad revision.
+func (c *communicatorImpl) GetBisectRange(ctx context.Context, opts GetBisectRangeOptions) (*model.APIBisectRange, error) {
+	params := url.Values{}
+	params.Set("build_variant", opts.BuildVariant)
+	params.Set("task_name", opts.TaskName)
+	params.Set("good", opts.GoodRevision)
+	params.Set("bad", opts.BadRevision)
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("projects/%s/bisect?%s", opts.ProjectID, params.Encode()),
+	}
+
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get bisect range for project '%s'", opts.ProjectID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting bisect range for project '%s'", opts.ProjectID)
+	}
+
+	bisectRange := &model.APIBisectRange{}
+	if err = utility.ReadJSON(resp.Body, bisectRange); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return bisectRange, nil
+}
+
 const server400 = "server returned status 400"
 
 func (c *communicatorImpl) Validate(ctx context.Context, data []byte, quiet bool, projectID string) (validator.ValidationErrors, error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/scheduler"
 	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
@@ -313,6 +314,14 @@ func (c *Mock) GetDistroByName(context.Context, string) (*model.APIDistro, error
 	return nil, nil
 }
 
+func (c *Mock) GetDistroSchedulerSnapshot(ctx context.Context, distroID string) (*scheduler.SimulationSnapshot, error) {
+	return nil, nil
+}
+
+func (c *Mock) SimulateDistroScheduler(ctx context.Context, opts SimulateDistroSchedulerOptions) (*scheduler.SimulationComparison, error) {
+	return nil, nil
+}
+
 func (c *Mock) UpdateServiceUser(context.Context, string, string, []string) error {
 	return nil
 }
@@ -347,6 +356,14 @@ func (c *Mock) GetEstimatedGeneratedTasks(ctx context.Context, patchId string, t
 	return 0, nil
 }
 
+func (c *Mock) GetProjectCostReport(ctx context.Context, opts GetProjectCostReportOptions) ([]restmodel.APICostReport, error) {
+	return nil, nil
+}
+
+func (c *Mock) GenerateTasksDryRun(ctx context.Context, versionID string, opts restmodel.APIGenerateTasksDryRunRequest) (*restmodel.APIGenerateTasksDryRun, error) {
+	return nil, nil
+}
+
 func (c *Mock) GetTaskLogs(ctx context.Context, opts GetTaskLogsOptions) (io.ReadCloser, error) {
 	return nil, nil
 }
@@ -355,6 +372,30 @@ func (c *Mock) GetTestLogs(ctx context.Context, opts GetTestLogsOptions) (io.Rea
 	return nil, nil
 }
 
+func (c *Mock) SearchTaskLogs(ctx context.Context, opts SearchTaskLogsOptions) ([]restmodel.APILogSearchMatch, error) {
+	return nil, nil
+}
+
+func (c *Mock) SearchProjectLogs(ctx context.Context, opts SearchProjectLogsOptions) ([]restmodel.APIProjectLogSearchResult, error) {
+	return nil, nil
+}
+
+func (c *Mock) GetTask(ctx context.Context, taskID string, execution *int) (*restmodel.APITask, error) {
+	return nil, nil
+}
+
+func (c *Mock) GetTestResults(ctx context.Context, taskID string, execution int) ([]restmodel.APITest, error) {
+	return nil, nil
+}
+
+func (c *Mock) ActivateTask(ctx context.Context, taskID string) (*restmodel.APITask, error) {
+	return nil, nil
+}
+
+func (c *Mock) GetBisectRange(ctx context.Context, opts GetBisectRangeOptions) (*restmodel.APIBisectRange, error) {
+	return nil, nil
+}
+
 func (c *Mock) GetUiV2URL(ctx context.Context) (string, error) {
 	return "https://example.com", nil
 }
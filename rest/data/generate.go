@@ -3,10 +3,13 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/pkg/errors"
 )
@@ -53,3 +56,90 @@ func GeneratePoll(ctx context.Context, taskID string) (bool, string, error) {
 
 	return t.GeneratedTasks, t.GenerateTasksError, nil
 }
+
+// GenerateTasksDryRun runs the JSON files for `generate.tasks` through the
+// same merge and validation steps as the generator task would against the
+// version's project, but does not save anything. It returns what the files
+// would add to the project along with any errors that would prevent the tasks
+// from being generated.
+func GenerateTasksDryRun(ctx context.Context, settings *evergreen.Settings, versionID, taskID string, jsonFiles []json.RawMessage) (*model.GeneratedProjectDiff, validator.ValidationErrors, error) {
+	v, err := model.VersionFindOneId(ctx, versionID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "finding version '%s'", versionID)
+	}
+	if v == nil {
+		return nil, nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", versionID),
+		}
+	}
+
+	generator, err := task.FindOneId(ctx, taskID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "finding task '%s'", taskID)
+	}
+	if generator == nil || generator.Version != v.Id {
+		return nil, nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found in version '%s'", taskID, versionID),
+		}
+	}
+	var errs validator.ValidationErrors
+	if generator.GeneratedTasks {
+		errs = append(errs, validator.ValidationError{
+			Level:   validator.Warning,
+			Message: fmt.Sprintf("task '%s' has already generated tasks, so running generate.tasks again would not change the version", taskID),
+		})
+	}
+
+	project, parserProject, err := model.FindAndTranslateProjectForVersion(ctx, settings, v, false)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "loading project for version '%s'", v.Id)
+	}
+
+	addError := func(err error) (*model.GeneratedProjectDiff, validator.ValidationErrors, error) {
+		errs = append(errs, validator.ValidationError{Level: validator.Error, Message: err.Error()})
+		return &model.GeneratedProjectDiff{}, errs, nil
+	}
+
+	projects := make([]model.GeneratedProject, 0, len(jsonFiles))
+	for _, f := range jsonFiles {
+		p, err := model.ParseProjectFromJSONString(string(f))
+		if err != nil {
+			return addError(errors.Wrap(err, "parsing JSON from `generate.tasks`"))
+		}
+		projects = append(projects, p)
+	}
+	g, err := model.MergeGeneratedProjects(ctx, projects)
+	if err != nil {
+		return addError(errors.Wrap(err, "merging generated projects"))
+	}
+	g.Task = generator
+
+	newProject, _, v, err := g.NewVersion(ctx, project, parserProject, v)
+	if err != nil {
+		return addError(err)
+	}
+	diff := model.GetGeneratedProjectDiff(project, newProject)
+
+	pref, err := model.FindMergedProjectRef(ctx, generator.Project, generator.Version, true)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "finding project ref '%s'", generator.Project)
+	}
+	if pref == nil {
+		return nil, nil, errors.Errorf("project '%s' not found", generator.Project)
+	}
+
+	projectErrs, settingsErrs := validator.CheckProjectConfiguration(ctx, settings, newProject, pref)
+	errs = append(errs, projectErrs...)
+	errs = append(errs, settingsErrs...)
+
+	if err = g.CheckForCycles(ctx, v, newProject, pref); err != nil {
+		errs = append(errs, validator.ValidationError{
+			Level:   validator.Error,
+			Message: errors.Wrap(err, "checking new dependency graph for cycles").Error(),
+		})
+	}
+
+	return &diff, errs, nil
+}
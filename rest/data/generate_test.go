@@ -2,12 +2,19 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func TestGeneratePoll(t *testing.T) {
@@ -57,3 +64,119 @@ func TestGeneratePoll(t *testing.T) {
 	assert.Equal(t, "this is an error", generateErrs)
 	assert.NoError(t, err)
 }
+
+func TestGenerateTasksDryRun(t *testing.T) {
+	const baseProject = `
+tasks:
+  - name: generator
+    commands:
+      - command: generate.tasks
+        params:
+          files:
+            - generate.json
+buildvariants:
+  - name: bv
+    display_name: Variant
+    run_on:
+      - distro
+    tasks:
+      - name: generator
+`
+	const generatedProject = `
+{
+  "tasks": [
+    {
+      "name": "new_task",
+      "depends_on": [{"name": "generator"}],
+      "commands": [{"command": "shell.exec", "params": {"script": "echo hi"}}]
+    }
+  ],
+  "buildvariants": [
+    {
+      "name": "bv",
+      "tasks": [{"name": "new_task"}]
+    }
+  ]
+}`
+	settings := &evergreen.Settings{}
+
+	for tName, tCase := range map[string]func(t *testing.T, generator task.Task){
+		"ReturnsWhatWouldBeAdded": func(t *testing.T, generator task.Task) {
+			diff, errs, err := GenerateTasksDryRun(t.Context(), settings, generator.Version, generator.Id, []json.RawMessage{json.RawMessage(generatedProject)})
+			require.NoError(t, err)
+			require.NotNil(t, diff)
+			assert.Empty(t, errs.AtLevel(validator.Error))
+			assert.Empty(t, diff.BuildVariants)
+			assert.Equal(t, []model.TVPair{{Variant: "bv", TaskName: "new_task"}}, diff.Tasks)
+			require.Len(t, diff.Dependencies, 1)
+			assert.Equal(t, model.TVPair{Variant: "bv", TaskName: "generator"}, diff.Dependencies[0].DependsOn)
+
+			dbGenerator, err := task.FindOneId(t.Context(), generator.Id)
+			require.NoError(t, err)
+			require.NotNil(t, dbGenerator)
+			assert.False(t, dbGenerator.GeneratedTasks, "dry run should not generate tasks")
+			tasks, err := task.Find(t.Context(), task.ByVersion(generator.Version))
+			require.NoError(t, err)
+			assert.Len(t, tasks, 1, "dry run should not create tasks")
+		},
+		"ReportsInvalidProject": func(t *testing.T, generator task.Task) {
+			invalid := `{"buildvariants": [{"name": "bv", "tasks": [{"name": "new_task", "depends_on": [{"name": "nonexistent"}]}]}], "tasks": [{"name": "new_task"}]}`
+			_, errs, err := GenerateTasksDryRun(t.Context(), settings, generator.Version, generator.Id, []json.RawMessage{json.RawMessage(invalid)})
+			require.NoError(t, err)
+			assert.NotEmpty(t, errs.AtLevel(validator.Error))
+		},
+		"ReportsUnparseableJSON": func(t *testing.T, generator task.Task) {
+			_, errs, err := GenerateTasksDryRun(t.Context(), settings, generator.Version, generator.Id, []json.RawMessage{json.RawMessage(`{"tasks": "not a list"}`)})
+			require.NoError(t, err)
+			assert.NotEmpty(t, errs.AtLevel(validator.Error))
+		},
+		"WarnsWhenGeneratorAlreadyRan": func(t *testing.T, generator task.Task) {
+			require.NoError(t, task.UpdateOne(t.Context(), bson.M{task.IdKey: generator.Id}, bson.M{"$set": bson.M{task.GeneratedTasksKey: true}}))
+
+			_, errs, err := GenerateTasksDryRun(t.Context(), settings, generator.Version, generator.Id, []json.RawMessage{json.RawMessage(generatedProject)})
+			require.NoError(t, err)
+			assert.NotEmpty(t, errs.AtLevel(validator.Warning))
+		},
+		"FailsForTaskInOtherVersion": func(t *testing.T, generator task.Task) {
+			other := task.Task{Id: "other_generator", Version: "other_version", Project: generator.Project}
+			require.NoError(t, other.Insert(t.Context()))
+
+			_, _, err := GenerateTasksDryRun(t.Context(), settings, generator.Version, other.Id, []json.RawMessage{json.RawMessage(generatedProject)})
+			assert.Error(t, err)
+		},
+		"FailsForNonexistentVersion": func(t *testing.T, generator task.Task) {
+			_, _, err := GenerateTasksDryRun(t.Context(), settings, "nonexistent", generator.Id, []json.RawMessage{json.RawMessage(generatedProject)})
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.VersionCollection, model.ParserProjectCollection, model.ProjectRefCollection, task.Collection, distro.Collection))
+
+			v := model.Version{
+				Id:         "version",
+				Identifier: "project",
+				Requester:  evergreen.RepotrackerVersionRequester,
+			}
+			require.NoError(t, v.Insert(t.Context()))
+			pp := model.ParserProject{}
+			require.NoError(t, util.UnmarshalYAMLWithFallback([]byte(baseProject), &pp))
+			pp.Id = v.Id
+			require.NoError(t, pp.Insert(t.Context()))
+			require.NoError(t, (&model.ProjectRef{Id: "project"}).Insert(t.Context()))
+			require.NoError(t, (&distro.Distro{Id: "distro"}).Insert(t.Context()))
+
+			generator := task.Task{
+				Id:           "generator",
+				Version:      v.Id,
+				Project:      "project",
+				BuildVariant: "bv",
+				DisplayName:  "generator",
+				Status:       evergreen.TaskStarted,
+				Requester:    evergreen.RepotrackerVersionRequester,
+			}
+			require.NoError(t, generator.Insert(t.Context()))
+
+			tCase(t, generator)
+		})
+	}
+}
@@ -262,6 +262,15 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = mergedSection.ValidateEnabledRepotracker(); err != nil {
 			return nil, err
 		}
+		if err = mergedSection.CostBudget.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid cost budget settings")
+		}
+		if err = mergedSection.LogStorage.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid log storage settings")
+		}
+		if err = mergedSection.ArtifactRetention.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid artifact retention settings")
+		}
 		// Validate owner/repo if the project is enabled or owner/repo is populated.
 		// This validation is cheap so it makes sense to be strict about this.
 		if mergedSection.Enabled || (mergedSection.Owner != "" && mergedSection.Repo != "") {
@@ -469,6 +478,10 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = parsley.ValidateFilters(mergedSection.ParsleyFilters); err != nil {
 			return nil, errors.Wrap(err, "invalid Parsley filters")
 		}
+	case model.ProjectPageTestSelectionSection:
+		if err = mergedSection.TestQuarantine.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid test quarantine settings")
+		}
 	// This section does not support repo-level at this time.
 	case model.ProjectPageGithubAppSettingsSection:
 		mergedSection.Id = mergedBeforeRef.Id
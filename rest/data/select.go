@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/task"
+	builtinselection "github.com/evergreen-ci/evergreen/model/testselection"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	testselection "github.com/evergreen-ci/test-selection-client"
 	"github.com/evergreen-ci/utility"
@@ -27,9 +29,14 @@ func newTestSelectionClient(c *http.Client) *testselection.APIClient {
 }
 
 // SelectTests uses the test selection service to return a filtered set of tests
-// to run based on the provided SelectTestsRequest. It returns the list of
-// selected tests.
+// to run based on the provided SelectTestsRequest. If no test selection service
+// is configured, it selects tests with Evergreen's built-in strategies instead.
+// It returns the list of selected tests.
 func SelectTests(ctx context.Context, req model.SelectTestsRequest) ([]string, error) {
+	if evergreen.GetEnvironment().Settings().TestSelection.URL == "" {
+		return selectTestsWithBuiltinStrategies(ctx, req)
+	}
+
 	httpClient := utility.GetHTTPClient()
 	defer utility.PutHTTPClient(httpClient)
 
@@ -65,6 +72,26 @@ func SelectTests(ctx context.Context, req model.SelectTestsRequest) ([]string, e
 	return selectedTests, nil
 }
 
+// selectTestsWithBuiltinStrategies selects tests using strategies computed from
+// the task's recent mainline test results.
+func selectTestsWithBuiltinStrategies(ctx context.Context, req model.SelectTestsRequest) ([]string, error) {
+	t, err := task.FindOneId(ctx, req.TaskID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding task '%s'", req.TaskID)
+	}
+	if t == nil {
+		return nil, errors.Errorf("task '%s' not found", req.TaskID)
+	}
+
+	selectedTests, err := builtinselection.Select(ctx, evergreen.GetEnvironment(), builtinselection.SelectOptions{
+		Task:         t,
+		Tests:        req.Tests,
+		Strategies:   req.Strategies,
+		CoverageRuns: req.CoverageRuns,
+	})
+	return selectedTests, errors.Wrap(err, "selecting tests with built-in strategies")
+}
+
 // SetTestQuarantined marks the test as quarantined or unquarantined in the test
 // selection service.
 func SetTestQuarantined(ctx context.Context, projectID, bvName, taskName, testName string, isQuarantined bool) error {
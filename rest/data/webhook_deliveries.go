@@ -0,0 +1,141 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/user"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/units"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/amboy"
+	"github.com/pkg/errors"
+)
+
+// GetWebhookDeliveries returns the most recent webhook deliveries for the
+// subscription, optionally filtered by status. The user must be able to
+// manage the subscription.
+func GetWebhookDeliveries(ctx context.Context, u *user.DBUser, subscriptionID, status string) ([]restModel.APIWebhookDelivery, error) {
+	if status != "" && !notification.WebhookDeliveryStatus(status).IsValid() {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid webhook delivery status '%s'", status),
+		}
+	}
+	if _, err := findWebhookSubscription(ctx, u, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := notification.FindWebhookDeliveriesForSubscription(ctx, subscriptionID, notification.WebhookDeliveryStatus(status))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding webhook deliveries for subscription '%s'", subscriptionID)
+	}
+
+	apiDeliveries := make([]restModel.APIWebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		apiDelivery := restModel.APIWebhookDelivery{}
+		apiDelivery.BuildFromService(d)
+		apiDeliveries = append(apiDeliveries, apiDelivery)
+	}
+	return apiDeliveries, nil
+}
+
+// ReplayWebhookDelivery sends a dead-lettered webhook notification again.
+// The user must be able to manage the subscription that created it.
+func ReplayWebhookDelivery(ctx context.Context, u *user.DBUser, subscriptionID, notificationID string) (*restModel.APIWebhookDelivery, error) {
+	if _, err := findWebhookSubscription(ctx, u, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := notification.FindWebhookDelivery(ctx, notificationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding webhook delivery '%s'", notificationID)
+	}
+	if delivery == nil || delivery.SubscriptionID != subscriptionID {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("webhook delivery '%s' not found for subscription '%s'", notificationID, subscriptionID),
+		}
+	}
+	if delivery.Status != notification.WebhookDeliveryDeadLetter {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("only dead-lettered webhook deliveries can be replayed, but delivery '%s' is '%s'", notificationID, delivery.Status),
+		}
+	}
+
+	n, err := notification.Find(ctx, notificationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding notification '%s'", notificationID)
+	}
+	if n == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("notification '%s' not found", notificationID),
+		}
+	}
+
+	if err = n.MarkUnsent(ctx); err != nil {
+		return nil, errors.Wrapf(err, "resetting notification '%s'", n.ID)
+	}
+	if err = delivery.MarkReplaying(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	queue := evergreen.GetEnvironment().RemoteQueue()
+	ts := time.Now().Format(units.TSFormat)
+	if err = amboy.EnqueueUniqueJob(ctx, queue, units.NewEventSendJob(n.ID, ts)); err != nil {
+		return nil, errors.Wrapf(err, "enqueueing job to replay notification '%s'", n.ID)
+	}
+
+	apiDelivery := &restModel.APIWebhookDelivery{}
+	apiDelivery.BuildFromService(*delivery)
+	return apiDelivery, nil
+}
+
+// findWebhookSubscription finds the webhook subscription and checks that the
+// user can manage it. Users can manage their own subscriptions and, if they
+// can edit a project's settings, the project's subscriptions.
+func findWebhookSubscription(ctx context.Context, u *user.DBUser, subscriptionID string) (*event.Subscription, error) {
+	sub, err := event.FindSubscriptionByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding subscription '%s'", subscriptionID)
+	}
+	if sub == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("subscription '%s' not found", subscriptionID),
+		}
+	}
+	if sub.Subscriber.Type != event.EvergreenWebhookSubscriberType {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("subscription '%s' is not a webhook subscription", subscriptionID),
+		}
+	}
+
+	canManage := false
+	switch sub.OwnerType {
+	case event.OwnerTypePerson:
+		canManage = sub.Owner == u.Username()
+	case event.OwnerTypeProject:
+		canManage = u.HasPermission(ctx, gimlet.PermissionOpts{
+			Resource:      sub.Owner,
+			ResourceType:  evergreen.ProjectResourceType,
+			Permission:    evergreen.PermissionProjectSettings,
+			RequiredLevel: evergreen.ProjectSettingsEdit.Value,
+		})
+	}
+	if !canManage {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    fmt.Sprintf("not authorized to manage subscription '%s'", subscriptionID),
+		}
+	}
+
+	return sub, nil
+}
@@ -1464,6 +1464,7 @@ func (a *APIProjectCreationConfig) ToService() (any, error) {
 type APICloudProviders struct {
 	AWS    *APIAWSConfig    `json:"aws"`
 	Docker *APIDockerConfig `json:"docker"`
+	Podman *APIPodmanConfig `json:"podman"`
 }
 
 func (a *APICloudProviders) BuildFromService(h any) error {
@@ -1477,6 +1478,10 @@ func (a *APICloudProviders) BuildFromService(h any) error {
 		if err := a.Docker.BuildFromService(v.Docker); err != nil {
 			return err
 		}
+		a.Podman = &APIPodmanConfig{}
+		if err := a.Podman.BuildFromService(v.Podman); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("programmatic error: expected cloud provider config but got type %T", h)
 	}
@@ -1492,6 +1497,10 @@ func (a *APICloudProviders) ToService() (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	podman, err := a.Podman.ToService()
+	if err != nil {
+		return nil, err
+	}
 
 	config := evergreen.CloudProviders{}
 
@@ -1503,6 +1512,10 @@ func (a *APICloudProviders) ToService() (any, error) {
 		config.Docker = docker.(evergreen.DockerConfig)
 	}
 
+	if podman != nil {
+		config.Podman = podman.(evergreen.PodmanConfig)
+	}
+
 	return config, nil
 }
 
@@ -1910,6 +1923,29 @@ func (a *APIDockerConfig) ToService() (any, error) {
 	}, nil
 }
 
+type APIPodmanConfig struct {
+	APIVersion *string `json:"api_version"`
+}
+
+func (a *APIPodmanConfig) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case evergreen.PodmanConfig:
+		a.APIVersion = utility.ToStringPtr(v.APIVersion)
+	default:
+		return errors.Errorf("programmatic error: expected Podman config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APIPodmanConfig) ToService() (any, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return evergreen.PodmanConfig{
+		APIVersion: utility.FromStringPtr(a.APIVersion),
+	}, nil
+}
+
 type APIRepoTrackerConfig struct {
 	NumNewRepoRevisionsToFetch int `json:"revs_to_fetch"`
 	MaxRepoRevisionsToSearch   int `json:"max_revs_to_search"`
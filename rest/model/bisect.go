@@ -0,0 +1,14 @@
+package model
+
+// APIBisectRange is the range of mainline versions between a known-good and a
+// known-bad revision that a task is bisected over.
+type APIBisectRange struct {
+	GoodRevision *string `json:"good_revision"`
+	GoodOrder    int     `json:"good_order"`
+	BadRevision  *string `json:"bad_revision"`
+	BadOrder     int     `json:"bad_order"`
+	// Tasks are the task's runs on the mainline versions strictly between
+	// the good and bad revisions, from oldest to newest. Versions that don't
+	// contain the task are omitted.
+	Tasks []APITask `json:"tasks"`
+}
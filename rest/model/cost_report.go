@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/utility"
+)
+
+// APICostReport is the total cost of the tasks in one group of a project's
+// cost report. Only the fields of the dimensions that the report is grouped by
+// are set.
+type APICostReport struct {
+	BuildVariant *string `json:"variant,omitempty"`
+	TaskName     *string `json:"task_name,omitempty"`
+	// Requester is the user-facing requester type of the tasks (e.g. patch,
+	// commit, github_merge_queue, ad_hoc).
+	Requester *string `json:"requester,omitempty"`
+	// Author is the author of the tasks' versions, which is the patch author
+	// for patches.
+	Author *string `json:"author,omitempty"`
+
+	NumTasks int `json:"num_tasks"`
+	// TimeTakenSecs is the total time in seconds that the tasks spent
+	// running.
+	TimeTakenSecs float64   `json:"time_taken_secs"`
+	Cost          cost.Cost `json:"cost"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (r *APICostReport) BuildFromService(report taskstats.CostReport) {
+	r.BuildVariant = stringPtrIfSet(report.BuildVariant)
+	r.TaskName = stringPtrIfSet(report.TaskName)
+	requester := report.Requester
+	if userRequester := evergreen.InternalRequesterToUserRequester(requester); userRequester != "" {
+		requester = string(userRequester)
+	}
+	r.Requester = stringPtrIfSet(requester)
+	r.Author = stringPtrIfSet(report.Author)
+	r.NumTasks = report.NumTasks
+	r.TimeTakenSecs = report.TimeTaken.Seconds()
+	r.Cost = report.Cost
+}
+
+func stringPtrIfSet(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return utility.ToStringPtr(s)
+}
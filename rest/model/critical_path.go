@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APICriticalPath is the longest chain of dependent tasks in a version, which
+// determines how long the version takes to finish.
+type APICriticalPath struct {
+	// The tasks on the critical path, ordered from the first task to run to
+	// the last.
+	Tasks []APICriticalPathTask `json:"tasks"`
+	// When the earliest task in the version became available to run.
+	StartTime *time.Time `json:"start_time"`
+	// When the last task on the critical path finished or, if it has not
+	// finished yet, when it is expected to finish.
+	FinishTime *time.Time `json:"finish_time"`
+	// The total wall-clock time from the start time to the finish time.
+	Makespan APIDuration `json:"makespan_ms"`
+	// Whether the makespan is partially based on expected durations because
+	// some tasks on the critical path have not finished.
+	Estimated bool `json:"estimated"`
+}
+
+// BuildFromService converts a service level critical path to an API model.
+func (cp *APICriticalPath) BuildFromService(in task.CriticalPath) {
+	cp.Tasks = make([]APICriticalPathTask, 0, len(in.Tasks))
+	for _, t := range in.Tasks {
+		apiTask := APICriticalPathTask{}
+		apiTask.BuildFromService(t)
+		cp.Tasks = append(cp.Tasks, apiTask)
+	}
+	cp.StartTime = ToTimePtr(in.StartTime)
+	cp.FinishTime = ToTimePtr(in.FinishTime)
+	cp.Makespan = NewAPIDuration(in.Makespan)
+	cp.Estimated = in.Estimated
+}
+
+// APICriticalPathTask is a task on a version's critical path.
+type APICriticalPathTask struct {
+	// The task ID.
+	TaskID *string `json:"task_id"`
+	// The display name of the task.
+	DisplayName *string `json:"display_name"`
+	// The build variant of the task.
+	BuildVariant *string `json:"build_variant"`
+	// The status of the task.
+	Status *string `json:"status"`
+	// How long the task waited to start after it became ready to run.
+	QueueWait APIDuration `json:"queue_wait_ms"`
+	// How long the task ran or, if it has not finished, how long it is
+	// expected to run.
+	ExecutionTime APIDuration `json:"execution_time_ms"`
+	// How much wall-clock time the task added to the version's makespan.
+	Contribution APIDuration `json:"contribution_ms"`
+	// Whether the task's times are based on its expected duration because it
+	// has not finished.
+	Estimated bool `json:"estimated"`
+}
+
+// BuildFromService converts a service level critical path task to an API
+// model.
+func (t *APICriticalPathTask) BuildFromService(in task.CriticalPathTask) {
+	t.TaskID = utility.ToStringPtr(in.ID)
+	t.DisplayName = utility.ToStringPtr(in.Name)
+	t.BuildVariant = utility.ToStringPtr(in.Variant)
+	t.Status = utility.ToStringPtr(in.Status)
+	t.QueueWait = NewAPIDuration(in.QueueWait)
+	t.ExecutionTime = NewAPIDuration(in.ExecutionTime)
+	t.Contribution = NewAPIDuration(in.Contribution)
+	t.Estimated = in.Estimated
+}
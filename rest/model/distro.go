@@ -12,16 +12,17 @@ import (
 // APIPlannerSettings is the model to be returned by the API whenever distro.PlannerSettings are fetched
 
 type APIPlannerSettings struct {
-	Version                   *string     `json:"version"`
-	TargetTime                APIDuration `json:"target_time"`
-	GroupVersions             bool        `json:"group_versions"`
-	PatchFactor               int64       `json:"patch_factor"`
-	PatchTimeInQueueFactor    int64       `json:"patch_time_in_queue_factor"`
-	MainlineTimeInQueueFactor int64       `json:"mainline_time_in_queue_factor"`
-	ExpectedRuntimeFactor     int64       `json:"expected_runtime_factor"`
-	GenerateTaskFactor        int64       `json:"generate_task_factor"`
-	NumDependentsFactor       float64     `json:"num_dependents_factor"`
-	CommitQueueFactor         int64       `json:"commit_queue_factor"`
+	Version                   *string           `json:"version"`
+	TargetTime                APIDuration       `json:"target_time"`
+	GroupVersions             bool              `json:"group_versions"`
+	PatchFactor               int64             `json:"patch_factor"`
+	PatchTimeInQueueFactor    int64             `json:"patch_time_in_queue_factor"`
+	MainlineTimeInQueueFactor int64             `json:"mainline_time_in_queue_factor"`
+	ExpectedRuntimeFactor     int64             `json:"expected_runtime_factor"`
+	GenerateTaskFactor        int64             `json:"generate_task_factor"`
+	NumDependentsFactor       float64           `json:"num_dependents_factor"`
+	CommitQueueFactor         int64             `json:"commit_queue_factor"`
+	ProjectShares             []APIProjectShare `json:"project_shares"`
 }
 
 // BuildFromService converts from service level distro.PlannerSetting to an APIPlannerSettings
@@ -39,6 +40,12 @@ func (s *APIPlannerSettings) BuildFromService(settings distro.PlannerSettings) {
 	s.GenerateTaskFactor = settings.GenerateTaskFactor
 	s.NumDependentsFactor = settings.NumDependentsFactor
 	s.CommitQueueFactor = settings.CommitQueueFactor
+	s.ProjectShares = []APIProjectShare{}
+	for _, share := range settings.ProjectShares {
+		apiShare := APIProjectShare{}
+		apiShare.BuildFromService(share)
+		s.ProjectShares = append(s.ProjectShares, apiShare)
+	}
 }
 
 // ToService returns a service layer distro.PlannerSettings using the data from APIPlannerSettings
@@ -57,10 +64,46 @@ func (s *APIPlannerSettings) ToService() distro.PlannerSettings {
 	settings.GenerateTaskFactor = s.GenerateTaskFactor
 	settings.NumDependentsFactor = s.NumDependentsFactor
 	settings.CommitQueueFactor = s.CommitQueueFactor
+	for _, share := range s.ProjectShares {
+		settings.ProjectShares = append(settings.ProjectShares, share.ToService())
+	}
 
 	return settings
 }
 
+// APIProjectShare is the model to be returned by the API for a project or
+// repo's share of a distro.
+type APIProjectShare struct {
+	ID    *string `json:"id"`
+	Share float64 `json:"share"`
+}
+
+// BuildFromService converts from service level distro.ProjectShare to an APIProjectShare.
+func (s *APIProjectShare) BuildFromService(share distro.ProjectShare) {
+	s.ID = utility.ToStringPtr(share.ID)
+	s.Share = share.Share
+}
+
+// ToService returns a service layer distro.ProjectShare using the data from APIProjectShare.
+func (s *APIProjectShare) ToService() distro.ProjectShare {
+	return distro.ProjectShare{
+		ID:    utility.FromStringPtr(s.ID),
+		Share: s.Share,
+	}
+}
+
+// APIProjectShareUsage is the model to be returned by the API for a project's
+// recent usage of a distro compared to its fair share.
+type APIProjectShareUsage struct {
+	ProjectID      *string     `json:"project_id"`
+	RepoID         *string     `json:"repo_id"`
+	Share          float64     `json:"share"`
+	TargetFraction float64     `json:"target_fraction"`
+	HostTime       APIDuration `json:"host_time"`
+	UsageFraction  float64     `json:"usage_fraction"`
+	Factor         float64     `json:"factor"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 //
 // APIHostAllocatorSettings is the model to be returned by the API whenever distro.HostAllocatorSettings are fetched
@@ -351,6 +394,32 @@ func (s *APICostData) ToService() distro.CostData {
 	}
 }
 
+// APIPreemptionSettings is the model to be returned by the API whenever
+// distro.PreemptionSettings are fetched.
+type APIPreemptionSettings struct {
+	Enabled           bool        `json:"enabled"`
+	PriorityThreshold int64       `json:"priority_threshold"`
+	WaitLimit         APIDuration `json:"wait_limit"`
+}
+
+// BuildFromService converts from service level distro.PreemptionSettings to
+// an APIPreemptionSettings.
+func (s *APIPreemptionSettings) BuildFromService(settings distro.PreemptionSettings) {
+	s.Enabled = settings.Enabled
+	s.PriorityThreshold = settings.PriorityThreshold
+	s.WaitLimit = NewAPIDuration(settings.WaitLimit)
+}
+
+// ToService returns a service layer distro.PreemptionSettings using the data
+// from APIPreemptionSettings.
+func (s *APIPreemptionSettings) ToService() distro.PreemptionSettings {
+	return distro.PreemptionSettings{
+		Enabled:           s.Enabled,
+		PriorityThreshold: s.PriorityThreshold,
+		WaitLimit:         s.WaitLimit.ToDuration(),
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 //
 // APIDistro is the model to be returned by the API whenever distros are fetched
@@ -378,6 +447,7 @@ type APIDistro struct {
 	PlannerSettings       APIPlannerSettings       `json:"planner_settings"`
 	DispatcherSettings    APIDispatcherSettings    `json:"dispatcher_settings"`
 	HostAllocatorSettings APIHostAllocatorSettings `json:"host_allocator_settings"`
+	PreemptionSettings    APIPreemptionSettings    `json:"preemption_settings"`
 	DisableShallowClone   bool                     `json:"disable_shallow_clone"`
 	HomeVolumeSettings    APIHomeVolumeSettings    `json:"home_volume_settings"`
 	IcecreamSettings      APIIceCreamSettings      `json:"icecream_settings"`
@@ -440,6 +510,9 @@ func (apiDistro *APIDistro) BuildFromService(d distro.Distro) {
 	allocatorSettings := APIHostAllocatorSettings{}
 	allocatorSettings.BuildFromService(d.HostAllocatorSettings)
 	apiDistro.HostAllocatorSettings = allocatorSettings
+	preemptionSettings := APIPreemptionSettings{}
+	preemptionSettings.BuildFromService(d.PreemptionSettings)
+	apiDistro.PreemptionSettings = preemptionSettings
 
 	dispatchSettings := APIDispatcherSettings{}
 	dispatchSettings.BuildFromService()
@@ -502,6 +575,7 @@ func (apiDistro *APIDistro) ToService() *distro.Distro {
 	d.FinderSettings = apiDistro.FinderSettings.ToService()
 	d.PlannerSettings = apiDistro.PlannerSettings.ToService()
 	d.HostAllocatorSettings = apiDistro.HostAllocatorSettings.ToService()
+	d.PreemptionSettings = apiDistro.PreemptionSettings.ToService()
 	d.DispatcherSettings = apiDistro.DispatcherSettings.ToService()
 	d.HomeVolumeSettings = apiDistro.HomeVolumeSettings.ToService()
 	d.IceCreamSettings = apiDistro.IcecreamSettings.ToService()
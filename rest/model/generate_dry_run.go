@@ -0,0 +1,42 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/evergreen-ci/evergreen/model"
+)
+
+// APIGenerateTasksDryRunRequest is the input for a `generate.tasks` dry run.
+type APIGenerateTasksDryRunRequest struct {
+	// The ID of the task that runs generate.tasks.
+	TaskID string `json:"task_id"`
+	// The JSON files that generate.tasks would receive.
+	Files []json.RawMessage `json:"files"`
+}
+
+// APIGenerateTasksDryRun is what `generate.tasks` would add to a version's
+// project, along with any validation problems with the result.
+type APIGenerateTasksDryRun struct {
+	// The names of build variants that would be added.
+	BuildVariants []string `json:"build_variants"`
+	// The variant-task pairs that would be added.
+	Tasks []model.TVPair `json:"tasks"`
+	// The dependencies of the variant-task pairs that would be added.
+	Dependencies []model.GeneratedDependency `json:"dependencies"`
+	// The display tasks that would be added or would get new execution tasks.
+	DisplayTasks []model.GeneratedDisplayTask `json:"display_tasks"`
+	// Errors that would prevent the tasks from being generated.
+	Errors []string `json:"errors"`
+	// Warnings about the resulting project that would not prevent the tasks
+	// from being generated.
+	Warnings []string `json:"warnings"`
+}
+
+// BuildFromService converts a service level generated project diff to an API
+// model.
+func (dr *APIGenerateTasksDryRun) BuildFromService(diff model.GeneratedProjectDiff) {
+	dr.BuildVariants = diff.BuildVariants
+	dr.Tasks = diff.Tasks
+	dr.Dependencies = diff.Dependencies
+	dr.DisplayTasks = diff.DisplayTasks
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APILogSearchLine is a line in a log search result.
+type APILogSearchLine struct {
+	LineNumber int       `json:"line_number"`
+	Timestamp  time.Time `json:"timestamp"`
+	Data       string    `json:"data"`
+}
+
+// APILogSearchMatch is a line in a task's logs that matched a log search.
+type APILogSearchMatch struct {
+	// LogType is one of task_log, agent_log, system_log or test_log.
+	LogType *string `json:"log_type"`
+	// TestLogPath is the path of the test log relative to the task's test
+	// logs directory, if the match is in a test log.
+	TestLogPath *string            `json:"test_log_path,omitempty"`
+	Line        APILogSearchLine   `json:"line"`
+	Before      []APILogSearchLine `json:"before"`
+	After       []APILogSearchLine `json:"after"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (m *APILogSearchMatch) BuildFromService(match task.LogSearchMatch) {
+	m.LogType = utility.ToStringPtr(match.LogType)
+	m.TestLogPath = stringPtrIfSet(match.TestLogPath)
+	m.Line = newAPILogSearchLine(match.LineNumber, match.Line)
+
+	m.Before = make([]APILogSearchLine, 0, len(match.Before))
+	for i, line := range match.Before {
+		m.Before = append(m.Before, newAPILogSearchLine(match.LineNumber-len(match.Before)+i, line))
+	}
+	m.After = make([]APILogSearchLine, 0, len(match.After))
+	for i, line := range match.After {
+		m.After = append(m.After, newAPILogSearchLine(match.LineNumber+i+1, line))
+	}
+}
+
+func newAPILogSearchLine(lineNumber int, line log.LogLine) APILogSearchLine {
+	return APILogSearchLine{
+		LineNumber: lineNumber,
+		Timestamp:  time.Unix(0, line.Timestamp).UTC(),
+		Data:       line.Data,
+	}
+}
+
+// APIProjectLogSearchResult is a task whose logs matched a project log search.
+type APIProjectLogSearchResult struct {
+	TaskID       *string    `json:"task_id"`
+	Execution    int        `json:"execution"`
+	DisplayName  *string    `json:"display_name"`
+	BuildVariant *string    `json:"build_variant"`
+	VersionID    *string    `json:"version_id"`
+	Revision     *string    `json:"revision"`
+	Requester    *string    `json:"requester"`
+	Status       *string    `json:"status"`
+	FinishTime   *time.Time `json:"finish_time"`
+	// FirstMatch is the first line in the task's logs that matched.
+	FirstMatch APILogSearchMatch `json:"first_match"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (r *APIProjectLogSearchResult) BuildFromService(result task.ProjectLogSearchResult) {
+	t := result.Task
+	r.TaskID = utility.ToStringPtr(t.Id)
+	r.Execution = t.Execution
+	r.DisplayName = utility.ToStringPtr(t.DisplayName)
+	r.BuildVariant = utility.ToStringPtr(t.BuildVariant)
+	r.VersionID = utility.ToStringPtr(t.Version)
+	r.Revision = utility.ToStringPtr(t.Revision)
+	requester := t.Requester
+	if userRequester := evergreen.InternalRequesterToUserRequester(requester); userRequester != "" {
+		requester = string(userRequester)
+	}
+	r.Requester = utility.ToStringPtr(requester)
+	r.Status = utility.ToStringPtr(t.Status)
+	r.FinishTime = ToTimePtr(t.FinishTime)
+	r.FirstMatch.BuildFromService(result.FirstMatch)
+}
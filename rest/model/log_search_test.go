@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPILogSearchMatchBuildFromService(t *testing.T) {
+	match := task.LogSearchMatch{
+		LogType:     task.LogSearchTypeTest,
+		TestLogPath: "suite/test.log",
+		SearchMatch: log.SearchMatch{
+			LineNumber: 10,
+			Line:       log.LogLine{Timestamp: 3, Data: "error"},
+			Before:     []log.LogLine{{Timestamp: 1, Data: "one"}, {Timestamp: 2, Data: "two"}},
+			After:      []log.LogLine{{Timestamp: 4, Data: "four"}},
+		},
+	}
+
+	var apiMatch APILogSearchMatch
+	apiMatch.BuildFromService(match)
+	assert.Equal(t, task.LogSearchTypeTest, utility.FromStringPtr(apiMatch.LogType))
+	assert.Equal(t, "suite/test.log", utility.FromStringPtr(apiMatch.TestLogPath))
+	assert.Equal(t, 10, apiMatch.Line.LineNumber)
+	assert.Equal(t, "error", apiMatch.Line.Data)
+	require.Len(t, apiMatch.Before, 2)
+	assert.Equal(t, 8, apiMatch.Before[0].LineNumber)
+	assert.Equal(t, 9, apiMatch.Before[1].LineNumber)
+	require.Len(t, apiMatch.After, 1)
+	assert.Equal(t, 11, apiMatch.After[0].LineNumber)
+	assert.Equal(t, "four", apiMatch.After[0].Data)
+}
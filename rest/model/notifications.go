@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
 )
 
 type APIEventStats struct {
@@ -23,6 +25,8 @@ type apiNotificationStats struct {
 	EvergreenWebhook  int `json:"evergreen_webhook"`
 	Email             int `json:"email"`
 	Slack             int `json:"slack"`
+	Teams             int `json:"teams"`
+	Chat              int `json:"chat"`
 }
 
 func (n *apiNotificationStats) BuildFromService(data notification.NotificationStats) {
@@ -32,4 +36,49 @@ func (n *apiNotificationStats) BuildFromService(data notification.NotificationSt
 	n.EvergreenWebhook = data.EvergreenWebhook
 	n.Email = data.Email
 	n.Slack = data.Slack
+	n.Teams = data.Teams
+	n.Chat = data.Chat
+}
+
+// APIWebhookDelivery is the delivery log for a webhook notification.
+type APIWebhookDelivery struct {
+	NotificationID *string             `json:"notification_id"`
+	SubscriptionID *string             `json:"subscription_id"`
+	URL            *string             `json:"url"`
+	Status         *string             `json:"status"`
+	AttemptCount   int                 `json:"attempt_count"`
+	Attempts       []APIWebhookAttempt `json:"attempts"`
+	Error          *string             `json:"error"`
+	LastAttemptAt  *time.Time          `json:"last_attempt_at"`
+}
+
+func (d *APIWebhookDelivery) BuildFromService(delivery notification.WebhookDelivery) {
+	d.NotificationID = utility.ToStringPtr(delivery.ID)
+	d.SubscriptionID = utility.ToStringPtr(delivery.SubscriptionID)
+	d.URL = utility.ToStringPtr(delivery.URL)
+	d.Status = utility.ToStringPtr(string(delivery.Status))
+	d.AttemptCount = len(delivery.Attempts)
+	d.Attempts = make([]APIWebhookAttempt, 0, len(delivery.Attempts))
+	for _, attempt := range delivery.Attempts {
+		apiAttempt := APIWebhookAttempt{}
+		apiAttempt.BuildFromService(attempt)
+		d.Attempts = append(d.Attempts, apiAttempt)
+	}
+	d.Error = utility.ToStringPtr(delivery.Error)
+	d.LastAttemptAt = ToTimePtr(delivery.LastAttemptAt)
+}
+
+// APIWebhookAttempt is a single attempt to deliver a webhook notification.
+type APIWebhookAttempt struct {
+	Time       *time.Time  `json:"time"`
+	StatusCode int         `json:"status_code"`
+	Latency    APIDuration `json:"latency_ms"`
+	Error      *string     `json:"error"`
+}
+
+func (a *APIWebhookAttempt) BuildFromService(attempt util.WebhookAttempt) {
+	a.Time = ToTimePtr(attempt.Time)
+	a.StatusCode = attempt.StatusCode
+	a.Latency = NewAPIDuration(attempt.Latency)
+	a.Error = utility.ToStringPtr(attempt.Error)
 }
@@ -1,12 +1,16 @@
 package model
 
 import (
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEventStats(t *testing.T) {
@@ -44,3 +48,32 @@ func TestNotificationStats(t *testing.T) {
 		assert.Equal(1, int(f.Int()))
 	}
 }
+
+func TestWebhookDelivery(t *testing.T) {
+	now := time.Now()
+	delivery := notification.WebhookDelivery{
+		ID:             "notification",
+		SubscriptionID: "subscription",
+		URL:            "https://example.com",
+		Status:         notification.WebhookDeliveryDeadLetter,
+		Attempts: []util.WebhookAttempt{
+			{Time: now.Add(-time.Minute), StatusCode: http.StatusBadGateway, Latency: 1500 * time.Millisecond, Error: "bad gateway"},
+			{Time: now, Error: "connection refused"},
+		},
+		Error:         "connection refused",
+		LastAttemptAt: now,
+	}
+
+	apiDelivery := APIWebhookDelivery{}
+	apiDelivery.BuildFromService(delivery)
+	assert.Equal(t, "notification", utility.FromStringPtr(apiDelivery.NotificationID))
+	assert.Equal(t, "subscription", utility.FromStringPtr(apiDelivery.SubscriptionID))
+	assert.Equal(t, string(notification.WebhookDeliveryDeadLetter), utility.FromStringPtr(apiDelivery.Status))
+	assert.Equal(t, "connection refused", utility.FromStringPtr(apiDelivery.Error))
+	assert.Equal(t, 2, apiDelivery.AttemptCount)
+	require.Len(t, apiDelivery.Attempts, 2)
+	assert.Equal(t, http.StatusBadGateway, apiDelivery.Attempts[0].StatusCode)
+	assert.Equal(t, APIDuration(1500), apiDelivery.Attempts[0].Latency)
+	assert.Equal(t, "bad gateway", utility.FromStringPtr(apiDelivery.Attempts[0].Error))
+	assert.Zero(t, apiDelivery.Attempts[1].StatusCode)
+}
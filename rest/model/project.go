@@ -498,6 +498,101 @@ func (ts *APITestSelectionSettings) BuildFromService(settings model.TestSelectio
 	ts.DefaultEnabled = utility.BoolPtrCopy(settings.DefaultEnabled)
 }
 
+type APITestQuarantineSettings struct {
+	// Whether or not flaky tests are automatically quarantined.
+	AutoNominate *bool `json:"auto_nominate,omitzero"`
+	// The fraction of recent mainline executions in which a test must flip
+	// between passing and failing to be automatically quarantined.
+	FlipRateThreshold float64 `json:"flip_rate_threshold,omitempty"`
+	// The number of recent mainline executions used to compute flip rates.
+	LookbackExecutions int `json:"lookback_executions,omitempty"`
+}
+
+func (tq *APITestQuarantineSettings) ToService() model.TestQuarantineSettings {
+	return model.TestQuarantineSettings{
+		AutoNominate:       utility.BoolPtrCopy(tq.AutoNominate),
+		FlipRateThreshold:  tq.FlipRateThreshold,
+		LookbackExecutions: tq.LookbackExecutions,
+	}
+}
+
+func (tq *APITestQuarantineSettings) BuildFromService(settings model.TestQuarantineSettings) {
+	tq.AutoNominate = utility.BoolPtrCopy(settings.AutoNominate)
+	tq.FlipRateThreshold = settings.FlipRateThreshold
+	tq.LookbackExecutions = settings.LookbackExecutions
+}
+
+type APICostBudgetSettings struct {
+	// The maximum cost, in dollars, of all tasks in the project that finish
+	// in a calendar month.
+	MonthlyBudget float64 `json:"monthly_budget,omitempty"`
+	// The maximum cost, in dollars, of all tasks in a single version.
+	VersionBudget float64 `json:"version_budget,omitempty"`
+	// The percentages of a budget at which alerts are sent.
+	AlertThresholds []int `json:"alert_thresholds,omitempty"`
+}
+
+func (cb *APICostBudgetSettings) ToService() model.CostBudgetSettings {
+	return model.CostBudgetSettings{
+		MonthlyBudget:   cb.MonthlyBudget,
+		VersionBudget:   cb.VersionBudget,
+		AlertThresholds: cb.AlertThresholds,
+	}
+}
+
+func (cb *APICostBudgetSettings) BuildFromService(settings model.CostBudgetSettings) {
+	cb.MonthlyBudget = settings.MonthlyBudget
+	cb.VersionBudget = settings.VersionBudget
+	cb.AlertThresholds = settings.AlertThresholds
+}
+
+type APILogStorageSettings struct {
+	// Whether the chunks of each finished task's logs are merged into a
+	// single object per log.
+	CompactLogs *bool `json:"compact_logs,omitempty"`
+	// The number of days after a patch task finishes that its logs are
+	// deleted. If 0, the logs are not deleted early.
+	PatchRetentionDays int `json:"patch_retention_days,omitempty"`
+	// The number of days after a mainline task finishes that its logs are
+	// deleted. If 0, the logs are not deleted early.
+	MainlineRetentionDays int `json:"mainline_retention_days,omitempty"`
+}
+
+func (ls *APILogStorageSettings) ToService() model.LogStorageSettings {
+	return model.LogStorageSettings{
+		CompactLogs:           utility.BoolPtrCopy(ls.CompactLogs),
+		PatchRetentionDays:    ls.PatchRetentionDays,
+		MainlineRetentionDays: ls.MainlineRetentionDays,
+	}
+}
+
+func (ls *APILogStorageSettings) BuildFromService(settings model.LogStorageSettings) {
+	ls.CompactLogs = utility.BoolPtrCopy(settings.CompactLogs)
+	ls.PatchRetentionDays = settings.PatchRetentionDays
+	ls.MainlineRetentionDays = settings.MainlineRetentionDays
+}
+
+type APIArtifactRetentionSettings struct {
+	// The number of days after a patch task uploads its files that they are
+	// deleted. If 0, the files are not deleted early.
+	PatchRetentionDays int `json:"patch_retention_days,omitempty"`
+	// The number of days after a mainline task uploads its files that they
+	// are deleted. If 0, the files are not deleted early.
+	MainlineRetentionDays int `json:"mainline_retention_days,omitempty"`
+}
+
+func (ar *APIArtifactRetentionSettings) ToService() model.ArtifactRetentionSettings {
+	return model.ArtifactRetentionSettings{
+		PatchRetentionDays:    ar.PatchRetentionDays,
+		MainlineRetentionDays: ar.MainlineRetentionDays,
+	}
+}
+
+func (ar *APIArtifactRetentionSettings) BuildFromService(settings model.ArtifactRetentionSettings) {
+	ar.PatchRetentionDays = settings.PatchRetentionDays
+	ar.MainlineRetentionDays = settings.MainlineRetentionDays
+}
+
 type APIProjectRef struct {
 	Id *string `json:"id"`
 	// GitHub org name.
@@ -619,6 +714,14 @@ type APIProjectRef struct {
 	GitHubPermissionGroupByRequester map[string]string `json:"github_permission_group_by_requester,omitempty"`
 	// Test selection settings.
 	TestSelection APITestSelectionSettings `json:"test_selection,omitzero"`
+	// Settings for quarantining flaky tests.
+	TestQuarantine APITestQuarantineSettings `json:"test_quarantine,omitzero"`
+	// Cost budgets and alert thresholds.
+	CostBudget APICostBudgetSettings `json:"cost_budget,omitzero"`
+	// Log compaction and retention settings.
+	LogStorage APILogStorageSettings `json:"log_storage,omitzero"`
+	// Artifact retention settings.
+	ArtifactRetention APIArtifactRetentionSettings `json:"artifact_retention,omitzero"`
 	// Whether or not to run every mainline commit version.
 	RunEveryMainlineCommit *bool `json:"run_every_mainline_commit,omitzero"`
 }
@@ -669,6 +772,10 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		ProjectHealthView:                p.ProjectHealthView,
 		GitHubPermissionGroupByRequester: p.GitHubPermissionGroupByRequester,
 		TestSelection:                    p.TestSelection.ToService(),
+		TestQuarantine:                   p.TestQuarantine.ToService(),
+		CostBudget:                       p.CostBudget.ToService(),
+		LogStorage:                       p.LogStorage.ToService(),
+		ArtifactRetention:                p.ArtifactRetention.ToService(),
 		RunEveryMainlineCommit:           utility.FromBoolPtr(p.RunEveryMainlineCommit),
 	}
 
@@ -775,6 +882,10 @@ func (p *APIProjectRef) BuildPublicFields(ctx context.Context, projectRef model.
 	p.GithubMQTriggerAliases = utility.ToStringPtrSlice(projectRef.GithubMQTriggerAliases)
 	p.GitHubPermissionGroupByRequester = projectRef.GitHubPermissionGroupByRequester
 	p.TestSelection.BuildFromService(projectRef.TestSelection)
+	p.TestQuarantine.BuildFromService(projectRef.TestQuarantine)
+	p.CostBudget.BuildFromService(projectRef.CostBudget)
+	p.LogStorage.BuildFromService(projectRef.LogStorage)
+	p.ArtifactRetention.BuildFromService(projectRef.ArtifactRetention)
 	p.RunEveryMainlineCommit = utility.ToBoolPtr(projectRef.RunEveryMainlineCommit)
 
 	if projectRef.ProjectHealthView == "" {
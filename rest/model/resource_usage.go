@@ -0,0 +1,137 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/utility"
+)
+
+// APITaskResourceUsage is the resource usage of the host a task execution ran
+// on, sampled while the task ran.
+type APITaskResourceUsage struct {
+	// The ID of the task.
+	TaskID *string `json:"task_id"`
+	// The execution of the task.
+	Execution int `json:"execution"`
+	// The distro of the host the task ran on.
+	DistroID *string `json:"distro_id"`
+	// The instance type of the host the task ran on, if known.
+	InstanceType *string `json:"instance_type"`
+	// The number of logical CPUs on the host.
+	NumCPUs int `json:"num_cpus"`
+	// The total memory of the host in bytes.
+	TotalMemoryBytes int64 `json:"total_memory_bytes"`
+	// The 95th percentile of the host's CPU usage as a percentage.
+	SustainedCPUPercent float64 `json:"sustained_cpu_percent"`
+	// The peak memory usage of the host as a percentage.
+	PeakMemoryPercent float64 `json:"peak_memory_percent"`
+	// The usage timeseries from oldest to newest. Each point is the average
+	// usage over an interval. Longer tasks have longer intervals.
+	Points []APIResourceUsagePoint `json:"points"`
+}
+
+// APIResourceUsagePoint is the average resource usage of a host over an
+// interval.
+type APIResourceUsagePoint struct {
+	// The start of the interval.
+	Time *time.Time `json:"time"`
+	// The length of the interval in seconds.
+	DurationSecs float64 `json:"duration_secs"`
+	// The CPU usage as a percentage.
+	CPUPercent float64 `json:"cpu_percent"`
+	// The memory usage as a percentage.
+	MemoryPercent float64 `json:"memory_percent"`
+	// Bytes read from disk per second.
+	DiskReadBytesPerSec float64 `json:"disk_read_bytes_per_sec"`
+	// Bytes written to disk per second.
+	DiskWriteBytesPerSec float64 `json:"disk_write_bytes_per_sec"`
+	// Bytes received over the network per second.
+	NetRecvBytesPerSec float64 `json:"net_recv_bytes_per_sec"`
+	// Bytes sent over the network per second.
+	NetSentBytesPerSec float64 `json:"net_sent_bytes_per_sec"`
+}
+
+// BuildFromService converts a service level task resource usage to an API
+// model.
+func (u *APITaskResourceUsage) BuildFromService(in resourceusage.TaskResourceUsage) {
+	u.TaskID = utility.ToStringPtr(in.TaskID)
+	u.Execution = in.Execution
+	u.DistroID = utility.ToStringPtr(in.DistroID)
+	u.InstanceType = utility.ToStringPtr(in.InstanceType)
+	u.NumCPUs = in.NumCPUs
+	u.TotalMemoryBytes = int64(in.TotalMemoryBytes)
+	u.SustainedCPUPercent = in.SustainedCPUPercent
+	u.PeakMemoryPercent = in.PeakMemoryPercent
+	u.Points = make([]APIResourceUsagePoint, 0, len(in.Points))
+	for _, p := range in.Points {
+		u.Points = append(u.Points, APIResourceUsagePoint{
+			Time:                 ToTimePtr(p.Time),
+			DurationSecs:         p.Duration.Seconds(),
+			CPUPercent:           p.CPUPercent,
+			MemoryPercent:        p.MemoryPercent,
+			DiskReadBytesPerSec:  p.DiskReadBytesPerSec,
+			DiskWriteBytesPerSec: p.DiskWriteBytesPerSec,
+			NetRecvBytesPerSec:   p.NetRecvBytesPerSec,
+			NetSentBytesPerSec:   p.NetSentBytesPerSec,
+		})
+	}
+}
+
+// APIResourceRecommendation recommends a different distro for a task on a
+// build variant based on the task's sustained resource usage.
+type APIResourceRecommendation struct {
+	// The build variant the task runs on.
+	BuildVariant *string `json:"build_variant"`
+	// The display name of the task.
+	TaskName *string `json:"task_name"`
+	// Either "downsize" if the task could run on a cheaper distro with
+	// smaller hosts, or "upsize" if the task's hosts are too small for it.
+	Direction *string `json:"direction"`
+	// The number of recent task executions the recommendation is based on.
+	NumExecutions int `json:"num_executions"`
+	// The sustained CPU usage of the task's hosts as a percentage.
+	SustainedCPUPercent float64 `json:"sustained_cpu_percent"`
+	// The peak memory usage of the task's hosts as a percentage.
+	PeakMemoryPercent float64 `json:"peak_memory_percent"`
+	// The distro the task currently runs on.
+	CurrentDistro APIDistroOption `json:"current_distro"`
+	// The recommended distro.
+	RecommendedDistro APIDistroOption `json:"recommended_distro"`
+	// When the recommendation was made.
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// APIDistroOption is a distro that a task could run on.
+type APIDistroOption struct {
+	// The distro ID.
+	DistroID *string `json:"distro_id"`
+	// The number of logical CPUs on the distro's hosts.
+	NumCPUs int `json:"num_cpus"`
+	// The memory of the distro's hosts in bytes.
+	MemoryBytes int64 `json:"memory_bytes"`
+	// The on-demand cost of one of the distro's hosts per hour.
+	HourlyRate float64 `json:"hourly_rate"`
+}
+
+// BuildFromService converts a service level resource recommendation to an API
+// model.
+func (r *APIResourceRecommendation) BuildFromService(in resourceusage.Recommendation) {
+	r.BuildVariant = utility.ToStringPtr(in.BuildVariant)
+	r.TaskName = utility.ToStringPtr(in.TaskName)
+	r.Direction = utility.ToStringPtr(in.Direction)
+	r.NumExecutions = in.NumExecutions
+	r.SustainedCPUPercent = in.SustainedCPUPercent
+	r.PeakMemoryPercent = in.PeakMemoryPercent
+	r.CurrentDistro.BuildFromService(in.CurrentDistro)
+	r.RecommendedDistro.BuildFromService(in.RecommendedDistro)
+	r.CreatedAt = ToTimePtr(in.CreatedAt)
+}
+
+// BuildFromService converts a service level distro option to an API model.
+func (o *APIDistroOption) BuildFromService(in resourceusage.DistroOption) {
+	o.DistroID = utility.ToStringPtr(in.DistroID)
+	o.NumCPUs = in.NumCPUs
+	o.MemoryBytes = int64(in.MemoryBytes)
+	o.HourlyRate = in.HourlyRate
+}
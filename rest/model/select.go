@@ -19,4 +19,7 @@ type SelectTestsRequest struct {
 	Tests []string `json:"tests"`
 	// Strategies is the optional list of test selection strategies to use.
 	Strategies []string `json:"strategies"`
+	// CoverageRuns is the optional number of consecutive runs over which the
+	// built-in RandomSample strategy runs every test.
+	CoverageRuns int `json:"coverage_runs,omitempty"`
 }
@@ -20,6 +20,8 @@ type APISubscriber struct {
 	Target              any                     `json:"target" swaggerignore:"true"`
 	WebhookSubscriber   *APIWebhookSubscriber   `json:"-"`
 	JiraIssueSubscriber *APIJIRAIssueSubscriber `json:"-"`
+	TeamsSubscriber     *APITeamsSubscriber     `json:"-"`
+	ChatSubscriber      *APIChatSubscriber      `json:"-"`
 }
 
 type APIGithubPRSubscriber struct {
@@ -103,6 +105,24 @@ func (s *APISubscriber) BuildFromService(in event.Subscriber) error {
 		target = sub
 		s.JiraIssueSubscriber = &sub
 
+	case event.TeamsSubscriberType:
+		sub := APITeamsSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+		s.TeamsSubscriber = &sub
+
+	case event.ChatSubscriberType:
+		sub := APIChatSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+		s.ChatSubscriber = &sub
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.RunChildPatchSubscriberType:
 		target = in.Target
@@ -180,6 +200,34 @@ func (s *APISubscriber) ToService() (event.Subscriber, error) {
 		}
 		target = apiModel.ToService()
 
+	case event.TeamsSubscriberType:
+		apiModel := APITeamsSubscriber{}
+		if s.TeamsSubscriber != nil {
+			apiModel = *s.TeamsSubscriber
+		} else {
+			if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+				return event.Subscriber{}, gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    errors.Wrap(err, "Teams subscriber target is malformed").Error(),
+				}
+			}
+		}
+		target = apiModel.ToService()
+
+	case event.ChatSubscriberType:
+		apiModel := APIChatSubscriber{}
+		if s.ChatSubscriber != nil {
+			apiModel = *s.ChatSubscriber
+		} else {
+			if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+				return event.Subscriber{}, gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    errors.Wrap(err, "chat subscriber target is malformed").Error(),
+				}
+			}
+		}
+		target = apiModel.ToService()
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.RunChildPatchSubscriberType:
 		target = s.Target
@@ -340,3 +388,47 @@ func (s *APIJIRAIssueSubscriber) ToService() event.JIRAIssueSubscriber {
 		IssueType: utility.FromStringPtr(s.IssueType),
 	}
 }
+
+type APITeamsSubscriber struct {
+	URL *string `json:"url" mapstructure:"url"`
+}
+
+func (s *APITeamsSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.TeamsSubscriber:
+		s.URL = utility.ToStringPtr(v.URL)
+
+	default:
+		return errors.Errorf("programmatic error: expected Teams subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APITeamsSubscriber) ToService() event.TeamsSubscriber {
+	return event.TeamsSubscriber{
+		URL: utility.FromStringPtr(s.URL),
+	}
+}
+
+type APIChatSubscriber struct {
+	URL *string `json:"url" mapstructure:"url"`
+}
+
+func (s *APIChatSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.ChatSubscriber:
+		s.URL = utility.ToStringPtr(v.URL)
+
+	default:
+		return errors.Errorf("programmatic error: expected chat subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APIChatSubscriber) ToService() event.ChatSubscriber {
+	return event.ChatSubscriber{
+		URL: utility.FromStringPtr(s.URL),
+	}
+}
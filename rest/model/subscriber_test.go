@@ -143,3 +143,67 @@ func TestSubscriberModelsSlack(t *testing.T) {
 	assert.NoError(err)
 	assert.EqualValues(slackSubscriber, origSlackSubscriber)
 }
+
+func TestSubscriberModelsTeams(t *testing.T) {
+	assert := assert.New(t)
+
+	target := event.TeamsSubscriber{
+		URL: "https://example.webhook.office.com/webhookb2/abc",
+	}
+	teamsSubscriber := event.Subscriber{
+		Type:   event.TeamsSubscriberType,
+		Target: &target,
+	}
+	apiTeamsSubscriber := APISubscriber{}
+	assert.NoError(apiTeamsSubscriber.BuildFromService(teamsSubscriber))
+	assert.NotNil(apiTeamsSubscriber.TeamsSubscriber)
+
+	origTeamsSubscriber, err := apiTeamsSubscriber.ToService()
+	assert.NoError(err)
+	assert.EqualValues(teamsSubscriber.Type, origTeamsSubscriber.Type)
+	assert.EqualValues(target, origTeamsSubscriber.Target)
+
+	// incoming subscribers have target serialized as a map
+	incoming := APISubscriber{
+		Type: utility.ToStringPtr(event.TeamsSubscriberType),
+		Target: map[string]any{
+			"url": "https://example.webhook.office.com/webhookb2/abc",
+		},
+	}
+
+	serviceModel, err := incoming.ToService()
+	assert.NoError(err)
+	assert.EqualValues(origTeamsSubscriber, serviceModel)
+}
+
+func TestSubscriberModelsChat(t *testing.T) {
+	assert := assert.New(t)
+
+	target := event.ChatSubscriber{
+		URL: "https://chat.example.com/hooks/abc",
+	}
+	chatSubscriber := event.Subscriber{
+		Type:   event.ChatSubscriberType,
+		Target: &target,
+	}
+	apiChatSubscriber := APISubscriber{}
+	assert.NoError(apiChatSubscriber.BuildFromService(chatSubscriber))
+	assert.NotNil(apiChatSubscriber.ChatSubscriber)
+
+	origChatSubscriber, err := apiChatSubscriber.ToService()
+	assert.NoError(err)
+	assert.EqualValues(chatSubscriber.Type, origChatSubscriber.Type)
+	assert.EqualValues(target, origChatSubscriber.Target)
+
+	// incoming subscribers have target serialized as a map
+	incoming := APISubscriber{
+		Type: utility.ToStringPtr(event.ChatSubscriberType),
+		Target: map[string]any{
+			"url": "https://chat.example.com/hooks/abc",
+		},
+	}
+
+	serviceModel, err := incoming.ToService()
+	assert.NoError(err)
+	assert.EqualValues(origChatSubscriber, serviceModel)
+}
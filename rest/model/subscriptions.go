@@ -30,6 +30,10 @@ type APISubscription struct {
 	Owner *string `json:"owner"`
 	// Data for the particular condition that triggers the subscription.
 	TriggerData map[string]string `json:"trigger_data,omitempty"`
+	// Optional Go template that renders the notification body in place of the
+	// default one. Supported for Slack, email, webhook, Teams and chat
+	// subscribers.
+	Template *string `json:"template,omitempty"`
 }
 
 func (s *APISelector) BuildFromService(selector event.Selector) {
@@ -51,6 +55,7 @@ func (s *APISubscription) BuildFromService(sub event.Subscription) error {
 	s.Owner = utility.ToStringPtr(sub.Owner)
 	s.OwnerType = utility.ToStringPtr(string(sub.OwnerType))
 	s.TriggerData = sub.TriggerData
+	s.Template = utility.ToStringPtr(sub.Template)
 	err := s.Subscriber.BuildFromService(sub.Subscriber)
 	if err != nil {
 		return err
@@ -80,6 +85,7 @@ func (s *APISubscription) ToService() (event.Subscription, error) {
 		Selectors:      []event.Selector{},
 		RegexSelectors: []event.Selector{},
 		TriggerData:    s.TriggerData,
+		Template:       utility.FromStringPtr(s.Template),
 	}
 	subscriber, err := s.Subscriber.ToService()
 	if err != nil {
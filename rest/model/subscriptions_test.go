@@ -44,6 +44,7 @@ func TestSubscriptionModels(t *testing.T) {
 			Type:   event.EmailSubscriberType,
 			Target: "email message",
 		},
+		Template: "{{ .DisplayName }} has {{ .Status }}",
 	}
 
 	apiSubscription := APISubscription{}
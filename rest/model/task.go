@@ -152,6 +152,7 @@ type APIAbortInfo struct {
 	TaskID     string `json:"task_id,omitempty"`
 	NewVersion string `json:"new_version,omitempty"`
 	PRClosed   bool   `json:"pr_closed,omitempty"`
+	Reason     string `json:"reason,omitempty"`
 }
 
 type LogLinks struct {
@@ -389,6 +390,7 @@ func (at *APITask) buildTask(t *task.Task) error {
 			TaskID:     t.AbortInfo.TaskID,
 			User:       t.AbortInfo.User,
 			PRClosed:   t.AbortInfo.PRClosed,
+			Reason:     t.AbortInfo.Reason,
 		},
 		HasAnnotations:       t.HasAnnotations,
 		IsAutomaticRestart:   t.IsAutomaticRestart,
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIQuarantinedTest is a test that is quarantined in a project. Failures of
+// quarantined tests do not fail the task.
+type APIQuarantinedTest struct {
+	// The project the test is quarantined in.
+	ProjectID *string `json:"project_id"`
+	// The name of the quarantined test.
+	TestName *string `json:"test_name"`
+	// How the test was quarantined, either "manual" or "nominated".
+	Source *string `json:"source"`
+	// Why the test was quarantined.
+	Reason *string `json:"reason"`
+	// For nominated tests, the fraction of recent mainline executions in
+	// which the test flipped between passing and failing.
+	FlipRate float64 `json:"flip_rate"`
+	// The user who quarantined the test.
+	CreatedBy *string `json:"created_by"`
+	// When the test was quarantined.
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// BuildFromService converts a service level quarantined test to an API model.
+func (qt *APIQuarantinedTest) BuildFromService(in testquarantine.QuarantinedTest) {
+	qt.ProjectID = utility.ToStringPtr(in.ProjectID)
+	qt.TestName = utility.ToStringPtr(in.TestName)
+	qt.Source = utility.ToStringPtr(in.Source)
+	qt.Reason = utility.ToStringPtr(in.Reason)
+	qt.FlipRate = in.FlipRate
+	qt.CreatedBy = utility.ToStringPtr(in.CreatedBy)
+	qt.CreatedAt = ToTimePtr(in.CreatedAt)
+}
+
+// ToService converts an API quarantined test to a service level model.
+func (qt *APIQuarantinedTest) ToService() testquarantine.QuarantinedTest {
+	return testquarantine.QuarantinedTest{
+		ProjectID: utility.FromStringPtr(qt.ProjectID),
+		TestName:  utility.FromStringPtr(qt.TestName),
+		Source:    utility.FromStringPtr(qt.Source),
+		Reason:    utility.FromStringPtr(qt.Reason),
+		FlipRate:  qt.FlipRate,
+		CreatedBy: utility.FromStringPtr(qt.CreatedBy),
+		CreatedAt: utility.FromTimePtr(qt.CreatedAt),
+	}
+}
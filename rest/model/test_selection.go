@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/testselection"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIFileTestMap associates the files in a project's repository with the tests
+// in one of its tasks that exercise them. It is used by the built-in
+// ChangedFiles test selection strategy.
+type APIFileTestMap struct {
+	// The project the map belongs to.
+	ProjectID *string `json:"project_id"`
+	// The display name of the task the tests run in.
+	TaskName *string `json:"task_name"`
+	// The files and the tests that exercise them.
+	Files []APIFileTests `json:"files"`
+	// When the map was last updated.
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// APIFileTests are the tests that exercise a file.
+type APIFileTests struct {
+	// The file's path relative to the repository root, or a pattern such as
+	// "src/db/*.go" matching multiple files.
+	File *string `json:"file"`
+	// The names of the tests that exercise the file.
+	Tests []string `json:"tests"`
+}
+
+// BuildFromService converts a service level file test map to an API model.
+func (m *APIFileTestMap) BuildFromService(in testselection.FileTestMap) {
+	m.ProjectID = utility.ToStringPtr(in.ProjectID)
+	m.TaskName = utility.ToStringPtr(in.TaskName)
+	m.Files = make([]APIFileTests, 0, len(in.Files))
+	for _, f := range in.Files {
+		m.Files = append(m.Files, APIFileTests{
+			File:  utility.ToStringPtr(f.File),
+			Tests: f.Tests,
+		})
+	}
+	m.UpdatedAt = ToTimePtr(in.UpdatedAt)
+}
+
+// ToService converts an API file test map to a service level model.
+func (m *APIFileTestMap) ToService() testselection.FileTestMap {
+	files := make([]testselection.FileTests, 0, len(m.Files))
+	for _, f := range m.Files {
+		files = append(files, testselection.FileTests{
+			File:  utility.FromStringPtr(f.File),
+			Tests: f.Tests,
+		})
+	}
+	return testselection.FileTestMap{
+		ProjectID: utility.FromStringPtr(m.ProjectID),
+		TaskName:  utility.FromStringPtr(m.TaskName),
+		Files:     files,
+		UpdatedAt: utility.FromTimePtr(m.UpdatedAt),
+	}
+}
@@ -53,6 +53,9 @@ type APIVersion struct {
 	// Will be null for versions created before this field was added.
 	Activated *bool `json:"activated"`
 	Aborted   *bool `json:"aborted"`
+	// Whether the version's artifacts are kept regardless of the project's
+	// artifact retention policy.
+	ArtifactsPinned bool `json:"artifacts_pinned"`
 	// The git tag that triggered this version, if any.
 	TriggeredGitTag *APIGitTag `json:"triggered_by_git_tag"`
 	// Git tags that were pushed to this version.
@@ -111,6 +114,7 @@ func (apiVersion *APIVersion) BuildFromService(ctx context.Context, v model.Vers
 	apiVersion.Errors = utility.ToStringPtrSlice(v.Errors)
 	apiVersion.Activated = v.Activated
 	apiVersion.Aborted = utility.ToBoolPtr(v.Aborted)
+	apiVersion.ArtifactsPinned = v.ArtifactsPinned
 	apiVersion.Ignored = utility.ToBoolPtr(v.Ignored)
 
 	var bd buildDetail
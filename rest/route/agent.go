@@ -648,6 +648,9 @@ func (h *attachFilesHandler) Run(ctx context.Context) gimlet.Responder {
 		BuildId:         t.BuildId,
 		Execution:       t.Execution,
 		CreateTime:      time.Now(),
+		ProjectID:       t.Project,
+		VersionID:       t.Version,
+		Requester:       t.Requester,
 		Files:           artifact.EscapeFiles(h.files),
 	}
 
@@ -0,0 +1,152 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+var bisectRevisionRegexp = regexp.MustCompile("^[0-9a-f]{1,40}$")
+
+//////////////////////////////////////
+// GET /projects/{project_id}/bisect //
+//////////////////////////////////////
+
+type projectBisectHandler struct {
+	projectID    string
+	buildVariant string
+	taskName     string
+	goodRevision string
+	badRevision  string
+}
+
+func makeGetProjectBisectRange() gimlet.RouteHandler {
+	return &projectBisectHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get the versions to bisect a task over
+//	@Description	Returns a task's runs on the mainline versions between a known-good and a known-bad revision, from oldest to newest, so that the commit that broke the task can be found by activating them in binary-search order.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/bisect [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id		path		string	true	"The project ID."
+//	@Param			build_variant	query		string	true	"The build variant of the task."
+//	@Param			task_name		query		string	true	"The display name of the task."
+//	@Param			good			query		string	true	"The revision, or a unique prefix of it, of a mainline version where the task succeeds."
+//	@Param			bad				query		string	true	"The revision, or a unique prefix of it, of a later mainline version where the task fails."
+//	@Success		200				{object}	model.APIBisectRange
+func (h *projectBisectHandler) Factory() gimlet.RouteHandler {
+	return &projectBisectHandler{}
+}
+
+func (h *projectBisectHandler) Parse(ctx context.Context, r *http.Request) error {
+	project := gimlet.GetVars(r)["project_id"]
+	projectID, err := dbModel.GetIdForProject(ctx, project)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "finding project '%s'", project).Error(),
+		}
+	}
+	h.projectID = projectID
+
+	vals := r.URL.Query()
+	h.buildVariant = vals.Get("build_variant")
+	if h.buildVariant == "" {
+		return errors.New("must specify a build variant")
+	}
+	h.taskName = vals.Get("task_name")
+	if h.taskName == "" {
+		return errors.New("must specify a task name")
+	}
+	h.goodRevision = vals.Get("good")
+	if !bisectRevisionRegexp.MatchString(h.goodRevision) {
+		return errors.Errorf("good revision '%s' must be a revision or revision prefix", h.goodRevision)
+	}
+	h.badRevision = vals.Get("bad")
+	if !bisectRevisionRegexp.MatchString(h.badRevision) {
+		return errors.Errorf("bad revision '%s' must be a revision or revision prefix", h.badRevision)
+	}
+
+	return nil
+}
+
+func (h *projectBisectHandler) Run(ctx context.Context) gimlet.Responder {
+	good, err := h.findMainlineVersion(ctx, h.goodRevision)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	bad, err := h.findMainlineVersion(ctx, h.badRevision)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	if good.RevisionOrderNumber >= bad.RevisionOrderNumber {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("good revision '%s' must be older than bad revision '%s'", good.Revision, bad.Revision),
+		})
+	}
+
+	tasks, err := dbModel.FindMainlineTasksInRange(ctx, dbModel.FindTaskHistoryOptions{
+		TaskName:     h.taskName,
+		BuildVariant: h.buildVariant,
+		ProjectId:    h.projectID,
+		LowerBound:   utility.ToIntPtr(good.RevisionOrderNumber),
+		UpperBound:   utility.ToIntPtr(bad.RevisionOrderNumber),
+	})
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s' on variant '%s' between revisions '%s' and '%s'", h.taskName, h.buildVariant, good.Revision, bad.Revision))
+	}
+
+	bisectRange := model.APIBisectRange{
+		GoodRevision: utility.ToStringPtr(good.Revision),
+		GoodOrder:    good.RevisionOrderNumber,
+		BadRevision:  utility.ToStringPtr(bad.Revision),
+		BadOrder:     bad.RevisionOrderNumber,
+		Tasks:        make([]model.APITask, 0, len(tasks)),
+	}
+	for _, t := range tasks {
+		apiTask := model.APITask{}
+		if err = apiTask.BuildFromService(ctx, &t, nil); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "converting task '%s' to API model", t.Id))
+		}
+		bisectRange.Tasks = append(bisectRange.Tasks, apiTask)
+	}
+
+	return gimlet.NewJSONResponse(bisectRange)
+}
+
+// findMainlineVersion finds the project's mainline version whose revision
+// starts with the given prefix.
+func (h *projectBisectHandler) findMainlineVersion(ctx context.Context, revisionPrefix string) (*dbModel.Version, error) {
+	versions, err := dbModel.VersionFind(ctx, dbModel.VersionByProjectIdAndRevisionPrefix(h.projectID, revisionPrefix).Limit(2))
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "finding mainline version for revision '%s'", revisionPrefix).Error(),
+		}
+	}
+	switch len(versions) {
+	case 0:
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no mainline version found for revision '%s' in project '%s'", revisionPrefix, h.projectID),
+		}
+	case 1:
+		return &versions[0], nil
+	default:
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("revision prefix '%s' matches multiple mainline versions in project '%s'", revisionPrefix, h.projectID),
+		}
+	}
+}
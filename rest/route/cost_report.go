@@ -0,0 +1,134 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////
+// GET /projects/{project_id}/cost_report //
+////////////////////////////////////////////
+
+type costReportHandler struct {
+	StatsHandler
+	costFilter taskstats.CostReportFilter
+}
+
+func makeGetProjectCostReport() gimlet.RouteHandler {
+	return &costReportHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get project cost report
+//	@Description	Returns the total cost of the project's tasks that finished in a date range, grouped by build variant, task, requester and/or version author. Groups are sorted from most to least expensive. Costs include previous executions of restarted tasks.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_report [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string		true	"the project ID"
+//	@Param			after_date	query	string		true	"The start date (inclusive) of the report, in the format YYYY-MM-DD"
+//	@Param			before_date	query	string		true	"The end date (exclusive) of the report, in the format YYYY-MM-DD. The report can cover at most 92 days."
+//	@Param			group_by	query	[]string	false	"Comma-separated dimensions to group costs by. Valid values are variant, task, requester and author. Defaults to variant,task."
+//	@Param			requesters	query	[]string	false	"Only include tasks with these requesters. Valid values are patch, github_pr, github_tag, commit, trigger, ad_hoc and github_merge_queue. Defaults to all requesters."
+//	@Param			variants	query	[]string	false	"Only include tasks in these build variants. Defaults to all build variants."
+//	@Success		200			{object}	[]model.APICostReport
+func (h *costReportHandler) Factory() gimlet.RouteHandler {
+	return &costReportHandler{}
+}
+
+func (h *costReportHandler) Parse(ctx context.Context, r *http.Request) error {
+	project := gimlet.GetVars(r)["project_id"]
+	projectID, err := dbModel.GetIdForProject(ctx, project)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			Message:    errors.Wrapf(err, "finding project '%s'", project).Error(),
+			StatusCode: http.StatusNotFound,
+		}
+	}
+	h.costFilter = taskstats.CostReportFilter{Project: projectID}
+
+	if err = h.parseCostReportFilter(r.URL.Query()); err != nil {
+		return errors.Wrap(err, "invalid query parameters")
+	}
+	if err = h.costFilter.Validate(); err != nil {
+		return gimlet.ErrorResponse{
+			Message:    errors.Wrap(err, "invalid filter").Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+func (h *costReportHandler) parseCostReportFilter(vals url.Values) error {
+	groupBy := h.readStringList(vals["group_by"])
+	if len(groupBy) == 0 {
+		groupBy = []string{string(taskstats.CostGroupByVariant), string(taskstats.CostGroupByTask)}
+	}
+	for _, gb := range groupBy {
+		h.costFilter.GroupBy = append(h.costFilter.GroupBy, taskstats.CostGroupBy(gb))
+	}
+
+	for _, requester := range h.readStringList(vals["requesters"]) {
+		userRequester := evergreen.UserRequester(requester)
+		if err := userRequester.Validate(); err != nil {
+			return gimlet.ErrorResponse{
+				Message:    err.Error(),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		h.costFilter.Requesters = append(h.costFilter.Requesters, evergreen.UserRequesterToInternalRequester(userRequester))
+	}
+
+	h.costFilter.BuildVariants = h.readStringList(vals["variants"])
+
+	var err error
+	if h.costFilter.AfterDate, err = h.readDate(vals.Get("after_date"), "after"); err != nil {
+		return err
+	}
+	h.costFilter.BeforeDate, err = h.readDate(vals.Get("before_date"), "before")
+	return err
+}
+
+// readDate parses a required date parameter value.
+func (h *costReportHandler) readDate(value, name string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, gimlet.ErrorResponse{
+			Message:    fmt.Sprintf("missing '%s' date", name),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	date, err := time.ParseInLocation(statsAPIDateFormat, value, time.UTC)
+	if err != nil {
+		return time.Time{}, gimlet.ErrorResponse{
+			Message:    errors.Wrapf(err, "parsing '%s' date in expected format (%s)", name, statsAPIDateFormat).Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return date, nil
+}
+
+func (h *costReportHandler) Run(ctx context.Context) gimlet.Responder {
+	reports, err := taskstats.GetCostReport(ctx, h.costFilter)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting cost report for project '%s'", h.costFilter.Project))
+	}
+
+	apiReports := make([]model.APICostReport, 0, len(reports))
+	for _, report := range reports {
+		apiReport := model.APICostReport{}
+		apiReport.BuildFromService(report)
+		apiReports = append(apiReports, apiReport)
+	}
+
+	return gimlet.NewJSONResponse(apiReports)
+}
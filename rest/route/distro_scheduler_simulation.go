@@ -0,0 +1,168 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+///////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/distros/{distro_id}/scheduler_snapshot
+
+type distroSchedulerSnapshotHandler struct {
+	distroID string
+}
+
+func makeGetDistroSchedulerSnapshot() gimlet.RouteHandler {
+	return &distroSchedulerSnapshotHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a scheduler snapshot for a distro
+//	@Description	Returns a snapshot of the distro's runnable tasks and hosts, which can be replayed later with the scheduler simulation route.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/scheduler_snapshot [get]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path		string	true	"distro ID"
+//	@Success		200			{object}	scheduler.SimulationSnapshot
+func (h *distroSchedulerSnapshotHandler) Factory() gimlet.RouteHandler {
+	return &distroSchedulerSnapshotHandler{}
+}
+
+// Parse fetches the distro ID from the http request.
+func (h *distroSchedulerSnapshotHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	return nil
+}
+
+// Run returns a scheduler snapshot for the distro.
+func (h *distroSchedulerSnapshotHandler) Run(ctx context.Context) gimlet.Responder {
+	snapshot, resp := makeDistroSchedulerSnapshot(ctx, h.distroID)
+	if resp != nil {
+		return resp
+	}
+	return gimlet.NewJSONResponse(snapshot)
+}
+
+///////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/distros/{distro_id}/scheduler_simulation
+
+type distroSchedulerSimulationHandler struct {
+	// Snapshot is a previously taken snapshot to replay. If it's not given,
+	// a snapshot of the distro is taken now.
+	Snapshot *scheduler.SimulationSnapshot `json:"snapshot,omitempty"`
+	// PlannerSettings and HostAllocatorSettings are the settings to simulate.
+	// Only the fields that are set override the distro's settings.
+	PlannerSettings       distro.PlannerSettings       `json:"planner_settings"`
+	HostAllocatorSettings distro.HostAllocatorSettings `json:"host_allocator_settings"`
+
+	distroID string
+}
+
+func makeDistroSchedulerSimulation() gimlet.RouteHandler {
+	return &distroSchedulerSimulationHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Simulate scheduling a distro
+//	@Description	Replays the task planner and host allocator on a snapshot of the distro's task queue and hosts, once with the distro's current settings and once with the given settings. Returns the projected queue wait times, host counts and cost of both.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/scheduler_simulation [post]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path		string								true	"distro ID"
+//	@Param			{object}	body		distroSchedulerSimulationHandler	true	"the settings to simulate"
+//	@Success		200			{object}	scheduler.SimulationComparison
+func (h *distroSchedulerSimulationHandler) Factory() gimlet.RouteHandler {
+	return &distroSchedulerSimulationHandler{}
+}
+
+// Parse fetches the distro ID and the settings to simulate from the http
+// request.
+func (h *distroSchedulerSimulationHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	body := utility.NewRequestReader(r)
+	defer body.Close()
+
+	if err := utility.ReadJSON(body, h); err != nil {
+		return errors.Wrap(err, "reading scheduler simulation parameters from request body")
+	}
+	if h.Snapshot != nil && h.Snapshot.Distro.Id != h.distroID {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("snapshot is for distro '%s', not distro '%s'", h.Snapshot.Distro.Id, h.distroID),
+		}
+	}
+	if h.HostAllocatorSettings.FutureHostFraction > 1 || h.HostAllocatorSettings.FutureHostFraction < 0 {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "future host fraction must be between 0 and 1",
+		}
+	}
+
+	return nil
+}
+
+// Run simulates scheduling the distro with its current settings and the given
+// settings.
+func (h *distroSchedulerSimulationHandler) Run(ctx context.Context) gimlet.Responder {
+	settings, err := evergreen.GetConfig(ctx)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting admin settings"))
+	}
+
+	snapshot := h.Snapshot
+	if snapshot == nil {
+		var resp gimlet.Responder
+		if snapshot, resp = makeDistroSchedulerSnapshot(ctx, h.distroID); resp != nil {
+			return resp
+		}
+	}
+
+	comparison, err := scheduler.CompareSimulation(ctx, *snapshot, scheduler.SimulationOptions{
+		PlannerSettings:       h.PlannerSettings,
+		HostAllocatorSettings: h.HostAllocatorSettings,
+		CostConfig:            settings.Cost,
+	})
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "simulating scheduler for distro '%s'", h.distroID))
+	}
+
+	return gimlet.NewJSONResponse(comparison)
+}
+
+// makeDistroSchedulerSnapshot takes a scheduler snapshot of the distro,
+// returning an error responder if it fails.
+func makeDistroSchedulerSnapshot(ctx context.Context, distroID string) (*scheduler.SimulationSnapshot, gimlet.Responder) {
+	d, err := distro.FindOneId(ctx, distroID)
+	if err != nil {
+		return nil, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding distro '%s'", distroID))
+	}
+	if d == nil {
+		return nil, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("distro '%s' not found", distroID),
+		})
+	}
+
+	settings, err := evergreen.GetConfig(ctx)
+	if err != nil {
+		return nil, gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting admin settings"))
+	}
+	snapshot, err := scheduler.MakeSimulationSnapshot(ctx, distroID, settings)
+	if err != nil {
+		return nil, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "taking scheduler snapshot of distro '%s'", distroID))
+	}
+
+	return snapshot, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/units"
 	"github.com/evergreen-ci/evergreen/validator"
@@ -1377,6 +1378,25 @@ func (h *hostAgentEndTask) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONInternalErrorResponder(err)
 	}
 
+	if h.details.ResourceUsage != nil && !t.Aborted {
+		usage := resourceusage.NewTaskResourceUsage(resourceusage.TaskInfo{
+			TaskID:       t.Id,
+			Execution:    t.Execution,
+			ProjectID:    t.Project,
+			BuildVariant: t.BuildVariant,
+			TaskName:     t.DisplayName,
+			Requester:    t.Requester,
+			DistroID:     currentHost.Distro.Id,
+			InstanceType: currentHost.InstanceType,
+			FinishTime:   finishTime,
+		}, *h.details.ResourceUsage)
+		grip.Error(ctx, message.WrapError(usage.Upsert(ctx), message.Fields{
+			"message":   "could not store task resource usage",
+			"task_id":   t.Id,
+			"execution": t.Execution,
+		}))
+	}
+
 	if evergreen.IsGithubMergeQueueRequester(t.Requester) {
 		if err = model.HandleEndTaskForGithubMergeQueueTask(ctx, t, h.details.Status); err != nil {
 			return gimlet.MakeJSONInternalErrorResponder(err)
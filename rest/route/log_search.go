@@ -0,0 +1,280 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxLogSearchContextLines     = 10
+	defaultTaskLogSearchMatches  = 100
+	maxTaskLogSearchMatches      = 1000
+	defaultProjectLogSearchDays  = 7
+	maxProjectLogSearchDays      = 30
+	logSearchPatternQueryParam   = "pattern"
+	logSearchLiteralQueryParam   = "literal"
+	logSearchExcludeTestLogParam = "exclude_test_logs"
+)
+
+// parseLogSearchPattern returns the search pattern from the query parameters.
+// If the literal parameter is true, the pattern matches the string exactly
+// rather than as a regular expression.
+func parseLogSearchPattern(vals url.Values) (*regexp.Regexp, error) {
+	pattern := vals.Get(logSearchPatternQueryParam)
+	if pattern == "" {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify a search pattern",
+		}
+	}
+	if strings.ToLower(vals.Get(logSearchLiteralQueryParam)) == "true" {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrapf(err, "invalid search pattern '%s'", pattern).Error(),
+		}
+	}
+	return re, nil
+}
+
+// parseBoundedInt parses an optional integer query parameter that must be
+// between min and max.
+func parseBoundedInt(vals url.Values, name string, min, max, defaultValue int) (int, error) {
+	s := vals.Get(name)
+	if s == "" {
+		return defaultValue, nil
+	}
+	val, err := strconv.Atoi(s)
+	if err != nil || val < min || val > max {
+		return 0, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("'%s' must be an integer between %d and %d", name, min, max),
+		}
+	}
+	return val, nil
+}
+
+//////////////////////////////////////
+// GET /tasks/{task_id}/logs/search //
+//////////////////////////////////////
+
+type taskLogSearchHandler struct {
+	tsk  *task.Task
+	opts task.LogSearchOptions
+}
+
+func makeSearchTaskLogs() gimlet.RouteHandler {
+	return &taskLogSearchHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search a task's logs
+//	@Description	Searches a task's task, agent, system and test logs for lines matching a regular expression. Matches are returned with their line numbers and the surrounding lines from the same log, grouped by log type in the order task, agent, system and test logs.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/logs/search [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id				path		string	true	"Task ID."
+//	@Param			pattern				query		string	true	"The regular expression (RE2 syntax) that matching lines contain."
+//	@Param			literal				query		bool	false	"If set to true, matches the pattern as a plain string rather than a regular expression."
+//	@Param			execution			query		int		false	"The 0-based number corresponding to the execution of the task ID. Defaults to the latest execution."
+//	@Param			context				query		int		false	"The number of lines to return before and after each match, up to 10. Defaults to 0."
+//	@Param			limit				query		int		false	"The maximum number of matches to return, up to 1000. Defaults to 100."
+//	@Param			exclude_test_logs	query		bool	false	"If set to true, doesn't search the task's test logs."
+//	@Success		200					{object}	[]model.APILogSearchMatch
+func (h *taskLogSearchHandler) Factory() gimlet.RouteHandler {
+	return &taskLogSearchHandler{}
+}
+
+func (h *taskLogSearchHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+
+	var execution *int
+	if execString := vals.Get("execution"); execString != "" {
+		exec, err := strconv.Atoi(execString)
+		if err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "parsing execution").Error(),
+			}
+		}
+		execution = utility.ToIntPtr(exec)
+	}
+	taskID := gimlet.GetVars(r)["task_id"]
+	tsk, err := task.FindByIdExecution(ctx, taskID, execution)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "finding task '%s'", taskID).Error(),
+		}
+	}
+	if tsk == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", taskID),
+		}
+	}
+	if tsk.DisplayOnly {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("cannot search logs for display task '%s'", taskID),
+		}
+	}
+	h.tsk = tsk
+
+	if h.opts.Pattern, err = parseLogSearchPattern(vals); err != nil {
+		return err
+	}
+	if h.opts.ContextLines, err = parseBoundedInt(vals, "context", 0, maxLogSearchContextLines, 0); err != nil {
+		return err
+	}
+	if h.opts.MaxMatches, err = parseBoundedInt(vals, "limit", 1, maxTaskLogSearchMatches, defaultTaskLogSearchMatches); err != nil {
+		return err
+	}
+	h.opts.ExcludeTestLogs = strings.ToLower(vals.Get(logSearchExcludeTestLogParam)) == "true"
+
+	return nil
+}
+
+func (h *taskLogSearchHandler) Run(ctx context.Context) gimlet.Responder {
+	matches, err := h.tsk.SearchLogs(ctx, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "searching logs for task '%s'", h.tsk.Id))
+	}
+
+	apiMatches := make([]model.APILogSearchMatch, 0, len(matches))
+	for _, match := range matches {
+		apiMatch := model.APILogSearchMatch{}
+		apiMatch.BuildFromService(match)
+		apiMatches = append(apiMatches, apiMatch)
+	}
+
+	return gimlet.NewJSONResponse(apiMatches)
+}
+
+////////////////////////////////////////////
+// GET /projects/{project_id}/logs/search //
+////////////////////////////////////////////
+
+type projectLogSearchHandler struct {
+	opts task.ProjectLogSearchOptions
+}
+
+func makeSearchProjectLogs() gimlet.RouteHandler {
+	return &projectLogSearchHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search the logs of a project's recent tasks
+//	@Description	Searches the logs of the project's most recently finished tasks and returns the tasks that printed a line matching a regular expression, along with the first matching line in each task. Results are sorted by finish time from oldest to newest, so the first result is the earliest searched task that printed the line. Searches that take longer than 30 seconds fail with a 504 status.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/logs/search [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id			path		string		true	"The project ID."
+//	@Param			pattern				query		string		true	"The regular expression (RE2 syntax) that matching lines contain."
+//	@Param			literal				query		bool		false	"If set to true, matches the pattern as a plain string rather than a regular expression."
+//	@Param			days				query		int			false	"Only search tasks that finished in this many past days, up to 30. Defaults to 7."
+//	@Param			task_limit			query		int			false	"The number of most recently finished tasks to search, up to 50. Defaults to 20."
+//	@Param			requesters			query		[]string	false	"Only search tasks with these requesters. Valid values are patch, github_pr, github_tag, commit, trigger, ad_hoc and github_merge_queue. Defaults to all requesters."
+//	@Param			variants			query		[]string	false	"Only search tasks in these build variants. Defaults to all build variants."
+//	@Param			tasks				query		[]string	false	"Only search tasks with these display names. Defaults to all tasks."
+//	@Param			exclude_test_logs	query		bool		false	"If set to true, doesn't search the tasks' test logs."
+//	@Success		200					{object}	[]model.APIProjectLogSearchResult
+func (h *projectLogSearchHandler) Factory() gimlet.RouteHandler {
+	return &projectLogSearchHandler{}
+}
+
+func (h *projectLogSearchHandler) Parse(ctx context.Context, r *http.Request) error {
+	project := gimlet.GetVars(r)["project_id"]
+	projectID, err := dbModel.GetIdForProject(ctx, project)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "finding project '%s'", project).Error(),
+		}
+	}
+	h.opts = task.ProjectLogSearchOptions{ProjectID: projectID}
+
+	vals := r.URL.Query()
+	if h.opts.Pattern, err = parseLogSearchPattern(vals); err != nil {
+		return err
+	}
+	days, err := parseBoundedInt(vals, "days", 1, maxProjectLogSearchDays, defaultProjectLogSearchDays)
+	if err != nil {
+		return err
+	}
+	h.opts.Since = time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	if h.opts.TaskLimit, err = parseBoundedInt(vals, "task_limit", 1, task.MaxProjectLogSearchTaskLimit, task.DefaultProjectLogSearchTaskLimit); err != nil {
+		return err
+	}
+
+	for _, requester := range splitCommaSeparatedParams(vals["requesters"]) {
+		userRequester := evergreen.UserRequester(requester)
+		if err = userRequester.Validate(); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    err.Error(),
+			}
+		}
+		h.opts.Requesters = append(h.opts.Requesters, evergreen.UserRequesterToInternalRequester(userRequester))
+	}
+	h.opts.BuildVariants = splitCommaSeparatedParams(vals["variants"])
+	h.opts.TaskNames = splitCommaSeparatedParams(vals["tasks"])
+	h.opts.ExcludeTestLogs = strings.ToLower(vals.Get(logSearchExcludeTestLogParam)) == "true"
+
+	return nil
+}
+
+func (h *projectLogSearchHandler) Run(ctx context.Context) gimlet.Responder {
+	results, err := task.SearchProjectLogs(ctx, h.opts)
+	if errors.Is(err, task.ErrProjectLogSearchTimedOut) {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusGatewayTimeout,
+			Message:    errors.Wrap(err, "narrow the search with a lower task limit or by requester, variant or task").Error(),
+		})
+	}
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "searching logs for project '%s'", h.opts.ProjectID))
+	}
+
+	apiResults := make([]model.APIProjectLogSearchResult, 0, len(results))
+	for _, result := range results {
+		apiResult := model.APIProjectLogSearchResult{}
+		apiResult.BuildFromService(result)
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return gimlet.NewJSONResponse(apiResults)
+}
+
+// splitCommaSeparatedParams splits query parameter values that may each be a
+// comma-separated list.
+func splitCommaSeparatedParams(values []string) []string {
+	var parsed []string
+	for _, val := range values {
+		for _, elem := range strings.Split(val, ",") {
+			if elem != "" {
+				parsed = append(parsed, elem)
+			}
+		}
+	}
+	return parsed
+}
@@ -407,6 +407,22 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid Parsley filters"))
 	}
 
+	if err = h.newProjectRef.TestQuarantine.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid test quarantine settings"))
+	}
+
+	if err = h.newProjectRef.CostBudget.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid cost budget settings"))
+	}
+
+	if err = h.newProjectRef.LogStorage.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid log storage settings"))
+	}
+
+	if err = h.newProjectRef.ArtifactRetention.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid artifact retention settings"))
+	}
+
 	err = dbModel.ValidateBbProject(ctx, h.newProjectRef.Id, h.newProjectRef.BuildBaronSettings, &h.newProjectRef.TaskAnnotationSettings.FileTicketWebhook)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating build baron config"))
@@ -0,0 +1,131 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/tasks/{task_id}/resource_usage
+
+type taskResourceUsageHandler struct {
+	taskID    string
+	execution *int
+}
+
+func makeGetTaskResourceUsage() gimlet.RouteHandler {
+	return &taskResourceUsageHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a task's resource usage
+//	@Description	Returns the CPU, memory, disk I/O and network usage of the host a task execution ran on, sampled while the task ran and downsampled to at most a few hundred points.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/resource_usage [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id		path		string	true	"Task ID."
+//	@Param			execution	query		int		false	"The 0-based number corresponding to the execution of the task ID. Defaults to the latest execution."
+//	@Success		200			{object}	model.APITaskResourceUsage
+func (h *taskResourceUsageHandler) Factory() gimlet.RouteHandler {
+	return &taskResourceUsageHandler{}
+}
+
+func (h *taskResourceUsageHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	if execString := r.URL.Query().Get("execution"); execString != "" {
+		execution, err := strconv.Atoi(execString)
+		if err != nil {
+			return errors.Wrap(err, "parsing execution")
+		}
+		h.execution = &execution
+	}
+	return nil
+}
+
+func (h *taskResourceUsageHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindByIdExecution(ctx, h.taskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	usage, err := resourceusage.FindOneByTaskExecution(ctx, h.taskID, t.Execution)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if usage == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no resource usage for task '%s' execution %d", h.taskID, t.Execution),
+		})
+	}
+
+	res := model.APITaskResourceUsage{}
+	res.BuildFromService(*usage)
+	return gimlet.NewJSONResponse(res)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/resource_recommendations
+
+type projectResourceRecommendationsHandler struct{}
+
+func makeFetchProjectResourceRecommendations() gimlet.RouteHandler {
+	return &projectResourceRecommendationsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		List resource recommendations
+//	@Description	Returns a recommendation for each task and build variant in the project whose recent runs would fit a cheaper distro, or that are constrained by their current distro's CPU or memory. Recommendations are refreshed daily from the tasks' resource usage and the distros' cost data.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/resource_recommendations [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Success		200			{array}	model.APIResourceRecommendation
+func (h *projectResourceRecommendationsHandler) Factory() gimlet.RouteHandler {
+	return &projectResourceRecommendationsHandler{}
+}
+
+func (h *projectResourceRecommendationsHandler) Parse(ctx context.Context, r *http.Request) error {
+	return nil
+}
+
+func (h *projectResourceRecommendationsHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+
+	recs, err := resourceusage.FindRecommendationsByProject(ctx, pRef.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	res := make([]model.APIResourceRecommendation, 0, len(recs))
+	for _, rec := range recs {
+		apiRec := model.APIResourceRecommendation{}
+		apiRec.BuildFromService(rec)
+		res = append(res, apiRec)
+	}
+
+	return gimlet.NewJSONResponse(res)
+}
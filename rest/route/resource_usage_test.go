@@ -0,0 +1,154 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskResourceUsageHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	insertUsage := func(t *testing.T, execution int, distroID string) {
+		u := resourceusage.NewTaskResourceUsage(resourceusage.TaskInfo{
+			TaskID:     "t1",
+			Execution:  execution,
+			ProjectID:  "project",
+			DistroID:   distroID,
+			FinishTime: time.Now(),
+		}, apimodels.ResourceUsage{
+			NumCPUs: 4,
+			Points:  []apimodels.ResourceUsagePoint{{CPUPercent: 50, MemoryPercent: 25}},
+		})
+		require.NoError(t, u.Upsert(t.Context()))
+	}
+	runHandler := func(t *testing.T, taskID, query string) gimlet.Responder {
+		req, err := http.NewRequest(http.MethodGet, "/tasks/"+taskID+"/resource_usage"+query, nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"task_id": taskID})
+		rh := makeGetTaskResourceUsage()
+		require.NoError(t, rh.Parse(ctx, req))
+		return rh.Run(ctx)
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"ReturnsLatestExecutionByDefault": func(t *testing.T) {
+			insertUsage(t, 0, "d0")
+			insertUsage(t, 1, "d1")
+
+			resp := runHandler(t, "t1", "")
+			require.Equal(t, http.StatusOK, resp.Status())
+			usage, ok := resp.Data().(model.APITaskResourceUsage)
+			require.True(t, ok)
+			assert.Equal(t, "t1", utility.FromStringPtr(usage.TaskID))
+			assert.Equal(t, 1, usage.Execution)
+			assert.Equal(t, "d1", utility.FromStringPtr(usage.DistroID))
+			assert.Equal(t, 4, usage.NumCPUs)
+		},
+		"ReturnsRequestedExecution": func(t *testing.T) {
+			require.NoError(t, db.Insert(t.Context(), task.OldCollection, task.Task{Id: "t1_0", OldTaskId: "t1", Execution: 0}))
+			insertUsage(t, 0, "d0")
+			insertUsage(t, 1, "d1")
+
+			resp := runHandler(t, "t1", "?execution=0")
+			require.Equal(t, http.StatusOK, resp.Status())
+			usage, ok := resp.Data().(model.APITaskResourceUsage)
+			require.True(t, ok)
+			assert.Equal(t, 0, usage.Execution)
+			assert.Equal(t, "d0", utility.FromStringPtr(usage.DistroID))
+		},
+		"FailsWithoutResourceUsage": func(t *testing.T) {
+			resp := runHandler(t, "t1", "")
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+		"FailsForNonexistentTask": func(t *testing.T) {
+			resp := runHandler(t, "nonexistent", "")
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+		"FailsWithInvalidExecution": func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/tasks/t1/resource_usage?execution=latest", nil)
+			require.NoError(t, err)
+			req = gimlet.SetURLVars(req, map[string]string{"task_id": "t1"})
+			assert.Error(t, makeGetTaskResourceUsage().Parse(ctx, req))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection, resourceusage.Collection))
+			require.NoError(t, (&task.Task{Id: "t1", Execution: 1}).Insert(t.Context()))
+
+			tCase(t)
+		})
+	}
+}
+
+func TestProjectResourceRecommendationsHandler(t *testing.T) {
+	require.NoError(t, db.ClearCollections(resourceusage.RecommendationsCollection))
+
+	for _, rec := range []resourceusage.Recommendation{
+		{
+			ProjectID:         "project",
+			BuildVariant:      "variant",
+			TaskName:          "compile",
+			Direction:         resourceusage.DirectionDownsize,
+			NumExecutions:     5,
+			CurrentDistro:     resourceusage.DistroOption{DistroID: "large"},
+			RecommendedDistro: resourceusage.DistroOption{DistroID: "small"},
+		},
+		{
+			ProjectID:    "other_project",
+			BuildVariant: "variant",
+			TaskName:     "compile",
+			Direction:    resourceusage.DirectionUpsize,
+		},
+	} {
+		require.NoError(t, rec.Upsert(t.Context()))
+	}
+
+	runHandler := func(t *testing.T, projectID string) gimlet.Responder {
+		projCtx := serviceModel.Context{ProjectRef: &serviceModel.ProjectRef{Id: projectID}}
+		ctx := context.WithValue(t.Context(), RequestContext, &projCtx)
+		req, err := http.NewRequest(http.MethodGet, "/projects/"+projectID+"/resource_recommendations", nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"project_id": projectID})
+		rh := makeFetchProjectResourceRecommendations()
+		require.NoError(t, rh.Parse(ctx, req))
+		return rh.Run(ctx)
+	}
+
+	t.Run("ReturnsProjectRecommendations", func(t *testing.T) {
+		resp := runHandler(t, "project")
+		require.Equal(t, http.StatusOK, resp.Status())
+		recs, ok := resp.Data().([]model.APIResourceRecommendation)
+		require.True(t, ok)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "compile", utility.FromStringPtr(recs[0].TaskName))
+		assert.Equal(t, resourceusage.DirectionDownsize, utility.FromStringPtr(recs[0].Direction))
+		assert.Equal(t, 5, recs[0].NumExecutions)
+	})
+	t.Run("ReturnsEmptyListWithoutRecommendations", func(t *testing.T) {
+		resp := runHandler(t, "project_without_recommendations")
+		require.Equal(t, http.StatusOK, resp.Status())
+		recs, ok := resp.Data().([]model.APIResourceRecommendation)
+		require.True(t, ok)
+		assert.Empty(t, recs)
+	})
+	t.Run("FailsWithoutProject", func(t *testing.T) {
+		ctx := context.WithValue(t.Context(), RequestContext, &serviceModel.Context{})
+		rh := makeFetchProjectResourceRecommendations()
+		resp := rh.Run(ctx)
+		assert.NotEqual(t, http.StatusOK, resp.Status())
+	})
+}
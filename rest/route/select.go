@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/testselection"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
@@ -53,6 +54,10 @@ func (t *selectTestsHandler) Parse(ctx context.Context, r *http.Request) error {
 	catcher.NewWhen(t.selectTests.BuildVariant == "", "build variant is required")
 	catcher.NewWhen(t.selectTests.TaskID == "", "task ID is required")
 	catcher.NewWhen(t.selectTests.TaskName == "", "task name is required")
+	catcher.ErrorfWhen(t.selectTests.CoverageRuns < 0 || t.selectTests.CoverageRuns > testselection.MaxCoverageRuns, "coverage runs must be between 0 and %d", testselection.MaxCoverageRuns)
+	if t.env.Settings().TestSelection.URL == "" {
+		catcher.Wrap(testselection.ValidateStrategies(t.selectTests.Strategies), "invalid built-in test selection strategies")
+	}
 	return catcher.Resolve()
 }
 
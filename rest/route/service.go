@@ -92,6 +92,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/task/{task_id}/heartbeat").Version(2).Post().Wrap(requireTask, requireHost).RouteHandler(makeHeartbeat())
 	app.AddRoute("/task/{task_id}/parser_project").Version(2).Get().Wrap(requireUserOrTask).RouteHandler(makeGetParserProject(env))
 	app.AddRoute("/task/{task_id}/project_ref").Version(2).Get().Wrap(requireUserOrTask).RouteHandler(makeGetProjectRef())
+	app.AddRoute("/task/{task_id}/quarantined_tests").Version(2).Get().Wrap(requireTask).RouteHandler(makeGetTaskQuarantinedTests())
 	app.AddRoute("/task/{task_id}/s3_usage").Version(2).Post().Wrap(requireTask).RouteHandler(makeReportS3Usage())
 	app.AddRoute("/task/{task_id}/set_results_info").Version(2).Post().Wrap(requireTask).RouteHandler(makeSetTaskResultsInfoHandler())
 	app.AddRoute("/task/{task_id}/start").Version(2).Post().Wrap(requireTask, requireHost).RouteHandler(makeStartTask(env))
@@ -150,6 +151,8 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/distros/{distro_id}/setup").Version(2).Get().Wrap(requireUser, editDistroSettings).RouteHandler(makeGetDistroSetup())
 	app.AddRoute("/distros/{distro_id}/setup").Version(2).Patch().Wrap(requireUser, editDistroSettings).RouteHandler(makeChangeDistroSetup())
 	app.AddRoute("/distros/{distro_id}/copy/{new_distro_id}").Version(2).Put().Wrap(requireUser, editDistroSettings).RouteHandler(makeCopyDistro())
+	app.AddRoute("/distros/{distro_id}/scheduler_snapshot").Version(2).Get().Wrap(requireUser, editDistroSettings).RouteHandler(makeGetDistroSchedulerSnapshot())
+	app.AddRoute("/distros/{distro_id}/scheduler_simulation").Version(2).Post().Wrap(requireUser, editDistroSettings).RouteHandler(makeDistroSchedulerSimulation())
 
 	app.AddRoute("/hooks/github").Version(2).Post().Wrap(requireValidGithubPayload).RouteHandler(makeGithubHooksRoute(sc, opts.APIQueue, opts.GithubSecret, settings))
 	app.AddRoute("/hooks/aws").Version(2).Post().Wrap(requireValidSNSPayload).RouteHandler(makeEC2SNS(env, opts.APIQueue))
@@ -198,8 +201,11 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}").Version(2).Put().Wrap(requireUser, createProject).RouteHandler(makePutProjectByID(env))
 	app.AddRoute("/projects/{project_id}/copy").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyProject(env))
 	app.AddRoute("/projects/{project_id}/copy/variables").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyVariables())
+	app.AddRoute("/projects/{project_id}/bisect").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectBisectRange())
 	app.AddRoute("/projects/{project_id}/backstage_variables").Version(2).Post().Wrap(requireUser, requireBackstage).RouteHandler(makeBackstageVariablesPost())
 	app.AddRoute("/projects/{project_id}/events").Version(2).Get().Wrap(requireUser, addProject, requireProjectAdmin, viewProjectSettings).RouteHandler(makeFetchProjectEvents())
+	app.AddRoute("/projects/{project_id}/cost_report").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectCostReport())
+	app.AddRoute("/projects/{project_id}/logs/search").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeSearchProjectLogs())
 	app.AddRoute("/projects/{project_id}/patches").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makePatchesByProjectRoute())
 	app.AddRoute("/projects/{project_id}/recent_versions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchProjectVersionsLegacy())
 	app.AddRoute("/projects/{project_id}/revisions/{commit_hash}/tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeTasksByProjectAndCommitHandler(parsleyURL))
@@ -211,6 +217,12 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}/task_executions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTaskExecutionsHandler())
 	app.AddRoute("/projects/{project_id}/patch_trigger_aliases").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchPatchTriggerAliases())
 	app.AddRoute("/projects/{project_id}/parameters").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchParameters())
+	app.AddRoute("/projects/{project_id}/resource_recommendations").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchProjectResourceRecommendations())
+	app.AddRoute("/projects/{project_id}/quarantined_tests").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchQuarantinedTests())
+	app.AddRoute("/projects/{project_id}/quarantined_tests").Version(2).Post().Wrap(requireUser, addProject, editProjectSettings).RouteHandler(makeQuarantineTest())
+	app.AddRoute("/projects/{project_id}/quarantined_tests").Version(2).Delete().Wrap(requireUser, addProject, editProjectSettings).RouteHandler(makeUnquarantineTest())
+	app.AddRoute("/projects/{project_id}/test_selection/file_maps/{task_name}").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchFileTestMap())
+	app.AddRoute("/projects/{project_id}/test_selection/file_maps/{task_name}").Version(2).Put().Wrap(requireUser, addProject, editProjectSettings).RouteHandler(makePutFileTestMap())
 	app.AddRoute("/permissions").Version(2).Get().Wrap(requireUser).RouteHandler(&permissionsGetHandler{})
 	app.AddRoute("/permissions/users").Version(2).Get().Wrap(requireUser).RouteHandler(makeGetAllUsersPermissions(env.RoleManager()))
 	app.AddRoute("/roles").Version(2).Get().Wrap(requireUser).RouteHandler(acl.NewGetAllRolesHandler(env.RoleManager()))
@@ -223,6 +235,8 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/subscriptions").Version(2).Delete().Wrap(requireUser).RouteHandler(makeDeleteSubscription())
 	app.AddRoute("/subscriptions").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchSubscription())
 	app.AddRoute("/subscriptions").Version(2).Post().Wrap(requireUser).RouteHandler(makeSetSubscription())
+	app.AddRoute("/subscriptions/{subscription_id}/webhook_deliveries").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchWebhookDeliveries())
+	app.AddRoute("/subscriptions/{subscription_id}/webhook_deliveries/{notification_id}/replay").Version(2).Post().Wrap(requireUser).RouteHandler(makeReplayWebhookDelivery())
 	app.AddRoute("/tasks/{task_id}").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTaskRoute(parsleyURL, opts.URL))
 	app.AddRoute("/tasks/{task_id}").Version(2).Patch().Wrap(requireUser, addProject, editTasks).RouteHandler(makeModifyTaskRoute())
 	app.AddRoute("/tasks/{task_id}/artifacts/url").Version(2).Patch().Wrap(requireUser, addProject, requireProjectAdmin, editTasks).RouteHandler(makeUpdateArtifactURLRoute())
@@ -238,6 +252,8 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/tasks/{task_id}/generated_tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetGeneratedTasks())
 	app.AddRoute("/tasks/{task_id}/build/TaskLogs").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTaskLogs(opts.URL))
 	app.AddRoute("/tasks/{task_id}/build/TestLogs/{path}").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTestLogs(opts.URL))
+	app.AddRoute("/tasks/{task_id}/logs/search").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeSearchTaskLogs())
+	app.AddRoute("/tasks/{task_id}/resource_usage").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTaskResourceUsage())
 	app.AddRoute("/tasks/{task_id}/github_dynamic_access_tokens").Version(2).Delete().Wrap(requireUser, viewTasks).RouteHandler(makeDeleteGitHubDynamicAccessTokens())
 	app.AddRoute("/user/settings").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchUserConfig())
 	app.AddRoute("/user/settings").Version(2).Post().Wrap(requireUser).RouteHandler(makeSetUserConfig())
@@ -257,6 +273,10 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/versions/{version_id}/abort").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeAbortVersion())
 	app.AddRoute("/versions/{version_id}/activate_tasks").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeActivateVersionTasks())
 	app.AddRoute("/versions/{version_id}/builds").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionBuilds(env))
+	app.AddRoute("/versions/{version_id}/critical_path").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionCriticalPath())
+	app.AddRoute("/versions/{version_id}/pin_artifacts").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makePinVersionArtifacts(true))
+	app.AddRoute("/versions/{version_id}/unpin_artifacts").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makePinVersionArtifacts(false))
+	app.AddRoute("/versions/{version_id}/generate_dry_run").Version(2).Post().Wrap(requireUser, viewTasks).RouteHandler(makeGenerateTasksDryRunHandler(env))
 	app.AddRoute("/versions/{version_id}/restart").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeRestartVersion())
 	app.AddRoute("/versions/{version_id}/annotations").Version(2).Get().Wrap(requireUser, viewAnnotations).RouteHandler(makeFetchAnnotationsByVersion())
 	app.AddRoute("/versions/{version_id}/manifest").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionManifest())
@@ -135,3 +135,96 @@ func (s *subscriptionDeleteHandler) Run(ctx context.Context) gimlet.Responder {
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/subscriptions/{subscription_id}/webhook_deliveries
+
+type webhookDeliveriesGetHandler struct {
+	subscriptionID string
+	status         string
+}
+
+func makeFetchWebhookDeliveries() gimlet.RouteHandler {
+	return &webhookDeliveriesGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		List webhook deliveries
+//	@Description	Returns the most recent delivery logs for a webhook subscription, including every delivery attempt's response code and latency. The user must own the subscription or be able to edit the settings of the project that owns it.
+//	@Tags			subscriptions
+//	@Router			/subscriptions/{subscription_id}/webhook_deliveries [get]
+//	@Security		Api-User || Api-Key
+//	@Param			subscription_id	path		string	true	"the subscription ID"
+//	@Param			status			query		string	false	"only return deliveries with this status (succeeded, dead-letter or replaying)"
+//	@Success		200				{array}		model.APIWebhookDelivery
+func (h *webhookDeliveriesGetHandler) Factory() gimlet.RouteHandler {
+	return &webhookDeliveriesGetHandler{}
+}
+
+func (h *webhookDeliveriesGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.subscriptionID = gimlet.GetVars(r)["subscription_id"]
+	if h.subscriptionID == "" {
+		return errors.New("must specify a subscription ID")
+	}
+	h.status = r.FormValue("status")
+
+	return nil
+}
+
+func (h *webhookDeliveriesGetHandler) Run(ctx context.Context) gimlet.Responder {
+	deliveries, err := data.GetWebhookDeliveries(ctx, MustHaveUser(ctx), h.subscriptionID, h.status)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting webhook deliveries for subscription '%s'", h.subscriptionID))
+	}
+
+	return gimlet.NewJSONResponse(deliveries)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/subscriptions/{subscription_id}/webhook_deliveries/{notification_id}/replay
+
+type webhookDeliveryReplayHandler struct {
+	subscriptionID string
+	notificationID string
+}
+
+func makeReplayWebhookDelivery() gimlet.RouteHandler {
+	return &webhookDeliveryReplayHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Replay a webhook delivery
+//	@Description	Sends a dead-lettered webhook notification again. The user must own the subscription or be able to edit the settings of the project that owns it.
+//	@Tags			subscriptions
+//	@Router			/subscriptions/{subscription_id}/webhook_deliveries/{notification_id}/replay [post]
+//	@Security		Api-User || Api-Key
+//	@Param			subscription_id	path		string	true	"the subscription ID"
+//	@Param			notification_id	path		string	true	"the ID of the notification to replay"
+//	@Success		200				{object}	model.APIWebhookDelivery
+func (h *webhookDeliveryReplayHandler) Factory() gimlet.RouteHandler {
+	return &webhookDeliveryReplayHandler{}
+}
+
+func (h *webhookDeliveryReplayHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.subscriptionID = vars["subscription_id"]
+	h.notificationID = vars["notification_id"]
+	if h.subscriptionID == "" || h.notificationID == "" {
+		return errors.New("must specify a subscription ID and notification ID")
+	}
+
+	return nil
+}
+
+func (h *webhookDeliveryReplayHandler) Run(ctx context.Context) gimlet.Responder {
+	delivery, err := data.ReplayWebhookDelivery(ctx, MustHaveUser(ctx), h.subscriptionID, h.notificationID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "replaying webhook delivery '%s'", h.notificationID))
+	}
+
+	return gimlet.NewJSONResponse(delivery)
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/units"
+	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -123,3 +125,65 @@ func (h *generatePollHandler) Run(ctx context.Context) gimlet.Responder {
 		Error:    jobErr,
 	})
 }
+
+// POST /versions/{version_id}/generate_dry_run
+
+func makeGenerateTasksDryRunHandler(env evergreen.Environment) gimlet.RouteHandler {
+	return &generateDryRunHandler{env: env}
+}
+
+type generateDryRunHandler struct {
+	versionID string
+	input     model.APIGenerateTasksDryRunRequest
+	env       evergreen.Environment
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Dry run generate.tasks
+//	@Description	Runs JSON files for generate.tasks through the same merge and validation steps that the given generator task would use against the version's project, without saving anything. Returns the build variants, tasks, dependencies, and display tasks that would be added, along with any validation errors.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/generate_dry_run [post]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string								true	"version ID"
+//	@Param			{object}	body		model.APIGenerateTasksDryRunRequest	true	"parameters"
+//	@Success		200			{object}	model.APIGenerateTasksDryRun
+func (h *generateDryRunHandler) Factory() gimlet.RouteHandler {
+	return &generateDryRunHandler{env: h.env}
+}
+
+func (h *generateDryRunHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+	if err := utility.ReadJSON(r.Body, &h.input); err != nil {
+		return errors.Wrap(err, "reading generate dry run request from JSON request body")
+	}
+	if h.input.TaskID == "" {
+		return errors.New("must specify the ID of the task that runs generate.tasks")
+	}
+	if len(h.input.Files) == 0 {
+		return errors.New("must specify at least one generate.tasks JSON file")
+	}
+
+	err := validateFileSize(h.input.Files, h.env.Settings().TaskLimits.MaxGenerateTaskJSONSize)
+	return errors.Wrap(err, "validating JSON size")
+}
+
+func (h *generateDryRunHandler) Run(ctx context.Context) gimlet.Responder {
+	diff, validationErrs, err := data.GenerateTasksDryRun(ctx, h.env.Settings(), h.versionID, h.input.TaskID, h.input.Files)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "dry running generate tasks for version '%s'", h.versionID))
+	}
+
+	dryRun := &model.APIGenerateTasksDryRun{}
+	dryRun.BuildFromService(*diff)
+	for _, validationErr := range validationErrs {
+		switch validationErr.Level {
+		case validator.Error:
+			dryRun.Errors = append(dryRun.Errors, validationErr.Message)
+		case validator.Warning:
+			dryRun.Warnings = append(dryRun.Warnings, validationErr.Message)
+		}
+	}
+
+	return gimlet.NewJSONResponse(dryRun)
+}
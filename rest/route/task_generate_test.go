@@ -12,9 +12,13 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/mock"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
@@ -221,3 +225,108 @@ func TestGeneratePollRun(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.Status())
 	require.False(t, resp.Data().(*apimodels.GeneratePollResponse).Finished)
 }
+
+func TestGenerateDryRunParse(t *testing.T) {
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(t.Context()))
+
+	for tName, tCase := range map[string]struct {
+		body    string
+		isValid bool
+	}{
+		"Valid":          {body: `{"task_id": "generator", "files": [{"tasks": []}]}`, isValid: true},
+		"MissingTaskID":  {body: `{"files": [{"tasks": []}]}`},
+		"MissingFiles":   {body: `{"task_id": "generator"}`},
+		"InvalidRequest": {body: `not json`},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			r, err := http.NewRequest(http.MethodPost, "/versions/version/generate_dry_run", bytes.NewBufferString(tCase.body))
+			require.NoError(t, err)
+			r = gimlet.SetURLVars(r, map[string]string{"version_id": "version"})
+
+			h := makeGenerateTasksDryRunHandler(env).Factory()
+			err = h.Parse(t.Context(), r)
+			if tCase.isValid {
+				require.NoError(t, err)
+				impl, ok := h.(*generateDryRunHandler)
+				require.True(t, ok)
+				assert.Equal(t, "version", impl.versionID)
+				assert.Equal(t, "generator", impl.input.TaskID)
+				assert.Len(t, impl.input.Files, 1)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateDryRunRun(t *testing.T) {
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(t.Context()))
+	require.NoError(t, db.ClearCollections(serviceModel.VersionCollection, serviceModel.ParserProjectCollection, serviceModel.ProjectRefCollection, task.Collection, distro.Collection))
+
+	v := serviceModel.Version{
+		Id:         "version",
+		Identifier: "project",
+		Requester:  evergreen.RepotrackerVersionRequester,
+	}
+	require.NoError(t, v.Insert(t.Context()))
+	pp := serviceModel.ParserProject{}
+	require.NoError(t, util.UnmarshalYAMLWithFallback([]byte(`
+tasks:
+  - name: generator
+buildvariants:
+  - name: bv
+    display_name: Variant
+    run_on:
+      - distro
+    tasks:
+      - name: generator
+`), &pp))
+	pp.Id = v.Id
+	require.NoError(t, pp.Insert(t.Context()))
+	require.NoError(t, (&serviceModel.ProjectRef{Id: "project"}).Insert(t.Context()))
+	require.NoError(t, (&distro.Distro{Id: "distro"}).Insert(t.Context()))
+	generator := task.Task{
+		Id:           "generator",
+		Version:      v.Id,
+		Project:      "project",
+		BuildVariant: "bv",
+		DisplayName:  "generator",
+		Status:       evergreen.TaskStarted,
+		Requester:    evergreen.RepotrackerVersionRequester,
+	}
+	require.NoError(t, generator.Insert(t.Context()))
+
+	run := func(t *testing.T, taskID, file string) gimlet.Responder {
+		h := &generateDryRunHandler{
+			env:       env,
+			versionID: v.Id,
+			input: model.APIGenerateTasksDryRunRequest{
+				TaskID: taskID,
+				Files:  []json.RawMessage{json.RawMessage(file)},
+			},
+		}
+		return h.Run(t.Context())
+	}
+
+	t.Run("ReturnsAddedTasks", func(t *testing.T) {
+		resp := run(t, generator.Id, `{"tasks": [{"name": "new_task"}], "buildvariants": [{"name": "bv", "tasks": [{"name": "new_task"}]}]}`)
+		require.Equal(t, http.StatusOK, resp.Status())
+		dryRun, ok := resp.Data().(*model.APIGenerateTasksDryRun)
+		require.True(t, ok)
+		assert.Empty(t, dryRun.Errors)
+		assert.Equal(t, []serviceModel.TVPair{{Variant: "bv", TaskName: "new_task"}}, dryRun.Tasks)
+	})
+	t.Run("ReturnsValidationErrors", func(t *testing.T) {
+		resp := run(t, generator.Id, `{"tasks": [{"name": "new_task"}], "buildvariants": [{"name": "bv", "tasks": [{"name": "new_task", "depends_on": [{"name": "nonexistent"}]}]}]}`)
+		require.Equal(t, http.StatusOK, resp.Status())
+		dryRun, ok := resp.Data().(*model.APIGenerateTasksDryRun)
+		require.True(t, ok)
+		assert.NotEmpty(t, dryRun.Errors)
+	})
+	t.Run("FailsForNonexistentTask", func(t *testing.T) {
+		resp := run(t, "nonexistent", `{"tasks": []}`)
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+}
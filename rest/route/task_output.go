@@ -22,6 +22,7 @@ type getTaskOutputLogsBaseHandler struct {
 	end           *int64
 	lineLimit     int
 	tailN         int
+	fieldFilters  []log.FieldFilter
 	printTime     bool
 	printPriority bool
 	paginate      bool
@@ -89,6 +90,13 @@ func (h *getTaskOutputLogsBaseHandler) parse(ctx context.Context, r *http.Reques
 			return errors.Wrap(err, "parsing tail limit")
 		}
 	}
+	for _, filter := range vals["field_filter"] {
+		fieldFilter, err := log.ParseFieldFilter(filter)
+		if err != nil {
+			return errors.Wrap(err, "parsing field filter")
+		}
+		h.fieldFilters = append(h.fieldFilters, fieldFilter)
+	}
 
 	h.printTime = strings.ToLower(vals.Get("print_time")) == "true"
 	h.printPriority = strings.ToLower(vals.Get("print_priority")) == "true"
@@ -186,6 +194,7 @@ func makeGetTaskLogs(url string) *getTaskLogsHandler {
 //	@Param			end				query		string	false	"End of targeted time interval (inclusive) in RFC3339 format. Defaults to the last timestamp of the requested logs."
 //	@Param			line_limit		query		int		false	"If set greater than 0, limits the number of log lines returned."
 //	@Param			tail_limit		query		int		false	"If set greater than 0, returns the last N log lines."
+//	@Param			field_filter	query		string	false	"Filter for structured (JSON) log lines in the form `<field><operator><value>`, where the operator is one of `=`, `!=`, `>`, `>=`, `<`, `<=`, e.g. `component=replset` or `level>=warn`. Nested fields are separated by dots. Only structured lines matching every filter are returned. Repeat the parameter key if more than one value."
 //	@Param			print_time		query		bool	false	"If set to true, returns log lines prefixed with their timestamp."
 //	@Param			print_priority	query		bool	false	"If set to true, returns log lines prefixed with their priority."
 //	@Param			paginate		query		bool	false	"If set to true, paginates the response."
@@ -212,11 +221,12 @@ func (h *getTaskLogsHandler) Parse(ctx context.Context, r *http.Request) error {
 
 func (h *getTaskLogsHandler) Run(ctx context.Context) gimlet.Responder {
 	it, err := h.tsk.GetTaskLogs(ctx, task.TaskLogGetOptions{
-		LogType:   h.logType,
-		Start:     h.start,
-		End:       h.end,
-		LineLimit: h.lineLimit,
-		TailN:     h.tailN,
+		LogType:      h.logType,
+		Start:        h.start,
+		End:          h.end,
+		LineLimit:    h.lineLimit,
+		TailN:        h.tailN,
+		FieldFilters: h.fieldFilters,
 	})
 	if err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting task logs"))
@@ -253,6 +263,7 @@ func makeGetTestLogs(url string) *getTestLogsHandler {
 //	@Param			end				query		string	false	"End of targeted time interval (inclusive) in RFC3339 format. Defaults to the last timestamp of the test log specified in the URL path."
 //	@Param			line_limit		query		int		false	"If set greater than 0, limits the number of log lines returned."
 //	@Param			tail_limit		query		int		false	"If set greater than 0, returns the last N log lines."
+//	@Param			field_filter	query		string	false	"Filter for structured (JSON) log lines in the form `<field><operator><value>`, where the operator is one of `=`, `!=`, `>`, `>=`, `<`, `<=`, e.g. `component=replset` or `level>=warn`. Nested fields are separated by dots. Only structured lines matching every filter are returned. Repeat the parameter key if more than one value."
 //	@Param			print_time		query		bool	false	"If set to true, returns log lines prefixed with their timestamp."
 //	@Param			print_priority	query		bool	false	"If set to true, returns log lines prefixed with their priority."
 //	@Param			paginate		query		bool	false	"If set to true, paginates the response."
@@ -275,11 +286,12 @@ func (h *getTestLogsHandler) Parse(ctx context.Context, r *http.Request) error {
 
 func (h *getTestLogsHandler) Run(ctx context.Context) gimlet.Responder {
 	it, err := h.tsk.GetTestLogs(ctx, task.TestLogGetOptions{
-		LogPaths:  h.logPaths,
-		Start:     h.start,
-		End:       h.end,
-		LineLimit: h.lineLimit,
-		TailN:     h.tailN,
+		LogPaths:     h.logPaths,
+		Start:        h.start,
+		End:          h.end,
+		LineLimit:    h.lineLimit,
+		TailN:        h.tailN,
+		FieldFilters: h.fieldFilters,
 	})
 	if err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting task logs"))
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/gimlet"
@@ -94,6 +95,13 @@ func TestGetTaskOutputLogsBaseHandlerParse(t *testing.T) {
 			hasErr:   true,
 			errCode:  400,
 		},
+		{
+			name:     "InvalidFieldFilter",
+			taskID:   "task",
+			urlQuery: "field_filter=component",
+			hasErr:   true,
+			errCode:  400,
+		},
 		{
 			name:     "LineLimitAndTailLimitSet",
 			taskID:   "task",
@@ -166,6 +174,19 @@ func TestGetTaskOutputLogsBaseHandlerParse(t *testing.T) {
 				paginate:      true,
 			},
 		},
+		{
+			name:     "ValidParametersWithFieldFilters",
+			taskID:   "task",
+			urlQuery: "field_filter=component%3Dreplset&field_filter=level%3E%3Dwarn&tail_limit=100",
+			expected: &getTaskOutputLogsBaseHandler{
+				tsk:   task1,
+				tailN: 100,
+				fieldFilters: []log.FieldFilter{
+					{Field: "component", Operator: log.FieldFilterEqual, Value: "replset"},
+					{Field: "level", Operator: log.FieldFilterGreaterThanOrEqual, Value: "warn"},
+				},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/%s/build/task_logs?%s", test.taskID, test.urlQuery))
@@ -197,6 +218,7 @@ func TestGetTaskOutputLogsBaseHandlerParse(t *testing.T) {
 				assert.Equal(t, test.expected.end, rh.end)
 				assert.Equal(t, test.expected.lineLimit, rh.lineLimit)
 				assert.Equal(t, test.expected.tailN, rh.tailN)
+				assert.Equal(t, test.expected.fieldFilters, rh.fieldFilters)
 				assert.Equal(t, test.expected.printTime, rh.printTime)
 				assert.Equal(t, test.expected.printPriority, rh.printPriority)
 				assert.Equal(t, test.expected.paginate, rh.paginate)
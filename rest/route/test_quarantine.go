@@ -0,0 +1,242 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/quarantined_tests
+
+type quarantinedTestsGetHandler struct{}
+
+func makeFetchQuarantinedTests() gimlet.RouteHandler {
+	return &quarantinedTestsGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		List quarantined tests
+//	@Description	Returns the tests quarantined in a project. Failures of quarantined tests are recorded with the status "quarantined-failed" and do not fail the task.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/quarantined_tests [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Success		200			{array}	model.APIQuarantinedTest
+func (h *quarantinedTestsGetHandler) Factory() gimlet.RouteHandler {
+	return &quarantinedTestsGetHandler{}
+}
+
+func (h *quarantinedTestsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	return nil
+}
+
+func (h *quarantinedTestsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+
+	qts, err := testquarantine.FindByProject(ctx, pRef.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding quarantined tests for project '%s'", pRef.Id))
+	}
+
+	res := make([]model.APIQuarantinedTest, 0, len(qts))
+	for _, qt := range qts {
+		apiQT := model.APIQuarantinedTest{}
+		apiQT.BuildFromService(qt)
+		res = append(res, apiQT)
+	}
+
+	return gimlet.NewJSONResponse(res)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/projects/{project_id}/quarantined_tests
+
+type quarantinedTestPostHandler struct {
+	test model.APIQuarantinedTest
+}
+
+func makeQuarantineTest() gimlet.RouteHandler {
+	return &quarantinedTestPostHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Quarantine a test
+//	@Description	Quarantines a test in a project. If the test is already quarantined, its reason is updated.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/quarantined_tests [post]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string						true	"the project ID"
+//	@Param			{object}	body	model.APIQuarantinedTest	true	"parameters"
+//	@Success		200			{object}	model.APIQuarantinedTest
+func (h *quarantinedTestPostHandler) Factory() gimlet.RouteHandler {
+	return &quarantinedTestPostHandler{}
+}
+
+func (h *quarantinedTestPostHandler) Parse(ctx context.Context, r *http.Request) error {
+	if err := utility.ReadJSON(r.Body, &h.test); err != nil {
+		return errors.Wrap(err, "reading quarantined test from JSON request body")
+	}
+	if utility.FromStringPtr(h.test.TestName) == "" {
+		return gimlet.ErrorResponse{
+			Message:    "test name must be specified",
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+func (h *quarantinedTestPostHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+	u := MustHaveUser(ctx)
+
+	qt := testquarantine.QuarantinedTest{
+		ProjectID: pRef.Id,
+		TestName:  utility.FromStringPtr(h.test.TestName),
+		Source:    testquarantine.SourceManual,
+		Reason:    utility.FromStringPtr(h.test.Reason),
+		CreatedBy: u.Username(),
+	}
+	if err := qt.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	dbQT, err := testquarantine.FindOneByProjectAndTestName(ctx, qt.ProjectID, qt.TestName)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if dbQT == nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Errorf("quarantined test '%s' not found after upsert", qt.TestName))
+	}
+
+	res := model.APIQuarantinedTest{}
+	res.BuildFromService(*dbQT)
+	return gimlet.NewJSONResponse(res)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/projects/{project_id}/quarantined_tests
+
+type quarantinedTestDeleteHandler struct {
+	testName string
+}
+
+func makeUnquarantineTest() gimlet.RouteHandler {
+	return &quarantinedTestDeleteHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Remove a test from quarantine
+//	@Description	Removes a test from a project's quarantine so that its failures fail the task again.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/quarantined_tests [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Param			test_name	query	string	true	"the name of the test to remove from quarantine"
+//	@Success		200
+func (h *quarantinedTestDeleteHandler) Factory() gimlet.RouteHandler {
+	return &quarantinedTestDeleteHandler{}
+}
+
+func (h *quarantinedTestDeleteHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.testName = r.URL.Query().Get("test_name")
+	if h.testName == "" {
+		return gimlet.ErrorResponse{
+			Message:    "test name must be specified",
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+func (h *quarantinedTestDeleteHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+
+	qt, err := testquarantine.FindOneByProjectAndTestName(ctx, pRef.Id, h.testName)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if qt == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("test '%s' is not quarantined in project '%s'", h.testName, pRef.Id),
+		})
+	}
+
+	if err := testquarantine.Remove(ctx, pRef.Id, h.testName); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/task/{task_id}/quarantined_tests
+
+type taskQuarantinedTestsGetHandler struct {
+	taskID string
+}
+
+func makeGetTaskQuarantinedTests() gimlet.RouteHandler {
+	return &taskQuarantinedTestsGetHandler{}
+}
+
+func (h *taskQuarantinedTestsGetHandler) Factory() gimlet.RouteHandler {
+	return &taskQuarantinedTestsGetHandler{}
+}
+
+func (h *taskQuarantinedTestsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	return nil
+}
+
+// Run returns the names of the tests quarantined in the task's project.
+func (h *taskQuarantinedTestsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	names, err := testquarantine.FindTestNamesByProject(ctx, t.Project)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding quarantined tests for project '%s'", t.Project))
+	}
+
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+
+	return gimlet.NewJSONResponse(res)
+}
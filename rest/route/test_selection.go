@@ -0,0 +1,127 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/testselection"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/test_selection/file_maps/{task_name}
+
+type fileTestMapGetHandler struct {
+	taskName string
+}
+
+func makeFetchFileTestMap() gimlet.RouteHandler {
+	return &fileTestMapGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a task's file test map
+//	@Description	Returns the map from files in the project's repository to the tests in the task that exercise them. The map is used by the built-in ChangedFiles test selection strategy.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_selection/file_maps/{task_name} [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"the project ID"
+//	@Param			task_name	path		string	true	"the display name of the task"
+//	@Success		200			{object}	model.APIFileTestMap
+func (h *fileTestMapGetHandler) Factory() gimlet.RouteHandler {
+	return &fileTestMapGetHandler{}
+}
+
+func (h *fileTestMapGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskName = gimlet.GetVars(r)["task_name"]; h.taskName == "" {
+		return errors.New("missing task name")
+	}
+	return nil
+}
+
+func (h *fileTestMapGetHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+
+	m, err := testselection.FindFileTestMap(ctx, pRef.Id, h.taskName)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if m == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no file test map for task '%s' in project '%s'", h.taskName, pRef.Id),
+		})
+	}
+
+	res := model.APIFileTestMap{}
+	res.BuildFromService(*m)
+	return gimlet.NewJSONResponse(res)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// PUT /rest/v2/projects/{project_id}/test_selection/file_maps/{task_name}
+
+type fileTestMapPutHandler struct {
+	taskName string
+	fileMap  model.APIFileTestMap
+}
+
+func makePutFileTestMap() gimlet.RouteHandler {
+	return &fileTestMapPutHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Set a task's file test map
+//	@Description	Replaces the map from files in the project's repository to the tests in the task that exercise them. Files may be patterns such as "src/db/*.go". The map is used by the built-in ChangedFiles test selection strategy.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_selection/file_maps/{task_name} [put]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string					true	"the project ID"
+//	@Param			task_name	path		string					true	"the display name of the task"
+//	@Param			{object}	body		model.APIFileTestMap	true	"parameters"
+//	@Success		200			{object}	model.APIFileTestMap
+func (h *fileTestMapPutHandler) Factory() gimlet.RouteHandler {
+	return &fileTestMapPutHandler{}
+}
+
+func (h *fileTestMapPutHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskName = gimlet.GetVars(r)["task_name"]; h.taskName == "" {
+		return errors.New("missing task name")
+	}
+	if err := utility.ReadJSON(r.Body, &h.fileMap); err != nil {
+		return errors.Wrap(err, "reading file test map from JSON request body")
+	}
+	return nil
+}
+
+func (h *fileTestMapPutHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	if pRef == nil {
+		return gimlet.MakeJSONErrorResponder(errors.New("project not found"))
+	}
+
+	m := h.fileMap.ToService()
+	m.ProjectID = pRef.Id
+	m.TaskName = h.taskName
+	if err := m.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid file test map"))
+	}
+	if err := m.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	res := model.APIFileTestMap{}
+	res.BuildFromService(m)
+	return gimlet.NewJSONResponse(res)
+}
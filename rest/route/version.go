@@ -347,6 +347,70 @@ func (h *versionRestartHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(versionModel)
 }
 
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/versions/{version_id}/pin_artifacts
+// POST /rest/v2/versions/{version_id}/unpin_artifacts
+
+// versionPinArtifactsHandler is a RequestHandler for pinning or unpinning a
+// version's artifacts.
+type versionPinArtifactsHandler struct {
+	versionId string
+	pinned    bool
+}
+
+func makePinVersionArtifacts(pinned bool) gimlet.RouteHandler {
+	return &versionPinArtifactsHandler{pinned: pinned}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Pin or unpin a version's artifacts
+//	@Description	Pinning a version keeps the files that its tasks uploaded with s3.put regardless of the project's artifact retention policy. Unpinning it lets the retention policy delete them again. Returns the version.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/pin_artifacts [post]
+//	@Router			/versions/{version_id}/unpin_artifacts [post]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string	true	"version ID"
+//	@Success		200			{object}	model.APIVersion
+func (h *versionPinArtifactsHandler) Factory() gimlet.RouteHandler {
+	return &versionPinArtifactsHandler{pinned: h.pinned}
+}
+
+// Parse fetches the versionId from the http request.
+func (h *versionPinArtifactsHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionId = gimlet.GetVars(r)["version_id"]
+
+	if h.versionId == "" {
+		return errors.New("missing version ID")
+	}
+
+	return nil
+}
+
+// Run sets whether the version's artifacts are pinned.
+func (h *versionPinArtifactsHandler) Run(ctx context.Context) gimlet.Responder {
+	foundVersion, err := dbModel.VersionFindOneId(ctx, h.versionId)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionId))
+	}
+	if foundVersion == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionId),
+		})
+	}
+
+	if err = dbModel.SetArtifactsPinned(ctx, h.versionId, h.pinned); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "setting artifacts pinned for version '%s'", h.versionId))
+	}
+	foundVersion.ArtifactsPinned = h.pinned
+
+	versionModel := &restModel.APIVersion{}
+	versionModel.BuildFromService(ctx, *foundVersion)
+	return gimlet.NewJSONResponse(versionModel)
+}
+
 ////////////////////////////////////////////////////////////////////////
 //
 // POST /rest/v2/versions/{version_id}/activate_tasks
@@ -514,3 +578,61 @@ func (h *versionManifestGetHandler) Run(ctx context.Context) gimlet.Responder {
 	apiMfst.BuildFromService(mfst)
 	return gimlet.NewJSONResponse(apiMfst)
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// Handler for the critical path of a version
+//
+//    /versions/{version_id}/critical_path
+
+type versionCriticalPathGetHandler struct {
+	versionId string
+}
+
+func makeGetVersionCriticalPath() gimlet.RouteHandler {
+	return &versionCriticalPathGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Fetch critical path by version ID
+//	@Description	Fetches the longest chain of dependent tasks in the version, along with how much wall-clock time each task on it contributed to the version's makespan. Tasks that have not finished are weighted by their expected duration.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/critical_path [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string	true	"version ID"
+//	@Success		200			{object}	model.APICriticalPath
+func (h *versionCriticalPathGetHandler) Factory() gimlet.RouteHandler {
+	return &versionCriticalPathGetHandler{}
+}
+
+// Parse fetches the versionId from the http request.
+func (h *versionCriticalPathGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionId = gimlet.GetVars(r)["version_id"]
+	if h.versionId == "" {
+		return errors.New("missing version ID")
+	}
+	return nil
+}
+
+func (h *versionCriticalPathGetHandler) Run(ctx context.Context) gimlet.Responder {
+	v, err := dbModel.VersionFindOneId(ctx, h.versionId)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionId))
+	}
+	if v == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionId),
+		})
+	}
+
+	cp, err := task.VersionCriticalPath(ctx, v.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "computing critical path for version '%s'", h.versionId))
+	}
+
+	apiCP := &restModel.APICriticalPath{}
+	apiCP.BuildFromService(*cp)
+	return gimlet.NewJSONResponse(apiCP)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
@@ -16,6 +17,8 @@ import (
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -294,6 +297,30 @@ func (s *VersionSuite) TestRestartVersion() {
 	s.Equal(evergreen.VersionStarted, v.Status)
 }
 
+// TestPinVersionArtifacts tests the routes for pinning and unpinning a
+// version's artifacts.
+func (s *VersionSuite) TestPinVersionArtifacts() {
+	for _, pinned := range []bool{true, false} {
+		handler := makePinVersionArtifacts(pinned).Factory().(*versionPinArtifactsHandler)
+		handler.versionId = "versionId"
+
+		res := handler.Run(s.ctx)
+		s.NotNil(res)
+		s.Equal(http.StatusOK, res.Status())
+		h, ok := res.Data().(*model.APIVersion)
+		s.True(ok)
+		s.Equal(pinned, h.ArtifactsPinned)
+
+		v, err := serviceModel.VersionFindOneId(s.ctx, "versionId")
+		s.NoError(err)
+		s.Equal(pinned, v.ArtifactsPinned)
+	}
+
+	handler := &versionPinArtifactsHandler{versionId: "nonexistent", pinned: true}
+	res := handler.Run(s.ctx)
+	s.Equal(http.StatusNotFound, res.Status())
+}
+
 // TestActivateVersionTasks tests the route for activating specific tasks in a version.
 func (s *VersionSuite) TestActivateVersionTasks() {
 	ctx := gimlet.AttachUser(s.ctx, &user.DBUser{Id: "caller1"})
@@ -439,3 +466,86 @@ func (s *VersionSuite) TestGetManifestForVersionErrorsForNonexistentVersion() {
 	s.NotNil(res)
 	s.Equal(http.StatusNotFound, res.Status())
 }
+
+func TestGetVersionCriticalPath(t *testing.T) {
+	require.NoError(t, db.ClearCollections(task.Collection, serviceModel.VersionCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, serviceModel.VersionCollection))
+	}()
+
+	v := serviceModel.Version{Id: "critical_path_version"}
+	require.NoError(t, v.Insert(t.Context()))
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	tasks := []task.Task{
+		{
+			Id:            "compile",
+			Version:       v.Id,
+			DisplayName:   "compile",
+			BuildVariant:  "bv",
+			Activated:     true,
+			ActivatedTime: start,
+			Status:        evergreen.TaskSucceeded,
+			StartTime:     start.Add(time.Minute),
+			FinishTime:    start.Add(10 * time.Minute),
+		},
+		{
+			Id:               "test",
+			Version:          v.Id,
+			DisplayName:      "test",
+			BuildVariant:     "bv",
+			Activated:        true,
+			ActivatedTime:    start,
+			Status:           evergreen.TaskUndispatched,
+			DependsOn:        []task.Dependency{{TaskId: "compile", Status: evergreen.TaskSucceeded}},
+			DisplayTaskId:    utility.ToStringPtr("display"),
+			ExpectedDuration: 20 * time.Minute,
+		},
+		{
+			// The display task would be the last task to finish if it were
+			// included in the critical path.
+			Id:               "display",
+			Version:          v.Id,
+			DisplayName:      "display",
+			BuildVariant:     "bv",
+			Activated:        true,
+			ActivatedTime:    start,
+			Status:           evergreen.TaskStarted,
+			DisplayOnly:      true,
+			ExecutionTasks:   []string{"test"},
+			StartTime:        start,
+			ExpectedDuration: 5 * time.Hour,
+		},
+	}
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert(t.Context()))
+	}
+
+	t.Run("ReturnsCriticalPath", func(t *testing.T) {
+		handler := &versionCriticalPathGetHandler{versionId: v.Id}
+		resp := handler.Run(t.Context())
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		cp, ok := resp.Data().(*model.APICriticalPath)
+		require.True(t, ok)
+		assert.True(t, cp.Estimated)
+		require.Len(t, cp.Tasks, 2, "display task should not be on the critical path")
+		assert.Equal(t, "compile", utility.FromStringPtr(cp.Tasks[0].TaskID))
+		assert.False(t, cp.Tasks[0].Estimated)
+		assert.Equal(t, model.NewAPIDuration(9*time.Minute), cp.Tasks[0].ExecutionTime)
+		assert.Equal(t, "test", utility.FromStringPtr(cp.Tasks[1].TaskID))
+		assert.True(t, cp.Tasks[1].Estimated)
+		assert.Equal(t, model.NewAPIDuration(20*time.Minute), cp.Tasks[1].ExecutionTime, "unfinished task should use its stored expected duration")
+
+		dbTask, err := task.FindOneId(t.Context(), "test")
+		require.NoError(t, err)
+		require.NotNil(t, dbTask)
+		assert.Equal(t, 20*time.Minute, dbTask.ExpectedDuration)
+		assert.True(t, utility.IsZeroTime(dbTask.DurationPrediction.CollectedAt), "computing the critical path should not cache expected durations")
+	})
+	t.Run("FailsForNonexistentVersion", func(t *testing.T) {
+		handler := &versionCriticalPathGetHandler{versionId: "nonexistent"}
+		resp := handler.Run(t.Context())
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+}
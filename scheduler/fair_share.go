@@ -0,0 +1,188 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FairShareUsageWindow is how far back the fair-share planner looks when
+	// measuring how much of a distro's host time each project has used.
+	FairShareUsageWindow = 24 * time.Hour
+
+	// minFairShareFactor and maxFairShareFactor bound how much the fair-share
+	// planner can scale a unit's rank value, so that a project that has used
+	// far more than its share is deprioritized but never starved.
+	minFairShareFactor = 0.25
+	maxFairShareFactor = 4.0
+)
+
+// ProjectHostUsage is the host time that each project recently used on a
+// distro, along with the repo that each project is attached to.
+type ProjectHostUsage struct {
+	// HostTime is the total time that each project's tasks ran on the
+	// distro's hosts within the usage window.
+	HostTime map[string]time.Duration `json:"host_time_ns"`
+	// Repos maps every project considered for fair-share to the ID of the repo
+	// it's attached to, if any.
+	Repos map[string]string `json:"repos"`
+}
+
+// ProjectShareUsage describes how much of a distro's recent host time a
+// project used compared to its fair share.
+type ProjectShareUsage struct {
+	ProjectID string `json:"project_id"`
+	RepoID    string `json:"repo_id,omitempty"`
+	// Share is the project's configured relative share of the distro.
+	Share float64 `json:"share"`
+	// TargetFraction is the fraction of the distro's host time that the
+	// project is entitled to.
+	TargetFraction float64 `json:"target_fraction"`
+	// HostTime is the host time the project used within the usage window.
+	HostTime time.Duration `json:"host_time_ns"`
+	// UsageFraction is the fraction of the distro's host time that the
+	// project used within the usage window.
+	UsageFraction float64 `json:"usage_fraction"`
+	// Factor is how much the fair-share planner scales the rank value of the
+	// project's tasks.
+	Factor float64 `json:"factor"`
+}
+
+// FindProjectHostUsage loads the host time that projects used on the distro
+// within the usage window. The given projects are considered in addition to
+// those that used the distro, so that projects that have tasks queued but
+// have not run anything recently are entitled to their share.
+func FindProjectHostUsage(ctx context.Context, distroID string, projectIDs []string) (*ProjectHostUsage, error) {
+	hostTime, err := task.GetProjectHostUsageForDistro(ctx, distroID, time.Now().Add(-FairShareUsageWindow))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting project host usage for distro '%s'", distroID)
+	}
+
+	usage := &ProjectHostUsage{
+		HostTime: hostTime,
+		Repos:    map[string]string{},
+	}
+	for projectID := range hostTime {
+		usage.Repos[projectID] = ""
+	}
+	for _, projectID := range projectIDs {
+		usage.Repos[projectID] = ""
+	}
+	if len(usage.Repos) == 0 {
+		return usage, nil
+	}
+
+	ids := make([]string, 0, len(usage.Repos))
+	for projectID := range usage.Repos {
+		ids = append(ids, projectID)
+	}
+	projectRefs, err := model.FindProjectRefsByIds(ctx, ids...)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding project refs")
+	}
+	for _, pRef := range projectRefs {
+		usage.Repos[pRef.Id] = pRef.RepoRefId
+	}
+
+	return usage, nil
+}
+
+// ShareUsage computes each project's usage of the distro compared to its
+// fair share. A project's share is its own configured share if it has one,
+// otherwise its repo's share, otherwise the default share. The returned usages
+// are sorted by project ID.
+func (u *ProjectHostUsage) ShareUsage(shares []distro.ProjectShare) []ProjectShareUsage {
+	if u == nil || len(u.Repos) == 0 {
+		return nil
+	}
+
+	configured := make(map[string]float64, len(shares))
+	for _, s := range shares {
+		configured[s.ID] = s.Share
+	}
+
+	var totalShare float64
+	var totalHostTime time.Duration
+	usages := make([]ProjectShareUsage, 0, len(u.Repos))
+	for projectID, repoID := range u.Repos {
+		share, ok := configured[projectID]
+		if !ok {
+			share, ok = configured[repoID]
+		}
+		if !ok {
+			share = distro.DefaultProjectShare
+		}
+		usages = append(usages, ProjectShareUsage{
+			ProjectID: projectID,
+			RepoID:    repoID,
+			Share:     share,
+			HostTime:  u.HostTime[projectID],
+		})
+		totalShare += share
+		totalHostTime += u.HostTime[projectID]
+	}
+
+	for i := range usages {
+		if totalShare > 0 {
+			usages[i].TargetFraction = usages[i].Share / totalShare
+		}
+		if totalHostTime > 0 {
+			usages[i].UsageFraction = float64(usages[i].HostTime) / float64(totalHostTime)
+		}
+		usages[i].Factor = fairShareFactor(usages[i].TargetFraction, usages[i].UsageFraction, totalHostTime > 0)
+	}
+
+	sort.Slice(usages, func(i, j int) bool { return usages[i].ProjectID < usages[j].ProjectID })
+	return usages
+}
+
+// fairShareFactor returns the ratio of a project's target fraction of host
+// time to the fraction it actually used, within the allowed bounds.
+func fairShareFactor(target, used float64, hasUsage bool) float64 {
+	if !hasUsage {
+		// Nobody has used the distro yet, so every project is even.
+		return 1
+	}
+	if used <= 0 {
+		return maxFairShareFactor
+	}
+
+	factor := target / used
+	if factor < minFairShareFactor {
+		return minFairShareFactor
+	}
+	if factor > maxFairShareFactor {
+		return maxFairShareFactor
+	}
+	return factor
+}
+
+// GetProjectShareUsage returns each project's current usage of the distro
+// compared to its fair share, considering the projects that used the distro
+// recently and the projects of the given queued tasks.
+func GetProjectShareUsage(ctx context.Context, d *distro.Distro, queuedProjectIDs []string) ([]ProjectShareUsage, error) {
+	usage, err := FindProjectHostUsage(ctx, d.Id, queuedProjectIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return usage.ShareUsage(d.PlannerSettings.ProjectShares), nil
+}
+
+// taskProjectIDs returns the unique projects of the tasks.
+func taskProjectIDs(tasks []task.Task) []string {
+	seen := StringSet{}
+	projectIDs := []string{}
+	for _, t := range tasks {
+		if seen.Visit(t.Project) {
+			continue
+		}
+		projectIDs = append(projectIDs, t.Project)
+	}
+	return projectIDs
+}
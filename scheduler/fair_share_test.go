@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectHostUsageShareUsage(t *testing.T) {
+	t.Run("NilUsage", func(t *testing.T) {
+		var usage *ProjectHostUsage
+		assert.Empty(t, usage.ShareUsage(nil))
+	})
+	t.Run("NoRecentUsage", func(t *testing.T) {
+		usage := &ProjectHostUsage{
+			Repos: map[string]string{"p1": "", "p2": ""},
+		}
+		usages := usage.ShareUsage(nil)
+		require.Len(t, usages, 2)
+		for _, u := range usages {
+			assert.Equal(t, 0.5, u.TargetFraction)
+			assert.Zero(t, u.UsageFraction)
+			assert.Equal(t, 1.0, u.Factor)
+		}
+	})
+	t.Run("DefaultShares", func(t *testing.T) {
+		usage := &ProjectHostUsage{
+			HostTime: map[string]time.Duration{"p1": 3 * time.Hour, "p2": time.Hour},
+			Repos:    map[string]string{"p1": "", "p2": "", "p3": ""},
+		}
+		usages := usage.ShareUsage(nil)
+		require.Len(t, usages, 3)
+
+		assert.Equal(t, "p1", usages[0].ProjectID)
+		assert.Equal(t, 3*time.Hour, usages[0].HostTime)
+		assert.InDelta(t, 1.0/3, usages[0].TargetFraction, 0.0001)
+		assert.InDelta(t, 0.75, usages[0].UsageFraction, 0.0001)
+		assert.InDelta(t, 4.0/9, usages[0].Factor, 0.0001)
+
+		assert.Equal(t, "p2", usages[1].ProjectID)
+		assert.InDelta(t, 0.25, usages[1].UsageFraction, 0.0001)
+		assert.InDelta(t, 4.0/3, usages[1].Factor, 0.0001)
+
+		assert.Equal(t, "p3", usages[2].ProjectID)
+		assert.Zero(t, usages[2].UsageFraction)
+		assert.Equal(t, maxFairShareFactor, usages[2].Factor)
+	})
+	t.Run("ProjectShareOverridesRepoShare", func(t *testing.T) {
+		usage := &ProjectHostUsage{
+			HostTime: map[string]time.Duration{"p1": time.Hour, "p2": time.Hour, "p3": time.Hour},
+			Repos:    map[string]string{"p1": "r1", "p2": "r1", "p3": ""},
+		}
+		usages := usage.ShareUsage([]distro.ProjectShare{
+			{ID: "r1", Share: 2},
+			{ID: "p2", Share: 4},
+		})
+		require.Len(t, usages, 3)
+
+		assert.Equal(t, "r1", usages[0].RepoID)
+		assert.Equal(t, 2.0, usages[0].Share)
+		assert.Equal(t, 4.0, usages[1].Share)
+		assert.Equal(t, distro.DefaultProjectShare, usages[2].Share)
+		assert.InDelta(t, 4.0/7, usages[1].TargetFraction, 0.0001)
+		assert.InDelta(t, 12.0/7, usages[1].Factor, 0.0001)
+	})
+	t.Run("FactorIsBounded", func(t *testing.T) {
+		usage := &ProjectHostUsage{
+			HostTime: map[string]time.Duration{"p1": 99 * time.Hour, "p2": time.Hour},
+			Repos:    map[string]string{"p1": "", "p2": ""},
+		}
+		usages := usage.ShareUsage([]distro.ProjectShare{{ID: "p2", Share: 9}})
+		require.Len(t, usages, 2)
+		assert.Equal(t, minFairShareFactor, usages[0].Factor)
+		assert.Equal(t, maxFairShareFactor, usages[1].Factor)
+	})
+}
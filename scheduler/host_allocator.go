@@ -7,6 +7,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
 )
 
 // HostAllocator is responsible for determining how many new hosts should be
@@ -20,6 +21,25 @@ type HostAllocatorData struct {
 	UsesContainers  bool
 	ContainerPool   *evergreen.ContainerPool
 	DistroQueueInfo model.DistroQueueInfo
+
+	// runningTaskFinder looks up the tasks running on the existing hosts. If
+	// it's not set, the tasks are found in the database.
+	runningTaskFinder runningTaskFinder
+}
+
+// runningTaskFinder returns the tasks with the given IDs, which are running on
+// hosts.
+type runningTaskFinder func(ctx context.Context, taskIDs []string) ([]task.Task, error)
+
+func findRunningTasks(ctx context.Context, taskIDs []string) ([]task.Task, error) {
+	return task.Find(ctx, task.ByIds(taskIDs))
+}
+
+func (d *HostAllocatorData) getRunningTaskFinder() runningTaskFinder {
+	if d.runningTaskFinder == nil {
+		return findRunningTasks
+	}
+	return d.runningTaskFinder
 }
 
 func GetHostAllocator(name string) HostAllocator {
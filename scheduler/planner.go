@@ -97,6 +97,9 @@ type Unit struct {
 	cachedValue task.SortingValueBreakdown
 	id          string
 	distro      *distro.Distro
+	// fairShareFactors are the fair-share factors of each project, which
+	// are only set for the fair-share planner.
+	fairShareFactors map[string]float64
 }
 
 // MakeUnit constructs a new unit, caching a reference to the distro
@@ -198,6 +201,9 @@ type unitInfo struct {
 	ContainsGenerateTask bool `json:"contains_generate_task"`
 	// ContainsStepbackTask indicates if the unit contains task activated by stepback.
 	ContainsStepbackTask bool `json:"contains_stepback_task"`
+	// FairShareFactor is the average fair-share factor of the projects of the tasks in the unit.
+	// It's zero if the distro does not use the fair-share planner.
+	FairShareFactor float64 `json:"fair_share_factor"`
 }
 
 // value computes a full SortingValueBreakdown, containing the final value by which the unit
@@ -255,13 +261,24 @@ func (u *unitInfo) computeRankValue(breakdown *task.SortingValueBreakdown) int64
 	// have to execute after shorter running tasks.
 	breakdown.RankValueBreakdown.EstimatedRuntimeImpact = u.Settings.GetExpectedRuntimeFactor() * int64(math.Floor(u.ExpectedRuntime.Minutes()/float64(unitLength)))
 
-	return 1 + breakdown.RankValueBreakdown.PatchImpact +
+	rankValue := 1 + breakdown.RankValueBreakdown.PatchImpact +
 		breakdown.RankValueBreakdown.PatchWaitTimeImpact +
 		breakdown.RankValueBreakdown.MainlineWaitTimeImpact +
 		breakdown.RankValueBreakdown.CommitQueueImpact +
 		breakdown.RankValueBreakdown.StepbackImpact +
 		breakdown.RankValueBreakdown.NumDependentsImpact +
 		breakdown.RankValueBreakdown.EstimatedRuntimeImpact
+
+	// Scale the value by how much of its fair share of the distro the unit's
+	// projects have used, so that projects that have used less than their
+	// share get ahead of projects that have used more.
+	if u.FairShareFactor > 0 && u.FairShareFactor != 1 {
+		scaled := max(int64(math.Round(float64(rankValue)*u.FairShareFactor)), 1)
+		breakdown.RankValueBreakdown.FairShareImpact = scaled - rankValue
+		rankValue = scaled
+	}
+
+	return rankValue
 }
 
 // computePriority computes the custom priority value for this unit, which will later be multiplied with the
@@ -331,6 +348,17 @@ func (unit *Unit) info(ctx context.Context) unitInfo {
 			info.MaxNumDependents = int64(t.NumDependents)
 		}
 		info.TaskIDs = append(info.TaskIDs, t.Id)
+
+		if unit.fairShareFactors != nil {
+			factor, ok := unit.fairShareFactors[t.Project]
+			if !ok {
+				factor = 1
+			}
+			info.FairShareFactor += factor
+		}
+	}
+	if len(info.TaskIDs) > 0 {
+		info.FairShareFactor /= float64(len(info.TaskIDs))
 	}
 
 	return info
@@ -418,6 +446,20 @@ func (tpl TaskPlan) Less(i, j int) bool {
 }
 func (tpl TaskPlan) Swap(i, j int) { tpl.units[i], tpl.units[j] = tpl.units[j], tpl.units[i] }
 
+// ApplyFairShare sets the fair-share factor of each project on the units in
+// the plan, which scales the units' sorting values by how much of their fair
+// share of the distro their projects have used.
+func (tpl TaskPlan) ApplyFairShare(usages []ProjectShareUsage) {
+	factors := make(map[string]float64, len(usages))
+	for _, usage := range usages {
+		factors[usage.ProjectID] = usage.Factor
+	}
+	for _, unit := range tpl.units {
+		unit.fairShareFactors = factors
+		unit.cachedValue = task.SortingValueBreakdown{}
+	}
+}
+
 func (tpl TaskPlan) Keys() []string {
 	out := []string{}
 	for _, unit := range tpl.units {
@@ -393,6 +393,30 @@ func TestPlanner(t *testing.T) {
 					assert.EqualValues(t, 719, unit.sortingValueBreakdown(ctx).TotalValue)
 					verifyRankBreakdown(t, unit.sortingValueBreakdown(ctx))
 				})
+				t.Run("FairShareUnderused", func(t *testing.T) {
+					unit := NewUnit(task.Task{Id: "foo", Project: "p1"})
+					unit.SetDistro(&distro.Distro{})
+					unit.fairShareFactors = map[string]float64{"p1": 2}
+					assert.EqualValues(t, 359, unit.sortingValueBreakdown(ctx).TotalValue)
+					assert.EqualValues(t, 179, unit.sortingValueBreakdown(ctx).RankValueBreakdown.FairShareImpact)
+					verifyRankBreakdown(t, unit.sortingValueBreakdown(ctx))
+				})
+				t.Run("FairShareOverused", func(t *testing.T) {
+					unit := NewUnit(task.Task{Id: "foo", Project: "p1"})
+					unit.SetDistro(&distro.Distro{})
+					unit.fairShareFactors = map[string]float64{"p1": 0.5}
+					assert.EqualValues(t, 91, unit.sortingValueBreakdown(ctx).TotalValue)
+					assert.EqualValues(t, -89, unit.sortingValueBreakdown(ctx).RankValueBreakdown.FairShareImpact)
+					verifyRankBreakdown(t, unit.sortingValueBreakdown(ctx))
+				})
+				t.Run("FairShareUnknownProject", func(t *testing.T) {
+					unit := NewUnit(task.Task{Id: "foo", Project: "p2"})
+					unit.SetDistro(&distro.Distro{})
+					unit.fairShareFactors = map[string]float64{"p1": 0.5}
+					assert.EqualValues(t, 180, unit.sortingValueBreakdown(ctx).TotalValue)
+					assert.Zero(t, unit.sortingValueBreakdown(ctx).RankValueBreakdown.FairShareImpact)
+					verifyRankBreakdown(t, unit.sortingValueBreakdown(ctx))
+				})
 			})
 			t.Run("RankCachesValue", func(t *testing.T) {
 				unit := NewUnit(task.Task{Id: "foo", Priority: 100})
@@ -567,7 +591,8 @@ func verifyRankBreakdown(t *testing.T, breakdown task.SortingValueBreakdown) {
 		breakdown.RankValueBreakdown.MainlineWaitTimeImpact +
 		breakdown.RankValueBreakdown.EstimatedRuntimeImpact +
 		breakdown.RankValueBreakdown.NumDependentsImpact +
-		breakdown.RankValueBreakdown.CommitQueueImpact
+		breakdown.RankValueBreakdown.CommitQueueImpact +
+		breakdown.RankValueBreakdown.FairShareImpact
 	totalPriorityValue := breakdown.PriorityBreakdown.InitialPriorityImpact +
 		breakdown.PriorityBreakdown.CommitQueueImpact +
 		breakdown.PriorityBreakdown.GeneratorTaskImpact +
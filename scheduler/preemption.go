@@ -0,0 +1,221 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// PreemptTasks aborts and requeues low-priority running tasks on the distro
+// when higher-priority tasks have waited longer than the distro's preemption
+// wait limit and the distro cannot start any more hosts. Tasks are eligible to
+// be preempted if they have a negative priority or belong to an inactive
+// patch. It returns the IDs of the preempted tasks.
+func PreemptTasks(ctx context.Context, d distro.Distro, upHosts []host.Host) ([]string, error) {
+	settings := d.PreemptionSettings
+	if !settings.Enabled || evergreen.IsContainerProvider(d.Provider) {
+		return nil, nil
+	}
+	if len(upHosts) < d.HostAllocatorSettings.MaximumHosts {
+		return nil, nil
+	}
+	runningTaskIDs := make([]string, 0, len(upHosts))
+	for _, h := range upHosts {
+		if h.IsFree() {
+			// The starved tasks can run as soon as the free host picks them up.
+			return nil, nil
+		}
+		runningTaskIDs = append(runningTaskIDs, h.RunningTask)
+	}
+
+	starved, err := findStarvedTasks(ctx, d.Id, settings)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding starved tasks")
+	}
+	if len(starved) == 0 {
+		return nil, nil
+	}
+
+	candidates, alreadyPreempted, err := findPreemptionCandidates(ctx, runningTaskIDs, settings.PriorityThreshold)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding tasks that can be preempted")
+	}
+
+	toPreempt := selectTasksToPreempt(candidates, len(starved)-alreadyPreempted)
+	if len(toPreempt) == 0 {
+		return nil, nil
+	}
+
+	reason := fmt.Sprintf("Preempted by the scheduler because %d task(s) with priority at least %d waited longer than %s for a host on distro '%s', which is at its maximum of %d hosts. The task will run again once it stops.",
+		len(starved), settings.PriorityThreshold, settings.WaitLimit, d.Id, d.HostAllocatorSettings.MaximumHosts)
+
+	catcher := grip.NewBasicCatcher()
+	preempted := make([]string, 0, len(toPreempt))
+	for _, t := range toPreempt {
+		if err := model.PreemptTask(ctx, t.Id, reason); err != nil {
+			catcher.Wrapf(err, "preempting task '%s'", t.Id)
+			continue
+		}
+		preempted = append(preempted, t.Id)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":         "preempted low priority tasks",
+		"runner":          RunnerName,
+		"distro":          d.Id,
+		"preempted_tasks": preempted,
+		"starved_tasks":   starved,
+		"max_hosts":       d.HostAllocatorSettings.MaximumHosts,
+	})
+
+	return preempted, catcher.Resolve()
+}
+
+// findStarvedTasks returns the IDs of the queued tasks for the distro that
+// are at or above the priority threshold, are ready to run, and have waited
+// longer than the wait limit.
+func findStarvedTasks(ctx context.Context, distroID string, settings distro.PreemptionSettings) ([]string, error) {
+	queue, err := model.LoadTaskQueue(ctx, distroID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading task queue for distro '%s'", distroID)
+	}
+	if queue == nil {
+		return nil, nil
+	}
+
+	var ids []string
+	for _, item := range queue.Queue {
+		if item.IsDispatched || !item.DependenciesMet || item.Priority < settings.PriorityThreshold {
+			continue
+		}
+		ids = append(ids, item.Id)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := task.Find(ctx, task.ByIds(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding high priority queued tasks")
+	}
+
+	var starved []string
+	for _, t := range tasks {
+		if t.Status != evergreen.TaskUndispatched || !t.Activated {
+			continue
+		}
+		if time.Since(taskWaitStart(t)) > settings.WaitLimit {
+			starved = append(starved, t.Id)
+		}
+	}
+	sort.Strings(starved)
+
+	return starved, nil
+}
+
+// taskWaitStart returns the time at which the task became ready to run.
+func taskWaitStart(t task.Task) time.Time {
+	if t.DependenciesMetTime.After(t.ActivatedTime) {
+		return t.DependenciesMetTime
+	}
+	return t.ActivatedTime
+}
+
+// findPreemptionCandidates returns the running tasks that are eligible to be
+// preempted, as well as the number of running tasks that are already aborting
+// and will soon free up their hosts.
+func findPreemptionCandidates(ctx context.Context, runningTaskIDs []string, priorityThreshold int64) ([]task.Task, int, error) {
+	if len(runningTaskIDs) == 0 {
+		return nil, 0, nil
+	}
+	running, err := task.Find(ctx, task.ByIds(runningTaskIDs))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "finding running tasks")
+	}
+
+	var alreadyAborted int
+	var eligible []task.Task
+	patchVersionIDs := StringSet{}
+	for _, t := range running {
+		if t.Aborted {
+			alreadyAborted++
+			continue
+		}
+		if !t.IsAbortable() || t.Priority >= priorityThreshold {
+			continue
+		}
+		// Tasks that can only be reset alongside other tasks can't be
+		// requeued on their own.
+		if t.IsPartOfSingleHostTaskGroup() || t.IsPartOfDisplay(ctx) {
+			continue
+		}
+		eligible = append(eligible, t)
+		if evergreen.IsPatchRequester(t.Requester) {
+			patchVersionIDs.Add(t.Version)
+		}
+	}
+
+	inactiveVersions := StringSet{}
+	if len(patchVersionIDs) > 0 {
+		ids := make([]string, 0, len(patchVersionIDs))
+		for id := range patchVersionIDs {
+			ids = append(ids, id)
+		}
+		versions, err := model.VersionFind(ctx, db.Query(bson.M{
+			model.VersionIdKey:        bson.M{"$in": ids},
+			model.VersionActivatedKey: false,
+		}).WithFields(model.VersionIdKey))
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "finding inactive patch versions")
+		}
+		for _, v := range versions {
+			inactiveVersions.Add(v.Id)
+		}
+	}
+
+	var candidates []task.Task
+	for _, t := range eligible {
+		if t.Priority < 0 || (evergreen.IsPatchRequester(t.Requester) && inactiveVersions.Check(t.Version)) {
+			candidates = append(candidates, t)
+		}
+	}
+
+	return candidates, alreadyAborted, nil
+}
+
+// selectTasksToPreempt picks up to n of the candidates to preempt. Tasks with
+// the lowest priority are preempted first, which puts tasks with negative
+// priority ahead of tasks in inactive patches. Among tasks with the same
+// priority, the ones that started most recently are preempted first so that
+// the least work is lost.
+func selectTasksToPreempt(candidates []task.Task, n int) []task.Task {
+	if n <= 0 || len(candidates) == 0 {
+		return nil
+	}
+
+	sorted := make([]task.Task, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].StartTime.After(sorted[j].StartTime)
+	})
+
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+	return sorted[:n]
+}
@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectTasksToPreempt(t *testing.T) {
+	now := time.Now()
+	candidates := []task.Task{
+		{Id: "inactive_patch_old", Priority: 0, StartTime: now.Add(-time.Hour)},
+		{Id: "inactive_patch_new", Priority: 0, StartTime: now.Add(-time.Minute)},
+		{Id: "negative", Priority: -1, StartTime: now.Add(-2 * time.Hour)},
+		{Id: "most_negative", Priority: -5, StartTime: now.Add(-3 * time.Hour)},
+	}
+
+	t.Run("NoneNeeded", func(t *testing.T) {
+		assert.Empty(t, selectTasksToPreempt(candidates, 0))
+		assert.Empty(t, selectTasksToPreempt(candidates, -1))
+	})
+	t.Run("NoCandidates", func(t *testing.T) {
+		assert.Empty(t, selectTasksToPreempt(nil, 2))
+	})
+	t.Run("LowestPriorityFirst", func(t *testing.T) {
+		selected := selectTasksToPreempt(candidates, 2)
+		require.Len(t, selected, 2)
+		assert.Equal(t, "most_negative", selected[0].Id)
+		assert.Equal(t, "negative", selected[1].Id)
+	})
+	t.Run("MostRecentlyStartedFirstWithinPriority", func(t *testing.T) {
+		selected := selectTasksToPreempt(candidates, 3)
+		require.Len(t, selected, 3)
+		assert.Equal(t, "inactive_patch_new", selected[2].Id)
+	})
+	t.Run("CappedAtCandidates", func(t *testing.T) {
+		assert.Len(t, selectTasksToPreempt(candidates, 10), len(candidates))
+	})
+	t.Run("DoesNotModifyCandidates", func(t *testing.T) {
+		_ = selectTasksToPreempt(candidates, 4)
+		assert.Equal(t, "inactive_patch_old", candidates[0].Id)
+	})
+}
+
+func TestTaskWaitStart(t *testing.T) {
+	activated := time.Now().Add(-time.Hour)
+	assert.Equal(t, activated, taskWaitStart(task.Task{ActivatedTime: activated}))
+
+	depsMet := activated.Add(30 * time.Minute)
+	assert.Equal(t, depsMet, taskWaitStart(task.Task{ActivatedTime: activated, DependenciesMetTime: depsMet}))
+}
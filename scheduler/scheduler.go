@@ -27,7 +27,9 @@ type TaskPlanner func(*distro.Distro, []task.Task, TaskPlannerOptions) ([]task.T
 func PrioritizeTasks(ctx context.Context, d *distro.Distro, tasks []task.Task, opts TaskPlannerOptions) ([]task.Task, error) {
 	opts.IncludesDependencies = d.DispatcherSettings.Version == evergreen.DispatcherVersionRevisedWithDependencies
 
-	// Currently, only the tunable planner is available, so we don't need to check the settings.
+	if d.PlannerSettings.Version == evergreen.PlannerVersionFairShare {
+		return runFairSharePlanner(ctx, d, tasks, opts)
+	}
 	return runTunablePlanner(ctx, d, tasks, opts)
 }
 
@@ -39,11 +41,46 @@ func runTunablePlanner(ctx context.Context, d *distro.Distro, tasks []task.Task,
 		return nil, errors.WithStack(err)
 	}
 
-	plan := PrepareTasksForPlanning(ctx, d, tasks).Export(ctx)
+	return persistPlan(ctx, d, PrepareTasksForPlanning(ctx, d, tasks), opts)
+}
+
+// runFairSharePlanner plans the tasks like the tunable planner, but
+// additionally weights them by how much of their fair share of the distro
+// their projects have recently used.
+func runFairSharePlanner(ctx context.Context, d *distro.Distro, tasks []task.Task, opts TaskPlannerOptions) ([]task.Task, error) {
+	var err error
+
+	tasks, err = PopulateCaches(ctx, opts.ID, tasks)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	taskPlan := PrepareTasksForPlanning(ctx, d, tasks)
+	usages, err := GetProjectShareUsage(ctx, d, taskProjectIDs(tasks))
+	if err != nil {
+		// Fall back to the tunable planner's ordering rather than failing to
+		// plan the distro at all.
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message":  "could not get project share usage, planning without fair-share",
+			"distro":   d.Id,
+			"runner":   RunnerName,
+			"planner":  d.PlannerSettings.Version,
+			"instance": opts.ID,
+		}))
+	} else {
+		taskPlan.ApplyFairShare(usages)
+	}
+
+	return persistPlan(ctx, d, taskPlan, opts)
+}
+
+// persistPlan sorts the task plan and persists it as the distro's task queue.
+func persistPlan(ctx context.Context, d *distro.Distro, taskPlan TaskPlan, opts TaskPlannerOptions) ([]task.Task, error) {
+	plan := taskPlan.Export(ctx)
 	info := GetDistroQueueInfo(ctx, d.Id, plan, d.GetTargetTime(), opts)
 	info.SecondaryQueue = opts.IsSecondaryQueue
 	info.PlanCreatedAt = opts.StartedAt
-	if err = PersistTaskQueue(ctx, d.Id, plan, info); err != nil {
+	if err := PersistTaskQueue(ctx, d.Id, plan, info); err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -0,0 +1,504 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+// SimulationSnapshot is a copy of the state that the scheduler and host
+// allocator use to plan a distro at a point in time. It can be saved and
+// replayed later under different planner and host allocator settings.
+type SimulationSnapshot struct {
+	// Distro is the distro with its planner and host allocator settings
+	// resolved against the admin settings.
+	Distro        distro.Distro            `json:"distro"`
+	ContainerPool *evergreen.ContainerPool `json:"container_pool,omitempty"`
+	// Tasks are the runnable tasks found for the distro by the task finder.
+	Tasks []task.Task `json:"tasks"`
+	// Hosts are the distro's hosts that are up.
+	Hosts []host.Host `json:"hosts"`
+	// RunningTasks are the tasks running on the hosts.
+	RunningTasks []task.Task `json:"running_tasks"`
+	// ProjectHostUsage is the projects' recent usage of the distro, which
+	// the fair-share planner uses to weight the tasks.
+	ProjectHostUsage *ProjectHostUsage `json:"project_host_usage,omitempty"`
+	TakenAt          time.Time         `json:"taken_at"`
+}
+
+// MakeSimulationSnapshot loads the distro's current runnable tasks and hosts.
+func MakeSimulationSnapshot(ctx context.Context, distroID string, settings *evergreen.Settings) (*SimulationSnapshot, error) {
+	d, err := distro.FindByIdWithDefaultSettings(ctx, distroID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding distro '%s'", distroID)
+	}
+	if d == nil {
+		return nil, errors.Errorf("distro '%s' not found", distroID)
+	}
+	if _, err = d.GetResolvedPlannerSettings(settings); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err = d.GetResolvedHostAllocatorSettings(settings); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	snapshot := &SimulationSnapshot{
+		Distro:  *d,
+		TakenAt: time.Now(),
+	}
+	if d.ContainerPool != "" {
+		snapshot.ContainerPool = settings.ContainerPools.GetContainerPool(d.ContainerPool)
+		if snapshot.ContainerPool == nil {
+			return nil, errors.Errorf("container pool '%s' not found for distro '%s'", d.ContainerPool, d.Id)
+		}
+	}
+
+	snapshot.Tasks, err = GetTaskFinder(settings.Scheduler.TaskFinder)(ctx, *d)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding runnable tasks for distro '%s'", d.Id)
+	}
+
+	hosts, err := host.AllActiveHosts(ctx, d.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding active hosts for distro '%s'", d.Id)
+	}
+	runningTaskIDs := []string{}
+	for _, h := range hosts.Uphosts() {
+		snapshot.Hosts = append(snapshot.Hosts, makeSimulationHost(h))
+		if h.RunningTask != "" {
+			runningTaskIDs = append(runningTaskIDs, h.RunningTask)
+		}
+	}
+	if len(runningTaskIDs) > 0 {
+		snapshot.RunningTasks, err = findRunningTasks(ctx, runningTaskIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "finding running tasks")
+		}
+	}
+
+	snapshot.ProjectHostUsage, err = FindProjectHostUsage(ctx, d.Id, taskProjectIDs(snapshot.Tasks))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Resolve the expected durations now so that replaying the snapshot
+	// later uses the durations as of when it was taken.
+	for i := range snapshot.Tasks {
+		snapshot.Tasks[i].FetchExpectedDuration(ctx)
+	}
+	for i := range snapshot.RunningTasks {
+		snapshot.RunningTasks[i].FetchExpectedDuration(ctx)
+	}
+
+	return snapshot, nil
+}
+
+// makeSimulationHost returns a copy of the host with only the fields that the
+// host allocator uses, so that snapshots do not contain host secrets.
+func makeSimulationHost(h host.Host) host.Host {
+	return host.Host{
+		Id:                         h.Id,
+		Status:                     h.Status,
+		Provider:                   h.Provider,
+		StartedBy:                  h.StartedBy,
+		CreationTime:               h.CreationTime,
+		RunningTask:                h.RunningTask,
+		RunningTaskExecution:       h.RunningTaskExecution,
+		RunningTaskGroup:           h.RunningTaskGroup,
+		RunningTaskGroupOrder:      h.RunningTaskGroupOrder,
+		RunningTaskBuildVariant:    h.RunningTaskBuildVariant,
+		RunningTaskVersion:         h.RunningTaskVersion,
+		RunningTaskProject:         h.RunningTaskProject,
+		TaskGroupTeardownStartTime: h.TaskGroupTeardownStartTime,
+	}
+}
+
+// SimulationOptions are the settings to replay a snapshot with. Only the
+// non-zero fields of the planner and host allocator settings override the
+// snapshot distro's settings.
+type SimulationOptions struct {
+	PlannerSettings       distro.PlannerSettings
+	HostAllocatorSettings distro.HostAllocatorSettings
+	// CostConfig is used to estimate the cost of the hosts.
+	CostConfig evergreen.CostConfig
+}
+
+// SimulationResult is the projected outcome of planning a distro and
+// allocating hosts for it.
+type SimulationResult struct {
+	PlannerSettings       distro.PlannerSettings       `json:"planner_settings"`
+	HostAllocatorSettings distro.HostAllocatorSettings `json:"host_allocator_settings"`
+
+	QueueLength                int `json:"queue_length"`
+	QueueLengthDepsMet         int `json:"queue_length_deps_met"`
+	ExistingHosts              int `json:"existing_hosts"`
+	EstimatedFreeHosts         int `json:"estimated_free_hosts"`
+	NewHosts                   int `json:"new_hosts"`
+	TotalHosts                 int `json:"total_hosts"`
+	CountDurationOverThreshold int `json:"count_duration_over_threshold"`
+
+	AverageWait time.Duration `json:"average_wait_ns"`
+	P90Wait     time.Duration `json:"p90_wait_ns"`
+	MaxWait     time.Duration `json:"max_wait_ns"`
+	// Makespan is how long it takes to run every task in the queue.
+	Makespan time.Duration `json:"makespan_ns"`
+	// HostTime is the total time that the hosts are up to run the queue,
+	// including the time for new hosts to start.
+	HostTime time.Duration `json:"host_time_ns"`
+	// EstimatedCost is the adjusted cost of the host time.
+	EstimatedCost float64 `json:"estimated_cost"`
+
+	// Tasks are the tasks in the order that the planner queued them.
+	Tasks []SimulatedTask `json:"tasks"`
+}
+
+// SimulatedTask is the projected schedule of a single task in the queue.
+type SimulatedTask struct {
+	ID               string        `json:"id"`
+	DisplayName      string        `json:"display_name"`
+	BuildVariant     string        `json:"build_variant"`
+	Requester        string        `json:"requester"`
+	ExpectedDuration time.Duration `json:"expected_duration_ns"`
+	ProjectedWait    time.Duration `json:"projected_wait_ns"`
+}
+
+// Simulate replays the planner and host allocator against the snapshot with
+// the given settings and projects how long the queued tasks will wait.
+//
+// The projection dispatches the planned queue in order to whichever host is
+// free first, with new hosts becoming available once they've started. A task
+// waits for its dependencies that are also in the queue; dependencies outside
+// of the queue are assumed to be finished. Task group max hosts and hosts
+// that the host allocator would request on later runs are not modeled.
+func Simulate(ctx context.Context, snapshot SimulationSnapshot, opts SimulationOptions) (*SimulationResult, error) {
+	d := snapshot.Distro
+	d.PlannerSettings = mergePlannerSettings(d.PlannerSettings, opts.PlannerSettings)
+	d.HostAllocatorSettings = mergeHostAllocatorSettings(d.HostAllocatorSettings, opts.HostAllocatorSettings)
+
+	// Times in the snapshot are shifted as if it were taken now, since the
+	// planner and host allocator measure how long tasks have waited and run
+	// relative to the current time.
+	offset := time.Since(snapshot.TakenAt)
+	tasks := make([]task.Task, 0, len(snapshot.Tasks))
+	for _, t := range snapshot.Tasks {
+		tasks = append(tasks, rebaseSimulatedTask(t, offset))
+	}
+	runningTasks := make(map[string]task.Task, len(snapshot.RunningTasks))
+	for _, t := range snapshot.RunningTasks {
+		runningTasks[t.Id] = rebaseSimulatedTask(t, offset)
+	}
+
+	taskPlan := PrepareTasksForPlanning(ctx, &d, tasks)
+	if d.PlannerSettings.Version == evergreen.PlannerVersionFairShare {
+		taskPlan.ApplyFairShare(snapshot.ProjectHostUsage.ShareUsage(d.PlannerSettings.ProjectShares))
+	}
+	plan := taskPlan.Export(ctx)
+	info := GetDistroQueueInfo(ctx, d.Id, plan, d.GetTargetTime(), TaskPlannerOptions{
+		IncludesDependencies: d.DispatcherSettings.Version == evergreen.DispatcherVersionRevisedWithDependencies,
+	})
+
+	result := &SimulationResult{
+		PlannerSettings:            d.PlannerSettings,
+		HostAllocatorSettings:      d.HostAllocatorSettings,
+		QueueLength:                info.Length,
+		QueueLengthDepsMet:         info.LengthWithDependenciesMet,
+		ExistingHosts:              len(snapshot.Hosts),
+		CountDurationOverThreshold: info.CountDurationOverThreshold,
+	}
+
+	if d.SingleTaskDistro {
+		result.NewHosts = info.LengthWithDependenciesMet
+	} else {
+		var err error
+		result.NewHosts, result.EstimatedFreeHosts, err = GetHostAllocator(d.HostAllocatorSettings.Version)(ctx, &HostAllocatorData{
+			Distro:          d,
+			ExistingHosts:   snapshot.Hosts,
+			UsesContainers:  snapshot.ContainerPool != nil,
+			ContainerPool:   snapshot.ContainerPool,
+			DistroQueueInfo: info,
+			runningTaskFinder: func(_ context.Context, taskIDs []string) ([]task.Task, error) {
+				found := make([]task.Task, 0, len(taskIDs))
+				for _, id := range taskIDs {
+					if t, ok := runningTasks[id]; ok {
+						found = append(found, t)
+					}
+				}
+				return found, nil
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "allocating hosts for distro '%s'", d.Id)
+		}
+	}
+	result.TotalHosts = result.ExistingHosts + result.NewHosts
+
+	result.project(ctx, plan, snapshot.Hosts, runningTasks, d.CostData, opts.CostConfig)
+
+	return result, nil
+}
+
+// SimulationComparison compares the outcome of replaying a snapshot with the
+// distro's current settings against replaying it with alternative settings.
+type SimulationComparison struct {
+	SnapshotTakenAt time.Time         `json:"snapshot_taken_at"`
+	Baseline        *SimulationResult `json:"baseline"`
+	Simulated       *SimulationResult `json:"simulated"`
+}
+
+// CompareSimulation simulates the snapshot with the distro's current settings
+// and with the settings in the options.
+func CompareSimulation(ctx context.Context, snapshot SimulationSnapshot, opts SimulationOptions) (*SimulationComparison, error) {
+	baseline, err := Simulate(ctx, snapshot, SimulationOptions{CostConfig: opts.CostConfig})
+	if err != nil {
+		return nil, errors.Wrap(err, "simulating with current settings")
+	}
+	simulated, err := Simulate(ctx, snapshot, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "simulating with alternative settings")
+	}
+	return &SimulationComparison{
+		SnapshotTakenAt: snapshot.TakenAt,
+		Baseline:        baseline,
+		Simulated:       simulated,
+	}, nil
+}
+
+// simulatedHost is a host in the projection, which is free to run another
+// task after freeAt has elapsed.
+type simulatedHost struct {
+	freeAt time.Duration
+	used   bool
+}
+
+// project dispatches the planned tasks onto the hosts and records the
+// projected wait times, makespan and cost.
+func (r *SimulationResult) project(ctx context.Context, plan []task.Task, existingHosts []host.Host, runningTasks map[string]task.Task, costData distro.CostData, costConfig evergreen.CostConfig) {
+	hosts := make([]simulatedHost, 0, len(existingHosts)+r.NewHosts)
+	for _, h := range existingHosts {
+		sh := simulatedHost{freeAt: model.EstimateHostStartDelay(h.Status)}
+		if t, ok := runningTasks[h.RunningTask]; ok {
+			remaining := t.FetchExpectedDuration(ctx).Average - time.Since(t.StartTime)
+			if remaining > 0 {
+				sh.freeAt = remaining
+			}
+			sh.used = true
+		}
+		hosts = append(hosts, sh)
+	}
+	newHostStartDelay := model.EstimateHostStartDelay(evergreen.HostUninitialized)
+	for i := 0; i < r.NewHosts; i++ {
+		hosts = append(hosts, simulatedHost{freeAt: newHostStartDelay})
+	}
+
+	inPlan := make(map[string]bool, len(plan))
+	for _, t := range plan {
+		inPlan[t.Id] = true
+	}
+	finishedAt := make(map[string]time.Duration, len(plan))
+	// waitsOnQueuedDep returns whether the task depends on a task in the
+	// queue that hasn't been projected yet, since the dispatcher won't run a
+	// task before its dependencies even if it's earlier in the queue.
+	waitsOnQueuedDep := func(t task.Task) bool {
+		for _, dep := range t.DependsOn {
+			if _, ok := finishedAt[dep.TaskId]; inPlan[dep.TaskId] && !ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	projected := make(map[string]SimulatedTask, len(plan))
+	waits := make([]time.Duration, 0, len(plan))
+	remaining := plan
+	ignoreDeps := false
+	for len(remaining) > 0 {
+		deferred := []task.Task{}
+		for _, t := range remaining {
+			if !ignoreDeps && waitsOnQueuedDep(t) {
+				deferred = append(deferred, t)
+				continue
+			}
+
+			duration := t.FetchExpectedDuration(ctx).Average
+			simulated := SimulatedTask{
+				ID:               t.Id,
+				DisplayName:      t.DisplayName,
+				BuildVariant:     t.BuildVariant,
+				Requester:        t.Requester,
+				ExpectedDuration: duration,
+				ProjectedWait:    -1,
+			}
+			if len(hosts) == 0 {
+				projected[t.Id] = simulated
+				continue
+			}
+
+			var depsFinishAt time.Duration
+			for _, dep := range t.DependsOn {
+				if depFinish, ok := finishedAt[dep.TaskId]; ok && depFinish > depsFinishAt {
+					depsFinishAt = depFinish
+				}
+			}
+
+			next := 0
+			for i := range hosts {
+				if hosts[i].freeAt < hosts[next].freeAt {
+					next = i
+				}
+			}
+			start := hosts[next].freeAt
+			if depsFinishAt > start {
+				start = depsFinishAt
+			}
+			hosts[next].freeAt = start + duration
+			hosts[next].used = true
+			finishedAt[t.Id] = start + duration
+
+			simulated.ProjectedWait = start
+			waits = append(waits, start)
+			projected[t.Id] = simulated
+			if start+duration > r.Makespan {
+				r.Makespan = start + duration
+			}
+		}
+		// If none of the remaining tasks could be projected, their
+		// dependencies are cyclic, so project them in queue order.
+		ignoreDeps = len(deferred) == len(remaining)
+		remaining = deferred
+	}
+	for _, t := range plan {
+		r.Tasks = append(r.Tasks, projected[t.Id])
+	}
+
+	for _, h := range hosts {
+		if h.used {
+			r.HostTime += h.freeAt
+		}
+	}
+	r.EstimatedCost = task.CalculateAdjustedTaskCost(r.HostTime.Seconds(), costData, costConfig)
+
+	if len(waits) == 0 {
+		return
+	}
+	var totalWait time.Duration
+	for _, wait := range waits {
+		totalWait += wait
+	}
+	r.AverageWait = totalWait / time.Duration(len(waits))
+	sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+	r.P90Wait = waits[(len(waits)*9)/10]
+	r.MaxWait = waits[len(waits)-1]
+}
+
+// rebaseSimulatedTask returns a copy of the task with its times shifted later
+// by the offset. Its expected duration is fixed so that it is not refreshed
+// from the database during the simulation.
+func rebaseSimulatedTask(t task.Task, offset time.Duration) task.Task {
+	shift := func(ts time.Time) time.Time {
+		if ts.IsZero() {
+			return ts
+		}
+		return ts.Add(offset)
+	}
+	t.ActivatedTime = shift(t.ActivatedTime)
+	t.IngestTime = shift(t.IngestTime)
+	t.ScheduledTime = shift(t.ScheduledTime)
+	t.DependenciesMetTime = shift(t.DependenciesMetTime)
+	t.DispatchTime = shift(t.DispatchTime)
+	t.StartTime = shift(t.StartTime)
+
+	if t.ExpectedDuration > 0 {
+		t.DurationPrediction = util.CachedDurationValue{
+			Value:       t.ExpectedDuration,
+			StdDev:      t.ExpectedDurationStdDev,
+			TTL:         simulationDurationTTL,
+			CollectedAt: time.Now(),
+		}
+	}
+
+	return t
+}
+
+// simulationDurationTTL is long enough that fixed expected durations do not
+// expire while a simulation is running.
+const simulationDurationTTL = 24 * time.Hour
+
+// mergePlannerSettings returns the base settings with the non-zero fields of
+// the overrides applied.
+func mergePlannerSettings(base, overrides distro.PlannerSettings) distro.PlannerSettings {
+	if overrides.Version != "" {
+		base.Version = overrides.Version
+	}
+	if overrides.TargetTime != 0 {
+		base.TargetTime = overrides.TargetTime
+	}
+	if overrides.GroupVersions != nil {
+		base.GroupVersions = overrides.GroupVersions
+	}
+	if overrides.PatchFactor != 0 {
+		base.PatchFactor = overrides.PatchFactor
+	}
+	if overrides.PatchTimeInQueueFactor != 0 {
+		base.PatchTimeInQueueFactor = overrides.PatchTimeInQueueFactor
+	}
+	if overrides.CommitQueueFactor != 0 {
+		base.CommitQueueFactor = overrides.CommitQueueFactor
+	}
+	if overrides.MainlineTimeInQueueFactor != 0 {
+		base.MainlineTimeInQueueFactor = overrides.MainlineTimeInQueueFactor
+	}
+	if overrides.ExpectedRuntimeFactor != 0 {
+		base.ExpectedRuntimeFactor = overrides.ExpectedRuntimeFactor
+	}
+	if overrides.GenerateTaskFactor != 0 {
+		base.GenerateTaskFactor = overrides.GenerateTaskFactor
+	}
+	if overrides.NumDependentsFactor != 0 {
+		base.NumDependentsFactor = overrides.NumDependentsFactor
+	}
+	if overrides.StepbackTaskFactor != 0 {
+		base.StepbackTaskFactor = overrides.StepbackTaskFactor
+	}
+	if len(overrides.ProjectShares) > 0 {
+		base.ProjectShares = overrides.ProjectShares
+	}
+	return base
+}
+
+// mergeHostAllocatorSettings returns the base settings with the non-zero
+// fields of the overrides applied.
+func mergeHostAllocatorSettings(base, overrides distro.HostAllocatorSettings) distro.HostAllocatorSettings {
+	if overrides.Version != "" {
+		base.Version = overrides.Version
+	}
+	if overrides.MinimumHosts != 0 {
+		base.MinimumHosts = overrides.MinimumHosts
+	}
+	if overrides.MaximumHosts != 0 {
+		base.MaximumHosts = overrides.MaximumHosts
+	}
+	if overrides.RoundingRule != "" {
+		base.RoundingRule = overrides.RoundingRule
+	}
+	if overrides.FeedbackRule != "" {
+		base.FeedbackRule = overrides.FeedbackRule
+	}
+	if overrides.HostsOverallocatedRule != "" {
+		base.HostsOverallocatedRule = overrides.HostsOverallocatedRule
+	}
+	if overrides.AcceptableHostIdleTime != 0 {
+		base.AcceptableHostIdleTime = overrides.AcceptableHostIdleTime
+	}
+	if overrides.FutureHostFraction != 0 {
+		base.FutureHostFraction = overrides.FutureHostFraction
+	}
+	return base
+}
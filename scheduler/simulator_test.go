@@ -0,0 +1,177 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	takenAt := time.Now().Add(-time.Hour)
+	makeSnapshot := func() SimulationSnapshot {
+		snapshot := SimulationSnapshot{
+			Distro: distro.Distro{
+				Id:       "d1",
+				Provider: evergreen.ProviderNameEc2Fleet,
+				PlannerSettings: distro.PlannerSettings{
+					Version:       evergreen.PlannerVersionTunable,
+					TargetTime:    30 * time.Minute,
+					GroupVersions: utility.FalsePtr(),
+				},
+				HostAllocatorSettings: distro.HostAllocatorSettings{
+					Version:      evergreen.HostAllocatorUtilization,
+					MaximumHosts: 10,
+				},
+				CostData: distro.CostData{OnDemandRate: 1},
+			},
+			TakenAt: takenAt,
+		}
+		for i := 0; i < 6; i++ {
+			snapshot.Tasks = append(snapshot.Tasks, task.Task{
+				Id:               fmt.Sprintf("t%d", i),
+				DistroId:         "d1",
+				Version:          fmt.Sprintf("v%d", i),
+				Requester:        evergreen.RepotrackerVersionRequester,
+				ActivatedTime:    takenAt.Add(-time.Duration(i) * time.Minute),
+				ExpectedDuration: 20 * time.Minute,
+			})
+		}
+		return snapshot
+	}
+
+	t.Run("AllocatesHostsForQueue", func(t *testing.T) {
+		result, err := Simulate(t.Context(), makeSnapshot(), SimulationOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, 6, result.QueueLength)
+		assert.Equal(t, 6, result.QueueLengthDepsMet)
+		assert.Equal(t, 0, result.ExistingHosts)
+		assert.Equal(t, 4, result.NewHosts)
+		assert.Equal(t, 4, result.TotalHosts)
+
+		startDelay := 4 * time.Minute
+		require.Len(t, result.Tasks, 6)
+		for i, simulated := range result.Tasks {
+			assert.Equal(t, 20*time.Minute, simulated.ExpectedDuration)
+			if i < 4 {
+				assert.Equal(t, startDelay, simulated.ProjectedWait)
+			} else {
+				assert.Equal(t, startDelay+20*time.Minute, simulated.ProjectedWait)
+			}
+		}
+		assert.Equal(t, startDelay+40*time.Minute, result.Makespan)
+		assert.Equal(t, startDelay+40*time.Minute, result.MaxWait+20*time.Minute)
+		assert.Equal(t, 2*(startDelay+40*time.Minute)+2*(startDelay+20*time.Minute), result.HostTime)
+		assert.InDelta(t, result.HostTime.Hours(), result.EstimatedCost, 0.0001)
+	})
+	t.Run("OverridesHostAllocatorSettings", func(t *testing.T) {
+		result, err := Simulate(t.Context(), makeSnapshot(), SimulationOptions{
+			HostAllocatorSettings: distro.HostAllocatorSettings{MaximumHosts: 2},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.HostAllocatorSettings.MaximumHosts)
+		assert.Equal(t, evergreen.HostAllocatorUtilization, result.HostAllocatorSettings.Version)
+		assert.Equal(t, 2, result.NewHosts)
+		assert.Equal(t, 4*time.Minute+60*time.Minute, result.Makespan)
+	})
+	t.Run("OverridesPlannerSettings", func(t *testing.T) {
+		result, err := Simulate(t.Context(), makeSnapshot(), SimulationOptions{
+			PlannerSettings: distro.PlannerSettings{TargetTime: time.Hour},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Hour, result.PlannerSettings.TargetTime)
+		assert.False(t, utility.FromBoolPtr(result.PlannerSettings.GroupVersions))
+		assert.Equal(t, 2, result.NewHosts)
+	})
+	t.Run("UsesExistingHosts", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.Hosts = []host.Host{
+			{Id: "h1", Status: evergreen.HostRunning},
+			{Id: "h2", Status: evergreen.HostRunning, RunningTask: "running"},
+		}
+		snapshot.RunningTasks = []task.Task{
+			{
+				Id:               "running",
+				StartTime:        takenAt.Add(-10 * time.Minute),
+				ExpectedDuration: 20 * time.Minute,
+			},
+		}
+		result, err := Simulate(t.Context(), snapshot, SimulationOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.ExistingHosts)
+		require.Len(t, result.Tasks, 6)
+		// The idle host runs the first task immediately.
+		assert.Zero(t, result.Tasks[0].ProjectedWait)
+	})
+	t.Run("FairShareOrdersUnderusedProjectsFirst", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		for i := range snapshot.Tasks {
+			snapshot.Tasks[i].Project = "heavy"
+			if i >= 3 {
+				snapshot.Tasks[i].Project = "light"
+			}
+		}
+		snapshot.ProjectHostUsage = &ProjectHostUsage{
+			HostTime: map[string]time.Duration{"heavy": 10 * time.Hour, "light": time.Hour},
+			Repos:    map[string]string{"heavy": "", "light": ""},
+		}
+
+		result, err := Simulate(t.Context(), snapshot, SimulationOptions{
+			PlannerSettings: distro.PlannerSettings{Version: evergreen.PlannerVersionFairShare},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Tasks, 6)
+		for i, simulated := range result.Tasks {
+			if i < 3 {
+				assert.Contains(t, []string{"t3", "t4", "t5"}, simulated.ID)
+			} else {
+				assert.Contains(t, []string{"t0", "t1", "t2"}, simulated.ID)
+			}
+		}
+	})
+	t.Run("ProjectsDependenciesInQueue", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.Tasks = snapshot.Tasks[:2]
+		snapshot.Tasks[1].DependsOn = []task.Dependency{{TaskId: "t0"}}
+		snapshot.Tasks[1].DependenciesMetTime = takenAt
+		snapshot.Hosts = []host.Host{
+			{Id: "h1", Status: evergreen.HostRunning},
+			{Id: "h2", Status: evergreen.HostRunning},
+		}
+		result, err := Simulate(t.Context(), snapshot, SimulationOptions{
+			HostAllocatorSettings: distro.HostAllocatorSettings{MaximumHosts: 2},
+		})
+		require.NoError(t, err)
+
+		waits := map[string]time.Duration{}
+		for _, simulated := range result.Tasks {
+			waits[simulated.ID] = simulated.ProjectedWait
+		}
+		assert.Zero(t, waits["t0"])
+		assert.Equal(t, 20*time.Minute, waits["t1"])
+	})
+}
+
+func TestMergePlannerSettings(t *testing.T) {
+	base := distro.PlannerSettings{
+		Version:     evergreen.PlannerVersionTunable,
+		TargetTime:  time.Minute,
+		PatchFactor: 5,
+	}
+	merged := mergePlannerSettings(base, distro.PlannerSettings{PatchFactor: 10})
+	assert.Equal(t, evergreen.PlannerVersionTunable, merged.Version)
+	assert.Equal(t, time.Minute, merged.TargetTime)
+	assert.EqualValues(t, 10, merged.PatchFactor)
+}
@@ -36,7 +36,7 @@ func UtilizationBasedHostAllocator(ctx context.Context, hostAllocatorData *HostA
 		}
 	}
 
-	if distro.Provider != evergreen.ProviderNameDocker && numExistingHosts >= distro.HostAllocatorSettings.MaximumHosts {
+	if !evergreen.IsContainerProvider(distro.Provider) && numExistingHosts >= distro.HostAllocatorSettings.MaximumHosts {
 		grip.Info(ctx, message.Fields{
 			"message":        "distro is at max hosts",
 			"distro":         distro.Id,
@@ -95,7 +95,8 @@ func UtilizationBasedHostAllocator(ctx context.Context, hostAllocatorData *HostA
 			distro.HostAllocatorSettings.FutureHostFraction,
 			hostAllocatorData.ContainerPool,
 			hostAllocatorData.DistroQueueInfo.MaxDurationThreshold,
-			maxHosts)
+			maxHosts,
+			hostAllocatorData.getRunningTaskFinder())
 
 		if err != nil {
 			return 0, len(freeHosts), errors.Wrapf(err, "error calculating hosts for distro %s", distro.Id)
@@ -132,7 +133,7 @@ func UtilizationBasedHostAllocator(ctx context.Context, hostAllocatorData *HostA
 // evalHostUtilization calculates the number of hosts needed by taking the total task scheduled task time
 // and dividing it by the target duration. Request however many hosts are needed to achieve that minus the
 // number of free hosts
-func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData TaskGroupData, futureHostFraction float64, containerPool *evergreen.ContainerPool, maxDurationThreshold time.Duration, maxHosts int) (int, int, error) {
+func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData TaskGroupData, futureHostFraction float64, containerPool *evergreen.ContainerPool, maxDurationThreshold time.Duration, maxHosts int, findRunningTasks runningTaskFinder) (int, int, error) {
 	existingHosts := taskGroupData.Hosts
 	taskGroupInfo := taskGroupData.Info
 	numLongRunningTasks := taskGroupInfo.CountDurationOverThreshold
@@ -165,7 +166,7 @@ func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData Tas
 	// summing their estimated time left to completion, and dividing that number by maxDurationThreshold.
 	// That estimate is then multiplied by the futureHostFraction coefficient, which is a fraction that allows us
 	// to tune the final estimate up or down.
-	expectedNumFreeHosts, err := calcExistingFreeHosts(ctx, existingHosts, futureHostFraction, maxDurationThreshold)
+	expectedNumFreeHosts, err := calcExistingFreeHosts(ctx, existingHosts, futureHostFraction, maxDurationThreshold, findRunningTasks)
 	if err != nil {
 		return numNewHosts, expectedNumFreeHosts, err
 	}
@@ -297,7 +298,7 @@ func calcNewHostsNeeded(totalShortRunningTasksExpectedDuration, maxDurationPerHo
 
 // calcExistingFreeHosts returns the number of hosts that are not running a task,
 // plus hosts that will soon be free scaled by some fraction
-func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFactor float64, maxDurationPerHost time.Duration) (int, error) {
+func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFactor float64, maxDurationPerHost time.Duration, findRunningTasks runningTaskFinder) (int, error) {
 	numFreeHosts := 0
 	if futureHostFactor > 1 {
 		return numFreeHosts, errors.New("future host factor cannot be greater than 1")
@@ -309,7 +310,7 @@ func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futur
 		}
 	}
 
-	soonToBeFree, err := getSoonToBeFreeHosts(ctx, existingHosts, futureHostFactor, maxDurationPerHost)
+	soonToBeFree, err := getSoonToBeFreeHosts(ctx, existingHosts, futureHostFactor, maxDurationPerHost, findRunningTasks)
 	if err != nil {
 		return 0, err
 	}
@@ -321,7 +322,7 @@ func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futur
 // to be free for some fraction of the next maxDurationPerHost interval
 // the final value is scaled by some fraction representing how confident we are that
 // the hosts will actually be free in the expected amount of time
-func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFraction float64, maxDurationPerHost time.Duration) (float64, error) {
+func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFraction float64, maxDurationPerHost time.Duration, findRunningTasks runningTaskFinder) (float64, error) {
 	runningTaskIds := []string{}
 
 	for _, existingDistroHost := range existingHosts {
@@ -334,7 +335,7 @@ func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, future
 		return 0.0, nil
 	}
 
-	runningTasks, err := task.Find(ctx, task.ByIds(runningTaskIds))
+	runningTasks, err := findRunningTasks(ctx, runningTaskIds)
 	if err != nil {
 		return 0.0, err
 	}
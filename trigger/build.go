@@ -209,6 +209,7 @@ func (t *buildTriggers) makeData(ctx context.Context, sub *event.Subscription, p
 		URL:             t.build.GetURL(t.uiConfig.Url),
 		PastTenseStatus: t.data.Status,
 		apiModel:        &api,
+		Build:           t.build,
 	}
 
 	if t.data.GithubCheckStatus != "" {
@@ -302,7 +303,7 @@ func (t *buildTriggers) generate(ctx context.Context, sub *event.Subscription, p
 		return nil, errors.Wrap(err, "collecting build data")
 	}
 
-	payload, err := makeCommonPayload(sub, t.Attributes(), data)
+	payload, err := makeCommonPayload(ctx, sub, t.Attributes(), data)
 	if err != nil {
 		return nil, errors.Wrap(err, "building notification")
 	}
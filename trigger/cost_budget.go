@@ -0,0 +1,125 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	registry.registerEventHandler(event.ResourceTypeCostBudget, event.EventCostBudgetThresholdReached, makeCostBudgetTriggers)
+}
+
+const costBudgetObjectName = "cost budget"
+
+type costBudgetTriggers struct {
+	event    *event.EventLogEntry
+	data     *event.CostBudgetEventData
+	uiConfig evergreen.UIConfig
+
+	base
+}
+
+func makeCostBudgetTriggers() eventHandler {
+	t := &costBudgetTriggers{}
+	t.base.triggers = map[string]trigger{
+		event.TriggerCostBudgetThreshold: t.costBudgetThreshold,
+	}
+	return t
+}
+
+func (t *costBudgetTriggers) Fetch(ctx context.Context, e *event.EventLogEntry) error {
+	var ok bool
+	t.data, ok = e.Data.(*event.CostBudgetEventData)
+	if !ok {
+		return errors.Errorf("cost budget event for project '%s' contains unexpected data with type '%T'", e.ResourceId, e.Data)
+	}
+
+	if t.data.ProjectIdentifier == "" {
+		identifier, err := model.GetIdentifierForProject(ctx, t.data.ProjectID)
+		if err != nil {
+			return errors.Wrapf(err, "getting identifier for project '%s'", t.data.ProjectID)
+		}
+		t.data.ProjectIdentifier = identifier
+	}
+
+	if err := t.uiConfig.Get(ctx); err != nil {
+		return errors.Wrap(err, "fetching UI config")
+	}
+
+	t.event = e
+	return nil
+}
+
+func (t *costBudgetTriggers) Attributes() event.Attributes {
+	attributes := event.Attributes{
+		ID:      []string{t.data.ProjectID},
+		Object:  []string{event.ObjectCostBudget},
+		Project: []string{t.data.ProjectID},
+	}
+	if t.data.VersionID != "" {
+		attributes.InVersion = []string{t.data.VersionID}
+	}
+	return attributes
+}
+
+func (t *costBudgetTriggers) costBudgetThreshold(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	data := t.makeData(sub)
+	payload, err := makeCommonPayload(ctx, sub, t.Attributes(), data)
+	if err != nil {
+		return nil, errors.Wrap(err, "building notification")
+	}
+	if payload == nil {
+		return nil, nil
+	}
+
+	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
+}
+
+func (t *costBudgetTriggers) makeData(sub *event.Subscription) *commonTemplateData {
+	projectName := t.data.ProjectIdentifier
+	if projectName == "" {
+		projectName = t.data.ProjectID
+	}
+
+	data := &commonTemplateData{
+		ID:              t.data.ProjectID,
+		EventID:         t.event.ID,
+		SubscriptionID:  sub.ID,
+		Object:          costBudgetObjectName,
+		Project:         projectName,
+		PastTenseStatus: fmt.Sprintf("reached %d%% ($%.2f of $%.2f)", t.data.ThresholdPercent, t.data.Spend, t.data.Budget),
+		apiModel:        t.data,
+	}
+
+	switch t.data.BudgetType {
+	case event.CostBudgetTypeVersion:
+		data.DisplayName = fmt.Sprintf("for version %s", t.data.VersionID)
+		data.URL = versionLink(versionLinkInput{uiBase: t.uiConfig.UIv2Url, versionID: t.data.VersionID})
+	default:
+		data.DisplayName = fmt.Sprintf("for %s", t.data.Period)
+		data.URL = fmt.Sprintf("%s/project/%s/settings/general", t.uiConfig.UIv2Url, projectName)
+	}
+	data.Description = fmt.Sprintf("Spend of $%.2f in project '%s' has reached %d%% of its %s cost budget of $%.2f.",
+		t.data.Spend, projectName, t.data.ThresholdPercent, t.data.BudgetType, t.data.Budget)
+
+	data.slack = []message.SlackAttachment{
+		{
+			Title:     fmt.Sprintf("Cost budget %s", data.DisplayName),
+			TitleLink: data.URL,
+			Color:     evergreenFailColor,
+			Fields: []*message.SlackAttachmentField{
+				{Title: "Budget", Value: fmt.Sprintf("$%.2f", t.data.Budget), Short: true},
+				{Title: "Spend", Value: fmt.Sprintf("$%.2f", t.data.Spend), Short: true},
+			},
+		},
+	}
+
+	return data
+}
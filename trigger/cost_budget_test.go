@@ -0,0 +1,89 @@
+package trigger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostBudgetTriggers(t *testing.T) {
+	makeTriggers := func(data *event.CostBudgetEventData) *costBudgetTriggers {
+		triggers := makeCostBudgetTriggers().(*costBudgetTriggers)
+		triggers.event = &event.EventLogEntry{ID: "e0", ResourceId: data.ProjectID}
+		triggers.data = data
+		triggers.uiConfig = evergreen.UIConfig{UIv2Url: "https://spruce.example.com"}
+		return triggers
+	}
+	monthly := &event.CostBudgetEventData{
+		ProjectID:         "project_id",
+		ProjectIdentifier: "project",
+		BudgetType:        event.CostBudgetTypeMonthly,
+		Period:            "2026-10",
+		Budget:            1000,
+		Spend:             812.5,
+		ThresholdPercent:  80,
+	}
+	version := &event.CostBudgetEventData{
+		ProjectID:         "project_id",
+		ProjectIdentifier: "project",
+		BudgetType:        event.CostBudgetTypeVersion,
+		VersionID:         "v0",
+		Budget:            50,
+		Spend:             51,
+		ThresholdPercent:  100,
+	}
+
+	t.Run("ValidateTrigger", func(t *testing.T) {
+		assert.True(t, ValidateTrigger(event.ResourceTypeCostBudget, event.TriggerCostBudgetThreshold))
+		assert.False(t, ValidateTrigger(event.ResourceTypeCostBudget, event.TriggerOutcome))
+	})
+	t.Run("Attributes", func(t *testing.T) {
+		attributes := makeTriggers(version).Attributes()
+		assert.Equal(t, []string{"project_id"}, attributes.ID)
+		assert.Equal(t, []string{event.ObjectCostBudget}, attributes.Object)
+		assert.Equal(t, []string{"project_id"}, attributes.Project)
+		assert.Equal(t, []string{"v0"}, attributes.InVersion)
+		assert.Empty(t, makeTriggers(monthly).Attributes().InVersion)
+	})
+	t.Run("Email", func(t *testing.T) {
+		sub := &event.Subscription{ID: "s0", Trigger: event.TriggerCostBudgetThreshold, Subscriber: event.Subscriber{Type: event.EmailSubscriberType, Target: "a@b.com"}}
+		n, err := makeTriggers(monthly).costBudgetThreshold(t.Context(), sub)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		email, ok := n.Payload.(*message.Email)
+		require.True(t, ok)
+		assert.Equal(t, "Evergreen: cost budget for 2026-10 in 'project' has reached 80% ($812.50 of $1000.00)!", email.Subject)
+		assert.Contains(t, email.Body, "https://spruce.example.com/project/project/settings/general")
+	})
+	t.Run("Slack", func(t *testing.T) {
+		sub := &event.Subscription{ID: "s0", Trigger: event.TriggerCostBudgetThreshold, Subscriber: event.Subscriber{Type: event.SlackSubscriberType, Target: "#channel"}}
+		n, err := makeTriggers(version).costBudgetThreshold(t.Context(), sub)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		slack, ok := n.Payload.(*notification.SlackPayload)
+		require.True(t, ok)
+		assert.Contains(t, slack.Body, "for version v0")
+		assert.Contains(t, slack.Body, "https://spruce.example.com/version/v0")
+		require.Len(t, slack.Attachments, 1)
+		assert.Len(t, slack.Attachments[0].Fields, 2)
+	})
+	t.Run("Webhook", func(t *testing.T) {
+		sub := &event.Subscription{ID: "s0", Trigger: event.TriggerCostBudgetThreshold, Subscriber: event.Subscriber{Type: event.EvergreenWebhookSubscriberType, Target: &event.WebhookSubscriber{URL: "https://example.com", Secret: []byte("secret")}}}
+		n, err := makeTriggers(version).costBudgetThreshold(t.Context(), sub)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		webhook, ok := n.Payload.(*util.EvergreenWebhook)
+		require.True(t, ok)
+		data := event.CostBudgetEventData{}
+		require.NoError(t, json.Unmarshal(webhook.Body, &data))
+		assert.Equal(t, *version, data)
+		assert.Equal(t, []string{event.ObjectCostBudget}, webhook.Headers["X-Evergreen-object"])
+	})
+}
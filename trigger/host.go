@@ -134,7 +134,7 @@ func (t *hostTriggers) Fetch(ctx context.Context, e *event.EventLogEntry) error
 	return nil
 }
 
-func (t *hostTriggers) generateExpiration(sub *event.Subscription) (*notification.Notification, error) {
+func (t *hostTriggers) generateExpiration(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	var payload any
 	var err error
 	switch sub.Subscriber.Type {
@@ -148,11 +148,12 @@ func (t *hostTriggers) generateExpiration(sub *event.Subscription) (*notificatio
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating template for event type '%s'", sub.Subscriber.Type)
 	}
+	applySubscriptionTemplate(ctx, sub, t.notificationTemplateData(sub), payload)
 
 	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
 }
 
-func (t *hostTriggers) generateTemporaryExemptionExpiration(sub *event.Subscription) (*notification.Notification, error) {
+func (t *hostTriggers) generateTemporaryExemptionExpiration(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	var payload any
 	var err error
 	switch sub.Subscriber.Type {
@@ -166,19 +167,45 @@ func (t *hostTriggers) generateTemporaryExemptionExpiration(sub *event.Subscript
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating template for event type '%s'", sub.Subscriber.Type)
 	}
+	applySubscriptionTemplate(ctx, sub, t.notificationTemplateData(sub), payload)
 
 	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
 }
 
-func (t *hostTriggers) generateIdleSpawnHost(sub *event.Subscription, body string) (*notification.Notification, error) {
+func (t *hostTriggers) generateIdleSpawnHost(ctx context.Context, sub *event.Subscription, body string) (*notification.Notification, error) {
 	payload, err := t.templateData.hostEmailPayload(idleHostEmailSubject, body, t.Attributes())
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating idle spawn host template for host '%s'", sub.ID)
 	}
+	applySubscriptionTemplate(ctx, sub, t.notificationTemplateData(sub), payload)
+
 	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
 }
 
-func (t *hostTriggers) generateAlertableInstanceType(sub *event.Subscription) (*notification.Notification, error) {
+// notificationTemplateData returns the data that a subscription's template is
+// executed against.
+func (t *hostTriggers) notificationTemplateData(sub *event.Subscription) event.NotificationTemplateData {
+	return event.NotificationTemplateData{
+		Object:         event.ObjectHost,
+		ID:             t.host.Id,
+		DisplayName:    t.templateData.Name,
+		URL:            t.templateData.URL,
+		Status:         t.host.Status,
+		EventID:        t.event.ID,
+		SubscriptionID: sub.ID,
+		Trigger:        sub.Trigger,
+		Host: &event.NotificationTemplateHost{
+			ID:           t.host.Id,
+			DisplayName:  t.templateData.Name,
+			Distro:       t.host.Distro.Id,
+			InstanceType: t.host.InstanceType,
+			Status:       t.host.Status,
+			StartedBy:    t.host.StartedBy,
+		},
+	}
+}
+
+func (t *hostTriggers) generateAlertableInstanceType(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	var payload any
 	var err error
 	switch sub.Subscriber.Type {
@@ -192,6 +219,7 @@ func (t *hostTriggers) generateAlertableInstanceType(sub *event.Subscription) (*
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating template for event type '%s'", sub.Subscriber.Type)
 	}
+	applySubscriptionTemplate(ctx, sub, t.notificationTemplateData(sub), payload)
 
 	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
 }
@@ -263,14 +291,14 @@ func (t *hostTriggers) makeHostExpirationNotification(ctx context.Context, sub *
 	timeZone := t.getTimeZone(ctx, sub, "host expiration")
 	t.templateData.ExpirationTime = t.host.ExpirationTime.In(timeZone).Format(time.RFC1123)
 
-	return t.generateExpiration(sub)
+	return t.generateExpiration(ctx, sub)
 }
 
 func (t *hostTriggers) makeHostTemporaryExemptionNotification(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	timeZone := t.getTimeZone(ctx, sub, "host temporary exemption expiration")
 	t.templateData.ExpirationTime = t.host.SleepSchedule.TemporarilyExemptUntil.In(timeZone).Format(time.RFC1123)
 
-	return t.generateTemporaryExemptionExpiration(sub)
+	return t.generateTemporaryExemptionExpiration(ctx, sub)
 }
 
 func (t *hostTriggers) getTimeZone(ctx context.Context, sub *event.Subscription, trigger string) *time.Location {
@@ -298,9 +326,9 @@ func (t *hostTriggers) spawnHostIdle(ctx context.Context, sub *event.Subscriptio
 	if !shouldNotify {
 		return nil, nil
 	}
-	return t.generateIdleSpawnHost(sub, idleStoppedHostEmailBody)
+	return t.generateIdleSpawnHost(ctx, sub, idleStoppedHostEmailBody)
 }
 
 func (t *hostTriggers) alertableInstanceType(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
-	return t.generateAlertableInstanceType(sub)
+	return t.generateAlertableInstanceType(ctx, sub)
 }
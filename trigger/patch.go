@@ -339,7 +339,7 @@ func (t *patchTriggers) generate(ctx context.Context, sub *event.Subscription) (
 		return nil, errors.Wrap(err, "collecting patch data")
 	}
 
-	payload, err := makeCommonPayload(sub, t.Attributes(), data)
+	payload, err := makeCommonPayload(ctx, sub, t.Attributes(), data)
 	if err != nil {
 		return nil, errors.Wrap(err, "building notification")
 	}
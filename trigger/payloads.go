@@ -2,6 +2,7 @@ package trigger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -17,6 +18,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 )
@@ -52,6 +54,7 @@ type commonTemplateData struct {
 	Task       *task.Task
 	ProjectRef *model.ProjectRef
 	Build      *build.Build
+	Version    *model.Version
 
 	apiModel any
 	slack    []message.SlackAttachment
@@ -242,6 +245,10 @@ const jiraIssueTitle string = "Evergreen {{ .Object }} '{{ .DisplayName }}' in '
 
 const slackTemplate string = `The {{ .Object }} <{{ .URL }}|{{ .DisplayName }}> in '{{ .Project }}' has {{ .PastTenseStatus }}!`
 
+const chatTemplate string = `The {{ .Object }} [{{ .DisplayName }}]({{ .URL }}) in '{{ .Project }}' has {{ .PastTenseStatus }}!`
+
+const teamsTemplate string = `The {{ .Object }} {{ .DisplayName }} in '{{ .Project }}' has {{ .PastTenseStatus }}!`
+
 func makeHeaders(headerMap map[string][]string) http.Header {
 	headers := http.Header{}
 	for headerField, headerData := range headerMap {
@@ -377,6 +384,146 @@ func slack(t *commonTemplateData) (*notification.SlackPayload, error) {
 	}, nil
 }
 
+// teams builds a Microsoft Teams message with an Adaptive Card. The card has
+// the same content as the Slack message for the event.
+func teams(t *commonTemplateData) (*util.ChatMessage, error) {
+	titleTmpl, err := ttemplate.New("teams").Parse(teamsTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Teams template")
+	}
+
+	buf := &bytes.Buffer{}
+	if err = titleTmpl.Execute(buf, t); err != nil {
+		return nil, errors.Wrap(err, "generating Teams message title from template")
+	}
+
+	body := []any{
+		map[string]any{
+			"type":   "TextBlock",
+			"text":   buf.String(),
+			"weight": "bolder",
+			"wrap":   true,
+		},
+	}
+	for _, attachment := range t.slack {
+		if attachment.Title != "" {
+			title := attachment.Title
+			if attachment.TitleLink != "" {
+				title = fmt.Sprintf("[%s](%s)", attachment.Title, attachment.TitleLink)
+			}
+			body = append(body, map[string]any{
+				"type": "TextBlock",
+				"text": title,
+				"wrap": true,
+			})
+		}
+		if attachment.Text != "" {
+			body = append(body, map[string]any{
+				"type":     "TextBlock",
+				"text":     attachment.Text,
+				"isSubtle": true,
+				"wrap":     true,
+			})
+		}
+		if len(attachment.Fields) > 0 {
+			facts := make([]map[string]string, 0, len(attachment.Fields))
+			for _, field := range attachment.Fields {
+				facts = append(facts, map[string]string{"title": field.Title, "value": field.Value})
+			}
+			body = append(body, map[string]any{
+				"type":  "FactSet",
+				"facts": facts,
+			})
+		}
+	}
+	body = append(body, map[string]any{
+		"type":     "TextBlock",
+		"text":     fmt.Sprintf("Subscription: %s; Event: %s", t.SubscriptionID, t.EventID),
+		"size":     "small",
+		"isSubtle": true,
+		"wrap":     true,
+	})
+
+	return teamsMessage(body, t.Object, t.URL)
+}
+
+// teamsMessage wraps the Adaptive Card body in a Teams message, with a button
+// that links to the object in Evergreen.
+func teamsMessage(body []any, object, link string) (*util.ChatMessage, error) {
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+		"actions": []map[string]string{
+			{
+				"type":  "Action.OpenUrl",
+				"title": fmt.Sprintf("View %s", object),
+				"url":   link,
+			},
+		},
+	}
+	msg := map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+
+	bytes, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "building Teams message JSON")
+	}
+
+	return &util.ChatMessage{Body: bytes}, nil
+}
+
+// chat builds a Markdown message for a generic chat-ops webhook. The message
+// has the same content as the Slack message for the event.
+func chat(t *commonTemplateData) (*util.ChatMessage, error) {
+	chatTmpl, err := ttemplate.New("chat").Parse(chatTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing chat template")
+	}
+
+	buf := &bytes.Buffer{}
+	if err = chatTmpl.Execute(buf, t); err != nil {
+		return nil, errors.Wrap(err, "generating chat message text from template")
+	}
+
+	for _, attachment := range t.slack {
+		if attachment.Title != "" {
+			if attachment.TitleLink != "" {
+				fmt.Fprintf(buf, "\n\n**[%s](%s)**", attachment.Title, attachment.TitleLink)
+			} else {
+				fmt.Fprintf(buf, "\n\n**%s**", attachment.Title)
+			}
+		}
+		if attachment.Text != "" {
+			fmt.Fprintf(buf, "\n\n%s", attachment.Text)
+		}
+		for _, field := range attachment.Fields {
+			fmt.Fprintf(buf, "\n- **%s**: %s", field.Title, field.Value)
+		}
+	}
+	fmt.Fprintf(buf, "\n\n_Subscription: %s; Event: %s_", t.SubscriptionID, t.EventID)
+
+	return chatMessage(buf.String())
+}
+
+// chatMessage builds a chat-ops webhook message with the Markdown text.
+func chatMessage(text string) (*util.ChatMessage, error) {
+	bytes, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, errors.Wrap(err, "building chat message JSON")
+	}
+
+	return &util.ChatMessage{Body: bytes}, nil
+}
+
 // truncateString splits a string into two parts, with the following behavior:
 // If the entire string is <= capacity, it's returned unchanged.
 // Otherwise, the string is split at the (capacity-3)'th byte. The first string
@@ -396,7 +543,17 @@ func truncateString(s string, capacity int) (string, string) {
 	return head, tail
 }
 
-func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes,
+func makeCommonPayload(ctx context.Context, sub *event.Subscription, eventAttributes event.Attributes,
+	data *commonTemplateData) (any, error) {
+	payload, err := makeDefaultPayload(sub, eventAttributes, data)
+	if err != nil || payload == nil {
+		return payload, err
+	}
+	applySubscriptionTemplate(ctx, sub, data.notificationTemplateData(sub.Trigger), payload)
+	return payload, nil
+}
+
+func makeDefaultPayload(sub *event.Subscription, eventAttributes event.Attributes,
 	data *commonTemplateData) (any, error) {
 	var err error
 	headerMap := eventAttributes.ToSelectorMap()
@@ -404,7 +561,7 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 	headerMap[event.SelectorStatus] = append(headerMap[event.SelectorStatus], data.PastTenseStatus)
 	data.Headers = makeHeaders(headerMap)
 	data.SubscriptionID = sub.ID
-	if data.Task != nil {
+	if data.Task != nil && data.FailedTests == nil {
 		data.FailedTests, err = getFailedTestsFromTemplate(*data.Task)
 		if err != nil {
 			return nil, errors.Wrap(err, "getting failed tests")
@@ -441,6 +598,13 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 
 	case event.SlackSubscriberType:
 		return slack(data)
+
+	case event.TeamsSubscriberType:
+		return teams(data)
+
+	case event.ChatSubscriberType:
+		return chat(data)
+
 	case event.RunChildPatchSubscriberType:
 		return nil, nil
 	}
@@ -448,6 +612,137 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 	return nil, errors.Errorf("unknown subscriber type '%s'", sub.Subscriber.Type)
 }
 
+// notificationTemplateData returns the data that a subscription's template is
+// executed against.
+func (t *commonTemplateData) notificationTemplateData(trigger string) event.NotificationTemplateData {
+	data := event.NotificationTemplateData{
+		Object:         t.Object,
+		ID:             t.ID,
+		DisplayName:    t.DisplayName,
+		Project:        t.Project,
+		URL:            t.URL,
+		Status:         t.PastTenseStatus,
+		Description:    t.Description,
+		EventID:        t.EventID,
+		SubscriptionID: t.SubscriptionID,
+		Trigger:        trigger,
+	}
+	if t.Task != nil {
+		data.Task = &event.NotificationTemplateTask{
+			ID:                 t.Task.Id,
+			DisplayName:        t.Task.DisplayName,
+			BuildVariant:       t.Task.BuildVariant,
+			Status:             t.Task.Status,
+			Execution:          t.Task.Execution,
+			Requester:          t.Task.Requester,
+			Revision:           t.Task.Revision,
+			Tags:               t.Task.Tags,
+			FailureType:        t.Task.Details.Type,
+			FailureDescription: t.Task.Details.Description,
+			TimedOut:           t.Task.Details.TimedOut,
+			StartTime:          t.Task.StartTime,
+			FinishTime:         t.Task.FinishTime,
+		}
+		if t.Task.DisplayTask != nil {
+			data.Task.DisplayName = t.Task.DisplayTask.DisplayName
+		}
+	}
+	if t.Build != nil {
+		data.Build = &event.NotificationTemplateBuild{
+			ID:           t.Build.Id,
+			BuildVariant: t.Build.BuildVariant,
+			DisplayName:  t.Build.DisplayName,
+			Status:       t.Build.Status,
+		}
+	}
+	if t.Version != nil {
+		data.Version = &event.NotificationTemplateVersion{
+			ID:        t.Version.Id,
+			Revision:  t.Version.Revision,
+			Author:    t.Version.Author,
+			Message:   t.Version.Message,
+			Requester: t.Version.Requester,
+			Status:    t.Version.Status,
+			Branch:    t.Version.Branch,
+		}
+	}
+	for _, test := range t.FailedTests {
+		data.FailedTests = append(data.FailedTests, event.NotificationTemplateTest{
+			Name:   test.GetDisplayTestName(),
+			Status: test.Status,
+			LogURL: test.LogURL,
+		})
+	}
+
+	return data
+}
+
+// applySubscriptionTemplate replaces the body of the payload with the
+// subscription's template, if it has one. If the template can't be executed,
+// the payload is left unchanged so the notification isn't lost.
+func applySubscriptionTemplate(ctx context.Context, sub *event.Subscription, data event.NotificationTemplateData, payload any) {
+	if sub.Template == "" {
+		return
+	}
+
+	body, err := event.ExecuteNotificationTemplate(sub.Template, data)
+	if err == nil {
+		err = setTemplatedChatMessage(sub, data, body, payload)
+	}
+	if err != nil {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message":         "could not execute subscription's notification template, falling back to the default",
+			"subscription_id": sub.ID,
+			"subscriber_type": sub.Subscriber.Type,
+			"trigger":         sub.Trigger,
+		}))
+		return
+	}
+
+	switch p := payload.(type) {
+	case *notification.SlackPayload:
+		p.Body = body
+		p.Attachments = nil
+	case *message.Email:
+		p.Body = body
+		p.PlainTextContents = true
+	case *util.EvergreenWebhook:
+		p.Body = []byte(body)
+	}
+}
+
+// setTemplatedChatMessage replaces the body of a Teams or chat payload with
+// the rendered template. Teams messages show it in an Adaptive Card, and chat
+// messages post it as the Markdown text.
+func setTemplatedChatMessage(sub *event.Subscription, data event.NotificationTemplateData, body string, payload any) error {
+	p, ok := payload.(*util.ChatMessage)
+	if !ok {
+		return nil
+	}
+
+	var msg *util.ChatMessage
+	var err error
+	switch sub.Subscriber.Type {
+	case event.TeamsSubscriberType:
+		msg, err = teamsMessage([]any{
+			map[string]any{
+				"type": "TextBlock",
+				"text": body,
+				"wrap": true,
+			},
+		}, data.Object, data.URL)
+	case event.ChatSubscriberType:
+		msg, err = chatMessage(body)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.Body = msg.Body
+	return nil
+}
+
 func getFailedTestsFromTemplate(t task.Task) ([]testresult.TestResult, error) {
 	results := []testresult.TestResult{}
 	for i := range t.LocalTestResults {
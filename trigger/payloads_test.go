@@ -2,6 +2,7 @@ package trigger
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -10,11 +11,13 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -162,6 +165,110 @@ func (s *payloadSuite) TestSlack() {
 	s.Empty(m.Attachments)
 }
 
+func (s *payloadSuite) TestTeams() {
+	s.t.slack = []message.SlackAttachment{
+		{
+			Title:     "thetask",
+			TitleLink: "https://example.com/task/thetask",
+			Fields: []*message.SlackAttachmentField{
+				{Title: "Build", Value: "buildname"},
+			},
+		},
+	}
+
+	m, err := teams(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	body := map[string]any{}
+	s.Require().NoError(json.Unmarshal(m.Body, &body))
+	s.Equal("message", body["type"])
+	attachments, ok := body["attachments"].([]any)
+	s.Require().True(ok)
+	s.Require().Len(attachments, 1)
+	s.Equal("application/vnd.microsoft.card.adaptive", attachments[0].(map[string]any)["contentType"])
+
+	s.Contains(string(m.Body), "The patch display-1234 in 'test' has failed!")
+	s.Contains(string(m.Body), "[thetask](https://example.com/task/thetask)")
+	s.Contains(string(m.Body), `"FactSet"`)
+	s.Contains(string(m.Body), "buildname")
+	s.Contains(string(m.Body), s.url)
+	s.Contains(string(m.Body), "Subscription: subscriptionid; Event: eventid")
+}
+
+func (s *payloadSuite) TestChat() {
+	m, err := chat(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	body := map[string]string{}
+	s.Require().NoError(json.Unmarshal(m.Body, &body))
+	s.Equal("The patch [display-1234](https://example.com/patch/1234) in 'test' has failed!\n\n_Subscription: subscriptionid; Event: eventid_", body["text"])
+}
+
+func (s *payloadSuite) TestApplySubscriptionTemplate() {
+	s.t.Task = &task.Task{
+		Id:           "taskid",
+		DisplayName:  "thetask",
+		BuildVariant: "bv",
+		Details: apimodels.TaskEndDetail{
+			Type: evergreen.CommandTypeTest,
+		},
+	}
+	s.t.FailedTests = []testresult.TestResult{
+		{TestName: "test0", Status: evergreen.TestFailedStatus},
+		{TestName: "test1", DisplayTestName: "display_test1", Status: evergreen.TestFailedStatus},
+	}
+	sub := &event.Subscription{
+		ID:       "subscriptionid",
+		Trigger:  event.TriggerOutcome,
+		Template: "{{ .Task.DisplayName }} on {{ .Task.BuildVariant }} {{ .Status }}:{{ range .FailedTests }} {{ .Name }}{{ end }} cc @team-oncall",
+	}
+	data := s.t.notificationTemplateData(sub.Trigger)
+
+	slackPayload, err := slack(&s.t)
+	s.Require().NoError(err)
+	applySubscriptionTemplate(s.T().Context(), sub, data, slackPayload)
+	s.Equal("thetask on bv failed: test0 display_test1 cc @team-oncall", slackPayload.Body)
+	s.Empty(slackPayload.Attachments)
+
+	email, err := emailPayload(&s.t)
+	s.Require().NoError(err)
+	applySubscriptionTemplate(s.T().Context(), sub, data, email)
+	s.Equal("thetask on bv failed: test0 display_test1 cc @team-oncall", email.Body)
+	s.True(email.PlainTextContents)
+
+	webhook, err := webhookPayload(map[string]string{}, s.t.Headers)
+	s.Require().NoError(err)
+	sub.Template = `{"version": {{ .TemplateVersion }}, "task": "{{ .Task.ID }}"}`
+	applySubscriptionTemplate(s.T().Context(), sub, data, webhook)
+	s.Equal(`{"version": 1, "task": "taskid"}`, string(webhook.Body))
+
+	sub.Template = "{{ .Task.DisplayName }} {{ .Status }} cc @team-oncall"
+	sub.Subscriber.Type = event.ChatSubscriberType
+	chatPayload, err := chat(&s.t)
+	s.Require().NoError(err)
+	applySubscriptionTemplate(s.T().Context(), sub, data, chatPayload)
+	chatBody := map[string]string{}
+	s.Require().NoError(json.Unmarshal(chatPayload.Body, &chatBody))
+	s.Equal("thetask failed cc @team-oncall", chatBody["text"])
+
+	sub.Subscriber.Type = event.TeamsSubscriberType
+	teamsPayload, err := teams(&s.t)
+	s.Require().NoError(err)
+	applySubscriptionTemplate(s.T().Context(), sub, data, teamsPayload)
+	s.Contains(string(teamsPayload.Body), `"text":"thetask failed cc @team-oncall"`)
+	s.Contains(string(teamsPayload.Body), "application/vnd.microsoft.card.adaptive")
+	s.NotContains(string(teamsPayload.Body), "Subscription: subscriptionid")
+
+	// Templates that fail to execute fall back to the default payload.
+	slackPayload, err = slack(&s.t)
+	s.Require().NoError(err)
+	sub.Template = "{{ .Host.ID }}"
+	applySubscriptionTemplate(s.T().Context(), sub, data, slackPayload)
+	s.Equal("The patch <https://example.com/patch/1234|display-1234> in 'test' has failed!", slackPayload.Body)
+}
+
 func (s *payloadSuite) TestGetFailedTestsFromTemplate() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -84,6 +84,7 @@ func NotificationsFromEvent(ctx context.Context, e *event.EventLogEntry) ([]noti
 		}
 		grip.Info(ctx, msg)
 
+		n.Metadata.SubscriptionID = subscriptions[i].ID
 		notifications = append(notifications, *n)
 	}
 
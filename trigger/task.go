@@ -62,6 +62,8 @@ func makeTaskTriggers() eventHandler {
 		triggerTaskRegressionByTest:              t.taskRegressionByTest,
 		triggerBuildBreak:                        t.buildBreak,
 		triggerTaskFailedOrBlocked:               t.taskFailedOrBlocked,
+		event.TriggerTestNewFailure:              t.testNewFailure,
+		event.TriggerTestConsecutiveFailures:     t.testConsecutiveFailures,
 	}
 
 	return t
@@ -328,6 +330,13 @@ func (t *taskTriggers) makeData(ctx context.Context, sub *event.Subscription, pa
 }
 
 func (t *taskTriggers) generate(ctx context.Context, sub *event.Subscription, pastTenseOverride, testNames string) (*notification.Notification, error) {
+	return t.generateWithTests(ctx, sub, pastTenseOverride, testNames, nil)
+}
+
+// generateWithTests generates a notification for the task. If failedTests is
+// not nil, the notification lists only those tests instead of all of the
+// task's failed tests.
+func (t *taskTriggers) generateWithTests(ctx context.Context, sub *event.Subscription, pastTenseOverride, testNames string, failedTests []testresult.TestResult) (*notification.Notification, error) {
 	var payload any
 	if sub.Subscriber.Type == event.JIRAIssueSubscriberType {
 		issueSub, ok := sub.Subscriber.Target.(*event.JIRAIssueSubscriber)
@@ -346,8 +355,12 @@ func (t *taskTriggers) generate(ctx context.Context, sub *event.Subscription, pa
 			return nil, errors.Wrap(err, "collecting task data")
 		}
 		data.emailContent = emailTaskContentTemplate
+		if failedTests != nil {
+			data.FailedTests = failedTests
+			data.slack = append(data.slack, testsSlackAttachment(failedTests))
+		}
 
-		payload, err = makeCommonPayload(sub, t.Attributes(), data)
+		payload, err = makeCommonPayload(ctx, sub, t.Attributes(), data)
 		if err != nil {
 			return nil, errors.Wrap(err, "building notification")
 		}
@@ -735,7 +748,7 @@ func isValidFailedTaskStatus(status string) bool {
 func isTestStatusRegression(oldStatus, newStatus string) bool {
 	switch oldStatus {
 	case evergreen.TestSkippedStatus, evergreen.TestSucceededStatus,
-		evergreen.TestSilentlyFailedStatus:
+		evergreen.TestSilentlyFailedStatus, evergreen.TestQuarantinedFailedStatus:
 		if newStatus == evergreen.TestFailedStatus {
 			return true
 		}
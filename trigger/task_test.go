@@ -1193,6 +1193,12 @@ func TestIsTestRegression(t *testing.T) {
 	assert.False(isTestStatusRegression(evergreen.TestSilentlyFailedStatus, evergreen.TestSilentlyFailedStatus))
 	assert.False(isTestStatusRegression(evergreen.TestSilentlyFailedStatus, evergreen.TestSkippedStatus))
 	assert.False(isTestStatusRegression(evergreen.TestSilentlyFailedStatus, evergreen.TestSucceededStatus))
+
+	assert.True(isTestStatusRegression(evergreen.TestQuarantinedFailedStatus, evergreen.TestFailedStatus))
+	assert.False(isTestStatusRegression(evergreen.TestQuarantinedFailedStatus, evergreen.TestQuarantinedFailedStatus))
+	assert.False(isTestStatusRegression(evergreen.TestQuarantinedFailedStatus, evergreen.TestSucceededStatus))
+	assert.False(isTestStatusRegression(evergreen.TestSucceededStatus, evergreen.TestQuarantinedFailedStatus))
+	assert.False(isTestStatusRegression(evergreen.TestFailedStatus, evergreen.TestQuarantinedFailedStatus))
 }
 
 func TestMapTestResultsByTestName(t *testing.T) {
@@ -1231,6 +1237,100 @@ func TestMapTestResultsByTestName(t *testing.T) {
 	}
 }
 
+func (s *taskSuite) TestTestNewFailure() {
+	sub := s.subs[2]
+	sub.Trigger = event.TriggerTestNewFailure
+
+	// A task without an earlier run has nothing to compare against.
+	s.makeTask(1, evergreen.TaskFailed)
+	s.makeTest(s.ctx, "test_0", evergreen.TestFailedStatus)
+	s.t = s.makeTaskTriggers(s.task.Id, s.task.Execution)
+	n, err := s.t.testNewFailure(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	s.makeTask(2, evergreen.TaskFailed)
+	s.makeTest(s.ctx, "test_0", evergreen.TestFailedStatus)
+	s.makeTest(s.ctx, "test_1", evergreen.TestFailedStatus)
+	s.t = s.makeTaskTriggers(s.task.Id, s.task.Execution)
+	n, err = s.t.testNewFailure(s.ctx, &sub)
+	s.NoError(err)
+	s.NotNil(n)
+	rec, err := alertrecord.FindByTestFailure(s.ctx, sub.ID, alertrecord.TestNewFailureId, "test_1", s.task.DisplayName, s.task.BuildVariant, s.task.Project, 2)
+	s.NoError(err)
+	s.NotNil(rec)
+	rec, err = alertrecord.FindByTestFailure(s.ctx, sub.ID, alertrecord.TestNewFailureId, "test_0", s.task.DisplayName, s.task.BuildVariant, s.task.Project, 2)
+	s.NoError(err)
+	s.Nil(rec)
+
+	// Triggering again for the same task shouldn't notify again.
+	n, err = s.t.testNewFailure(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	// Both tests failed in the previous run.
+	s.makeTask(3, evergreen.TaskFailed)
+	s.makeTest(s.ctx, "test_0", evergreen.TestFailedStatus)
+	s.makeTest(s.ctx, "test_1", evergreen.TestFailedStatus)
+	s.t = s.makeTaskTriggers(s.task.Id, s.task.Execution)
+	n, err = s.t.testNewFailure(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+}
+
+func (s *taskSuite) TestTestConsecutiveFailures() {
+	sub := s.subs[2]
+	sub.Trigger = event.TriggerTestConsecutiveFailures
+	sub.TriggerData = map[string]string{event.TestConsecutiveFailuresKey: "3"}
+
+	shouldNotify := []bool{false, false, true, false}
+	for i, expected := range shouldNotify {
+		s.makeTask(i+1, evergreen.TaskFailed)
+		s.makeTest(s.ctx, "test_0", evergreen.TestFailedStatus)
+		s.t = s.makeTaskTriggers(s.task.Id, s.task.Execution)
+		n, err := s.t.testConsecutiveFailures(s.ctx, &sub)
+		s.NoError(err)
+		s.Equal(expected, n != nil, "run %d", i+1)
+	}
+
+	// A passing run resets the streak.
+	s.makeTask(5, evergreen.TaskSucceeded)
+	s.makeTest(s.ctx, "test_0", evergreen.TestSucceededStatus)
+	for i, expected := range shouldNotify {
+		s.makeTask(i+6, evergreen.TaskFailed)
+		s.makeTest(s.ctx, "test_0", evergreen.TestFailedStatus)
+		s.t = s.makeTaskTriggers(s.task.Id, s.task.Execution)
+		n, err := s.t.testConsecutiveFailures(s.ctx, &sub)
+		s.NoError(err)
+		s.Equal(expected, n != nil, "run %d", i+6)
+	}
+
+	sub.TriggerData[event.TestConsecutiveFailuresKey] = "1"
+	_, err := s.t.testConsecutiveFailures(s.ctx, &sub)
+	s.Error(err)
+}
+
+func TestFailureStreak(t *testing.T) {
+	previousFailures := []map[string]bool{
+		failedTestNames([]testresult.TestResult{
+			{TestName: "a", Status: evergreen.TestFailedStatus},
+			{TestName: "b", Status: evergreen.TestFailedStatus},
+			{TestName: "c", Status: evergreen.TestSucceededStatus},
+		}),
+		failedTestNames([]testresult.TestResult{
+			{TestName: "a", Status: evergreen.TestFailedStatus},
+			{TestName: "b", Status: evergreen.TestSucceededStatus},
+			{TestName: "c", Status: evergreen.TestFailedStatus},
+		}),
+	}
+
+	assert.Equal(t, 2, failureStreak("a", previousFailures))
+	assert.Equal(t, 1, failureStreak("b", previousFailures))
+	assert.Equal(t, 0, failureStreak("c", previousFailures))
+	assert.Equal(t, 0, failureStreak("d", previousFailures))
+	assert.Equal(t, 0, failureStreak("a", nil))
+}
+
 func (s *taskSuite) TestTaskExceedsTime() {
 	now := time.Now()
 	// task that exceeds time should generate
@@ -0,0 +1,237 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/alertrecord"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// testNewFailure notifies for tests that fail in a mainline task but didn't
+// fail in the previous mainline run of the task.
+func (t *taskTriggers) testNewFailure(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	failing, err := t.failingMainlineTests(ctx, sub)
+	if err != nil || len(failing) == 0 {
+		return nil, err
+	}
+
+	previous, err := previousMainlineTasks(ctx, t.task, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) == 0 {
+		// There's no earlier run to compare against.
+		return nil, nil
+	}
+	previousFailures := failedTestNames(previous[0].LocalTestResults)
+
+	newlyFailing := []testresult.TestResult{}
+	for _, test := range failing {
+		if !previousFailures[test.GetDisplayTestName()] {
+			newlyFailing = append(newlyFailing, test)
+		}
+	}
+
+	return t.generateForTestFailures(ctx, sub, alertrecord.TestNewFailureId, "started failing", newlyFailing)
+}
+
+// testConsecutiveFailures notifies for tests that have failed in the
+// subscription's number of consecutive mainline runs of the task. It notifies
+// once per streak, when the streak reaches the number of failures.
+func (t *taskTriggers) testConsecutiveFailures(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	n, err := strconv.Atoi(sub.TriggerData[event.TestConsecutiveFailuresKey])
+	if err != nil || n < 2 || n > event.MaxTestConsecutiveFailures {
+		return nil, errors.Errorf("subscription '%s' has an invalid number of consecutive failures", sub.ID)
+	}
+
+	failing, err := t.failingMainlineTests(ctx, sub)
+	if err != nil || len(failing) == 0 {
+		return nil, err
+	}
+
+	// Fetch one more run than needed to tell whether the streak started
+	// exactly n runs ago.
+	previous, err := previousMainlineTasks(ctx, t.task, n)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) < n-1 {
+		return nil, nil
+	}
+	previousFailures := make([]map[string]bool, 0, len(previous))
+	for _, previousTask := range previous {
+		previousFailures = append(previousFailures, failedTestNames(previousTask.LocalTestResults))
+	}
+
+	streakReached := []testresult.TestResult{}
+	for _, test := range failing {
+		if failureStreak(test.GetDisplayTestName(), previousFailures)+1 == n {
+			streakReached = append(streakReached, test)
+		}
+	}
+
+	return t.generateForTestFailures(ctx, sub, alertrecord.TestConsecutiveFailureId, fmt.Sprintf("failed %d consecutive times", n), streakReached)
+}
+
+// failingMainlineTests returns the failing tests in the task that match the
+// subscription's test regex. It returns no tests if the task isn't a failed
+// mainline task.
+func (t *taskTriggers) failingMainlineTests(ctx context.Context, sub *event.Subscription) ([]testresult.TestResult, error) {
+	if t.task.IsPartOfDisplay(ctx) {
+		return nil, nil
+	}
+	if !utility.StringSliceContains(evergreen.SystemVersionRequesterTypes, t.task.Requester) || !isValidFailedTaskStatus(t.task.Status) {
+		return nil, nil
+	}
+	if t.task.IsUnfinishedSystemUnresponsive() {
+		return nil, nil
+	}
+
+	if err := t.task.PopulateTestResults(ctx); err != nil {
+		return nil, errors.Wrap(err, "populating test results for task")
+	}
+
+	failing := []testresult.TestResult{}
+	for _, test := range t.task.LocalTestResults {
+		if test.Status != evergreen.TestFailedStatus {
+			continue
+		}
+		match, err := testMatchesRegex(test.GetDisplayTestName(), sub)
+		if err != nil {
+			grip.Error(ctx, message.WrapError(err, message.Fields{
+				"source":  "test-trigger",
+				"message": "bad regex in db",
+				"task":    t.task.Id,
+				"project": t.task.Project,
+			}))
+			return nil, nil
+		}
+		if match {
+			failing = append(failing, test)
+		}
+	}
+
+	return failing, nil
+}
+
+// generateForTestFailures generates a notification listing the tests, skipping
+// tests that were already alerted on for this revision of the task (e.g. if
+// the task was restarted).
+func (t *taskTriggers) generateForTestFailures(ctx context.Context, sub *event.Subscription, alertType, pastTense string, tests []testresult.TestResult) (*notification.Notification, error) {
+	toAlert := []testresult.TestResult{}
+	for _, test := range tests {
+		rec, err := alertrecord.FindByTestFailure(ctx, sub.ID, alertType, test.GetDisplayTestName(), t.task.DisplayName, t.task.BuildVariant, t.task.Project, t.task.RevisionOrderNumber)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding alert record for test '%s'", test.GetDisplayTestName())
+		}
+		if rec != nil {
+			continue
+		}
+		test.LogURL = test.GetLogURL(evergreen.GetEnvironment(), evergreen.LogViewerHTML)
+		toAlert = append(toAlert, test)
+	}
+	if len(toAlert) == 0 {
+		return nil, nil
+	}
+
+	testNames := make([]string, 0, len(toAlert))
+	for _, test := range toAlert {
+		testNames = append(testNames, test.GetDisplayTestName())
+	}
+	n, err := t.generateWithTests(ctx, sub, pastTense, strings.Join(testNames, ", "), toAlert)
+	if err != nil || n == nil {
+		return n, err
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, name := range testNames {
+		catcher.Add(alertrecord.InsertNewTestFailureRecord(ctx, sub.ID, alertType, t.task.Id, name, t.task.DisplayName, t.task.BuildVariant, t.task.Project, t.task.RevisionOrderNumber))
+	}
+
+	return n, catcher.Resolve()
+}
+
+// previousMainlineTasks returns up to limit of the most recent completed
+// mainline runs of the task before it, newest first, with their test results
+// populated.
+func previousMainlineTasks(ctx context.Context, t *task.Task, limit int) ([]task.Task, error) {
+	query := db.Query(task.ByBeforeRevisionWithStatusesAndRequesters(t.RevisionOrderNumber,
+		evergreen.TaskCompletedStatuses, t.BuildVariant, t.DisplayName, t.Project, evergreen.SystemVersionRequesterTypes)).
+		Sort([]string{"-" + task.RevisionOrderNumberKey}).
+		Limit(limit)
+	tasks, err := task.FindAll(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding previous mainline tasks")
+	}
+	for i := range tasks {
+		if err = tasks[i].PopulateTestResults(ctx); err != nil {
+			return nil, errors.Wrapf(err, "populating test results for previous task '%s'", tasks[i].Id)
+		}
+	}
+
+	return tasks, nil
+}
+
+// failedTestNames returns the set of display names of the failed tests.
+func failedTestNames(results []testresult.TestResult) map[string]bool {
+	names := map[string]bool{}
+	for _, result := range results {
+		if result.Status == evergreen.TestFailedStatus {
+			names[result.GetDisplayTestName()] = true
+		}
+	}
+	return names
+}
+
+// failureStreak returns how many of the most recent previous runs the test
+// failed in, in a row. previousFailures is ordered newest first.
+func failureStreak(testName string, previousFailures []map[string]bool) int {
+	streak := 0
+	for _, failures := range previousFailures {
+		if !failures[testName] {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
+// testsSlackAttachment lists the tests with links to their logs.
+func testsSlackAttachment(tests []testresult.TestResult) message.SlackAttachment {
+	fields := []*message.SlackAttachmentField{}
+	for i, test := range tests {
+		if i == slackAttachmentsLimit {
+			fields = append(fields, &message.SlackAttachmentField{
+				Title: "More",
+				Value: fmt.Sprintf("and %d more tests", len(tests)-slackAttachmentsLimit),
+			})
+			break
+		}
+		value := test.Status
+		if test.LogURL != "" {
+			value = fmt.Sprintf("<%s|%s>", test.LogURL, "logs")
+		}
+		fields = append(fields, &message.SlackAttachmentField{
+			Title: test.GetDisplayTestName(),
+			Value: value,
+		})
+	}
+
+	return message.SlackAttachment{
+		Title:  "Failing tests",
+		Color:  evergreenFailColor,
+		Fields: fields,
+	}
+}
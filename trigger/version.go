@@ -151,6 +151,7 @@ func (t *versionTriggers) makeData(ctx context.Context, sub *event.Subscription,
 		}),
 		PastTenseStatus:   versionStatus,
 		apiModel:          &api,
+		Version:           t.version,
 		githubState:       message.GithubStatePending,
 		githubContext:     thirdparty.GithubStatusDefaultContext,
 		githubDescription: evergreen.PRTasksRunningDescription,
@@ -193,7 +194,7 @@ func (t *versionTriggers) generate(ctx context.Context, sub *event.Subscription,
 	if err != nil {
 		return nil, errors.Wrap(err, "collecting version data")
 	}
-	payload, err := makeCommonPayload(sub, t.Attributes(), data)
+	payload, err := makeCommonPayload(ctx, sub, t.Attributes(), data)
 	if err != nil {
 		return nil, errors.Wrap(err, "building notification")
 	}
@@ -252,7 +252,7 @@ func containerStateJobs(ctx context.Context, env evergreen.Environment, ts time.
 	var jobs []amboy.Job
 	// Create a job to check container state consistency for each parent.
 	for _, p := range parents {
-		jobs = append(jobs, NewHostMonitorContainerStateJob(&p, evergreen.ProviderNameDocker, ts.Format(TSFormat)))
+		jobs = append(jobs, NewHostMonitorContainerStateJob(&p, containerProviderForParent(ctx, &p), ts.Format(TSFormat)))
 	}
 	return jobs, nil
 }
@@ -266,11 +266,33 @@ func oldestImageRemovalJobs(ctx context.Context, _ evergreen.Environment, ts tim
 	var jobs []amboy.Job
 	// Create an oldestImageJob when images take up too much disk space.
 	for _, p := range parents {
-		jobs = append(jobs, NewOldestImageRemovalJob(&p, evergreen.ProviderNameDocker, ts.Format(TSFormat)))
+		jobs = append(jobs, NewOldestImageRemovalJob(&p, containerProviderForParent(ctx, &p), ts.Format(TSFormat)))
 	}
 	return jobs, nil
 }
 
+// containerProviderForParent returns the provider of the containers that run
+// on the given parent host. It defaults to Docker if the provider can't be
+// determined.
+func containerProviderForParent(ctx context.Context, parent *host.Host) string {
+	if parent.ContainerPoolSettings == nil {
+		return evergreen.ProviderNameDocker
+	}
+	d, err := distro.FindOne(ctx, bson.M{distro.ContainerPoolKey: parent.ContainerPoolSettings.Id})
+	if err != nil || d == nil {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message":        "could not find container distro for parent, defaulting to Docker",
+			"parent":         parent.Id,
+			"container_pool": parent.ContainerPoolSettings.Id,
+		}))
+		return evergreen.ProviderNameDocker
+	}
+	if !evergreen.IsContainerProvider(d.Provider) {
+		return evergreen.ProviderNameDocker
+	}
+	return d.Provider
+}
+
 func hostAllocatorJobs(ctx context.Context, env evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
 	config, err := evergreen.GetConfig(ctx)
 	if err != nil {
@@ -824,6 +846,124 @@ func PopulateCacheHistoricalTaskDataJob(part int) amboy.QueueOperation {
 	}
 }
 
+// PopulateTestQuarantineNominationJobs enqueues a daily job for each project
+// that automatically quarantines flaky tests.
+func PopulateTestQuarantineNominationJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled tracked projects")
+		}
+
+		// Although we don't run this hourly, we still queue hourly to improve resiliency.
+		ts := utility.RoundPartOfDay(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.IsTestQuarantineAutoNominateEnabled() {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewTestQuarantineNominationJob(project.Id, ts)), "enqueueing test quarantine nomination job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+// PopulateProjectCostBudgetJobs enqueues a job for each project with a cost
+// budget that alerts when the project's spend crosses a budget threshold.
+func PopulateProjectCostBudgetJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled tracked projects")
+		}
+
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.CostBudget.IsEnabled() {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewProjectCostBudgetJob(project.Id, ts)), "enqueueing cost budget job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+// PopulateProjectLogStorageJobs enqueues a job for each project with log
+// storage settings that compacts and expires the logs of the project's tasks.
+func PopulateProjectLogStorageJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled tracked projects")
+		}
+
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.LogStorage.IsEnabled() {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewProjectLogStorageJob(project.Id, ts)), "enqueueing log storage job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+// PopulateResourceRightSizingJobs enqueues a job for each project that
+// recommends distros for the project's tasks based on their resource usage.
+func PopulateResourceRightSizingJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled tracked projects")
+		}
+
+		// Although we don't run this hourly, we still queue hourly to improve resiliency.
+		ts := utility.RoundPartOfDay(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewResourceRightSizingJob(project.Id, ts)), "enqueueing resource right-sizing job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+// PopulateProjectArtifactRetentionJobs enqueues a job for each project with an
+// artifact retention policy that deletes the project's expired artifacts.
+func PopulateProjectArtifactRetentionJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled tracked projects")
+		}
+
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.ArtifactRetention.IsEnabled() {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewProjectArtifactRetentionJob(project.Id, ts)), "enqueueing artifact retention job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
 func PopulateSpawnhostExpirationCheckJob() amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
 		hosts, err := host.FindSpawnhostsWithNoExpirationToExtend(ctx)
@@ -56,6 +56,11 @@ func (j *cronsRemoteHourJob) Run(ctx context.Context) {
 		PopulateDuplicateTaskCheckJobs(),
 		PopulateUnexpirableSpawnHostStatsJob(),
 		PopulateDistroAutoTuneJobs(),
+		PopulateTestQuarantineNominationJobs(),
+		PopulateProjectCostBudgetJobs(),
+		PopulateProjectLogStorageJobs(),
+		PopulateProjectArtifactRetentionJobs(),
+		PopulateResourceRightSizingJobs(),
 	}
 
 	queue := j.env.RemoteQueue()
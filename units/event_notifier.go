@@ -245,7 +245,7 @@ func notificationIsEnabled(ctx context.Context, flags *evergreen.ServiceFlags, n
 	case event.JIRAIssueSubscriberType, event.JIRACommentSubscriberType:
 		return !flags.JIRANotificationsDisabled
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatSubscriberType:
 		return !flags.WebhookNotificationsDisabled
 
 	case event.EmailSubscriberType:
@@ -165,7 +165,7 @@ func (j *eventSendJob) checkDegradedMode(ctx context.Context, n *notification.No
 	case event.JIRACommentSubscriberType:
 		return checkFlag(ctx, j.flags.JIRANotificationsDisabled)
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatSubscriberType:
 		return checkFlag(ctx, j.flags.WebhookNotificationsDisabled)
 
 	case event.EmailSubscriberType:
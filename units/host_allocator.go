@@ -250,6 +250,12 @@ func (j *hostAllocatorJob) Run(ctx context.Context) {
 		j.AddError(errors.Wrapf(err, "enqueueing host create jobs"))
 	}
 
+	if distro.PreemptionSettings.Enabled && !distro.SingleTaskDistro && len(hostsSpawned) == 0 {
+		if _, err := scheduler.PreemptTasks(ctx, *distro, upHosts); err != nil {
+			j.AddError(errors.Wrapf(err, "preempting low priority tasks for distro '%s'", distro.Id))
+		}
+	}
+
 	// ignoring all the tasks that will take longer than the threshold to run,
 	// and the hosts allocated for them,
 	// how long will it take the current fleet of hosts, plus the ones we spawned, to chew through
@@ -0,0 +1,130 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	projectArtifactRetentionJobName = "project-artifact-retention"
+
+	// maxArtifactEntriesPerRun limits the number of artifact entries that
+	// are expired, separately for patch and mainline tasks, in a single run
+	// to avoid S3 rate limiting.
+	maxArtifactEntriesPerRun = 500
+
+	projectArtifactRetentionJobMaxTime = 45 * time.Minute
+)
+
+func init() {
+	registry.AddJobType(projectArtifactRetentionJobName, func() amboy.Job {
+		return makeProjectArtifactRetentionJob()
+	})
+}
+
+type projectArtifactRetentionJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+}
+
+func makeProjectArtifactRetentionJob() *projectArtifactRetentionJob {
+	j := &projectArtifactRetentionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    projectArtifactRetentionJobName,
+				Version: 0,
+			},
+		},
+	}
+	j.UpdateTimeInfo(amboy.JobTimeInfo{MaxTime: projectArtifactRetentionJobMaxTime})
+	return j
+}
+
+// NewProjectArtifactRetentionJob returns a job that deletes the files that the
+// project's tasks uploaded to S3 once they are older than the project's
+// artifact retention policy allows, except for those of pinned versions.
+func NewProjectArtifactRetentionJob(projectID, ts string) amboy.Job {
+	j := makeProjectArtifactRetentionJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", projectArtifactRetentionJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", projectArtifactRetentionJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *projectArtifactRetentionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	pRef, err := model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if pRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !pRef.ArtifactRetention.IsEnabled() {
+		return
+	}
+
+	pinnedVersions, err := model.FindArtifactsPinnedVersionIDs(ctx, j.ProjectID)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	now := time.Now()
+	if days := pRef.ArtifactRetention.PatchRetentionDays; days > 0 {
+		j.AddError(errors.Wrap(j.expireArtifacts(ctx, evergreen.PatchRequesters, now.AddDate(0, 0, -days), pinnedVersions), "expiring patch task artifacts"))
+	}
+	if days := pRef.ArtifactRetention.MainlineRetentionDays; days > 0 {
+		mainlineRequesters := slices.DeleteFunc(slices.Clone(evergreen.AllRequesterTypes), evergreen.IsPatchRequester)
+		j.AddError(errors.Wrap(j.expireArtifacts(ctx, mainlineRequesters, now.AddDate(0, 0, -days), pinnedVersions), "expiring mainline task artifacts"))
+	}
+}
+
+func (j *projectArtifactRetentionJob) expireArtifacts(ctx context.Context, requesters []string, createdBefore time.Time, pinnedVersions []string) error {
+	entries, err := artifact.FindExpirationCandidates(ctx, j.ProjectID, requesters, createdBefore, pinnedVersions, maxArtifactEntriesPerRun)
+	if err != nil {
+		return errors.Wrap(err, "finding artifacts that have expired")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	var numRemoved int
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			catcher.Add(err)
+			break
+		}
+		removed, err := entry.Expire(ctx)
+		numRemoved += removed
+		catcher.Wrapf(err, "expiring artifacts for task '%s' execution %d", entry.TaskId, entry.Execution)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":         "expired task artifacts",
+		"job_id":          j.ID(),
+		"project_id":      j.ProjectID,
+		"requesters":      requesters,
+		"created_before":  createdBefore,
+		"num_pinned":      len(pinnedVersions),
+		"num_entries":     len(entries),
+		"objects_removed": numRemoved,
+		"num_errors":      catcher.Len(),
+	})
+
+	return catcher.Resolve()
+}
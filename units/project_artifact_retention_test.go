@@ -0,0 +1,126 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestProjectArtifactRetentionJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testutil.TestSpan(ctx, t)
+
+	now := time.Now()
+	// Every entry links to the same object as the pinned version's entry, so
+	// the job expires the entries without deleting the object from S3.
+	sharedFile := artifact.File{
+		Name:       "shared",
+		Link:       "https://example.com/shared",
+		Bucket:     "bucket",
+		FileKey:    "shared",
+		Command:    artifact.S3PutCommand,
+		AWSRoleARN: "role",
+	}
+	insertEntry := func(t *testing.T, taskID, versionID, requester string, createTime time.Time) {
+		entry := artifact.Entry{
+			TaskId:          taskID,
+			TaskDisplayName: taskID,
+			BuildId:         "build",
+			ProjectID:       "project",
+			VersionID:       versionID,
+			Requester:       requester,
+			CreateTime:      createTime,
+			Files:           []artifact.File{sharedFile},
+		}
+		require.NoError(t, entry.Upsert(t.Context()))
+	}
+	findEntry := func(t *testing.T, taskID string) *artifact.Entry {
+		entry, err := artifact.FindOne(t.Context(), artifact.ByTaskId(taskID))
+		require.NoError(t, err)
+		require.NotZero(t, entry)
+		require.Len(t, entry.Files, 1)
+		return entry
+	}
+	assertExpired := func(t *testing.T, taskID string) {
+		entry := findEntry(t, taskID)
+		assert.NotZero(t, entry.ExpiredAt, "entry '%s' should be expired", taskID)
+		assert.True(t, entry.Files[0].Expired, "file of entry '%s' should be expired", taskID)
+	}
+	assertNotExpired := func(t *testing.T, taskID string) {
+		entry := findEntry(t, taskID)
+		assert.Zero(t, entry.ExpiredAt, "entry '%s' should not be expired", taskID)
+		assert.False(t, entry.Files[0].Expired, "file of entry '%s' should not be expired", taskID)
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, pRef model.ProjectRef){
+		"ExpiresPatchArtifactsPastRetention": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.ArtifactRetention.PatchRetentionDays = 1
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertEntry(t, "old_patch", "v1", evergreen.PatchVersionRequester, now.Add(-2*24*time.Hour))
+			insertEntry(t, "new_patch", "v2", evergreen.PatchVersionRequester, now.Add(-time.Hour))
+			insertEntry(t, "old_mainline", "v3", evergreen.RepotrackerVersionRequester, now.Add(-30*24*time.Hour))
+
+			j := NewProjectArtifactRetentionJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			assertExpired(t, "old_patch")
+			assertNotExpired(t, "new_patch")
+			assertNotExpired(t, "old_mainline")
+			assertNotExpired(t, "pinned_patch")
+		},
+		"ExpiresMainlineArtifactsPastRetention": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.ArtifactRetention.MainlineRetentionDays = 5
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertEntry(t, "old_mainline", "v1", evergreen.RepotrackerVersionRequester, now.Add(-6*24*time.Hour))
+			insertEntry(t, "new_mainline", "v2", evergreen.RepotrackerVersionRequester, now.Add(-2*24*time.Hour))
+			insertEntry(t, "old_patch", "v3", evergreen.PatchVersionRequester, now.Add(-30*24*time.Hour))
+
+			j := NewProjectArtifactRetentionJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			assertExpired(t, "old_mainline")
+			assertNotExpired(t, "new_mainline")
+			assertNotExpired(t, "old_patch")
+			assertNotExpired(t, "pinned_patch")
+		},
+		"NoopsWithoutRetention": func(t *testing.T, pRef model.ProjectRef) {
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertEntry(t, "old_patch", "v1", evergreen.PatchVersionRequester, now.Add(-30*24*time.Hour))
+
+			j := NewProjectArtifactRetentionJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			assertNotExpired(t, "old_patch")
+		},
+		"FailsForNonexistentProject": func(t *testing.T, pRef model.ProjectRef) {
+			j := NewProjectArtifactRetentionJob("nonexistent", "ts")
+			j.Run(t.Context())
+			assert.Error(t, j.Error())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.ProjectRefCollection, model.VersionCollection, artifact.Collection))
+			require.NoError(t, db.EnsureIndex(artifact.Collection, mongo.IndexModel{Keys: artifact.ExpirationIndex}))
+			require.NoError(t, db.EnsureIndex(artifact.Collection, mongo.IndexModel{Keys: artifact.FileLocationIndex}))
+
+			pinned := model.Version{Id: "pinned_version", Identifier: "project", ArtifactsPinned: true}
+			require.NoError(t, pinned.Insert(t.Context()))
+			insertEntry(t, "pinned_patch", pinned.Id, evergreen.PatchVersionRequester, now.Add(-30*24*time.Hour))
+
+			tCase(t, model.ProjectRef{Id: "project", Identifier: "project_identifier"})
+		})
+	}
+}
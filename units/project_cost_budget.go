@@ -0,0 +1,202 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/alertrecord"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	projectCostBudgetJobName = "project-cost-budget"
+
+	// projectCostBudgetVersionLookback is how far back to look for finished
+	// tasks when finding versions whose spend may have changed since the job
+	// last ran. It is longer than the interval between runs so that versions
+	// are not missed if a run is delayed.
+	projectCostBudgetVersionLookback = 3 * time.Hour
+)
+
+func init() {
+	registry.AddJobType(projectCostBudgetJobName, func() amboy.Job {
+		return makeProjectCostBudgetJob()
+	})
+}
+
+type projectCostBudgetJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+}
+
+func makeProjectCostBudgetJob() *projectCostBudgetJob {
+	return &projectCostBudgetJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    projectCostBudgetJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewProjectCostBudgetJob returns a job that aggregates the project's spend
+// and logs an event for each of the project's cost budgets whose spend has
+// reached a new alert threshold.
+func NewProjectCostBudgetJob(projectID, ts string) amboy.Job {
+	j := makeProjectCostBudgetJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", projectCostBudgetJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", projectCostBudgetJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *projectCostBudgetJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	pRef, err := model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if pRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !pRef.CostBudget.IsEnabled() {
+		return
+	}
+
+	now := time.Now().UTC()
+	if pRef.CostBudget.MonthlyBudget > 0 {
+		j.AddError(errors.Wrap(j.checkMonthlyBudget(ctx, pRef, now), "checking monthly cost budget"))
+	}
+	if pRef.CostBudget.VersionBudget > 0 {
+		j.AddError(errors.Wrap(j.checkVersionBudget(ctx, pRef, now), "checking version cost budget"))
+	}
+}
+
+func (j *projectCostBudgetJob) checkMonthlyBudget(ctx context.Context, pRef *model.ProjectRef, now time.Time) error {
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	versionCosts, err := task.GetProjectAdjustedCostsByVersion(ctx, pRef.Id, monthStart)
+	if err != nil {
+		return errors.Wrap(err, "aggregating spend for the month")
+	}
+	var spend float64
+	for _, vc := range versionCosts {
+		spend += vc.Total
+	}
+	spend = cost.RoundCost(spend)
+
+	budget := pRef.CostBudget.MonthlyBudget
+	threshold := pRef.CostBudget.HighestThresholdReached(budget, spend)
+	if threshold == 0 {
+		return nil
+	}
+
+	record, err := alertrecord.FindByMonthlyCostBudgetThreshold(ctx, pRef.Id, threshold, monthStart)
+	if err != nil {
+		return errors.Wrap(err, "finding alert record for monthly cost budget")
+	}
+	if record != nil {
+		return nil
+	}
+
+	// Record the alert before logging the event so that the event is not
+	// logged again by the next run if recording the alert fails.
+	if err = alertrecord.InsertNewMonthlyCostBudgetRecord(ctx, pRef.Id, threshold); err != nil {
+		return err
+	}
+	event.LogCostBudgetThresholdReached(ctx, event.CostBudgetEventData{
+		ProjectID:         pRef.Id,
+		ProjectIdentifier: pRef.Identifier,
+		BudgetType:        event.CostBudgetTypeMonthly,
+		Period:            monthStart.Format("2006-01"),
+		Budget:            budget,
+		Spend:             spend,
+		ThresholdPercent:  threshold,
+	})
+	grip.Info(ctx, message.Fields{
+		"message":   "monthly cost budget threshold reached",
+		"job_id":    j.ID(),
+		"project":   pRef.Id,
+		"budget":    budget,
+		"spend":     spend,
+		"threshold": threshold,
+	})
+
+	return nil
+}
+
+func (j *projectCostBudgetJob) checkVersionBudget(ctx context.Context, pRef *model.ProjectRef, now time.Time) error {
+	recentCosts, err := task.GetProjectAdjustedCostsByVersion(ctx, pRef.Id, now.Add(-projectCostBudgetVersionLookback))
+	if err != nil {
+		return errors.Wrap(err, "finding versions with recently finished tasks")
+	}
+	versionIDs := make([]string, 0, len(recentCosts))
+	for _, vc := range recentCosts {
+		versionIDs = append(versionIDs, vc.VersionID)
+	}
+
+	// The recent costs only include the tasks that finished recently, so the
+	// spend of each version must be aggregated across all of its tasks.
+	versionCosts, err := task.GetAdjustedCostsForVersions(ctx, versionIDs)
+	if err != nil {
+		return errors.Wrap(err, "aggregating spend for versions")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	budget := pRef.CostBudget.VersionBudget
+	for _, vc := range versionCosts {
+		spend := cost.RoundCost(vc.Total)
+		threshold := pRef.CostBudget.HighestThresholdReached(budget, spend)
+		if threshold == 0 {
+			continue
+		}
+
+		record, err := alertrecord.FindByVersionCostBudgetThreshold(ctx, vc.VersionID, threshold)
+		if err != nil {
+			catcher.Wrapf(err, "finding alert record for version '%s'", vc.VersionID)
+			continue
+		}
+		if record != nil {
+			continue
+		}
+
+		if err = alertrecord.InsertNewVersionCostBudgetRecord(ctx, pRef.Id, vc.VersionID, threshold); err != nil {
+			catcher.Add(err)
+			continue
+		}
+		event.LogCostBudgetThresholdReached(ctx, event.CostBudgetEventData{
+			ProjectID:         pRef.Id,
+			ProjectIdentifier: pRef.Identifier,
+			BudgetType:        event.CostBudgetTypeVersion,
+			VersionID:         vc.VersionID,
+			Budget:            budget,
+			Spend:             spend,
+			ThresholdPercent:  threshold,
+		})
+		grip.Info(ctx, message.Fields{
+			"message":   "version cost budget threshold reached",
+			"job_id":    j.ID(),
+			"project":   pRef.Id,
+			"version":   vc.VersionID,
+			"budget":    budget,
+			"spend":     spend,
+			"threshold": threshold,
+		})
+	}
+
+	return catcher.Resolve()
+}
@@ -0,0 +1,153 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/alertrecord"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestProjectCostBudgetJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testutil.TestSpan(ctx, t)
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	insertTasks := func(t *testing.T, tasks ...task.Task) {
+		for _, tsk := range tasks {
+			require.NoError(t, tsk.Insert(t.Context()))
+		}
+	}
+	findBudgetEvents := func(t *testing.T) []event.CostBudgetEventData {
+		events, err := event.FindUnprocessedEvents(t.Context(), -1)
+		require.NoError(t, err)
+		var data []event.CostBudgetEventData
+		for _, e := range events {
+			require.Equal(t, event.ResourceTypeCostBudget, e.ResourceType)
+			budgetData, ok := e.Data.(*event.CostBudgetEventData)
+			require.True(t, ok)
+			data = append(data, *budgetData)
+		}
+		return data
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, pRef model.ProjectRef){
+		"MonthlyBudgetLogsHighestThresholdReached": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.CostBudget.MonthlyBudget = 100
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTasks(t,
+				task.Task{Id: "t1", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 30}},
+				task.Task{Id: "t2", Project: pRef.Id, Version: "v2", Status: evergreen.TaskFailed, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 20, AdjustedS3LogPutCost: 5}},
+				task.Task{Id: "last_month", Project: pRef.Id, Version: "v0", Status: evergreen.TaskSucceeded, FinishTime: monthStart.Add(-time.Hour), TaskCost: cost.Cost{AdjustedEC2Cost: 100}},
+				task.Task{Id: "display", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, DisplayOnly: true, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 100}},
+				task.Task{Id: "other_project", Project: "other", Version: "v3", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 100}},
+			)
+
+			j := NewProjectCostBudgetJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			events := findBudgetEvents(t)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.CostBudgetTypeMonthly, events[0].BudgetType)
+			assert.Equal(t, monthStart.Format("2006-01"), events[0].Period)
+			assert.Equal(t, 50, events[0].ThresholdPercent)
+			assert.Equal(t, 55.0, events[0].Spend)
+
+			record, err := alertrecord.FindByMonthlyCostBudgetThreshold(t.Context(), pRef.Id, 50, monthStart)
+			require.NoError(t, err)
+			assert.NotZero(t, record)
+
+			j = NewProjectCostBudgetJob(pRef.Id, "ts2")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+			assert.Len(t, findBudgetEvents(t), 1, "threshold should only be alerted on once")
+		},
+		"MonthlyBudgetIncludesPreviousExecutions": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.CostBudget.MonthlyBudget = 100
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTasks(t, task.Task{Id: "t1", Project: pRef.Id, Version: "v1", Execution: 1, Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 40}})
+			oldTask := task.Task{Id: "t1_0", OldTaskId: "t1", Project: pRef.Id, Version: "v1", Status: evergreen.TaskFailed, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 45}}
+			require.NoError(t, db.Insert(t.Context(), task.OldCollection, oldTask))
+
+			j := NewProjectCostBudgetJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			events := findBudgetEvents(t)
+			require.Len(t, events, 1)
+			assert.Equal(t, 80, events[0].ThresholdPercent)
+			assert.Equal(t, 85.0, events[0].Spend)
+		},
+		"MonthlyBudgetDoesNotAlertOnRecordedThreshold": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.CostBudget.MonthlyBudget = 100
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTasks(t, task.Task{Id: "t1", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 60}})
+			require.NoError(t, alertrecord.InsertNewMonthlyCostBudgetRecord(t.Context(), pRef.Id, 50))
+
+			j := NewProjectCostBudgetJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+			assert.Empty(t, findBudgetEvents(t))
+		},
+		"VersionBudgetIncludesAllOfVersionsTasks": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.CostBudget.VersionBudget = 10
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTasks(t,
+				task.Task{Id: "recent", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 6}},
+				task.Task{Id: "earlier", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-2 * projectCostBudgetVersionLookback), TaskCost: cost.Cost{AdjustedEC2Cost: 3}},
+				task.Task{Id: "under_budget", Project: pRef.Id, Version: "v2", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 1}},
+				task.Task{Id: "not_recent", Project: pRef.Id, Version: "v3", Status: evergreen.TaskSucceeded, FinishTime: now.Add(-2 * projectCostBudgetVersionLookback), TaskCost: cost.Cost{AdjustedEC2Cost: 20}},
+			)
+
+			j := NewProjectCostBudgetJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			events := findBudgetEvents(t)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.CostBudgetTypeVersion, events[0].BudgetType)
+			assert.Equal(t, "v1", events[0].VersionID)
+			assert.Equal(t, 80, events[0].ThresholdPercent)
+			assert.Equal(t, 9.0, events[0].Spend)
+
+			record, err := alertrecord.FindByVersionCostBudgetThreshold(t.Context(), "v1", 80)
+			require.NoError(t, err)
+			assert.NotZero(t, record)
+		},
+		"NoopsWithoutBudget": func(t *testing.T, pRef model.ProjectRef) {
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTasks(t, task.Task{Id: "t1", Project: pRef.Id, Version: "v1", Status: evergreen.TaskSucceeded, FinishTime: now, TaskCost: cost.Cost{AdjustedEC2Cost: 1000}})
+
+			j := NewProjectCostBudgetJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+			assert.Empty(t, findBudgetEvents(t))
+		},
+		"FailsForNonexistentProject": func(t *testing.T, pRef model.ProjectRef) {
+			j := NewProjectCostBudgetJob("nonexistent", "ts")
+			j.Run(t.Context())
+			assert.Error(t, j.Error())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.ProjectRefCollection, task.Collection, task.OldCollection, alertrecord.Collection, event.EventCollection))
+			require.NoError(t, db.EnsureIndex(task.Collection, mongo.IndexModel{Keys: task.TaskHistoricalDataIndex}))
+
+			tCase(t, model.ProjectRef{Id: "project", Identifier: "project_identifier"})
+		})
+	}
+}
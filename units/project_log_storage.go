@@ -0,0 +1,180 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	projectLogStorageJobName = "project-log-storage"
+
+	// logCompactionDelay is how long after a task finishes before its logs
+	// are compacted, so that logs which are still being uploaded when the
+	// task finishes are not compacted early.
+	logCompactionDelay = time.Hour
+	// logCompactionLookback is how far back to look for finished tasks
+	// whose logs have not been compacted. Each run compacts the oldest tasks
+	// in the window first, and the window is much longer than the interval
+	// between runs so that a backlog larger than a single run can compact is
+	// worked through before its tasks fall out of the window.
+	logCompactionLookback = 7 * 24 * time.Hour
+	// maxLogStorageTasksPerRun limits the number of tasks whose logs are
+	// compacted, and separately the number whose logs are expired, in a
+	// single run to avoid S3 rate limiting.
+	maxLogStorageTasksPerRun = 500
+
+	projectLogStorageJobMaxTime = 45 * time.Minute
+)
+
+func init() {
+	registry.AddJobType(projectLogStorageJobName, func() amboy.Job {
+		return makeProjectLogStorageJob()
+	})
+}
+
+type projectLogStorageJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+}
+
+func makeProjectLogStorageJob() *projectLogStorageJob {
+	j := &projectLogStorageJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    projectLogStorageJobName,
+				Version: 0,
+			},
+		},
+	}
+	j.UpdateTimeInfo(amboy.JobTimeInfo{MaxTime: projectLogStorageJobMaxTime})
+	return j
+}
+
+// NewProjectLogStorageJob returns a job that compacts the logs of the
+// project's recently finished tasks and deletes the logs of tasks that are
+// older than the project's log retention policy allows.
+func NewProjectLogStorageJob(projectID, ts string) amboy.Job {
+	j := makeProjectLogStorageJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", projectLogStorageJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", projectLogStorageJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *projectLogStorageJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	pRef, err := model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if pRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !pRef.LogStorage.IsEnabled() {
+		return
+	}
+
+	now := time.Now()
+	// Expire logs before compacting so that logs which are about to be
+	// deleted are not compacted first.
+	if days := pRef.LogStorage.PatchRetentionDays; days > 0 {
+		j.AddError(errors.Wrap(j.expireLogs(ctx, evergreen.PatchRequesters, now.AddDate(0, 0, -days)), "expiring patch task logs"))
+	}
+	if days := pRef.LogStorage.MainlineRetentionDays; days > 0 {
+		mainlineRequesters := slices.DeleteFunc(slices.Clone(evergreen.AllRequesterTypes), evergreen.IsPatchRequester)
+		j.AddError(errors.Wrap(j.expireLogs(ctx, mainlineRequesters, now.AddDate(0, 0, -days)), "expiring mainline task logs"))
+	}
+	if utility.FromBoolPtr(pRef.LogStorage.CompactLogs) {
+		j.AddError(errors.Wrap(j.compactLogs(ctx, now), "compacting task logs"))
+	}
+}
+
+func (j *projectLogStorageJob) compactLogs(ctx context.Context, now time.Time) error {
+	tasks, err := task.FindLogCompactionCandidates(ctx, j.ProjectID, now.Add(-logCompactionLookback), now.Add(-logCompactionDelay), maxLogStorageTasksPerRun)
+	if err != nil {
+		return errors.Wrap(err, "finding tasks whose logs need compacting")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	var numLogs, numChunksRemoved int
+	for _, t := range tasks {
+		if err := ctx.Err(); err != nil {
+			catcher.Add(err)
+			break
+		}
+		stats, err := t.CompactLogs(ctx)
+		numLogs += stats.Logs
+		numChunksRemoved += stats.ChunksRemoved
+		catcher.Wrapf(err, "compacting logs for task '%s' execution %d", t.Id, t.Execution)
+	}
+
+	if len(tasks) >= maxLogStorageTasksPerRun {
+		grip.Warning(ctx, message.Fields{
+			"message":    "project has more tasks whose logs need compacting than a single run can compact",
+			"job_id":     j.ID(),
+			"project_id": j.ProjectID,
+			"oldest":     tasks[0].FinishTime,
+			"lookback":   logCompactionLookback.String(),
+		})
+	}
+	grip.Info(ctx, message.Fields{
+		"message":        "compacted task logs",
+		"job_id":         j.ID(),
+		"project_id":     j.ProjectID,
+		"num_tasks":      len(tasks),
+		"num_logs":       numLogs,
+		"chunks_removed": numChunksRemoved,
+		"num_errors":     catcher.Len(),
+	})
+
+	return catcher.Resolve()
+}
+
+func (j *projectLogStorageJob) expireLogs(ctx context.Context, requesters []string, finishedBefore time.Time) error {
+	tasks, err := task.FindLogExpirationCandidates(ctx, j.ProjectID, requesters, finishedBefore, maxLogStorageTasksPerRun)
+	if err != nil {
+		return errors.Wrap(err, "finding tasks whose logs have expired")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	var numRemoved int
+	for _, t := range tasks {
+		if err := ctx.Err(); err != nil {
+			catcher.Add(err)
+			break
+		}
+		removed, err := t.ExpireLogs(ctx)
+		numRemoved += removed
+		catcher.Wrapf(err, "expiring logs for task '%s' execution %d", t.Id, t.Execution)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":         "expired task logs",
+		"job_id":          j.ID(),
+		"project_id":      j.ProjectID,
+		"requesters":      requesters,
+		"finished_before": finishedBefore,
+		"num_tasks":       len(tasks),
+		"objects_removed": numRemoved,
+		"num_errors":      catcher.Len(),
+	})
+
+	return catcher.Resolve()
+}
@@ -0,0 +1,117 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectLogStorageJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testutil.TestSpan(ctx, t)
+
+	now := time.Now()
+	insertTaskWithLogs := func(t *testing.T, id, requester string, finishTime time.Time) {
+		bucketConfig := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+		tsk := &task.Task{
+			Id:         id,
+			Project:    "project",
+			Requester:  requester,
+			Status:     evergreen.TaskSucceeded,
+			FinishTime: finishTime,
+			TaskOutputInfo: &task.TaskOutput{
+				TaskLogs: task.TaskLogOutput{BucketConfig: bucketConfig},
+				TestLogs: task.TestLogOutput{BucketConfig: bucketConfig},
+			},
+		}
+		require.NoError(t, tsk.Insert(t.Context()))
+		for i, data := range []string{"one", "two"} {
+			line := log.LogLine{Priority: level.Info, Timestamp: now.UnixNano() + int64(i), Data: data}
+			require.NoError(t, task.AppendTaskLogs(t.Context(), tsk, task.TaskLogTypeTask, []log.LogLine{line}))
+		}
+	}
+	findTaskOutput := func(t *testing.T, id string) *task.TaskOutput {
+		dbTask, err := task.FindOneId(t.Context(), id)
+		require.NoError(t, err)
+		require.NotZero(t, dbTask)
+		require.NotZero(t, dbTask.TaskOutputInfo)
+		return dbTask.TaskOutputInfo
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, pRef model.ProjectRef){
+		"CompactsTasksFinishedWithinLookback": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.LogStorage.CompactLogs = utility.TruePtr()
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTaskWithLogs(t, "finished_days_ago", evergreen.RepotrackerVersionRequester, now.Add(-3*24*time.Hour))
+			insertTaskWithLogs(t, "finished_hours_ago", evergreen.PatchVersionRequester, now.Add(-2*time.Hour))
+			insertTaskWithLogs(t, "finished_recently", evergreen.RepotrackerVersionRequester, now.Add(-logCompactionDelay/2))
+			insertTaskWithLogs(t, "finished_before_lookback", evergreen.RepotrackerVersionRequester, now.Add(-logCompactionLookback-time.Hour))
+
+			j := NewProjectLogStorageJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			assert.NotZero(t, findTaskOutput(t, "finished_days_ago").LogsCompactedAt)
+			assert.NotZero(t, findTaskOutput(t, "finished_hours_ago").LogsCompactedAt)
+			assert.Zero(t, findTaskOutput(t, "finished_recently").LogsCompactedAt, "logs should not be compacted until the delay has passed")
+			assert.Zero(t, findTaskOutput(t, "finished_before_lookback").LogsCompactedAt)
+			for _, id := range []string{"finished_days_ago", "finished_hours_ago", "finished_recently", "finished_before_lookback"} {
+				assert.Zero(t, findTaskOutput(t, id).LogsExpiredAt)
+			}
+		},
+		"ExpiresLogsPastRetention": func(t *testing.T, pRef model.ProjectRef) {
+			pRef.LogStorage.PatchRetentionDays = 1
+			pRef.LogStorage.MainlineRetentionDays = 5
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTaskWithLogs(t, "old_patch", evergreen.PatchVersionRequester, now.Add(-2*24*time.Hour))
+			insertTaskWithLogs(t, "new_patch", evergreen.PatchVersionRequester, now.Add(-time.Hour))
+			insertTaskWithLogs(t, "old_mainline", evergreen.RepotrackerVersionRequester, now.Add(-6*24*time.Hour))
+			insertTaskWithLogs(t, "new_mainline", evergreen.RepotrackerVersionRequester, now.Add(-2*24*time.Hour))
+
+			j := NewProjectLogStorageJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			assert.NotZero(t, findTaskOutput(t, "old_patch").LogsExpiredAt)
+			assert.Zero(t, findTaskOutput(t, "new_patch").LogsExpiredAt)
+			assert.NotZero(t, findTaskOutput(t, "old_mainline").LogsExpiredAt)
+			assert.Zero(t, findTaskOutput(t, "new_mainline").LogsExpiredAt)
+			assert.Zero(t, findTaskOutput(t, "new_mainline").LogsCompactedAt, "logs should not be compacted unless compaction is enabled")
+		},
+		"NoopsWithoutLogStorageSettings": func(t *testing.T, pRef model.ProjectRef) {
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertTaskWithLogs(t, "task", evergreen.PatchVersionRequester, now.Add(-30*24*time.Hour))
+
+			j := NewProjectLogStorageJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			output := findTaskOutput(t, "task")
+			assert.Zero(t, output.LogsCompactedAt)
+			assert.Zero(t, output.LogsExpiredAt)
+		},
+		"FailsForNonexistentProject": func(t *testing.T, pRef model.ProjectRef) {
+			j := NewProjectLogStorageJob("nonexistent", "ts")
+			j.Run(t.Context())
+			assert.Error(t, j.Error())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.ProjectRefCollection, task.Collection, task.OldCollection))
+
+			tCase(t, model.ProjectRef{Id: "project", Identifier: "project_identifier"})
+		})
+	}
+}
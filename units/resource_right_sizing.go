@@ -0,0 +1,218 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	resourceRightSizingJobName = "resource-right-sizing"
+
+	// resourceRightSizingLookback is how far back to look at task resource
+	// usage when recommending distros.
+	resourceRightSizingLookback = 14 * 24 * time.Hour
+	// resourceUsageRetention is how long task resource usage is kept.
+	resourceUsageRetention = 30 * 24 * time.Hour
+	// distroOptionsCacheTTL is how long the distro options are cached. The
+	// jobs for every project are enqueued together once a day, so each app
+	// server aggregates the options about once a day rather than once for
+	// each project.
+	distroOptionsCacheTTL = time.Hour
+
+	resourceRightSizingJobMaxTime = 15 * time.Minute
+)
+
+// distroOptionsCache caches the distros that tasks can be recommended to run
+// on, which are the same for every project.
+var distroOptionsCache struct {
+	mu        sync.Mutex
+	options   map[string]resourceusage.DistroOption
+	expiresAt time.Time
+}
+
+func init() {
+	registry.AddJobType(resourceRightSizingJobName, func() amboy.Job {
+		return makeResourceRightSizingJob()
+	})
+}
+
+type resourceRightSizingJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+}
+
+func makeResourceRightSizingJob() *resourceRightSizingJob {
+	j := &resourceRightSizingJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    resourceRightSizingJobName,
+				Version: 0,
+			},
+		},
+	}
+	j.UpdateTimeInfo(amboy.JobTimeInfo{MaxTime: resourceRightSizingJobMaxTime})
+	return j
+}
+
+// NewResourceRightSizingJob returns a job that recommends cheaper or larger
+// distros for the project's tasks based on their recent resource usage, and
+// removes resource usage that is older than the retention period.
+func NewResourceRightSizingJob(projectID, ts string) amboy.Job {
+	j := makeResourceRightSizingJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", resourceRightSizingJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", resourceRightSizingJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *resourceRightSizingJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	pRef, err := model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if pRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+
+	now := time.Now()
+	j.AddError(errors.Wrap(j.recommend(ctx, now), "recommending distros"))
+	j.AddError(errors.Wrap(resourceusage.RemoveByProjectBefore(ctx, j.ProjectID, now.Add(-resourceUsageRetention)), "removing old resource usage"))
+}
+
+func (j *resourceRightSizingJob) recommend(ctx context.Context, now time.Time) error {
+	since := now.Add(-resourceRightSizingLookback)
+	usages, err := resourceusage.FindSummariesByProjectSince(ctx, j.ProjectID, since)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]resourceusage.DistroOption
+	if len(usages) > 0 {
+		if options, err = getDistroOptions(ctx, now); err != nil {
+			return errors.Wrap(err, "finding distro options")
+		}
+	}
+
+	// Usages are sorted newest first, and grouping them preserves that
+	// order within each task.
+	type taskKey struct {
+		buildVariant string
+		taskName     string
+	}
+	var keys []taskKey
+	usagesByTask := map[taskKey][]resourceusage.TaskResourceUsage{}
+	for _, u := range usages {
+		key := taskKey{buildVariant: u.BuildVariant, taskName: u.TaskName}
+		if _, ok := usagesByTask[key]; !ok {
+			keys = append(keys, key)
+		}
+		usagesByTask[key] = append(usagesByTask[key], u)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	keepIDs := []string{}
+	var numDownsize, numUpsize int
+	for _, key := range keys {
+		rec := resourceusage.Recommend(usagesByTask[key], options)
+		if rec == nil {
+			continue
+		}
+		rec.CreatedAt = now
+		if err := rec.Upsert(ctx); err != nil {
+			catcher.Add(err)
+			continue
+		}
+		keepIDs = append(keepIDs, rec.ID)
+		if rec.Direction == resourceusage.DirectionUpsize {
+			numUpsize++
+		} else {
+			numDownsize++
+		}
+	}
+	catcher.Add(resourceusage.RemoveRecommendationsByProjectExcept(ctx, j.ProjectID, keepIDs))
+
+	grip.Info(ctx, message.Fields{
+		"message":      "recommended distros for tasks",
+		"job_id":       j.ID(),
+		"project_id":   j.ProjectID,
+		"num_usages":   len(usages),
+		"num_tasks":    len(keys),
+		"num_downsize": numDownsize,
+		"num_upsize":   numUpsize,
+		"num_errors":   catcher.Len(),
+	})
+
+	return catcher.Resolve()
+}
+
+// getDistroOptions returns the cached distro options, finding them again if
+// they have expired.
+func getDistroOptions(ctx context.Context, now time.Time) (map[string]resourceusage.DistroOption, error) {
+	distroOptionsCache.mu.Lock()
+	defer distroOptionsCache.mu.Unlock()
+
+	if distroOptionsCache.options != nil && now.Before(distroOptionsCache.expiresAt) {
+		return distroOptionsCache.options, nil
+	}
+	options, err := findDistroOptions(ctx, now.Add(-resourceRightSizingLookback))
+	if err != nil {
+		return nil, err
+	}
+	distroOptionsCache.options = options
+	distroOptionsCache.expiresAt = now.Add(distroOptionsCacheTTL)
+	return options, nil
+}
+
+// findDistroOptions returns the enabled distros with cost data whose host
+// sizes are known from the resource usage of tasks that ran on them since the
+// given time.
+func findDistroOptions(ctx context.Context, since time.Time) (map[string]resourceusage.DistroOption, error) {
+	capacities, err := resourceusage.FindDistroCapacities(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	capacityByDistro := make(map[string]resourceusage.DistroCapacity, len(capacities))
+	distroIDs := make([]string, 0, len(capacities))
+	for _, c := range capacities {
+		capacityByDistro[c.DistroID] = c
+		distroIDs = append(distroIDs, c.DistroID)
+	}
+
+	distros, err := distro.Find(ctx, distro.ByIds(distroIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding distros")
+	}
+
+	options := map[string]resourceusage.DistroOption{}
+	for _, d := range distros {
+		if d.Disabled || !d.CostData.IsConfigured() {
+			continue
+		}
+		c := capacityByDistro[d.Id]
+		options[d.Id] = resourceusage.DistroOption{
+			DistroID:    d.Id,
+			Arch:        d.Arch,
+			NumCPUs:     c.NumCPUs,
+			MemoryBytes: c.MemoryBytes,
+			HourlyRate:  d.CostData.OnDemandRate,
+		}
+	}
+	return options, nil
+}
@@ -0,0 +1,164 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestResourceRightSizingJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testutil.TestSpan(ctx, t)
+
+	const gb = 1 << 30
+	now := time.Now()
+	insertUsages := func(t *testing.T, projectID, taskName, distroID string, numCPUs int, memoryBytes uint64, finishTimes ...time.Time) {
+		for i, finishTime := range finishTimes {
+			u := resourceusage.NewTaskResourceUsage(resourceusage.TaskInfo{
+				TaskID:       taskName + "_" + distroID,
+				Execution:    i,
+				ProjectID:    projectID,
+				BuildVariant: "variant",
+				TaskName:     taskName,
+				DistroID:     distroID,
+				FinishTime:   finishTime,
+			}, apimodels.ResourceUsage{
+				NumCPUs:          numCPUs,
+				TotalMemoryBytes: memoryBytes,
+				Points:           []apimodels.ResourceUsagePoint{{CPUPercent: 10, MemoryPercent: 10}},
+			})
+			require.NoError(t, u.Upsert(t.Context()))
+		}
+	}
+	recentFinishTimes := func(n int) []time.Time {
+		var finishTimes []time.Time
+		for i := 0; i < n; i++ {
+			finishTimes = append(finishTimes, now.Add(-time.Duration(i+1)*time.Hour))
+		}
+		return finishTimes
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, pRef model.ProjectRef){
+		"RecommendsDistroKnownFromOtherProjects": func(t *testing.T, pRef model.ProjectRef) {
+			require.NoError(t, pRef.Insert(t.Context()))
+			insertUsages(t, pRef.Id, "compile", "large", 8, 16*gb, recentFinishTimes(resourceusage.MinRecommendationExecutions)...)
+			// The small distro's host size is only known from another
+			// project's tasks.
+			insertUsages(t, "other_project", "lint", "small", 2, 4*gb, now.Add(-time.Hour))
+
+			j := NewResourceRightSizingJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			recs, err := resourceusage.FindRecommendationsByProject(t.Context(), pRef.Id)
+			require.NoError(t, err)
+			require.Len(t, recs, 1)
+			assert.Equal(t, "compile", recs[0].TaskName)
+			assert.Equal(t, resourceusage.DirectionDownsize, recs[0].Direction)
+			assert.Equal(t, "large", recs[0].CurrentDistro.DistroID)
+			assert.Equal(t, "small", recs[0].RecommendedDistro.DistroID)
+			assert.Equal(t, 2, recs[0].RecommendedDistro.NumCPUs)
+			assert.Equal(t, 0.1, recs[0].RecommendedDistro.HourlyRate)
+		},
+		"RemovesStaleRecommendationsAndOldUsage": func(t *testing.T, pRef model.ProjectRef) {
+			require.NoError(t, pRef.Insert(t.Context()))
+			stale := resourceusage.Recommendation{ProjectID: pRef.Id, BuildVariant: "variant", TaskName: "removed_task"}
+			require.NoError(t, stale.Upsert(t.Context()))
+			otherProjectRec := resourceusage.Recommendation{ProjectID: "other_project", BuildVariant: "variant", TaskName: "task"}
+			require.NoError(t, otherProjectRec.Upsert(t.Context()))
+			insertUsages(t, pRef.Id, "old_task", "large", 8, 16*gb, now.Add(-resourceUsageRetention-time.Hour))
+			insertUsages(t, "other_project", "old_task", "small", 2, 4*gb, now.Add(-resourceUsageRetention-time.Hour))
+
+			j := NewResourceRightSizingJob(pRef.Id, "ts")
+			j.Run(t.Context())
+			require.NoError(t, j.Error())
+
+			recs, err := resourceusage.FindRecommendationsByProject(t.Context(), pRef.Id)
+			require.NoError(t, err)
+			assert.Empty(t, recs)
+			recs, err = resourceusage.FindRecommendationsByProject(t.Context(), "other_project")
+			require.NoError(t, err)
+			assert.Len(t, recs, 1, "other projects' recommendations should be kept")
+
+			u, err := resourceusage.FindOneByTaskExecution(t.Context(), "old_task_large", 0)
+			require.NoError(t, err)
+			assert.Nil(t, u)
+			u, err = resourceusage.FindOneByTaskExecution(t.Context(), "old_task_small", 0)
+			require.NoError(t, err)
+			assert.NotNil(t, u, "other projects' resource usage should be kept")
+		},
+		"FailsForNonexistentProject": func(t *testing.T, pRef model.ProjectRef) {
+			j := NewResourceRightSizingJob("nonexistent", "ts")
+			j.Run(t.Context())
+			assert.Error(t, j.Error())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.ProjectRefCollection, distro.Collection, resourceusage.Collection, resourceusage.RecommendationsCollection))
+			require.NoError(t, db.EnsureIndex(resourceusage.Collection, mongo.IndexModel{Keys: resourceusage.ProjectFinishTimeIndex}))
+			resetDistroOptionsCache()
+
+			for _, d := range []distro.Distro{
+				{Id: "small", Arch: "linux_amd64", CostData: distro.CostData{OnDemandRate: 0.1}},
+				{Id: "large", Arch: "linux_amd64", CostData: distro.CostData{OnDemandRate: 0.4}},
+			} {
+				require.NoError(t, d.Insert(t.Context()))
+			}
+
+			tCase(t, model.ProjectRef{Id: "project", Identifier: "project_identifier"})
+		})
+	}
+}
+
+func TestGetDistroOptions(t *testing.T) {
+	require.NoError(t, db.ClearCollections(distro.Collection, resourceusage.Collection))
+	resetDistroOptionsCache()
+	defer resetDistroOptionsCache()
+
+	now := time.Now()
+	insertDistroWithUsage := func(t *testing.T, distroID string) {
+		d := distro.Distro{Id: distroID, Arch: "linux_amd64", CostData: distro.CostData{OnDemandRate: 0.1}}
+		require.NoError(t, d.Insert(t.Context()))
+		u := resourceusage.NewTaskResourceUsage(resourceusage.TaskInfo{
+			TaskID:     "task_" + distroID,
+			ProjectID:  "project",
+			DistroID:   distroID,
+			FinishTime: now.Add(-time.Hour),
+		}, apimodels.ResourceUsage{NumCPUs: 2, TotalMemoryBytes: 1 << 30})
+		require.NoError(t, u.Upsert(t.Context()))
+	}
+
+	insertDistroWithUsage(t, "d1")
+	options, err := getDistroOptions(t.Context(), now)
+	require.NoError(t, err)
+	assert.Len(t, options, 1)
+	assert.Contains(t, options, "d1")
+
+	insertDistroWithUsage(t, "d2")
+	options, err = getDistroOptions(t.Context(), now.Add(distroOptionsCacheTTL/2))
+	require.NoError(t, err)
+	assert.Len(t, options, 1, "options should be cached")
+
+	options, err = getDistroOptions(t.Context(), now.Add(distroOptionsCacheTTL))
+	require.NoError(t, err)
+	assert.Len(t, options, 2, "options should be found again once the cache expires")
+	assert.Contains(t, options, "d2")
+}
+
+func resetDistroOptionsCache() {
+	distroOptionsCache.mu.Lock()
+	defer distroOptionsCache.mu.Unlock()
+	distroOptionsCache.options = nil
+	distroOptionsCache.expiresAt = time.Time{}
+}
@@ -0,0 +1,194 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testquarantine"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	testQuarantineNominationJobName = "test-quarantine-nomination"
+
+	// testQuarantineNominationWindow is how far back to look for mainline
+	// task executions when computing test flip rates.
+	testQuarantineNominationWindow = 14 * utility.Day
+	// testQuarantineMaxTasks is the maximum number of task executions whose
+	// test results are considered in a single run of the job.
+	testQuarantineMaxTasks = 1000
+	// testQuarantineMinSamples is the minimum number of pass/fail results a
+	// test must have before it can be nominated for quarantine.
+	testQuarantineMinSamples = 5
+)
+
+func init() {
+	registry.AddJobType(testQuarantineNominationJobName, func() amboy.Job {
+		return makeTestQuarantineNominationJob()
+	})
+}
+
+type testQuarantineNominationJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+
+	env evergreen.Environment
+}
+
+func makeTestQuarantineNominationJob() *testQuarantineNominationJob {
+	return &testQuarantineNominationJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    testQuarantineNominationJobName,
+				Version: 0,
+			},
+		},
+	}
+}
+
+// NewTestQuarantineNominationJob returns a job that quarantines tests in the
+// project whose results on recent mainline commits flip between passing and
+// failing more often than the project's configured threshold.
+func NewTestQuarantineNominationJob(projectID, ts string) amboy.Job {
+	j := makeTestQuarantineNominationJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", testQuarantineNominationJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", testQuarantineNominationJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+// testHistoryKey identifies a sequence of executions of the same task on the
+// same build variant.
+type testHistoryKey struct {
+	buildVariant string
+	taskName     string
+}
+
+func (j *testQuarantineNominationJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	pRef, err := model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if pRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !pRef.IsTestQuarantineAutoNominateEnabled() {
+		return
+	}
+
+	tasks, err := task.FindRecentMainlineTasksWithTestResults(ctx, j.ProjectID, time.Now().Add(-testQuarantineNominationWindow), testQuarantineMaxTasks)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding recent mainline tasks with test results"))
+		return
+	}
+
+	flipRates, err := j.getFlipRates(ctx, tasks, pRef.TestQuarantine.GetLookbackExecutions())
+	if err != nil {
+		j.AddError(errors.Wrap(err, "computing test flip rates"))
+		return
+	}
+
+	alreadyQuarantined, err := testquarantine.FindTestNamesByProject(ctx, j.ProjectID)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding already quarantined tests"))
+		return
+	}
+
+	threshold := pRef.TestQuarantine.GetFlipRateThreshold()
+	var nominated []string
+	for testName, flipRate := range flipRates {
+		if flipRate < threshold || alreadyQuarantined[testName] {
+			continue
+		}
+
+		qt := testquarantine.QuarantinedTest{
+			ProjectID: j.ProjectID,
+			TestName:  testName,
+			Source:    testquarantine.SourceNominated,
+			Reason:    fmt.Sprintf("flip rate of %.2f over recent mainline executions exceeded threshold of %.2f", flipRate, threshold),
+			FlipRate:  flipRate,
+		}
+		if err := qt.Upsert(ctx); err != nil {
+			j.AddError(err)
+			continue
+		}
+		nominated = append(nominated, testName)
+	}
+
+	grip.InfoWhen(ctx, len(nominated) > 0, message.Fields{
+		"message":   "quarantined flaky tests",
+		"job_id":    j.ID(),
+		"project":   j.ProjectID,
+		"threshold": threshold,
+		"tests":     nominated,
+	})
+}
+
+// getFlipRates returns the highest flip rate of each test across all the
+// task histories it appears in. Only the most recent lookback executions of
+// each task are considered.
+func (j *testQuarantineNominationJob) getFlipRates(ctx context.Context, tasks []task.Task, lookback int) (map[string]float64, error) {
+	histories := map[testHistoryKey][]task.Task{}
+	for _, t := range tasks {
+		key := testHistoryKey{buildVariant: t.BuildVariant, taskName: t.DisplayName}
+		if len(histories[key]) >= lookback {
+			continue
+		}
+		histories[key] = append(histories[key], t)
+	}
+
+	flipRates := map[string]float64{}
+	for _, history := range histories {
+		// Tasks are sorted newest first, but flip rates must be computed
+		// in the order the tasks ran.
+		sort.SliceStable(history, func(i, k int) bool {
+			return history[i].RevisionOrderNumber < history[k].RevisionOrderNumber
+		})
+
+		statuses := map[string][]string{}
+		for _, t := range history {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			results, err := t.GetTestResults(ctx, j.env, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+			}
+			for _, r := range results.Results {
+				name := r.GetDisplayTestName()
+				statuses[name] = append(statuses[name], r.Status)
+			}
+		}
+
+		for name, testStatuses := range statuses {
+			if len(testStatuses) < testQuarantineMinSamples {
+				continue
+			}
+			if rate := testquarantine.FlipRate(testStatuses); rate > flipRates[name] {
+				flipRates[name] = rate
+			}
+		}
+	}
+
+	return flipRates, nil
+}
@@ -0,0 +1,142 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultChatTimeout = 30 * time.Second
+	chatRetries        = 2
+)
+
+// ChatMessage is a JSON payload posted to a chat service's incoming webhook,
+// such as a Microsoft Teams channel or a generic chat-ops endpoint.
+type ChatMessage struct {
+	NotificationID string `bson:"notification_id"`
+	URL            string `bson:"url"`
+	Body           []byte `bson:"body"`
+}
+
+type chatMessage struct {
+	raw ChatMessage
+
+	message.Base
+}
+
+// NewChatMessage returns a composer for a message to a chat incoming webhook.
+func NewChatMessage(raw ChatMessage) message.Composer {
+	return &chatMessage{
+		raw: raw,
+	}
+}
+
+func (c *chatMessage) Loggable() bool {
+	if len(c.raw.URL) == 0 || len(c.raw.Body) == 0 {
+		return false
+	}
+
+	_, err := url.Parse(c.raw.URL)
+	if err != nil {
+		grip.Error(context.Background(), message.WrapError(err, message.Fields{
+			"message":         "chat webhook invalid url",
+			"notification_id": c.raw.NotificationID,
+		}))
+	}
+
+	return err == nil
+}
+
+func (c *chatMessage) Raw() any {
+	return &c.raw
+}
+
+func (c *chatMessage) String() string {
+	return string(c.raw.Body)
+}
+
+type chatLogger struct {
+	client *http.Client
+	*send.Base
+}
+
+// NewChatLogger returns a sender that posts chat messages to incoming
+// webhooks.
+func NewChatLogger() (send.Sender, error) {
+	return &chatLogger{
+		Base: send.NewBase("chat"),
+	}, nil
+}
+
+func (c *chatLogger) Send(ctx context.Context, m message.Composer) {
+	if c.Level().ShouldLog(m) {
+		if err := c.send(ctx, m); err != nil {
+			c.ErrorHandler()(ctx, err, m)
+		}
+	}
+}
+
+func (c *chatLogger) send(ctx context.Context, m message.Composer) error {
+	raw, ok := m.Raw().(*ChatMessage)
+	if !ok {
+		return errors.Errorf("received unexpected composer %T", m.Raw())
+	}
+
+	client := c.client
+	if client == nil {
+		client = utility.GetHTTPClient()
+		defer utility.PutHTTPClient(client)
+	}
+
+	return utility.Retry(ctx, func() (bool, error) {
+		return raw.post(ctx, client)
+	}, utility.RetryOptions{
+		MaxAttempts: chatRetries + 1,
+		MinDelay:    defaultMinDelay,
+	})
+}
+
+// post makes a single attempt to post the message. It returns whether the post
+// can be retried if it failed.
+func (c *ChatMessage) post(ctx context.Context, client *http.Client) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultChatTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(c.Body))
+	if err != nil {
+		return false, errors.Wrap(err, "creating chat HTTP request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "sending chat message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		err = errors.Errorf("chat webhook response was %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), string(body))
+		// Client errors other than rate limiting won't succeed on retry.
+		canRetry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return canRetry, message.WrapError(err, message.Fields{
+			"message":         "error sending chat notification",
+			"notification_id": c.NotificationID,
+			"status_code":     resp.StatusCode,
+		})
+	}
+
+	return false, nil
+}
+
+func (c *chatLogger) Flush(_ context.Context) error { return nil }
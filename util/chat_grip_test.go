@@ -0,0 +1,113 @@
+package util
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatComposer(t *testing.T) {
+	m := NewChatMessage(ChatMessage{})
+	assert.False(t, m.Loggable())
+
+	m = NewChatMessage(ChatMessage{URL: "https://example.com"})
+	assert.False(t, m.Loggable())
+
+	m = NewChatMessage(ChatMessage{
+		NotificationID: "evergreen",
+		URL:            "https://example.com",
+		Body:           []byte(`{"text":"hi"}`),
+	})
+	assert.True(t, m.Loggable())
+	assert.Equal(t, `{"text":"hi"}`, m.String())
+}
+
+func TestChatSender(t *testing.T) {
+	sender, err := NewChatLogger()
+	require.NoError(t, err)
+	s, ok := sender.(*chatLogger)
+	require.True(t, ok)
+
+	for name, test := range map[string]func(*testing.T, *mockChatTransport){
+		"Succeeds": func(t *testing.T, transport *mockChatTransport) {
+			assert.NoError(t, s.SetErrorHandler(func(_ context.Context, err error, _ message.Composer) {
+				t.Fatal("error handler was called, but shouldn't have been")
+			}))
+			s.Send(t.Context(), NewChatMessage(ChatMessage{
+				NotificationID: "evergreen",
+				URL:            "https://example.com/hook",
+				Body:           []byte(`{"text":"hi"}`),
+			}))
+			assert.Equal(t, 1, transport.attemptCount)
+			assert.Equal(t, "https://example.com/hook", transport.lastURL)
+			assert.Equal(t, `{"text":"hi"}`, transport.lastBody)
+			assert.Equal(t, "application/json", transport.lastContentType)
+		},
+		"RetriesServerErrors": func(t *testing.T, transport *mockChatTransport) {
+			transport.statusCodes = []int{http.StatusInternalServerError, http.StatusTooManyRequests}
+			assert.NoError(t, s.SetErrorHandler(func(_ context.Context, err error, _ message.Composer) {
+				t.Fatal("error handler was called, but shouldn't have been")
+			}))
+			s.Send(t.Context(), NewChatMessage(ChatMessage{
+				URL:  "https://example.com/hook",
+				Body: []byte(`{"text":"hi"}`),
+			}))
+			assert.Equal(t, 3, transport.attemptCount)
+		},
+		"DoesNotRetryClientErrors": func(t *testing.T, transport *mockChatTransport) {
+			transport.statusCodes = []int{http.StatusBadRequest}
+			var capturedErr error
+			assert.NoError(t, s.SetErrorHandler(func(_ context.Context, err error, _ message.Composer) {
+				capturedErr = err
+			}))
+			s.Send(t.Context(), NewChatMessage(ChatMessage{
+				URL:  "https://example.com/hook",
+				Body: []byte(`{"text":"hi"}`),
+			}))
+			assert.Equal(t, 1, transport.attemptCount)
+			assert.ErrorContains(t, capturedErr, "chat webhook response was 400 (Bad Request)")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			transport := &mockChatTransport{}
+			s.client = &http.Client{Transport: transport}
+			test(t, transport)
+		})
+	}
+}
+
+type mockChatTransport struct {
+	// statusCodes are the status codes returned for each attempt. Once they
+	// run out, requests succeed.
+	statusCodes     []int
+	attemptCount    int
+	lastURL         string
+	lastBody        string
+	lastContentType string
+}
+
+func (t *mockChatTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attemptCount++
+	t.lastURL = req.URL.String()
+	t.lastContentType = req.Header.Get("Content-Type")
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.lastBody = string(body)
+
+	statusCode := http.StatusOK
+	if t.attemptCount <= len(t.statusCodes) {
+		statusCode = t.statusCodes[t.attemptCount-1]
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(http.StatusText(statusCode))),
+	}, nil
+}
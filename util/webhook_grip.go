@@ -31,6 +31,21 @@ type EvergreenWebhook struct {
 	Retries        int         `bson:"retries"`
 	MinDelayMS     int         `bson:"min_delay_ms"`
 	TimeoutMS      int         `bson:"timeout_ms"`
+
+	// DeliveryCallback, if set, is called with every attempt made to deliver
+	// the webhook once the webhook is either delivered or out of retries. err
+	// is the final delivery error, if any.
+	DeliveryCallback func(attempts []WebhookAttempt, err error) `bson:"-"`
+}
+
+// WebhookAttempt is the outcome of a single attempt to deliver a webhook.
+type WebhookAttempt struct {
+	Time time.Time `bson:"time" json:"time"`
+	// StatusCode is the HTTP status code of the response, if the webhook
+	// receiver responded.
+	StatusCode int           `bson:"status_code,omitempty" json:"status_code,omitempty"`
+	Latency    time.Duration `bson:"latency" json:"latency"`
+	Error      string        `bson:"error,omitempty" json:"error,omitempty"`
 }
 
 type evergreenWebhookMessage struct {
@@ -150,49 +165,72 @@ func (w *evergreenWebhookLogger) send(m message.Composer) error {
 		client = utility.GetHTTPClient()
 		defer utility.PutHTTPClient(client)
 	}
-	return utility.Retry(context.Background(), func() (bool, error) {
-		req, err := raw.request()
+
+	var attempts []WebhookAttempt
+	err := utility.Retry(context.Background(), func() (bool, error) {
+		attempt := WebhookAttempt{Time: time.Now()}
+		canRetry, err := raw.attempt(client, timeout, &attempt)
+		attempt.Latency = time.Since(attempt.Time)
 		if err != nil {
-			return false, errors.Wrap(err, "making webhook request")
+			attempt.Error = err.Error()
 		}
+		attempts = append(attempts, attempt)
+		return canRetry, err
+	}, utility.RetryOptions{
+		MaxAttempts: raw.Retries + 1,
+		MinDelay:    minDelay,
+	})
 
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
-		defer cancel()
-		req = req.WithContext(ctx)
+	if raw.DeliveryCallback != nil {
+		raw.DeliveryCallback(attempts, err)
+	}
 
-		resp, err := client.Do(req)
-		msgFields := message.Fields{
-			"message":         "error sending webhook notification",
-			"notification_id": raw.NotificationID,
-			"webhook_url":     raw.URL,
-			"is_ctx_err":      utility.IsContextError(ctx.Err()),
-		}
-		if err != nil {
-			return true, message.WrapError(errors.Wrap(err, "sending webhook data"), msgFields)
-		}
+	return err
+}
 
-		defer resp.Body.Close()
+// attempt makes a single attempt to deliver the webhook, recording the
+// response status code in the attempt. It returns whether the delivery can be
+// retried if it failed.
+func (w *EvergreenWebhook) attempt(client *http.Client, timeout time.Duration, attempt *WebhookAttempt) (bool, error) {
+	req, err := w.request()
+	if err != nil {
+		return false, errors.Wrap(err, "making webhook request")
+	}
 
-		msgFields["status_code"] = resp.StatusCode
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req = req.WithContext(ctx)
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return true, message.WrapError(errors.Wrap(err, "reading webhook response"), msgFields)
-		}
-		msgFields["response_body"] = string(body)
+	resp, err := client.Do(req)
+	msgFields := message.Fields{
+		"message":         "error sending webhook notification",
+		"notification_id": w.NotificationID,
+		"webhook_url":     w.URL,
+		"is_ctx_err":      utility.IsContextError(ctx.Err()),
+	}
+	if err != nil {
+		return true, message.WrapError(errors.Wrap(err, "sending webhook data"), msgFields)
+	}
 
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return true, message.WrapError(errors.Errorf("webhook response was %d (%s)", resp.StatusCode, http.StatusText(resp.StatusCode)), msgFields)
-		}
+	defer resp.Body.Close()
 
-		msgFields["message"] = "successfully sent webhook notification"
-		grip.Info(ctx, msgFields)
+	attempt.StatusCode = resp.StatusCode
+	msgFields["status_code"] = resp.StatusCode
 
-		return false, nil
-	}, utility.RetryOptions{
-		MaxAttempts: raw.Retries + 1,
-		MinDelay:    minDelay,
-	})
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, message.WrapError(errors.Wrap(err, "reading webhook response"), msgFields)
+	}
+	msgFields["response_body"] = string(body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return true, message.WrapError(errors.Errorf("webhook response was %d (%s)", resp.StatusCode, http.StatusText(resp.StatusCode)), msgFields)
+	}
+
+	msgFields["message"] = "successfully sent webhook notification"
+	grip.Info(ctx, msgFields)
+
+	return false, nil
 }
 
 func (w *evergreenWebhookLogger) Flush(_ context.Context) error { return nil }
@@ -159,6 +159,63 @@ func TestEvergreenWebhookSender(t *testing.T) {
 			assert.Equal(t, attempts, transport.attemptCount)
 			assert.Equal(t, body, transport.lastBody)
 		},
+		"DeliveryCallbackRecordsAttempts": func(t *testing.T) {
+			transport.minAttempts = 2
+			secret := []byte("hi")
+			transport.secret = secret
+
+			var recorded []WebhookAttempt
+			var deliveryErr error
+			called := false
+			m := NewWebhookMessage(EvergreenWebhook{
+				NotificationID: "evergreen",
+				URL:            "https://example.com",
+				Secret:         secret,
+				Body:           []byte("something important"),
+				Retries:        2,
+				DeliveryCallback: func(attempts []WebhookAttempt, err error) {
+					called = true
+					recorded = attempts
+					deliveryErr = err
+				},
+			})
+			assert.NoError(t, s.SetErrorHandler(func(_ context.Context, err error, _ message.Composer) {
+				t.Fatal("error handler was called, but shouldn't have been")
+			}))
+
+			s.Send(t.Context(), m)
+			require.True(t, called)
+			assert.NoError(t, deliveryErr)
+			require.Len(t, recorded, 2)
+			assert.Equal(t, http.StatusBadRequest, recorded[0].StatusCode)
+			assert.NotEmpty(t, recorded[0].Error)
+			assert.Equal(t, http.StatusNoContent, recorded[1].StatusCode)
+			assert.Empty(t, recorded[1].Error)
+			assert.False(t, recorded[1].Time.IsZero())
+		},
+		"DeliveryCallbackOnExhaustedRetries": func(t *testing.T) {
+			var recorded []WebhookAttempt
+			var deliveryErr error
+			m := NewWebhookMessage(EvergreenWebhook{
+				NotificationID: "evergreen",
+				URL:            "https://example.com",
+				Secret:         []byte("forged secret"),
+				Body:           []byte("something important"),
+				Retries:        1,
+				DeliveryCallback: func(attempts []WebhookAttempt, err error) {
+					recorded = attempts
+					deliveryErr = err
+				},
+			})
+			assert.NoError(t, s.SetErrorHandler(func(_ context.Context, err error, _ message.Composer) {}))
+
+			s.Send(t.Context(), m)
+			assert.Error(t, deliveryErr)
+			require.Len(t, recorded, 2)
+			for _, attempt := range recorded {
+				assert.Equal(t, http.StatusBadRequest, attempt.StatusCode)
+			}
+		},
 	} {
 		transport = mockWebhookTransport{}
 		s.client = &http.Client{
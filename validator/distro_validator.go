@@ -32,11 +32,13 @@ var distroSyntaxValidators = []distroValidator{
 	ensureValidArch,
 	ensureValidBootstrapSettings,
 	ensureValidStaticBootstrapSettings,
+	ensureValidProcessSettings,
 	ensureHasNoUnauthorizedCharacters,
 	ensureHasValidHostAllocatorSettings,
 	ensureHasValidPlannerSettings,
 	ensureHasValidFinderSettings,
 	ensureHasValidDispatcherSettings,
+	ensureHasValidPreemptionSettings,
 	ensureHasValidVirtualWorkstationSettings,
 }
 
@@ -294,6 +296,32 @@ func ensureValidStaticBootstrapSettings(ctx context.Context, d *distro.Distro, s
 	return nil
 }
 
+// ensureValidProcessSettings checks that the process provider is
+// enabled in this build and that process hosts are not bootstrapped, since
+// they run on the app server and cannot be reached over SSH.
+func ensureValidProcessSettings(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
+	if d.Provider != evergreen.ProviderNameProcess {
+		return nil
+	}
+	if !evergreen.ProcessProviderEnabled {
+		return ValidationErrors{
+			{
+				Message: fmt.Sprintf("process distro %s cannot be used because the process provider is not enabled in this build", d.Id),
+				Level:   Error,
+			},
+		}
+	}
+	if d.BootstrapSettings.Method != distro.BootstrapMethodNone {
+		return ValidationErrors{
+			{
+				Message: fmt.Sprintf("process distro %s must use bootstrap method '%s'", d.Id, distro.BootstrapMethodNone),
+				Level:   Error,
+			},
+		}
+	}
+	return nil
+}
+
 func ensureHasNonZeroID(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
 	if d == nil {
 		return ValidationErrors{{Error, "distro cannot be nil"}}
@@ -475,6 +503,35 @@ func ensureHasValidPlannerSettings(ctx context.Context, d *distro.Distro, s *eve
 			Level:   Error,
 		})
 	}
+	shareIDs := map[string]bool{}
+	for _, share := range settings.ProjectShares {
+		if share.ID == "" {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("invalid planner_settings.project_shares for distro '%s' - each share must specify a project or repo ID", d.Id),
+				Level:   Error,
+			})
+			continue
+		}
+		if shareIDs[share.ID] {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("invalid planner_settings.project_shares for distro '%s' - project or repo '%s' has more than one share", d.Id, share.ID),
+				Level:   Error,
+			})
+		}
+		shareIDs[share.ID] = true
+		if share.Share <= 0 {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("invalid planner_settings.project_shares value of %f for project or repo '%s' in distro '%s' - its value must be positive", share.Share, share.ID, d.Id),
+				Level:   Error,
+			})
+		}
+	}
+	if len(settings.ProjectShares) > 0 && settings.Version != evergreen.PlannerVersionFairShare {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("planner_settings.project_shares for distro '%s' only apply to the '%s' planner", d.Id, evergreen.PlannerVersionFairShare),
+			Level:   Warning,
+		})
+	}
 
 	return errs
 }
@@ -507,6 +564,36 @@ func ensureHasValidDispatcherSettings(ctx context.Context, d *distro.Distro, s *
 	return nil
 }
 
+// ensureHasValidPreemptionSettings checks that the distro's PreemptionSettings are valid
+func ensureHasValidPreemptionSettings(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
+	settings := d.PreemptionSettings
+	if !settings.Enabled {
+		return nil
+	}
+
+	errs := ValidationErrors{}
+	if settings.PriorityThreshold <= 0 {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("invalid preemption_settings.priority_threshold value of %d for distro '%s' - its value must be a positive integer", settings.PriorityThreshold, d.Id),
+			Level:   Error,
+		})
+	}
+	if settings.WaitLimit < time.Minute {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("invalid preemption_settings.wait_limit value of %s for distro '%s' - its value must be at least 1 minute", settings.WaitLimit, d.Id),
+			Level:   Error,
+		})
+	}
+	if evergreen.IsContainerProvider(d.Provider) || d.Provider == evergreen.ProviderNameStatic {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("preemption cannot be enabled for distro '%s' because its provider '%s' does not have a maximum number of hosts", d.Id, d.Provider),
+			Level:   Error,
+		})
+	}
+
+	return errs
+}
+
 func ensureHasValidVirtualWorkstationSettings(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
 	if !d.IsVirtualWorkstation {
 		return nil
@@ -537,7 +624,7 @@ func ensureHasValidVirtualWorkstationSettings(ctx context.Context, d *distro.Dis
 func validateAliases(d *distro.Distro, allDistroAliases, allDistroIDs []string) ValidationErrors {
 	var validationErrs ValidationErrors
 	// Parent and container distros do not support aliases.
-	if d.ContainerPool != "" || d.Provider == evergreen.ProviderNameDocker {
+	if d.ContainerPool != "" || evergreen.IsContainerProvider(d.Provider) {
 		validationErrs = append(validationErrs, ensureNoAliases(d, allDistroAliases)...)
 	}
 	if len(d.Aliases) > 0 {
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
@@ -674,6 +675,28 @@ func TestEnsureValidStaticBootstrapSettings(t *testing.T) {
 	assert.NotNil(t, ensureValidStaticBootstrapSettings(ctx, &d, &evergreen.Settings{}))
 }
 
+func TestEnsureValidProcessSettings(t *testing.T) {
+	ctx := context.Background()
+	d := distro.Distro{
+		Provider: evergreen.ProviderNameProcess,
+	}
+	d.BootstrapSettings.Method = distro.BootstrapMethodNone
+	if evergreen.ProcessProviderEnabled {
+		assert.Nil(t, ensureValidProcessSettings(ctx, &d, &evergreen.Settings{}))
+	} else {
+		assert.NotNil(t, ensureValidProcessSettings(ctx, &d, &evergreen.Settings{}), "process provider should not be allowed when it is not enabled in the build")
+	}
+
+	for _, method := range []string{
+		distro.BootstrapMethodLegacySSH,
+		distro.BootstrapMethodSSH,
+		distro.BootstrapMethodUserData,
+	} {
+		d.BootstrapSettings.Method = method
+		assert.NotNil(t, ensureValidProcessSettings(ctx, &d, &evergreen.Settings{}))
+	}
+}
+
 func TestEnsureHasValidVirtualWorkstationSettings(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -698,6 +721,39 @@ func TestEnsureHasValidVirtualWorkstationSettings(t *testing.T) {
 	}, settings))
 }
 
+func TestEnsureHasValidPreemptionSettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	settings := &evergreen.Settings{}
+	assert.Empty(t, ensureHasValidPreemptionSettings(ctx, &distro.Distro{
+		Provider: evergreen.ProviderNameStatic,
+	}, settings))
+	assert.Empty(t, ensureHasValidPreemptionSettings(ctx, &distro.Distro{
+		Provider: evergreen.ProviderNameEc2OnDemand,
+		PreemptionSettings: distro.PreemptionSettings{
+			Enabled:           true,
+			PriorityThreshold: 50,
+			WaitLimit:         30 * time.Minute,
+		},
+	}, settings))
+	assert.Len(t, ensureHasValidPreemptionSettings(ctx, &distro.Distro{
+		Provider: evergreen.ProviderNameEc2OnDemand,
+		PreemptionSettings: distro.PreemptionSettings{
+			Enabled:   true,
+			WaitLimit: time.Second,
+		},
+	}, settings), 2)
+	assert.Len(t, ensureHasValidPreemptionSettings(ctx, &distro.Distro{
+		Provider: evergreen.ProviderNameStatic,
+		PreemptionSettings: distro.PreemptionSettings{
+			Enabled:           true,
+			PriorityThreshold: 50,
+			WaitLimit:         30 * time.Minute,
+		},
+	}, settings), 1)
+}
+
 func TestValidateAliases(t *testing.T) {
 	assert.NotNil(t, validateAliases(&distro.Distro{
 		Id:            "distro",
@@ -386,15 +386,14 @@ func CheckProjectConfigurationIsValid(ctx context.Context, settings *evergreen.S
 	))
 	defer span.End()
 	catcher := grip.NewBasicCatcher()
-	projectErrors := CheckProjectErrors(ctx, project)
-	projectErrors = append(projectErrors, CheckProjectMixedValidations(project).AtLevel(Error)...)
+	projectErrors, settingsErrs := CheckProjectConfiguration(ctx, settings, project, pref)
 	if len(projectErrors) != 0 {
 		if errs := projectErrors.AtLevel(Error); len(errs) != 0 {
 			catcher.Errorf("project contains errors: %s", ValidationErrorsToString(errs))
 		}
 	}
 
-	if settingsErrs := CheckProjectSettings(ctx, settings, project, pref, false); len(settingsErrs) != 0 {
+	if len(settingsErrs) != 0 {
 		if errs := settingsErrs.AtLevel(Error); len(errs) != 0 {
 			catcher.Errorf("project contains errors related to project settings: %s", ValidationErrorsToString(errs))
 		}
@@ -402,6 +401,16 @@ func CheckProjectConfigurationIsValid(ctx context.Context, settings *evergreen.S
 	return catcher.Resolve()
 }
 
+// CheckProjectConfiguration returns the problems with the project
+// configuration itself and the problems with it related to the project
+// settings. The configuration is only valid if neither contains errors.
+func CheckProjectConfiguration(ctx context.Context, settings *evergreen.Settings, project *model.Project, pref *model.ProjectRef) (projectErrs ValidationErrors, settingsErrs ValidationErrors) {
+	projectErrs = CheckProjectErrors(ctx, project)
+	projectErrs = append(projectErrs, CheckProjectMixedValidations(project).AtLevel(Error)...)
+	settingsErrs = CheckProjectSettings(ctx, settings, project, pref, false)
+	return projectErrs, settingsErrs
+}
+
 // ensure that if any task spec references 'model.AllDependencies', it
 // references no other dependency within the variant
 func validateAllDependenciesSpec(project *model.Project) ValidationErrors {
@@ -1980,6 +1989,37 @@ func validateHostCreates(p *model.Project) ValidationErrors {
 	counts := tasksThatCallHostCreateByProvider(p)
 	errs := validateTimesCalledPerTask(p, counts.All, evergreen.HostCreateCommandName, HostCreateLimitPerTask, Error)
 	errs = append(errs, validateHostCreateTotals(p, counts)...)
+	errs = append(errs, validateHostCreateProviders(p)...)
+	return errs
+}
+
+// validateHostCreateProviders checks that host.create is not used with a
+// provider that it cannot create hosts with. Podman hosts can only be created
+// as containers in a container distro, not by host.create.
+func validateHostCreateProviders(p *model.Project) ValidationErrors {
+	errs := ValidationErrors{}
+	checkCmds := func(cmds []model.PluginCommandConf, location string) {
+		for _, c := range cmds {
+			if c.Command != evergreen.HostCreateCommandName {
+				continue
+			}
+			if provider, ok := c.Params["provider"].(string); ok && provider == evergreen.ProviderNamePodman {
+				errs = append(errs, ValidationError{
+					Level:   Error,
+					Message: fmt.Sprintf("%s cannot use %s with provider '%s': only EC2 hosts can be created by %s", location, evergreen.HostCreateCommandName, provider, evergreen.HostCreateCommandName),
+				})
+			}
+		}
+	}
+	for name, cmds := range p.Functions {
+		if cmds == nil {
+			continue
+		}
+		checkCmds(cmds.List(), fmt.Sprintf("function '%s'", name))
+	}
+	for _, t := range p.Tasks {
+		checkCmds(t.Commands, fmt.Sprintf("task '%s'", t.Name))
+	}
 	return errs
 }
 
@@ -4879,6 +4879,42 @@ func TestValidateCreateHosts(t *testing.T) {
 	assert.Len(errs, 1)
 }
 
+func TestValidateHostCreateProviders(t *testing.T) {
+	yml := `
+  functions:
+    podman_host:
+    - command: host.create
+      params:
+        provider: podman
+  tasks:
+  - name: t_1
+    commands:
+    - func: podman_host
+    - command: host.create
+      params:
+        provider: podman
+    - command: host.create
+      params:
+        distro: d
+  buildvariants:
+  - name: "bv"
+    display_name: "bv_display"
+    tasks:
+    - name: t_1
+  `
+	var p model.Project
+	pp, err := model.LoadProjectInto(t.Context(), []byte(yml), nil, "id", &p)
+	require.NoError(t, err)
+	require.NotNil(t, pp)
+
+	errs := validateHostCreateProviders(&p)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.Equal(t, Error, err.Level)
+		assert.Contains(t, err.Message, "provider 'podman'")
+	}
+}
+
 func TestValidateParameters(t *testing.T) {
 	p := &model.Project{
 		Parameters: []model.ParameterInfo{